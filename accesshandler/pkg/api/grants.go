@@ -3,6 +3,7 @@ package api
 import (
 	"encoding/json"
 	"net/http"
+	"sort"
 
 	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/apikit/logger"
@@ -62,6 +63,42 @@ func (a *API) PostGrants(w http.ResponseWriter, r *http.Request) {
 	apio.JSON(ctx, w, res, http.StatusCreated)
 }
 
+// Check grant
+// (POST /api/v1/grants/check)
+func (a *API) CheckGrant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b types.CheckGrantJSONRequestBody
+
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	prov, ok := config.Providers[b.Provider]
+	if !ok {
+		apio.ErrorString(ctx, w, "provider not found", http.StatusBadRequest)
+		return
+	}
+
+	checker, ok := prov.Provider.(providers.IsActiver)
+	if !ok {
+		apio.JSON(ctx, w, types.GrantCheckResponse{Supported: false}, http.StatusOK)
+		return
+	}
+	args, err := json.Marshal(b.With.AdditionalProperties)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	active, err := checker.IsActive(ctx, string(b.Subject), args, b.ID)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	apio.JSON(ctx, w, types.GrantCheckResponse{Supported: true, Active: active}, http.StatusOK)
+}
+
 // Revoke grant
 // (POST /api/v1/grants/{grantId}/revoke)
 func (a *API) PostGrantsRevoke(w http.ResponseWriter, r *http.Request, grantId string) {
@@ -116,8 +153,8 @@ func (a *API) ValidateGrant(w http.ResponseWriter, r *http.Request) {
 
 	validator, ok := prov.Provider.(providers.GrantValidator)
 	if !ok {
-		// provider doesn't implement validation, so just return a HTTP OK response with an empty validation
-		apio.JSON(ctx, w, nil, http.StatusOK)
+		// provider doesn't implement validation, so there are no steps to report
+		apio.JSON(ctx, w, types.GrantValidationResponse{Supported: false, Validations: []types.GrantValidation{}}, http.StatusOK)
 		return
 	}
 	args, err := json.Marshal(b.With.AdditionalProperties)
@@ -128,11 +165,33 @@ func (a *API) ValidateGrant(w http.ResponseWriter, r *http.Request) {
 	// the provider implements validation, so try and validate the request
 	validationSteps := validator.ValidateGrant()
 	validationResult := validationSteps.Run(ctx, string(b.Subject), args)
-	if m := validationResult.FailureMessage(); m != "" {
-		apio.Error(ctx, w, apio.NewRequestError(errors.New(m), http.StatusBadRequest))
-		log.Errorw("validate grant failed", "validation", validationResult)
-		return
+	if validationResult.Failed() {
+		log.Infow("validate grant failed", "validation", validationResult)
+	}
+
+	res := types.GrantValidationResponse{Supported: true, Validations: []types.GrantValidation{}}
+	for id, v := range validationResult {
+		result := types.GrantValidation{
+			Id:     id,
+			Name:   v.Name,
+			Status: types.PASSED,
+			Logs:   []types.Log{},
+		}
+		if !v.Logs.HasSucceeded() {
+			result.Status = types.FAILED
+		}
+		for _, l := range v.Logs {
+			result.Logs = append(result.Logs, types.Log{
+				Level: types.LogLevel(l.Level),
+				Msg:   l.Msg,
+			})
+		}
+		res.Validations = append(res.Validations, result)
 	}
+	// steps are run in parallel, so they're sorted to give a stable response.
+	sort.Slice(res.Validations, func(i, j int) bool {
+		return res.Validations[i].Id < res.Validations[j].Id
+	})
 
-	apio.JSON(ctx, w, nil, http.StatusOK)
+	apio.JSON(ctx, w, res, http.StatusOK)
 }
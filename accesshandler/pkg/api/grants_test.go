@@ -1,8 +1,10 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -12,6 +14,8 @@ import (
 	"github.com/benbjohnson/clock"
 	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/common-fate/accesshandler/pkg/config"
+	"github.com/common-fate/common-fate/accesshandler/pkg/diagnostics"
+	"github.com/common-fate/common-fate/accesshandler/pkg/providers"
 	"github.com/common-fate/common-fate/accesshandler/pkg/providers/okta"
 	"github.com/common-fate/common-fate/accesshandler/pkg/providers/testvault"
 	"github.com/common-fate/iso8601"
@@ -145,6 +149,7 @@ func TestValidateGrant(t *testing.T) {
 		body     string
 		wantCode int
 		wantErr  string
+		wantBody string
 	}
 
 	TenAM := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
@@ -157,7 +162,8 @@ func TestValidateGrant(t *testing.T) {
 
 	testcases := []testcase{
 		{name: "400 response if provider doesn't exist", body: fmt.Sprintf(`{"id":"abcd","subject":"chris@commonfate.io","provider":"no-provider","with":{"group":"Admins"},"start":"%s","end":"%s"}`, TenAMISO8601, TenThirtyAMISO8601), wantCode: http.StatusBadRequest, wantErr: "provider not found"},
-		{name: "200 response if provider doesn't implement validate", body: fmt.Sprintf(`{"id":"abcd","subject":"chris@commonfate.io","provider":"testvault","with":{"group":"Admins"},"start":"%s","end":"%s"}`, TenAMISO8601, TenThirtyAMISO8601), wantCode: http.StatusOK, wantErr: ""},
+		{name: "200 response if provider doesn't implement validate", body: fmt.Sprintf(`{"id":"abcd","subject":"chris@commonfate.io","provider":"testvault","with":{"group":"Admins"},"start":"%s","end":"%s"}`, TenAMISO8601, TenThirtyAMISO8601), wantCode: http.StatusOK, wantErr: "", wantBody: `{"supported":false,"validations":[]}`},
+		{name: "failed steps are returned", body: fmt.Sprintf(`{"id":"abcd","subject":"josh@commonfate.io","provider":"validating","with":{"group":"Admins"},"start":"%s","end":"%s"}`, TenAMISO8601, TenThirtyAMISO8601), wantCode: http.StatusOK, wantErr: "", wantBody: `{"supported":true,"validations":[{"id":"group-exists","logs":[{"level":"INFO","msg":"group exists"}],"name":"We couldn't find the group","status":"PASSED"},{"id":"user-exists","logs":[{"level":"ERROR","msg":"could not find user josh@commonfate.io"}],"name":"We couldn't find your user","status":"FAILED"}]}`},
 	}
 	config.ConfigureTestProviders([]config.Provider{
 		{
@@ -170,6 +176,11 @@ func TestValidateGrant(t *testing.T) {
 			Type:     "testvault",
 			Provider: &testvault.Provider{},
 		},
+		{
+			ID:       "validating",
+			Type:     "validating",
+			Provider: &validatingProvider{users: []string{"chris@commonfate.io"}},
+		},
 	})
 	for i := range testcases {
 		tc := testcases[i]
@@ -188,10 +199,122 @@ func TestValidateGrant(t *testing.T) {
 			handler.ServeHTTP(rr, req)
 
 			assert.Equal(t, tc.wantCode, rr.Code)
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
 			var apiErr apio.ErrorResponse
 
-			_ = json.NewDecoder(rr.Body).Decode(&apiErr)
+			_ = json.Unmarshal(data, &apiErr)
 			assert.Equal(t, tc.wantErr, apiErr.Error)
+			if tc.wantBody != "" {
+				assert.Equal(t, tc.wantBody, string(data))
+			}
+		})
+	}
+}
+
+// validatingProvider is a provider which validates that the subject of a grant is one of its users.
+type validatingProvider struct {
+	testvault.Provider
+	users []string
+}
+
+func (p *validatingProvider) ValidateGrant() providers.GrantValidationSteps {
+	return providers.GrantValidationSteps{
+		"user-exists": {
+			UserErrorMessage: "We couldn't find your user",
+			Run: func(ctx context.Context, subject string, args []byte) diagnostics.Logs {
+				for _, u := range p.users {
+					if u == subject {
+						return diagnostics.Info("user exists")
+					}
+				}
+				return diagnostics.Error(fmt.Errorf("could not find user %s", subject))
+			},
+		},
+		"group-exists": {
+			UserErrorMessage: "We couldn't find the group",
+			Run: func(ctx context.Context, subject string, args []byte) diagnostics.Logs {
+				return diagnostics.Info("group exists")
+			},
+		},
+	}
+}
+
+// checkingProvider is a provider which reports that the assigned subjects have access.
+type checkingProvider struct {
+	testvault.Provider
+	assigned []string
+}
+
+func (p *checkingProvider) IsActive(ctx context.Context, subject string, args []byte, grantID string) (bool, error) {
+	for _, a := range p.assigned {
+		if a == subject {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (p *checkingProvider) ListAssignments(ctx context.Context, args []byte) ([]string, error) {
+	return p.assigned, nil
+}
+
+func TestCheckGrant(t *testing.T) {
+	type testcase struct {
+		name     string
+		body     string
+		wantCode int
+		wantBody string
+	}
+
+	testcases := []testcase{
+		{name: "active", body: `{"id":"abcd","subject":"chris@commonfate.io","provider":"checking","with":{"group":"Admins"},"start":"2022-01-01T10:00:00Z","end":"2022-01-01T10:30:00Z","status":"ACTIVE"}`, wantCode: http.StatusOK, wantBody: `{"active":true,"supported":true}`},
+		{name: "removed", body: `{"id":"abcd","subject":"josh@commonfate.io","provider":"checking","with":{"group":"Admins"},"start":"2022-01-01T10:00:00Z","end":"2022-01-01T10:30:00Z","status":"ACTIVE"}`, wantCode: http.StatusOK, wantBody: `{"active":false,"supported":true}`},
+		{name: "provider doesn't support checking", body: `{"id":"abcd","subject":"chris@commonfate.io","provider":"noop","with":{"group":"Admins"},"start":"2022-01-01T10:00:00Z","end":"2022-01-01T10:30:00Z","status":"ACTIVE"}`, wantCode: http.StatusOK, wantBody: `{"active":false,"supported":false}`},
+		{name: "provider doesn't exist", body: `{"id":"abcd","subject":"chris@commonfate.io","provider":"no-provider","with":{"group":"Admins"},"start":"2022-01-01T10:00:00Z","end":"2022-01-01T10:30:00Z","status":"ACTIVE"}`, wantCode: http.StatusBadRequest, wantBody: `{"error":"provider not found"}`},
+	}
+	config.ConfigureTestProviders([]config.Provider{
+		{
+			ID:       "checking",
+			Type:     "testvault",
+			Provider: &checkingProvider{assigned: []string{"chris@commonfate.io"}},
+		},
+		{
+			ID:       "noop",
+			Type:     "testvault",
+			Provider: &noopProvider{},
+		},
+	})
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			handler := newTestServer(t)
+
+			req, err := http.NewRequest("POST", "/api/v1/grants/check", strings.NewReader(tc.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			assert.JSONEq(t, tc.wantBody, rr.Body.String())
 		})
 	}
 }
+
+// noopProvider implements only the bare minimum provider interface.
+type noopProvider struct{}
+
+func (p *noopProvider) Grant(ctx context.Context, subject string, args []byte, grantID string) error {
+	return nil
+}
+
+func (p *noopProvider) Revoke(ctx context.Context, subject string, args []byte, grantID string) error {
+	return nil
+}
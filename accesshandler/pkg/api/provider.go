@@ -1,6 +1,7 @@
 package api
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
 	"sort"
@@ -101,3 +102,38 @@ func (a *API) RefreshAccessProviders(w http.ResponseWriter, r *http.Request) {
 	sort.Slice(listProvidersResponse, func(i, j int) bool { return listProvidersResponse[i].Id < listProvidersResponse[j].Id })
 	apio.JSON(r.Context(), w, listProvidersResponse, http.StatusOK)
 }
+
+func (a *API) ListProviderAssignments(w http.ResponseWriter, r *http.Request, providerId string) {
+	ctx := r.Context()
+	prov, ok := config.Providers[providerId]
+	if !ok {
+		apio.Error(ctx, w, apio.NewRequestError(&providers.ProviderNotFoundError{Provider: providerId}, http.StatusNotFound))
+		return
+	}
+	var b types.ListProviderAssignmentsJSONRequestBody
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	lister, ok := prov.Provider.(providers.AssignmentLister)
+	if !ok {
+		apio.JSON(ctx, w, types.AssignmentsResponse{Supported: false, Subjects: []string{}}, http.StatusOK)
+		return
+	}
+	args, err := json.Marshal(b.With.AdditionalProperties)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	subjects, err := lister.ListAssignments(ctx, args)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	if subjects == nil {
+		subjects = []string{}
+	}
+	apio.JSON(ctx, w, types.AssignmentsResponse{Supported: true, Subjects: subjects}, http.StatusOK)
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/common-fate/apikit/apio"
@@ -247,3 +248,49 @@ func TestListProviderArgOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestListProviderAssignments(t *testing.T) {
+	type testcase struct {
+		name       string
+		providerID string
+		wantCode   int
+		wantBody   string
+	}
+
+	testcases := []testcase{
+		{name: "ok", providerID: "checking", wantCode: http.StatusOK, wantBody: `{"supported":true,"subjects":["chris@commonfate.io"]}`},
+		{name: "provider doesn't support listing assignments", providerID: "noop", wantCode: http.StatusOK, wantBody: `{"supported":false,"subjects":[]}`},
+		{name: "provider not found", providerID: "other", wantCode: http.StatusNotFound, wantBody: `{"error":"no provider found matching: other"}`},
+	}
+	config.ConfigureTestProviders([]config.Provider{
+		{
+			ID:       "checking",
+			Type:     "testvault",
+			Provider: &checkingProvider{assigned: []string{"chris@commonfate.io"}},
+		},
+		{
+			ID:       "noop",
+			Type:     "testvault",
+			Provider: &noopProvider{},
+		},
+	})
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			handler := newTestServer(t)
+
+			req, err := http.NewRequest("POST", "/api/v1/providers/"+tc.providerID+"/assignments", strings.NewReader(`{"with":{"group":"Admins"}}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			assert.JSONEq(t, tc.wantBody, rr.Body.String())
+		})
+	}
+}
@@ -23,19 +23,13 @@ func newTestServer(t *testing.T, opts ...func(a *API)) http.Handler {
 	// zaptest outputs logs if a test fails.
 	log := zaptest.NewLogger(t)
 
-	rt := &local.Runtime{}
-	err := rt.Init(context.Background())
-	if err != nil {
-		t.Fatal(err)
-	}
 	clk := clock.NewMock()
 
 	// default test time is 1st Jan 2022, 10:00am UTC
 	clk.Set(time.Date(2022, 01, 01, 10, 0, 0, 0, time.UTC))
 
 	a := API{
-		runtime: rt,
-		Clock:   clk,
+		Clock: clk,
 	}
 
 	// apply any option functions
@@ -43,6 +37,14 @@ func newTestServer(t *testing.T, opts ...func(a *API)) http.Handler {
 		o(&a)
 	}
 
+	// the runtime shares the clock of the API, so that tests can advance grants through their lifecycle.
+	rt := &local.Runtime{Clock: a.Clock}
+	err := rt.Init(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.runtime = rt
+
 	swagger, err := types.GetSwagger()
 	if err != nil {
 		t.Fatal(err)
@@ -217,6 +217,61 @@ func (p *Provider) IsActive(ctx context.Context, subject string, args []byte, gr
 	return false, nil
 }
 
+// ListAssignments returns the email addresses of the users who are assigned the permission set in the account.
+// Assignments to groups are not included, as Common Fate only assigns permission sets to users.
+func (p *Provider) ListAssignments(ctx context.Context, args []byte) ([]string, error) {
+	var a Args
+	err := json.Unmarshal(args, &a)
+	if err != nil {
+		return nil, err
+	}
+
+	var emails []string
+	hasMore := true
+	var nextToken *string
+	for hasMore {
+		res, err := p.client.ListAccountAssignments(ctx, &ssoadmin.ListAccountAssignmentsInput{
+			AccountId:        &a.AccountID,
+			InstanceArn:      aws.String(p.instanceARN.Get()),
+			PermissionSetArn: &a.PermissionSetARN,
+			NextToken:        nextToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, aa := range res.AccountAssignments {
+			if aa.PrincipalType != types.PrincipalTypeUser {
+				continue
+			}
+			user, err := p.idStoreClient.DescribeUser(ctx, &identitystore.DescribeUserInput{
+				IdentityStoreId: aws.String(p.identityStoreID.Get()),
+				UserId:          aa.PrincipalId,
+			})
+			if err != nil {
+				return nil, err
+			}
+			emails = append(emails, userEmail(user.Emails, aws.ToString(user.UserName)))
+		}
+		nextToken = res.NextToken
+		hasMore = nextToken != nil
+	}
+	return emails, nil
+}
+
+// userEmail returns the primary email address of an AWS SSO user.
+// The username is returned if the user doesn't have an email address, as usernames are usually emails.
+func userEmail(emails []idtypes.Email, username string) string {
+	for _, e := range emails {
+		if e.Primary {
+			return aws.ToString(e.Value)
+		}
+	}
+	if len(emails) > 0 {
+		return aws.ToString(emails[0].Value)
+	}
+	return username
+}
+
 // getUser retrieves the AWS SSO user from a provided email address.
 func (p *Provider) getUser(ctx context.Context, email string) (*idtypes.User, error) {
 	res, err := p.idStoreClient.ListUsers(ctx, &identitystore.ListUsersInput{
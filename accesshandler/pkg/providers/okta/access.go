@@ -58,7 +58,7 @@ func (p *Provider) IsActive(ctx context.Context, subject string, args []byte, gr
 		return false, err
 	}
 
-	users, _, err := p.client.Group.ListGroupUsers(ctx, a.GroupID, nil)
+	users, err := p.listGroupUsers(ctx, a.GroupID)
 	if err != nil {
 		return false, err
 	}
@@ -67,6 +67,48 @@ func (p *Provider) IsActive(ctx context.Context, subject string, args []byte, gr
 	return exists, nil
 }
 
+// ListAssignments returns the email addresses of the members of the Okta group.
+func (p *Provider) ListAssignments(ctx context.Context, args []byte) ([]string, error) {
+	var a Args
+	err := json.Unmarshal(args, &a)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := p.listGroupUsers(ctx, a.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	var emails []string
+	for _, u := range users {
+		if u.Profile == nil {
+			continue
+		}
+		if email, ok := (*u.Profile)["email"].(string); ok {
+			emails = append(emails, email)
+		}
+	}
+	return emails, nil
+}
+
+// listGroupUsers lists all members of the Okta group, following each page of results.
+func (p *Provider) listGroupUsers(ctx context.Context, groupID string) ([]*okta.User, error) {
+	users, res, err := p.client.Group.ListGroupUsers(ctx, groupID, nil)
+	if err != nil {
+		return nil, err
+	}
+	for res.HasNextPage() {
+		var nextUsers []*okta.User
+		res, err = res.Next(ctx, &nextUsers)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, nextUsers...)
+	}
+	return users, nil
+}
+
 func (p *Provider) getUserByEmail(ctx context.Context, email string) (*okta.User, error) {
 	users, _, err := p.client.User.ListUsers(ctx, &query.Params{
 		Search: fmt.Sprintf("profile.email eq \"%s\"", email),
@@ -19,6 +19,19 @@ type Accessor interface {
 	Revoke(ctx context.Context, subject string, args []byte, grantID string) error
 }
 
+// IsActivers can check whether access which was granted still exists in the provider.
+// This is used to detect access which has been removed outside of Common Fate.
+type IsActiver interface {
+	IsActive(ctx context.Context, subject string, args []byte, grantID string) (bool, error)
+}
+
+// AssignmentListers can list the users who currently have access to a target in the provider,
+// such as the members of a group. This is used to detect access which wasn't granted by Common Fate.
+type AssignmentLister interface {
+	// ListAssignments returns the email addresses of the users who have access to the target described by args.
+	ListAssignments(ctx context.Context, args []byte) ([]string, error)
+}
+
 // AccessTokeners can indicate whether they need an access token to be generated
 // as part of the access workflow.
 //
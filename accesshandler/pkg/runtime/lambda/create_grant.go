@@ -10,7 +10,6 @@ import (
 
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/common-fate/accesshandler/pkg/types"
-	"github.com/common-fate/common-fate/pkg/gevent"
 )
 
 // WorkflowInput is the input to the Step Functions workflow execution
@@ -48,19 +47,11 @@ func (r *Runtime) CreateGrant(ctx context.Context, vcg types.ValidCreateGrant) (
 	}
 
 	//running the step function
+	// the grant created event is emitted by the first step of the workflow.
 	_, err = sfnClient.StartExecution(ctx, sei)
 	if err != nil {
 		return types.Grant{}, err
 	}
-	eventsBus, err := gevent.NewSender(ctx, gevent.SenderOpts{EventBusARN: r.EventBusArn})
-	if err != nil {
-		return types.Grant{}, err
-	}
-	evt := &gevent.GrantCreated{Grant: grant}
-	err = eventsBus.Put(ctx, evt)
-	if err != nil {
-		return types.Grant{}, err
-	}
 
 	return grant, nil
 }
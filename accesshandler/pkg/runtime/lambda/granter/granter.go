@@ -24,6 +24,8 @@ type Granter struct {
 type EventType string
 
 const (
+	// CREATE is the first step of the workflow, which emits the event for the grant being created.
+	CREATE     EventType = "CREATE"
 	ACTIVATE   EventType = "ACTIVATE"
 	DEACTIVATE EventType = "DEACTIVATE"
 )
@@ -67,6 +69,9 @@ func (g *Granter) HandleRequest(ctx context.Context, in InputEvent) (Output, err
 	grant := in.Grant
 	log := g.rawLog.With("grant.id", grant.ID)
 	log.Infow("Handling event", "event", in)
+	if in.Action == CREATE {
+		return g.create(ctx, in)
+	}
 	prov, ok := config.Providers[grant.Provider]
 	if !ok {
 		return Output{}, &providers.ProviderNotFoundError{Provider: grant.Provider}
@@ -115,7 +120,11 @@ func (g *Granter) HandleRequest(ctx context.Context, in InputEvent) (Output, err
 		log.Errorf("error while handling granter event", "error", err.Error(), "event", in)
 		grant.Status = types.GrantStatusERROR
 
-		eventErr := eventsBus.Put(ctx, gevent.GrantFailed{Grant: grant, Reason: err.Error()})
+		eventErr := eventsBus.Put(ctx, gevent.GrantFailed{
+			Grant:          grant,
+			Reason:         err.Error(),
+			IdempotencyKey: gevent.GrantIdempotencyKey(grant.ID, gevent.GrantFailedType, string(in.Action)),
+		})
 		if eventErr != nil {
 			return Output{}, errors.Wrapf(err, "failed to emit event, emit error: %s", eventErr.Error())
 		}
@@ -127,10 +136,10 @@ func (g *Granter) HandleRequest(ctx context.Context, in InputEvent) (Output, err
 	switch in.Action {
 	case ACTIVATE:
 		grant.Status = types.GrantStatusACTIVE
-		evt = &gevent.GrantActivated{Grant: grant}
+		evt = &gevent.GrantActivated{Grant: grant, IdempotencyKey: gevent.GrantIdempotencyKey(grant.ID, gevent.GrantActivatedType, string(in.Action))}
 	case DEACTIVATE:
 		grant.Status = types.GrantStatusEXPIRED
-		evt = &gevent.GrantExpired{Grant: grant}
+		evt = &gevent.GrantExpired{Grant: grant, IdempotencyKey: gevent.GrantIdempotencyKey(grant.ID, gevent.GrantExpiredType, string(in.Action))}
 	}
 
 	log.Infow("emitting event", "event", evt, "action", in.Action)
@@ -144,3 +153,19 @@ func (g *Granter) HandleRequest(ctx context.Context, in InputEvent) (Output, err
 	}
 	return o, nil
 }
+
+// create emits the event for the grant being created.
+// It is emitted by the workflow so that it is always emitted before the grant is activated.
+func (g *Granter) create(ctx context.Context, in InputEvent) (Output, error) {
+	eventsBus, err := gevent.NewSender(ctx, gevent.SenderOpts{EventBusARN: g.cfg.EventBusArn})
+	if err != nil {
+		return Output{}, err
+	}
+	grant := in.Grant
+	grant.Status = types.GrantStatusPENDING
+	err = eventsBus.Put(ctx, &gevent.GrantCreated{Grant: grant, IdempotencyKey: gevent.GrantIdempotencyKey(grant.ID, gevent.GrantCreatedType, string(in.Action))})
+	if err != nil {
+		return Output{}, err
+	}
+	return Output{Grant: grant}, nil
+}
@@ -2,36 +2,110 @@ package local
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/gevent"
 )
 
+var errGrantNotFound = errors.New("grant not found")
+
 // CreateGrant creates a new grant.
 func (r *Runtime) CreateGrant(ctx context.Context, vcg types.ValidCreateGrant) (types.Grant, error) {
 	grant := types.NewGrant(vcg)
 	logger.Get(ctx).Infow("creating grant", "grant", grant)
 
-	tx := r.db.Txn(true)
-	defer tx.Commit()
-	err := tx.Insert("grants", &grant)
+	err := r.save(grant)
 	if err != nil {
 		return types.Grant{}, err
 	}
 
-	go func() {
-		ctx := context.Background()
-		waitFor := time.Until(grant.Start.Time)
-		time.Sleep(waitFor)
+	r.put(ctx, &gevent.GrantCreated{Grant: grant, IdempotencyKey: gevent.GrantIdempotencyKey(grant.ID, gevent.GrantCreatedType, "CREATE")})
 
-		logger.Get(ctx).Infow("activating grant", "grant", grant)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.timers[grant.ID] = r.Clock.AfterFunc(r.until(grant.Start.Time), func() {
+		r.transition(grant.ID, types.GrantStatusPENDING, types.GrantStatusACTIVE)
+	})
 
-		dur := grant.End.Sub(grant.Start.Time)
-		time.Sleep(dur)
+	return grant, nil
+}
 
+// transition moves a grant from one status to another, if it is still in the from status.
+// Grants which become active are scheduled to expire at their end time.
+func (r *Runtime) transition(grantID string, from types.GrantStatus, to types.GrantStatus) {
+	ctx := context.Background()
+	grant, err := r.get(grantID)
+	if err != nil || grant.Status != from {
+		return
+	}
+	if to == types.GrantStatusACTIVE {
+		logger.Get(ctx).Infow("activating grant", "grant", grant)
+	} else {
 		logger.Get(ctx).Infow("deactivating grant", "grant", grant)
-	}()
+	}
+	grant.Status = to
+	err = r.save(*grant)
+	if err != nil {
+		logger.Get(ctx).Errorw("failed to update grant", "grant", grant, "error", err)
+		return
+	}
 
-	return grant, nil
+	r.mu.Lock()
+	if to == types.GrantStatusACTIVE {
+		r.timers[grantID] = r.Clock.AfterFunc(r.until(grant.End.Time), func() {
+			r.transition(grantID, types.GrantStatusACTIVE, types.GrantStatusEXPIRED)
+		})
+	} else {
+		delete(r.timers, grantID)
+	}
+	r.mu.Unlock()
+
+	if to == types.GrantStatusACTIVE {
+		r.put(ctx, &gevent.GrantActivated{Grant: *grant, IdempotencyKey: gevent.GrantIdempotencyKey(grantID, gevent.GrantActivatedType, "ACTIVATE")})
+	} else {
+		r.put(ctx, &gevent.GrantExpired{Grant: *grant, IdempotencyKey: gevent.GrantIdempotencyKey(grantID, gevent.GrantExpiredType, "DEACTIVATE")})
+	}
+}
+
+// put emits an event for a grant if an event bus is configured.
+// Events are emitted without holding the lock, so that the event handler can call back into the runtime.
+func (r *Runtime) put(ctx context.Context, evt gevent.EventTyper) {
+	if r.Eventbus == nil {
+		return
+	}
+	err := r.Eventbus.Put(ctx, evt)
+	if err != nil {
+		logger.Get(ctx).Errorw("failed to emit event", "event", evt, "error", err)
+	}
+}
+
+func (r *Runtime) get(grantID string) (*types.Grant, error) {
+	tx := r.db.Txn(false)
+	defer tx.Abort()
+	raw, err := tx.First("grants", "id", grantID)
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, errGrantNotFound
+	}
+	grant := *raw.(*types.Grant)
+	return &grant, nil
+}
+
+func (r *Runtime) save(grant types.Grant) error {
+	tx := r.db.Txn(true)
+	defer tx.Commit()
+	return tx.Insert("grants", &grant)
+}
+
+func (r *Runtime) until(t time.Time) time.Duration {
+	d := t.Sub(r.Clock.Now())
+	if d < 0 {
+		return 0
+	}
+	return d
 }
@@ -5,8 +5,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/common-fate/iso8601"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestCreateGrant(t *testing.T) {
@@ -39,3 +42,74 @@ func TestCreateGrant(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestGrantLifecycle(t *testing.T) {
+	ctx := context.Background()
+	clk := clock.NewMock()
+	clk.Set(time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC))
+	events := &eventRecorder{}
+	r := Runtime{Clock: clk, Eventbus: events}
+
+	err := r.Init(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	create := func(id string) {
+		g := types.CreateGrant{
+			Id:       id,
+			Provider: "test",
+			Subject:  "test@acme.com",
+			Start:    iso8601.New(clk.Now().Add(time.Minute)),
+			End:      iso8601.New(clk.Now().Add(time.Hour)),
+		}
+		vcg, err := g.Validate(ctx, clk.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = r.CreateGrant(ctx, *vcg)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	status := func(id string) types.GrantStatus {
+		g, err := r.get(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return g.Status
+	}
+
+	create("expires")
+	create("revoked")
+	assert.Equal(t, types.GrantStatusPENDING, status("expires"))
+
+	clk.Add(time.Minute)
+	assert.Equal(t, types.GrantStatusACTIVE, status("expires"))
+	assert.Equal(t, types.GrantStatusACTIVE, status("revoked"))
+
+	_, err = r.RevokeGrant(ctx, "revoked", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clk.Add(time.Hour)
+	assert.Equal(t, types.GrantStatusEXPIRED, status("expires"))
+	assert.Equal(t, types.GrantStatusREVOKED, status("revoked"))
+	// revocations are emitted by Common Fate rather than the runtime.
+	assert.ElementsMatch(t, []string{
+		gevent.GrantCreatedType, gevent.GrantCreatedType,
+		gevent.GrantActivatedType, gevent.GrantActivatedType,
+		gevent.GrantExpiredType,
+	}, events.events)
+}
+
+// eventRecorder records the types of the events which are emitted.
+type eventRecorder struct {
+	events []string
+}
+
+func (e *eventRecorder) Put(ctx context.Context, detail gevent.EventTyper) error {
+	e.events = append(e.events, detail.EventType())
+	return nil
+}
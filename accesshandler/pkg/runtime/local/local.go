@@ -2,18 +2,59 @@ package local
 
 import (
 	"context"
+	"sync"
 
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/hashicorp/go-memdb"
+	"github.com/sethvargo/go-envconfig"
 )
 
 // Runtime is a local runtime for testing use only which executes
-// grants using goroutines. It stores grants in memory.
+// grants using timers on Clock. It stores grants in memory.
+//
+// When Clock is a mock clock, grants are only activated and deactivated
+// when the clock is advanced, which makes tests deterministic.
 type Runtime struct {
-	db *memdb.MemDB
+	// Clock defaults to the system clock if it isn't set.
+	Clock clock.Clock
+	// Eventbus receives the events for grants being created, activated and expiring,
+	// which are emitted by the grant workflows in the lambda runtime.
+	// If it isn't set, events are sent to the COMMONFATE_EVENT_BUS_ARN event bus if it is configured.
+	Eventbus EventPutter
+	db       *memdb.MemDB
+
+	mu sync.Mutex
+	// timers activate pending grants and deactivate active grants, by grant ID.
+	timers map[string]*clock.Timer
+}
+
+type EventPutter interface {
+	Put(ctx context.Context, detail gevent.EventTyper) error
 }
 
 // Init initialises the runtime and sets up the in-memory storage.
 func (r *Runtime) Init(ctx context.Context) error {
+	if r.Clock == nil {
+		r.Clock = clock.New()
+	}
+	if r.Eventbus == nil {
+		var cfg struct {
+			EventBusArn string `env:"COMMONFATE_EVENT_BUS_ARN"`
+		}
+		err := envconfig.Process(ctx, &cfg)
+		if err != nil {
+			return err
+		}
+		if cfg.EventBusArn != "" {
+			sender, err := gevent.NewSender(ctx, gevent.SenderOpts{EventBusARN: cfg.EventBusArn})
+			if err != nil {
+				return err
+			}
+			r.Eventbus = sender
+		}
+	}
+	r.timers = make(map[string]*clock.Timer)
 	schema := &memdb.DBSchema{
 		Tables: map[string]*memdb.TableSchema{
 			"grants": {
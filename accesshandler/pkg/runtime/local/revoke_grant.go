@@ -7,9 +7,29 @@ import (
 	"github.com/common-fate/common-fate/accesshandler/pkg/types"
 )
 
-func (r *Runtime) RevokeGrant(ctx context.Context, grant string, revoker string) (*types.Grant, error) {
+func (r *Runtime) RevokeGrant(ctx context.Context, grantID string, revoker string) (*types.Grant, error) {
 
-	logger.Get(ctx).Infow("revoking grant", "grant", grant, "revoker", revoker)
+	logger.Get(ctx).Infow("revoking grant", "grant", grantID, "revoker", revoker)
 
-	return &types.Grant{}, nil
+	grant, err := r.get(grantID)
+	if err == errGrantNotFound {
+		// the local runtime doesn't require the grant to exist, as grants aren't persisted between runs.
+		return &types.Grant{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if t, ok := r.timers[grantID]; ok {
+		t.Stop()
+		delete(r.timers, grantID)
+	}
+	grant.Status = types.GrantStatusREVOKED
+	err = r.save(*grant)
+	if err != nil {
+		return nil, err
+	}
+	return grant, nil
 }
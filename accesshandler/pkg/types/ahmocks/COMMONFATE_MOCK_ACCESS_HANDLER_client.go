@@ -36,6 +36,46 @@ func (m *MockClientWithResponsesInterface) EXPECT() *MockClientWithResponsesInte
 	return m.recorder
 }
 
+// CheckGrantWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) CheckGrantWithBodyWithResponse(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 ...types.RequestEditorFn) (*types.CheckGrantResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckGrantWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.CheckGrantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckGrantWithBodyWithResponse indicates an expected call of CheckGrantWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) CheckGrantWithBodyWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckGrantWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).CheckGrantWithBodyWithResponse), varargs...)
+}
+
+// CheckGrantWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) CheckGrantWithResponse(arg0 context.Context, arg1 types.Grant, arg2 ...types.RequestEditorFn) (*types.CheckGrantResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckGrantWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.CheckGrantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckGrantWithResponse indicates an expected call of CheckGrantWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) CheckGrantWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckGrantWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).CheckGrantWithResponse), varargs...)
+}
+
 // GetAccessInstructionsWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) GetAccessInstructionsWithResponse(arg0 context.Context, arg1 string, arg2 *types.GetAccessInstructionsParams, arg3 ...types.RequestEditorFn) (*types.GetAccessInstructionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +196,46 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) ListProviderArgOptionsWi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProviderArgOptionsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListProviderArgOptionsWithResponse), varargs...)
 }
 
+// ListProviderAssignmentsWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ListProviderAssignmentsWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...types.RequestEditorFn) (*types.ListProviderAssignmentsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProviderAssignmentsWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.ListProviderAssignmentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProviderAssignmentsWithBodyWithResponse indicates an expected call of ListProviderAssignmentsWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ListProviderAssignmentsWithBodyWithResponse(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProviderAssignmentsWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListProviderAssignmentsWithBodyWithResponse), varargs...)
+}
+
+// ListProviderAssignmentsWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ListProviderAssignmentsWithResponse(arg0 context.Context, arg1 string, arg2 types.ListProviderAssignmentsJSONRequestBody, arg3 ...types.RequestEditorFn) (*types.ListProviderAssignmentsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProviderAssignmentsWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.ListProviderAssignmentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProviderAssignmentsWithResponse indicates an expected call of ListProviderAssignmentsWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ListProviderAssignmentsWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProviderAssignmentsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListProviderAssignmentsWithResponse), varargs...)
+}
+
 // ListProvidersWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) ListProvidersWithResponse(arg0 context.Context, arg1 ...types.RequestEditorFn) (*types.ListProvidersResponse, error) {
 	m.ctrl.T.Helper()
@@ -45,6 +45,12 @@ const (
 	GrantStatusREVOKED GrantStatus = "REVOKED"
 )
 
+// Defines values for GrantValidationStatus.
+const (
+	FAILED GrantValidationStatus = "FAILED"
+	PASSED GrantValidationStatus = "PASSED"
+)
+
 // Defines values for LogLevel.
 const (
 	LogLevelERROR   LogLevel = "ERROR"
@@ -161,6 +167,20 @@ type Grant_With struct {
 	AdditionalProperties map[string]string `json:"-"`
 }
 
+// A check that a grant will succeed, run without granting the access.
+type GrantValidation struct {
+	// The ID of the validation step, such as `user-exists`.
+	Id   string `json:"id"`
+	Logs []Log  `json:"logs"`
+	Name string `json:"name"`
+
+	// The status of a grant validation step.
+	Status GrantValidationStatus `json:"status"`
+}
+
+// The status of a grant validation step.
+type GrantValidationStatus string
+
 // Group defines model for Group.
 type Group struct {
 	Description *string `json:"description,omitempty"`
@@ -241,17 +261,42 @@ type ProviderHealth struct {
 // ArgOptionsResponse defines model for ArgOptionsResponse.
 type ArgOptionsResponse = ArgOptions
 
+// AssignmentsResponse defines model for AssignmentsResponse.
+type AssignmentsResponse struct {
+	// The email addresses of the users who have access.
+	Subjects []string `json:"subjects"`
+
+	// false if the provider can't list assignments.
+	Supported bool `json:"supported"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error *string `json:"error,omitempty"`
 }
 
+// GrantCheckResponse defines model for GrantCheckResponse.
+type GrantCheckResponse struct {
+	// true if the access exists in the provider.
+	Active bool `json:"active"`
+
+	// false if the provider can't check whether access exists.
+	Supported bool `json:"supported"`
+}
+
 // GrantResponse defines model for GrantResponse.
 type GrantResponse struct {
 	// A temporary assignment of a user to a principal.
 	Grant Grant `json:"grant"`
 }
 
+// GrantValidationResponse defines model for GrantValidationResponse.
+type GrantValidationResponse struct {
+	// false if the provider doesn't implement grant validation.
+	Supported   bool              `json:"supported"`
+	Validations []GrantValidation `json:"validations"`
+}
+
 // HealthResponse defines model for HealthResponse.
 type HealthResponse struct {
 	Health *ProviderHealth `json:"health,omitempty"`
@@ -274,6 +319,9 @@ type ValidateRequest struct {
 // PostGrantsJSONBody defines parameters for PostGrants.
 type PostGrantsJSONBody = CreateGrant
 
+// CheckGrantJSONBody defines parameters for CheckGrant.
+type CheckGrantJSONBody = Grant
+
 // ValidateGrantJSONBody defines parameters for ValidateGrant.
 type ValidateGrantJSONBody = CreateGrant
 
@@ -298,18 +346,88 @@ type GetAccessInstructionsParams struct {
 	FrontendUrl string `form:"frontendUrl" json:"frontendUrl"`
 }
 
+// ListProviderAssignmentsJSONBody defines parameters for ListProviderAssignments.
+type ListProviderAssignmentsJSONBody struct {
+	// Provider-specific grant data describing the target. Must match the provider's schema.
+	With ListProviderAssignmentsJSONBody_With `json:"with"`
+}
+
+// ListProviderAssignmentsJSONBody_With defines parameters for ListProviderAssignments.
+type ListProviderAssignmentsJSONBody_With struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
 // PostGrantsJSONRequestBody defines body for PostGrants for application/json ContentType.
 type PostGrantsJSONRequestBody = PostGrantsJSONBody
 
+// CheckGrantJSONRequestBody defines body for CheckGrant for application/json ContentType.
+type CheckGrantJSONRequestBody = CheckGrantJSONBody
+
 // ValidateGrantJSONRequestBody defines body for ValidateGrant for application/json ContentType.
 type ValidateGrantJSONRequestBody = ValidateGrantJSONBody
 
 // PostGrantsRevokeJSONRequestBody defines body for PostGrantsRevoke for application/json ContentType.
 type PostGrantsRevokeJSONRequestBody PostGrantsRevokeJSONBody
 
+// ListProviderAssignmentsJSONRequestBody defines body for ListProviderAssignments for application/json ContentType.
+type ListProviderAssignmentsJSONRequestBody ListProviderAssignmentsJSONBody
+
 // ValidateSetupJSONRequestBody defines body for ValidateSetup for application/json ContentType.
 type ValidateSetupJSONRequestBody ValidateRequest
 
+// Getter for additional properties for ListProviderAssignmentsJSONBody_With. Returns the specified
+// element and whether it was found
+func (a ListProviderAssignmentsJSONBody_With) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for ListProviderAssignmentsJSONBody_With
+func (a *ListProviderAssignmentsJSONBody_With) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for ListProviderAssignmentsJSONBody_With to handle AdditionalProperties
+func (a *ListProviderAssignmentsJSONBody_With) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for ListProviderAssignmentsJSONBody_With to handle AdditionalProperties
+func (a ListProviderAssignmentsJSONBody_With) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
 // Getter for additional properties for ArgSchema. Returns the specified
 // element and whether it was found
 func (a ArgSchema) Get(fieldName string) (value Argument, found bool) {
@@ -656,6 +774,11 @@ type ClientInterface interface {
 
 	PostGrants(ctx context.Context, body PostGrantsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CheckGrant request with any body
+	CheckGrantWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CheckGrant(ctx context.Context, body CheckGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ValidateGrant request with any body
 	ValidateGrantWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -687,6 +810,11 @@ type ClientInterface interface {
 	// ListProviderArgOptions request
 	ListProviderArgOptions(ctx context.Context, providerId string, argId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListProviderAssignments request with any body
+	ListProviderAssignmentsWithBody(ctx context.Context, providerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ListProviderAssignments(ctx context.Context, providerId string, body ListProviderAssignmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ValidateSetup request with any body
 	ValidateSetupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -729,6 +857,30 @@ func (c *Client) PostGrants(ctx context.Context, body PostGrantsJSONRequestBody,
 	return c.Client.Do(req)
 }
 
+func (c *Client) CheckGrantWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCheckGrantRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CheckGrant(ctx context.Context, body CheckGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCheckGrantRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ValidateGrantWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewValidateGrantRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -861,6 +1013,30 @@ func (c *Client) ListProviderArgOptions(ctx context.Context, providerId string,
 	return c.Client.Do(req)
 }
 
+func (c *Client) ListProviderAssignmentsWithBody(ctx context.Context, providerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProviderAssignmentsRequestWithBody(c.Server, providerId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListProviderAssignments(ctx context.Context, providerId string, body ListProviderAssignmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProviderAssignmentsRequest(c.Server, providerId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ValidateSetupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewValidateSetupRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -952,6 +1128,46 @@ func NewPostGrantsRequestWithBody(server string, contentType string, body io.Rea
 	return req, nil
 }
 
+// NewCheckGrantRequest calls the generic CheckGrant builder with application/json body
+func NewCheckGrantRequest(server string, body CheckGrantJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCheckGrantRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCheckGrantRequestWithBody generates requests for CheckGrant with any type of body
+func NewCheckGrantRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/grants/check")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewValidateGrantRequest calls the generic ValidateGrant builder with application/json body
 func NewValidateGrantRequest(server string, body ValidateGrantJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -1315,6 +1531,53 @@ func NewListProviderArgOptionsRequest(server string, providerId string, argId st
 	return req, nil
 }
 
+// NewListProviderAssignmentsRequest calls the generic ListProviderAssignments builder with application/json body
+func NewListProviderAssignmentsRequest(server string, providerId string, body ListProviderAssignmentsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewListProviderAssignmentsRequestWithBody(server, providerId, "application/json", bodyReader)
+}
+
+// NewListProviderAssignmentsRequestWithBody generates requests for ListProviderAssignments with any type of body
+func NewListProviderAssignmentsRequestWithBody(server string, providerId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "providerId", runtime.ParamLocationPath, providerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/providers/%s/assignments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewValidateSetupRequest calls the generic ValidateSetup builder with application/json body
 func NewValidateSetupRequest(server string, body ValidateSetupJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -1406,6 +1669,11 @@ type ClientWithResponsesInterface interface {
 
 	PostGrantsWithResponse(ctx context.Context, body PostGrantsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGrantsResponse, error)
 
+	// CheckGrant request with any body
+	CheckGrantWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CheckGrantResponse, error)
+
+	CheckGrantWithResponse(ctx context.Context, body CheckGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*CheckGrantResponse, error)
+
 	// ValidateGrant request with any body
 	ValidateGrantWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateGrantResponse, error)
 
@@ -1437,6 +1705,11 @@ type ClientWithResponsesInterface interface {
 	// ListProviderArgOptions request
 	ListProviderArgOptionsWithResponse(ctx context.Context, providerId string, argId string, reqEditors ...RequestEditorFn) (*ListProviderArgOptionsResponse, error)
 
+	// ListProviderAssignments request with any body
+	ListProviderAssignmentsWithBodyWithResponse(ctx context.Context, providerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ListProviderAssignmentsResponse, error)
+
+	ListProviderAssignmentsWithResponse(ctx context.Context, providerId string, body ListProviderAssignmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*ListProviderAssignmentsResponse, error)
+
 	// ValidateSetup request with any body
 	ValidateSetupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateSetupResponse, error)
 
@@ -1501,10 +1774,49 @@ func (r PostGrantsResponse) StatusCode() int {
 	return 0
 }
 
+type CheckGrantResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// true if the access exists in the provider.
+		Active bool `json:"active"`
+
+		// false if the provider can't check whether access exists.
+		Supported bool `json:"supported"`
+	}
+	JSON400 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+	JSON500 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r CheckGrantResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CheckGrantResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ValidateGrantResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON400      *struct {
+	JSON200      *struct {
+		// false if the provider doesn't implement grant validation.
+		Supported   bool              `json:"supported"`
+		Validations []GrantValidation `json:"validations"`
+	}
+	JSON400 *struct {
 		Error *string `json:"error,omitempty"`
 	}
 	JSON500 *struct {
@@ -1745,6 +2057,40 @@ func (r ListProviderArgOptionsResponse) StatusCode() int {
 	return 0
 }
 
+type ListProviderAssignmentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// The email addresses of the users who have access.
+		Subjects []string `json:"subjects"`
+
+		// false if the provider can't list assignments.
+		Supported bool `json:"supported"`
+	}
+	JSON400 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+	JSON404 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProviderAssignmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProviderAssignmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ValidateSetupResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1798,6 +2144,23 @@ func (c *ClientWithResponses) PostGrantsWithResponse(ctx context.Context, body P
 	return ParsePostGrantsResponse(rsp)
 }
 
+// CheckGrantWithBodyWithResponse request with arbitrary body returning *CheckGrantResponse
+func (c *ClientWithResponses) CheckGrantWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CheckGrantResponse, error) {
+	rsp, err := c.CheckGrantWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCheckGrantResponse(rsp)
+}
+
+func (c *ClientWithResponses) CheckGrantWithResponse(ctx context.Context, body CheckGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*CheckGrantResponse, error) {
+	rsp, err := c.CheckGrant(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCheckGrantResponse(rsp)
+}
+
 // ValidateGrantWithBodyWithResponse request with arbitrary body returning *ValidateGrantResponse
 func (c *ClientWithResponses) ValidateGrantWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateGrantResponse, error) {
 	rsp, err := c.ValidateGrantWithBody(ctx, contentType, body, reqEditors...)
@@ -1895,6 +2258,23 @@ func (c *ClientWithResponses) ListProviderArgOptionsWithResponse(ctx context.Con
 	return ParseListProviderArgOptionsResponse(rsp)
 }
 
+// ListProviderAssignmentsWithBodyWithResponse request with arbitrary body returning *ListProviderAssignmentsResponse
+func (c *ClientWithResponses) ListProviderAssignmentsWithBodyWithResponse(ctx context.Context, providerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ListProviderAssignmentsResponse, error) {
+	rsp, err := c.ListProviderAssignmentsWithBody(ctx, providerId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProviderAssignmentsResponse(rsp)
+}
+
+func (c *ClientWithResponses) ListProviderAssignmentsWithResponse(ctx context.Context, providerId string, body ListProviderAssignmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*ListProviderAssignmentsResponse, error) {
+	rsp, err := c.ListProviderAssignments(ctx, providerId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProviderAssignmentsResponse(rsp)
+}
+
 // ValidateSetupWithBodyWithResponse request with arbitrary body returning *ValidateSetupResponse
 func (c *ClientWithResponses) ValidateSetupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateSetupResponse, error) {
 	rsp, err := c.ValidateSetupWithBody(ctx, contentType, body, reqEditors...)
@@ -1996,6 +2376,56 @@ func ParsePostGrantsResponse(rsp *http.Response) (*PostGrantsResponse, error) {
 	return response, nil
 }
 
+// ParseCheckGrantResponse parses an HTTP response from a CheckGrantWithResponse call
+func ParseCheckGrantResponse(rsp *http.Response) (*CheckGrantResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CheckGrantResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// true if the access exists in the provider.
+			Active bool `json:"active"`
+
+			// false if the provider can't check whether access exists.
+			Supported bool `json:"supported"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseValidateGrantResponse parses an HTTP response from a ValidateGrantWithResponse call
 func ParseValidateGrantResponse(rsp *http.Response) (*ValidateGrantResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -2010,6 +2440,17 @@ func ParseValidateGrantResponse(rsp *http.Response) (*ValidateGrantResponse, err
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// false if the provider doesn't implement grant validation.
+			Supported   bool              `json:"supported"`
+			Validations []GrantValidation `json:"validations"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			Error *string `json:"error,omitempty"`
@@ -2354,6 +2795,56 @@ func ParseListProviderArgOptionsResponse(rsp *http.Response) (*ListProviderArgOp
 	return response, nil
 }
 
+// ParseListProviderAssignmentsResponse parses an HTTP response from a ListProviderAssignmentsWithResponse call
+func ParseListProviderAssignmentsResponse(rsp *http.Response) (*ListProviderAssignmentsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListProviderAssignmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// The email addresses of the users who have access.
+			Subjects []string `json:"subjects"`
+
+			// false if the provider can't list assignments.
+			Supported bool `json:"supported"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseValidateSetupResponse parses an HTTP response from a ValidateSetupWithResponse call
 func ParseValidateSetupResponse(rsp *http.Response) (*ValidateSetupResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -2399,6 +2890,9 @@ type ServerInterface interface {
 	// Create Grant
 	// (POST /api/v1/grants)
 	PostGrants(w http.ResponseWriter, r *http.Request)
+	// Check grant
+	// (POST /api/v1/grants/check)
+	CheckGrant(w http.ResponseWriter, r *http.Request)
 	// ValidateGrant
 	// (POST /api/v1/grants/validate)
 	ValidateGrant(w http.ResponseWriter, r *http.Request)
@@ -2426,6 +2920,9 @@ type ServerInterface interface {
 	// List provider arg options
 	// (GET /api/v1/providers/{providerId}/args/{argId}/options)
 	ListProviderArgOptions(w http.ResponseWriter, r *http.Request, providerId string, argId string)
+	// List assignments
+	// (POST /api/v1/providers/{providerId}/assignments)
+	ListProviderAssignments(w http.ResponseWriter, r *http.Request, providerId string)
 	// Validate an Access Provider's settings
 	// (POST /api/v1/setup/validate)
 	ValidateSetup(w http.ResponseWriter, r *http.Request)
@@ -2470,6 +2967,21 @@ func (siw *ServerInterfaceWrapper) PostGrants(w http.ResponseWriter, r *http.Req
 	handler(w, r.WithContext(ctx))
 }
 
+// CheckGrant operation middleware
+func (siw *ServerInterfaceWrapper) CheckGrant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CheckGrant(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // ValidateGrant operation middleware
 func (siw *ServerInterfaceWrapper) ValidateGrant(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -2728,6 +3240,32 @@ func (siw *ServerInterfaceWrapper) ListProviderArgOptions(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
+// ListProviderAssignments operation middleware
+func (siw *ServerInterfaceWrapper) ListProviderAssignments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "providerId" -------------
+	var providerId string
+
+	err = runtime.BindStyledParameter("simple", false, "providerId", chi.URLParam(r, "providerId"), &providerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "providerId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProviderAssignments(w, r, providerId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // ValidateSetup operation middleware
 func (siw *ServerInterfaceWrapper) ValidateSetup(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -2862,6 +3400,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/grants", wrapper.PostGrants)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/grants/check", wrapper.CheckGrant)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/grants/validate", wrapper.ValidateGrant)
 	})
@@ -2889,6 +3430,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/providers/{providerId}/args/{argId}/options", wrapper.ListProviderArgOptions)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/providers/{providerId}/assignments", wrapper.ListProviderAssignments)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/setup/validate", wrapper.ValidateSetup)
 	})
@@ -2899,59 +3443,68 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xbeW8buRX/KgRbIC0wOnzEjfXXurGTVZO1DdlJiu4GG2pIjRhT5JjkWFEMffeCx9wc",
-	"S5YTZBfY/BN5hsfje793c+5hLBap4IRrBUf3UJLbjCj9b4EpsQ/eI0Yx0mTiXphHseCacPsTpSmjMdJU",
-	"8MFnJbh5puI5WSDzK5UiJVL7lTLl/sdExZKmZg4cwes5AbOMMaBXKQGYzCin5hUQM6DnBKRS3FFMJIwg",
-	"+YIWKSNwZGheCD5DmgzQUvWUEjCCZgE4gkpLyhO4juCS6rklEmO7JGKXNYJaE9qU5bs/UyAWfEaTTNrD",
-	"9sv9xPQziTWM4JdeInr+4QKlv7p1P+bLryPLXCoJhqNfHTc8jR+bi63NPzNepYJ7tp3I5MKSpib+8aNk",
-	"8XdJZnAE/zYo5T1wb9WgXBrafet88K/ATEiAOHgtEdcAySRbEK77hm9nUgq5E1V1hBCzTkAy6wB/mlSe",
-	"cGCnA0l0JjnBYCbFwkLoJI6JUuBnxDEj0lJsD/ENKE7MOpvYazdrAcBN/bjN0YCiPGHEsd7S/zNBTM+/",
-	"wQHmdqFNJ7j0auC23U4gbmw8J/ENyIEMpgKv7AFKs/LkI9y5pSx8R/eQarJQ2x7opVXq98UKsDwZkhKt",
-	"WlKrbraN7MqljUFDDxgUM9eTZ9XdgnbMlZZZXByuvnj1LRAczMUSaAGQw7uBvjfnBBsRiEzGpP8b/40b",
-	"0/aJVmZ/AjNKGAZLyhiYEsCNRaYzwAWoDgNIEoDuEGVoyogxgnVR0KeSKxgBVoc9sW2zbgREtfUCARaF",
-	"rLLSImU0mVtgUQxH8PlRcnS7XA5xOr37YpesWMCAjossVZuV3I5aR1CkHec3XFdZkjiB+GHmiFtB1tG3",
-	"EaD57h8rfCoPtx1/bm7xZ5HKY7G8ZTLnz1Whfl3+dIOLsf4Crmt0+UW3I+vgZpnt06NjOZRpkpPlVm0J",
-	"rcb5gKsvhbrLaaywYdsKRpbQwHZeD18JuThjJCc55GcRM452AYgbZr1uRZPty8ipps6tCY5M4IQyppVR",
-	"qEXGNFWEOU4Sni0MMK7O3p69vK4YrSp1Tt3O0YKEyc8YadCeLzs+v3x3DSP4y7u312O/R/TQZl76ISdf",
-	"xTHFMB/b3r8ObgeB7TD04usR/Tp7fvivVRY7R/ZSEqTJ69yRN12vddOGq1MCYjsUt+0e4Tis7oRjoOmC",
-	"5NGsW41yML66eHE03LPiRLpfi273h/v7veFRb+/gem9vdHA8Ohj2j/f3/gcj6IbDETS+s2dWblnIeiBK",
-	"lTD79K/N0AKfrdCJYmuJlaIJN7/0nCrAydIRHAqui7g8eO7xaTN+N6u60+f2XtRPLW60MQQLyt8SnpiY",
-	"ZC+wrdJI6g7Tal49idvDg2/MbZU5LIaxsUCUAYSxNOzwJGeqk1UFNXbiZlY9Mf3JQ6SeSklMZzT2NGGk",
-	"UR/8kikNFkjH85qUnyngbGQ7QWoqeM6bCpQ8zbmUI6tXFrMVha/qa0jnvWTtES8MpnL9fEivSjTnOPRA",
-	"ewgehXRNMid/8hv3Y7GAJfetozFKhhfU5Vd5+hGyNposUiGRXHldtC7ABo45MEwISXlMU8T+/Hao3AtN",
-	"0RDHU9Qbohdx7/Dg+KCH8PF+7+j4+d7wYP9oun+Murbg1m3B8elfdmlbu6SRzjoi1DiT0qDOjKlT3K9E",
-	"E5dn56fj89cwgicvr8fvz2AEJ2fvL96cncIInv33cjxxvyaTi0kwDPjLNHabRhv7eBlFWxvKio38xtbR",
-	"Rk5bauhultTjsQKrpxhX+/CxGUFH5P74cLUmB0PKdpHp7c3s9vNx+uLrcnXEYHGQi4Li+nHiOWVYEl4r",
-	"ebSpr6WK0UYmMDQlrPvNpSQz+iXkuOxrkNr3ADEmlgqU2gVsaedLHke7YQS7SJP4ycs54QBTlTK0ItiY",
-	"R2QNYyj6vEMs20Iq7jj58KhkWlNGnsvbSQoffllm6cHB8LNMDktJPZhNbpXiV0kJCK9G8ba5PE3iFRmy",
-	"dJbeYpfwvBVJUIIiAYRruWqHFYzcOVS0LbWZZV9XncP4/NUFjOCHk8m5U+ZuN7BQSffCC6IUSjqqQDU5",
-	"WwLdahXRmpNux6U9vjiQ5O74lnw9ntrlu/Rudw3aCbOV03RgdB0V/qjNycvSYzQqdfgBe7GdsTNDKuRV",
-	"tuoksFVuDeCwrLAClCDKlbY2olYtBZY5RWTgC/z5Lm0A2+KmymvOHTFyiqSmccaQ9Ju5mqjKKSLY1l0Q",
-	"X9VqdhttLsWbYtHyyBFQWTwHSIFPjCrdU0r0jP9Tn/ohM8hEsr11MdoQII93FX0eChLduzb9dSvw++Xk",
-	"4vXk7OoKRvDq3cuX7lfp47vMQghulsxKVNQUqWdGAJAt0O1qEhpdkO72VRPSxd93xDepvGWzgKplI1Tx",
-	"Zxq4rsyqnhCcXI5/v754c3YOFIkl0WCOlK0CTgnhRSnQsCpjtj4PR1pmJAAbv3yb1A9zoudENkmq0uMX",
-	"mwrBCOIP4btYYHwazKg25XIhFOSUB8TspbIh6HUhIuUzkXeckEtC/MYvbWcZvELaoC2TDI7gXOtUjQaD",
-	"suvcp6KdFVSmNlqO4ORyDJt9i/ylMfVEKrfGXn/oWgiEo5TCETzoD/tDY8+QnluQDVBKB3d7A5txuP4E",
-	"CWRRb6nSLiuxHQYDUwv+sYH2a6Jfu+mNHvP+cPjUZuhjoh3k+wHNfsbGrtrFGzPvuaM2tEdxqkG9N22b",
-	"bNligeQqZ1LBCY2MMf01P8bHdQRToQK8dRUogHxWnDfUisazSwbHp66bhklKOAaCB3rRzxSQGTcJfB98",
-	"MOGvzDinPDGjTz5cgbdoMcUImHQHXGmSglcZd52uyJVox6dGPc3ClN8JJ6eKY6nPAUshb2ZMLM02bVRc",
-	"ClWFRX4PZLUFIppZJahGJEXcs12eKcnt73v7B4fPj55el4vnkqqf6nq7IXvc7sZEtQgZwOd1UeRpNA7W",
-	"LYXb2wzh+mWFdQQPdwD+N1AXj/uiuNDUl3XUsE6DPHKytiKoS+8RMwMMhpHOVcopjsrimBBs0S8yb82M",
-	"chhku/JPC8N5PJDT+DgY7yT7ddiGhgzWD5JbnSkBMd3b/8d4PZDkTtw4cSGJFkQTaWR834nv8anRW/PM",
-	"eKg8ThtBvyKs+nAXjZRMbkZ9H7sM7sRSBRAHKLZBVFGO7LJgbsYTAFD3bI4tctzZPJMklUQRrmkOUFut",
-	"jBFj7gFVJggo7ldQHrMMuyqHGe1V3OyCAbkjoZ5bIx4qaQpeHwuj8s9haLy8ky0MTXl/KRgGTaxPVsAE",
-	"fHLh7+E4lruZuaf0bhggjovAVfXB2OZ7fqy9y6TADFHmW/L+VlMsMClE+3w4BP8Yc00kRwxcEXlHJLCn",
-	"/WcwEivi1sfLq3ELbFvWN6fVeF+5tlVhvWdPnfcFnx6OQsthzdOb15eVt0+KRR917ysQdn7XILPkQZCB",
-	"A0lmkqh5t5+cECYQtmCMUTwnuFn0aN9PrfN64nZws/7YTG/YAkt387hdjLzPf47xuhOWr4muXMUD0xWg",
-	"OKiblZrWk9i0HXe6IHg4PPwRVthwKa2IrhERBJx+yfvH+f2Nkhy4cK/XvF/YLV2HltrdRXt/uTiRTdty",
-	"9/Dz9fUl2B8OwcUbl2wh8IlnjOXXIs3Uxn3JZuEGC2JLN/5BiIIgxIKXGB8MvvLo4pmq90nzQOw2I3JV",
-	"CqVsH24vkSi0Z37lG6RoZY0R5eA/VxfnvnvdsT2SiXra3mU6m/fNa7wKbbpL9BmFgtx3k7f55tXyDkZq",
-	"PhVI4o79Z9JaBvxOssdGwN/NzgSA9oDF2SXu28VOtSyOt/N1On+w8ZGJ2hhhGohYbXBXWoELxX3pd1qk",
-	"rV6HlL/XSVVpkB5yQCe5Fn0vcBRXcf/IXsiwz1+i+CNgYnCPZGL+qNz47g6DjfhFGnJFlY9pHoqQa9e4",
-	"H58sBL4f+nFSrQXFVqw5D7+nXKPgYlaIO+NDEZ2l2xW6qKt0KaI15UkrIAGngriGjqekdU9tanLOhCpN",
-	"JMGgBxBjjb6osSdIKYLBHUW1e+P2y5tqzINs1HM4HJZ5bDOmiRF3/aVqC9RQ7eu9+QTfLqXKXtrggHI7",
-	"vnKtLVyluzK8axdpwrCqfJc4aH6UuFO1o/UJ0o6OL1hrM2xopCzPStE7K6tsbcCBvOw0jQYDJmLE5kLp",
-	"0fHweB+asMAn4ve1CMdoS/EkT9HXH9f/DwAA//+8wYgx1TkAAA==",
+	"H4sIAAAAAAAC/+xce2/buLL/KoTuBXovoNjOo7mN/9rcJO36NJsESbo9OLvFlpbGFluJVEjKrlv4ux/w",
+	"oTcVy06K9gD7VxKJ5AxnfjOcGY7yzQtYkjIKVApv/M3j8JCBkP/PQgL6we84JiGWcGteqEcBoxKo/hWn",
+	"aUwCLAmjw0+CUfVMBBEkWP2WcpYCl3alTJifIYiAk1TN8cbefQRolsUxkqsUUAgzQol6hdgMyQhQytmC",
+	"hMA934MvOElj8MaK54TRGZYwxEuxJwTzfE8t4I09ITmhc2/te0siI81kGOolcXxTY6g1oc1ZTv2FQAGj",
+	"MzLPuN7soKTHpp8gkJ7vfdmbsz37MMHpH2bdD/nya18Ll3AIvfEfRhqWxw/NxdbrtRkvUkat2E75/Fqz",
+	"Jm7t46108d8cZt7Y+69hqe+heSuG5dLeet2Sg32FZowjTNEbjqlEmM+zBKgcKLmdCkHmVP25G291nIhM",
+	"C6EDK5BgEiMchhyEAJHDJBPABVpGDEV4AQgHAQihtEQkJG5t2weYc7xSf4ssTRmXELYJz3AsAJE6IlGA",
+	"6QuJYiIkwqUEKtCYMhYDpi3dl5T8crcuELgg6dwpkgxhJDGfg0SE1vjUKrrgnPFnUA6odRziXPfg/pQi",
+	"PR1xkBmnEKIZZ4nm9dTs4ldMw9hyrHF2FkHw+RnYxoEkC2grVvKs0KuVJHwhQoq2ENta3RkygdoVWkYg",
+	"I+B1uluix+6rD3beW3KVrWqLRnNt0F3bzjXxDErQhDb5Ik2stWcztc82T5EgdB6D8VMl//YcI4w+i4va",
+	"Uu8hA6E0T9QBptyElfqi4MoNsPK9pls4s40SLLfbdnWP4KlKr69D4iCyWGpPnE+n8xxXWgG/Ao5l9Axy",
+	"j/RCmwRwY6VuyPbzTWasMcz82EVTFq70Bsog6Mlb2EWj+YbOdAiyhWq3VWe5tNImfiT8UXMtezo40Q5l",
+	"QoXkWVBsrr549S1iFEVsqY8te4BpJOkYE0KlApbxAAZ/0j+pAtlHUpn9Ec0IxCFakjhGU0BUxY9khihD",
+	"1WEIc0B4gUmMpzEoA6urgjyVXRYDYrxkth2EKgURqWNWh4hcMaSQLI3JPNLAIqE39l4ez48flstRmE4X",
+	"X/SSlXjN4WRZlvbwEXrU2vdY2rF/JXWRzedGIXZYLaJ6jIDhbyNAWVqCM5dTubl+8vn8EH5iKT9hy4eY",
+	"5/K5K8yvK/rfEBDr6NZb1/iyi/Zj6/DzMjsgxyd8xNN5zpZZtaW0muQdoWqp1F12o5Xttb2grxl1kLN2",
+	"+Jrx5MIcV214GB3hWAURCQIzTP1RtWT90jemKXNvEvoqzcP6zJAMJVksiYDYSBJolihg3F1cXpzdV5xW",
+	"lTtjblc4ATf7WQwN3vNlJ1c37+493/vt3eX9xNLwHyNmte+Kd6s4JqGXj23Tr4PbQKAfhl59PSZfZy+P",
+	"/m+VBeYgO+OAJbzh2KWTUxtVSKa8YqCHhm2/BzTsyK1oiCRJIE+qzGqEosnd9avj0b5WJ5aDWi5+MDo4",
+	"2Bsd7+0f3u/vjw9PxoejwcnB/r883zPDvbGnzs49tXLLQ9bTZiKYojO4V0MLfLayCBJqT6xzLvWbjIhA",
+	"FJaGYVcpoKgiOPc9OW9WG9SqZvdFflXfNfsssed7CaGXQOcqJtl3kBUSc+mmqV89Sdqjw2eWtk1Fe+Td",
+	"1ay7Q1QFN3riZlE9sViTh0h7IoWAzEhgeQqxxAP0WyYkSrAMopqWXwhkfGS7nNMOknM7rRSkNM+5ln1t",
+	"VxqzFYOv2qvL5q1m9RavFaZy+3zMrko05zi0QHsMHoV2PaW1XyzhQcASr5S+PmiUkYUJMdWgPH9yeRsJ",
+	"Sco45qtK/cMEjjkwVAhJaEBSHP/n+6GSFp7iURhM8d4Ivwr2jg5PDvdweHKwd3zycn90eHA8PTjBXSSo",
+	"Pra8yfnffqmvX5JYZh0RapBxrlCnxtQ5HlSiiZuLq/PJ1RvP907P7ie/X3i+d3vx+/Xbi3PP9y7+eTO5",
+	"Nb/d3l7fOsOAv11jt2vUsY/Vkd/bUVZ85DN7RxL2t9DdPKnFYwVWT3SulZTe4WZNVUJGWBbVOp35iiwI",
+	"QIXTPKNIEWGZrSupAkxZ53NkvuEmh1NWDpCQkPqKWISwQB/VBvdMtfDjwBVrxWzev7RxyZy1eNoV25e+",
+	"YIsS2J2Z5ASuplTBr+a+Cc5yKc+RRbmpdXlXmQlzRjYrgFrQNa91enen/dLr08nlxfkjXN3l3LfkZXK/",
+	"rRPOjsRw+2yoxrNipV/i8/B59vDpJH31dbk6jr11vpHrguP6doKIxCEHWoPdxgufTUKI8RTi7jc3HGbk",
+	"i8tg9WuU6vcIxzFbClQ6b6Qrh1/yNM0Mg9AkMmAnLyOgKCQijfEKQnX6Yn3uunS8wHHWQytmO/lwvxRa",
+	"U0dWyv00FR59WWbp4eHoE58flZp6tFjRs4xdsuJQXo3jvqUiMg9WMIrTWfoQmnxa+R+XBtkcAZV81fad",
+	"MSwgbs9R1q1m6ddVK55cvb72fO/96e2VOSu6o4xEzLsXTkAIPO8oMtb0rBk0q1VUq3baT0r7NDnksDh5",
+	"gK8nU718l93tbkE7Ybaymw6Mrv0i3GlL8qYMSFzHYYe/6OfsVmmNvQqpTgZb1XwHDiunA55jQoXUPqJW",
+	"jEdaOEXgaa9SbyoXl/Xd6tq5sHShIxJIMZckyGLMLTFTchc5RyrsIDOE6Wq7S/ZtIo9K0BETIVWbx56+",
+	"/f6hccdjh3qd/7oX+Ovm9vrN7cXdnSo4vjs7M7+VIWSXW+gTtDRV2g5jOkG3q0toXLJ1Nwo0IV38vQDb",
+	"DmA9m9+6NSX6ztRc+q3q+ebpzeSv++u3F1dIQMBBoggLXWSeAtB8BS2qLNbXP95Y8gwcsLHLt1mtXphX",
+	"WKry076w7cJ3scDk3JmwbyoVuFCQc+5Qs9XKhpzKZCCEzlh+oYlNjmsJn+k2K/QaS/B8L+OxN/YiKVMx",
+	"Hg7LFqwBYe2kszK10dyBTm8mXvNaLH+pXD1wYdbYH4zMDRVQnBJ1pTIYDUae76VYRhpkQ5yS4WJ/qENp",
+	"/WQOjiT9kgibGOlcSMFUg3+ioP0G5BszvdFwdTAaPbXZYctLe+d12cZL2+u3at7L0aiLRrGrYb0LaK0L",
+	"G0mC+SoXUiEJieeiaLwQ3gdVq2LCIVtT4Cyu+/P72qLFRz9W7l2nrCGkqrjHqKPr54VQiawkCQzQexX+",
+	"8oxSlckyik7f36FLnExDrBNddCchRa8zai5SfXMDMDlX5qkWJnTBjJ4qB0t9Dloy/nkWs6Ui00bFDRNV",
+	"WORNkaseiGgWLVA1Iininn5lDA4Pf+0fHB69PH562TeIOBG/1O12Q3GiX/tgtcbd0SPivJdatwxufzOE",
+	"681Ia9872gH4z2AuFvdF7appL2u/4Z2GuoyjHYXbkNRrUXSFdbRpCanMqKNZyxgfEcoMMmFSyhAkBEUB",
+	"chmRINJnpT4nOSRsASFimRQk1PXTiuMu46/pSnV+YgUNIiTHUnc9JGxhOn10rVP38ik2WZaikHAIZLwa",
+	"/ElvtScQ6GPRZzRGuknq427dcXU71VLLlbCdnfYDdwHrtft46IHWehPjD4SsFuy8P2LzWL8btHnIKbrL",
+	"k49WJsvjQvWQKfwBDqJmUUzhOT9QfERoEGehWki9yzE9wySGcDu89evKqwMu3/H3xFzDoe6OPEfT44+D",
+	"X11yDrh90z8n4XrIYcE+G9hhjhOQwBVWv3WeLBNVJiXqmYoN8wxp7NkVvWr0bPKAUhPNfOtDV6hzq7nS",
+	"nlC33Zb3TF2xg5nxBJTUY0ojFj7p7IrgkHIQQCXJ7Uy75gDHsXlAhAq/i8Y5Y0qmvqhG28NVUQkRLMDV",
+	"TNHIREqenF8x7AzdnwCwVt99HGbZmOpMQHKfpFItnhjHZmNgMzOPUW0AjDANCzclBmiiKy12bGACBeXw",
+	"zBzLPQpYCIVqX45G6H8mVAJXJec74AvgSO/2f505UJExbq+vRntvX9E3p9VkX+nHrYjeiqcu+0JOj+d/",
+	"5bDm7tXrm8rbJ2WBWzX0OhK+75relTJwCnDIYcZBRN3n/S3EDIcajAEOIgib5cb2Z1J1Wd8aCmbWzy30",
+	"hi/QfDe32yXIb/mvk3DdCcs3ICs91irIJqHTNivV5CeJqZ90uiB4NDr6EV5YSSmtqK4RETgO/VL22537",
+	"GzU5NFHrXrNxvFu7Bi3V8Tabq+Vs+fHw6/39DToYjdD1W1PmwOijql/m/e5qaqMRviuktQ9cHDgh5uxO",
+	"fzT4yqOLF6LeAJMHYg8Z8FWplLIvpL9GfBfN/MtDlOKVdkaEon/cXV/ZtqQO8pjPxdNol4WkvCGqJisX",
+	"0V2iT98V5L67vcyJVwurIRbRlGEedtCfce0Zwnc83jYC/m5+xgG0RzzOLnHfLn6q5XGsn6/z+YOdD5+L",
+	"jRGmgoi2BvOtAjKhuL10mRbZt7UhYRv2iah96Nh5AJ3mVvS9wFF8Y/Ezn0JKfLY77mfAxPAb5nP1R+VT",
+	"nu4wWKmfpa6jqPJN92MRcu37nO2TBcdn7D9Oq7WgWKs1l+H31KvvXEwr8dnxUX6X7qimPCNUu2oml7pQ",
+	"Xf9C3/bsxqu+X7CXhWj1NIFkCtw00VGkb1h00bl3+XuJdYikT2cIVdxdOVR3qVi7/gXAIwZUDny2ytB3",
+	"bBFunh1GRc/RONz9vy92ciyOf0Lx42KIywYkanmiAJmlW1XXBUhVOm9lD+icgel7sIJtfS0wBcRhToQE",
+	"DiHaU+2IjfYhdfhjoexlQXDt6z39/XM1QcE6RTkajcqik8MiTBtGtVNIcW2vRfMJhgdFnFBlx4Tq8ZWP",
+	"C9x19zslu7bduDVV+V82w+Y/stkJZK0PwXdEmLMwrsTQqC+8KFVvQiKhC3nGfZcNGePhMGYBjiMm5Phk",
+	"dHLgrT8UVbNvtXREHW3Fk7yetv6w/vcAT4SmDglIAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -3,6 +3,7 @@ package grants
 import (
 	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/common-fate/clio"
 	"github.com/common-fate/common-fate/accesshandler/pkg/types"
 	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
@@ -32,8 +33,9 @@ var Handle = cli.Command{
 			panic(err)
 		}
 		granter := targetgroupgranter.Granter{
-			Cfg: cfg,
-			DB:  db,
+			Cfg:   cfg,
+			DB:    db,
+			Clock: clock.New(),
 			RequestRouter: &requestroutersvc.Service{
 				DB: db,
 			},
@@ -0,0 +1,13 @@
+package autoapproval
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var Command = cli.Command{
+	Name:        "auto-approval",
+	Description: "Manage how access requests are approved automatically",
+	Usage:       "Manage how access requests are approved automatically",
+	Action:      cli.ShowSubcommandHelp,
+	Subcommands: []*cli.Command{&webhookCommand},
+}
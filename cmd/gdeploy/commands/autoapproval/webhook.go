@@ -0,0 +1,80 @@
+package autoapproval
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/common-fate/clio"
+	"github.com/common-fate/common-fate/pkg/autoapproval"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/urfave/cli/v2"
+)
+
+var webhookCommand = cli.Command{
+	Name:        "webhook",
+	Description: "Configure the auto-approval webhook",
+	Action:      cli.ShowSubcommandHelp,
+	Subcommands: []*cli.Command{&configureWebhookCommand},
+}
+
+var configureWebhookCommand = cli.Command{
+	Name:        "configure",
+	Description: "Configure the URL of the auto-approval webhook and the secret used to sign requests to it",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "url", Usage: "the URL of the auto-approval webhook"},
+	},
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		f := c.Path("file")
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		url := c.String("url")
+		if url == "" {
+			p := survey.Input{
+				Message: "The URL of the auto-approval webhook",
+				Default: dc.Deployment.Parameters.AutoApprovalWebhookURL,
+			}
+			err = survey.AskOne(&p, &url, survey.WithValidator(survey.Required))
+			if err != nil {
+				return err
+			}
+		}
+
+		var secret gconfig.SecretStringValue
+		cfg := autoapproval.WebhookSecretConfig(&secret)
+		if dc.Deployment.Parameters.AutoApprovalWebhookSecret != "" {
+			// the existing secret isn't read from SSM, it is only replaced if a new one is entered.
+			err = cfg.Load(ctx, &gconfig.MapLoader{SkipLoadingSecrets: true, Values: map[string]string{"webhookSecret": dc.Deployment.Parameters.AutoApprovalWebhookSecret}})
+			if err != nil {
+				return err
+			}
+		}
+		for _, v := range cfg {
+			err := deploy.CLIPrompt(v)
+			if err != nil {
+				return err
+			}
+		}
+
+		dc.Deployment.Parameters.AutoApprovalWebhookURL = url
+		if cfg[0].HasChanged() {
+			// the secret is stored in SSM and only the reference to it is saved in the deployment configuration.
+			newConfig, err := cfg.Dump(ctx, gconfig.SSMDumper{Suffix: dc.Deployment.Parameters.DeploymentSuffix})
+			if err != nil {
+				return err
+			}
+			dc.Deployment.Parameters.AutoApprovalWebhookSecret = newConfig["webhookSecret"]
+		}
+
+		err = dc.Save(f)
+		if err != nil {
+			return err
+		}
+
+		clio.Success("Successfully configured the auto-approval webhook")
+		clio.Warn("Your changes won't be applied until you redeploy. Run 'gdeploy update' to apply the changes to your CloudFormation deployment.")
+		return nil
+	},
+}
@@ -0,0 +1,12 @@
+package grants
+
+import "github.com/urfave/cli/v2"
+
+var Command = cli.Command{
+	Name:        "grants",
+	Description: "Manage grants",
+	Usage:       "Manage grants",
+	Subcommands: []*cli.Command{
+		&RevokeCommand,
+	},
+}
@@ -0,0 +1,144 @@
+package grants
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/common-fate/clio"
+	"github.com/common-fate/clio/clierr"
+	"github.com/common-fate/common-fate/pkg/cliconfig"
+	"github.com/common-fate/common-fate/pkg/client"
+	"github.com/common-fate/common-fate/pkg/table"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/urfave/cli/v2"
+)
+
+var RevokeCommand = cli.Command{
+	Name:        "revoke",
+	Description: "Revoke every active or pending grant for a user, access rule, target group or provider at once, such as during a security incident",
+	Usage:       "Revoke grants in bulk",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "user", Usage: "Revoke grants for the user with this ID"},
+		&cli.StringFlag{Name: "rule", Usage: "Revoke grants for the access rule with this ID"},
+		&cli.StringFlag{Name: "target-group", Usage: "Revoke grants provisioned by the target group with this ID"},
+		&cli.StringFlag{Name: "provider", Usage: "Revoke grants for the Access Handler provider with this ID"},
+		&cli.StringFlag{Name: "reason", Usage: "Why the grants are being revoked, which is included in the audit record"},
+		&cli.BoolFlag{Name: "dry-run", Usage: "List the grants which would be revoked without revoking them"},
+		&cli.BoolFlag{Name: "yes", Aliases: []string{"y"}, Usage: "Revoke the grants without asking for confirmation"},
+	},
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		cfg, err := cliconfig.Load()
+		if err != nil {
+			return err
+		}
+
+		cf, err := client.FromConfig(ctx, cfg)
+		if err != nil {
+			return err
+		}
+
+		body := types.AdminBulkRevokeJSONRequestBody{
+			UserId:        optional(c.String("user")),
+			AccessRuleId:  optional(c.String("rule")),
+			TargetGroupId: optional(c.String("target-group")),
+			ProviderId:    optional(c.String("provider")),
+			Reason:        optional(c.String("reason")),
+		}
+		if body.UserId == nil && body.AccessRuleId == nil && body.TargetGroupId == nil && body.ProviderId == nil {
+			return clierr.New("At least one of --user, --rule, --target-group or --provider must be provided.")
+		}
+
+		// list the grants which match before revoking them, so that they can be confirmed.
+		dryRun := true
+		body.DryRun = &dryRun
+		res, err := cf.AdminBulkRevokeWithResponse(ctx, body)
+		if err != nil {
+			return err
+		}
+		if len(res.JSON200.Revocations) == 0 {
+			clio.Info("There are no active or pending grants which match")
+			return nil
+		}
+		if c.Bool("dry-run") {
+			printRevocations(res.JSON200.Revocations)
+			clio.Infof("%d grants would be revoked", len(res.JSON200.Revocations))
+			return nil
+		}
+
+		if !c.Bool("yes") {
+			printRevocations(res.JSON200.Revocations)
+			confirm := false
+			err = survey.AskOne(&survey.Confirm{Message: fmt.Sprintf("Revoke %d grants?", len(res.JSON200.Revocations))}, &confirm)
+			if err != nil {
+				return err
+			}
+			if !confirm {
+				return nil
+			}
+		}
+
+		dryRun = false
+		res, err = cf.AdminBulkRevokeWithResponse(ctx, body)
+		if err != nil {
+			return err
+		}
+		bulkID := res.JSON200.Id
+		clio.Infof("Queued %d grants to be revoked (bulk revocation %s)", len(res.JSON200.Revocations), bulkID)
+
+		// the grants are revoked in the background, so wait for every grant to be attempted.
+		result := res.JSON200
+		for result.Status != nil && *result.Status != types.BulkRevokeStatusCOMPLETED {
+			time.Sleep(pollInterval)
+			got, err := cf.AdminGetBulkRevocationWithResponse(ctx, bulkID)
+			if err != nil {
+				return err
+			}
+			if got.JSON200 == nil {
+				return clierr.New(fmt.Sprintf("Couldn't get the progress of bulk revocation %s: %s", bulkID, string(got.Body)))
+			}
+			result = got.JSON200
+		}
+		printRevocations(result.Revocations)
+
+		var failed int
+		for _, r := range result.Revocations {
+			if r.Status == types.BulkRevocationStatusFAILED {
+				failed++
+			}
+		}
+		if failed > 0 {
+			return clierr.New(fmt.Sprintf("%d of %d grants couldn't be revoked (bulk revocation %s).", failed, len(result.Revocations), bulkID),
+				clierr.Info("Failed revocations are retried automatically. Run 'gdeploy grants revoke' again to retry them now."),
+			)
+		}
+		clio.Successf("Revoked %d grants (bulk revocation %s)", len(result.Revocations), bulkID)
+		return nil
+	},
+}
+
+// pollInterval is how often the progress of a bulk revocation is checked.
+const pollInterval = 2 * time.Second
+
+func printRevocations(revocations []types.BulkRevocation) {
+	tbl := table.New(os.Stderr)
+	tbl.Columns("Request", "User", "Access Rule", "Provider", "Status", "Error")
+	for _, r := range revocations {
+		var e string
+		if r.Error != nil {
+			e = *r.Error
+		}
+		tbl.Row(r.RequestId, r.UserId, r.AccessRuleId, r.Provider, string(r.Status), e)
+	}
+	tbl.Flush()
+}
+
+// optional returns nil for empty flag values, so that they are omitted from the request.
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
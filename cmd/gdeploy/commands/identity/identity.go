@@ -5,6 +5,7 @@ import (
 
 	"github.com/common-fate/clio/clierr"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/identity/groups"
+	"github.com/common-fate/common-fate/cmd/gdeploy/commands/identity/scim"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/identity/sso"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/identity/sync"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/identity/users"
@@ -23,6 +24,7 @@ var Command = cli.Command{
 	Subcommands: []*cli.Command{
 		&sso.SSOCommand,
 		&sync.SyncCommand,
+		&scim.SCIMCommand,
 		&CognitoSamlCommand,
 		middleware.WithBeforeFuncs(&users.UsersCommand, PreventNonCognitoUsage()),
 		middleware.WithBeforeFuncs(&groups.GroupsCommand, PreventNonCognitoUsage()),
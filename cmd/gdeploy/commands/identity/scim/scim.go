@@ -0,0 +1,85 @@
+package scim
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/common-fate/clio"
+	"github.com/common-fate/clio/clierr"
+	"github.com/common-fate/common-fate/pkg/cliconfig"
+	"github.com/common-fate/common-fate/pkg/client"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/urfave/cli/v2"
+)
+
+var SCIMCommand = cli.Command{
+	Name:        "scim",
+	Description: "Let your identity provider push changes to users and groups to Common Fate using SCIM, rather than waiting for the next identity sync",
+	Usage:       "Configure SCIM provisioning",
+	Subcommands: []*cli.Command{&enableCommand, &disableCommand},
+	Action:      cli.ShowSubcommandHelp,
+}
+
+var enableCommand = cli.Command{
+	Name:        "enable",
+	Description: "Create a SCIM token for your identity provider. If SCIM is already enabled, the existing token is replaced.",
+	Usage:       "Enable SCIM provisioning",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		o, err := dc.LoadOutput(ctx)
+		if err != nil {
+			return err
+		}
+		cfg, err := cliconfig.Load()
+		if err != nil {
+			return err
+		}
+		cf, err := client.FromConfig(ctx, cfg)
+		if err != nil {
+			return err
+		}
+		res, err := cf.AdminCreateScimTokenWithResponse(ctx)
+		if err != nil {
+			return err
+		}
+		if res.JSON201 == nil {
+			return clierr.New(fmt.Sprintf("Creating the SCIM token failed with status %d: %s", res.StatusCode(), string(res.Body)))
+		}
+
+		clio.Success("Enabled SCIM provisioning. Configure your identity provider with the following settings:")
+		fmt.Printf("SCIM base URL: %s/webhook/v1/scim/v2\n", o.WebhookURL)
+		fmt.Printf("Bearer token:  %s\n", res.JSON201.Token)
+		clio.Warn("The token won't be shown again. Run 'gdeploy identity scim enable' again to replace it.")
+		return nil
+	},
+}
+
+var disableCommand = cli.Command{
+	Name:        "disable",
+	Description: "Delete the SCIM token, so that your identity provider can no longer push changes to Common Fate. Users and groups which were provisioned using SCIM are kept.",
+	Usage:       "Disable SCIM provisioning",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		cfg, err := cliconfig.Load()
+		if err != nil {
+			return err
+		}
+		cf, err := client.FromConfig(ctx, cfg)
+		if err != nil {
+			return err
+		}
+		res, err := cf.AdminDeleteScimTokenWithResponse(ctx)
+		if err != nil {
+			return err
+		}
+		if res.StatusCode() != http.StatusNoContent {
+			return clierr.New(fmt.Sprintf("Deleting the SCIM token failed with status %d: %s", res.StatusCode(), string(res.Body)))
+		}
+		clio.Success("Disabled SCIM provisioning")
+		return nil
+	},
+}
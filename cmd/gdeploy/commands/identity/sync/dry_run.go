@@ -0,0 +1,82 @@
+package sync
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/common-fate/clio"
+	"github.com/common-fate/clio/clierr"
+	"github.com/common-fate/common-fate/pkg/cliconfig"
+	"github.com/common-fate/common-fate/pkg/client"
+	"github.com/common-fate/common-fate/pkg/table"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/urfave/cli/v2"
+)
+
+// dryRun prints the changes the identity sync would make, using the admin API.
+func dryRun(c *cli.Context) error {
+	ctx := c.Context
+	cfg, err := cliconfig.Load()
+	if err != nil {
+		return err
+	}
+
+	cf, err := client.FromConfig(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	var body types.AdminDryRunIdentitySyncJSONRequestBody
+	if c.IsSet("group-filter") {
+		filter := c.String("group-filter")
+		body.GroupFilter = &filter
+	}
+	if c.IsSet("source") {
+		source := c.String("source")
+		body.Source = &source
+	}
+
+	res, err := cf.AdminDryRunIdentitySyncWithResponse(ctx, body)
+	if err != nil {
+		return err
+	}
+	if res.JSON200 == nil {
+		return clierr.New(fmt.Sprintf("Previewing the identity sync failed with status %d: %s", res.StatusCode(), string(res.Body)))
+	}
+	diff := res.JSON200
+
+	for _, src := range diff.Sources {
+		if src.GroupFilter != "" {
+			clio.Infof("Using group filter %q with %s", src.GroupFilter, src.IdpType)
+		} else {
+			clio.Infof("Using no group filter with %s", src.IdpType)
+		}
+	}
+
+	if len(diff.Users) == 0 && len(diff.Groups) == 0 {
+		clio.Info("The sync would not make any changes to users or groups")
+		return nil
+	}
+
+	if len(diff.Groups) > 0 {
+		tbl := table.New(os.Stderr)
+		tbl.Columns("Action", "Group", "ID", "Added Users", "Removed Users")
+		for _, g := range diff.Groups {
+			tbl.Row(string(g.Action), g.Group.Name, g.Group.Id, strings.Join(g.AddedUsers, ", "), strings.Join(g.RemovedUsers, ", "))
+		}
+		tbl.Flush()
+	}
+
+	if len(diff.Users) > 0 {
+		tbl := table.New(os.Stderr)
+		tbl.Columns("Action", "User", "ID", "Added Groups", "Removed Groups")
+		for _, u := range diff.Users {
+			tbl.Row(string(u.Action), u.User.Email, u.User.Id, strings.Join(u.AddedGroups, ", "), strings.Join(u.RemovedGroups, ", "))
+		}
+		tbl.Flush()
+	}
+
+	clio.Infof("The sync would change %d users and %d groups. No changes have been made.", len(diff.Users), len(diff.Groups))
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
@@ -19,9 +20,21 @@ import (
 
 var SyncCommand = cli.Command{
 	Name: "sync",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{Name: "dry-run", Usage: "List the changes the sync would make to users and groups without making them"},
+		&cli.StringFlag{Name: "group-filter", Usage: "Preview the effect of a different identity group filter, used with --dry-run. Pass an empty string to preview removing the filter"},
+		&cli.StringFlag{Name: "source", Usage: "The identity provider which --group-filter is applied to, when multiple identity providers are configured. Defaults to the identity provider with the highest precedence"},
+	},
 	Action: func(c *cli.Context) error {
 		ctx := c.Context
 
+		if c.Bool("dry-run") {
+			return dryRun(c)
+		}
+		if c.IsSet("group-filter") || c.IsSet("source") {
+			return clierr.New("--group-filter and --source can only be used with --dry-run. To change the group filter, update the identity settings of your deployment.")
+		}
+
 		dc, err := deploy.ConfigFromContext(ctx)
 		if err != nil {
 			return err
@@ -66,6 +79,13 @@ var SyncCommand = cli.Command{
 			if idp == "" {
 				idp = identitysync.IDPTypeCognito
 			}
+			if sources := dc.Deployment.Parameters.IdentitySources; len(sources) > 0 {
+				idpTypes := make([]string, len(sources))
+				for i, s := range sources {
+					idpTypes[i] = s.Type
+				}
+				idp = strings.Join(idpTypes, ", ")
+			}
 			clio.Successf("Successfully synced users and groups using %s", idp)
 		} else {
 			return fmt.Errorf("user and group sync failed with lambda invoke status code: %d", res.StatusCode)
@@ -15,17 +15,20 @@ var Command = cli.Command{
 // These output names are defined in the CDK stack
 // the services names are defined here for this CLI command, and may be different in other usages
 var ServiceLogGroupNameMap = map[string]string{
-	"api":            "APILogGroupName",
-	"idp-sync":       "IDPSyncLogGroupName",
-	"accesshandler":  "AccessHandlerLogGroupName",
-	"events":         "EventBusLogGroupName",
-	"event-handler":  "EventsHandlerLogGroupName",
-	"granter":        "GranterLogGroupName",
-	"slack-notifier": "SlackNotifierLogGroupName",
-	"governance-api": "GovernanceAPILogGroupName",
-	"webhook":        "WebhookLogGroupName",
-	"cache-sync":     "CacheSyncLogGroupName",
-	"healthcheck":    "HealthcheckLogGroupName",
+	"api":              "APILogGroupName",
+	"idp-sync":         "IDPSyncLogGroupName",
+	"accesshandler":    "AccessHandlerLogGroupName",
+	"events":           "EventBusLogGroupName",
+	"event-handler":    "EventsHandlerLogGroupName",
+	"granter":          "GranterLogGroupName",
+	"slack-notifier":   "SlackNotifierLogGroupName",
+	"governance-api":   "GovernanceAPILogGroupName",
+	"webhook":          "WebhookLogGroupName",
+	"cache-sync":       "CacheSyncLogGroupName",
+	"healthcheck":      "HealthcheckLogGroupName",
+	"request-sweeper":  "RequestSweeperLogGroupName",
+	"grant-reconciler": "GrantReconcilerLogGroupName",
+	"grant-retrier":    "GrantRetrierLogGroupName",
 }
 
 // the services names are defined here for this CLI command, and may be different in other usages
@@ -41,4 +44,7 @@ var ServiceNames = []string{
 	"cache-sync",
 	"healthcheck",
 	"governance-api",
+	"request-sweeper",
+	"grant-reconciler",
+	"grant-retrier",
 }
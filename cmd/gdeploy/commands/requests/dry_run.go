@@ -0,0 +1,125 @@
+package requests
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/common-fate/clio"
+	"github.com/common-fate/clio/clierr"
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/cliconfig"
+	"github.com/common-fate/common-fate/pkg/client"
+	"github.com/common-fate/common-fate/pkg/table"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/urfave/cli/v2"
+)
+
+var DryRunCommand = cli.Command{
+	Name:        "dry-run",
+	Description: "Check whether a request would succeed without making it. Shows the results of the provider's grant validation, who would be asked to approve the request, any existing grants it overlaps with, and when the grant would be active",
+	Usage:       "Check whether a request would succeed without making it",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "rule", Usage: "The ID of the access rule to request", Required: true},
+		&cli.DurationFlag{Name: "duration", Usage: "How long to request access for", Value: time.Hour},
+		&cli.StringSliceFlag{Name: "with", Aliases: []string{"w"}, Usage: "An argument value to request, in the format key=value. Repeat the flag to request multiple values"},
+		&cli.StringFlag{Name: "reason", Usage: "The reason for the request"},
+		&cli.BoolFlag{Name: "break-glass", Usage: "Check a break-glass request, which skips approval"},
+	},
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		cfg, err := cliconfig.Load()
+		if err != nil {
+			return err
+		}
+
+		cf, err := client.FromConfig(ctx, cfg)
+		if err != nil {
+			return err
+		}
+
+		body := types.UserDryRunRequestJSONRequestBody{
+			AccessRuleId: c.String("rule"),
+			Timing:       types.RequestTiming{DurationSeconds: int(c.Duration("duration").Seconds())},
+		}
+		if reason := c.String("reason"); reason != "" {
+			body.Reason = &reason
+		}
+		if c.Bool("break-glass") {
+			breakGlass := true
+			body.BreakGlass = &breakGlass
+		}
+		if len(c.StringSlice("with")) > 0 {
+			with := types.CreateRequestWith{AdditionalProperties: make(map[string][]string)}
+			for _, kv := range c.StringSlice("with") {
+				k, v, ok := strings.Cut(kv, "=")
+				if !ok {
+					return clierr.New(fmt.Sprintf("Invalid --with value %q, expected key=value.", kv))
+				}
+				with.AdditionalProperties[k] = append(with.AdditionalProperties[k], v)
+			}
+			body.With = &types.CreateRequestWithSubRequest{with}
+		}
+
+		res, err := cf.UserDryRunRequestWithResponse(ctx, body)
+		if err != nil {
+			return err
+		}
+
+		var failed bool
+		for _, r := range res.JSON200.Requests {
+			if printDryRun(r) {
+				failed = true
+			}
+		}
+		if failed {
+			return clierr.New("The request would not succeed.")
+		}
+		clio.Success("The request would succeed")
+		return nil
+	},
+}
+
+// printDryRun prints the result for a single combination of arguments, and returns true if the request would fail.
+func printDryRun(r types.DryRunRequest) bool {
+	var args []string
+	for k, v := range r.With {
+		args = append(args, k+"="+v)
+	}
+	if len(args) > 0 {
+		clio.Infof("Request with %s", strings.Join(args, ", "))
+	}
+	clio.Infof("Access would be active from %s to %s", r.Start.Local().Format(time.RFC1123), r.End.Local().Format(time.RFC1123))
+	if r.RequiresApproval {
+		clio.Infof("Approval would be required from: %s", strings.Join(r.Approvers, ", "))
+	} else {
+		clio.Info("The request would be approved automatically")
+	}
+
+	failed := false
+	if len(r.OverlappingRequests) > 0 {
+		clio.Warnf("The request overlaps existing grants for the same access: %s", strings.Join(r.OverlappingRequests, ", "))
+		// requests which are approved automatically are rejected if they overlap an existing grant.
+		failed = !r.RequiresApproval
+	}
+
+	if !r.ValidationSupported {
+		clio.Info("The provider doesn't support validating grants")
+		return failed
+	}
+	tbl := table.New(os.Stderr)
+	tbl.Columns("Validation", "Status", "Details")
+	for _, v := range r.Validations {
+		var msgs []string
+		for _, l := range v.Logs {
+			msgs = append(msgs, l.Msg)
+		}
+		tbl.Row(v.Id, string(v.Status), strings.Join(msgs, "; "))
+		if v.Status == ahTypes.FAILED {
+			failed = true
+		}
+	}
+	tbl.Flush()
+	return failed
+}
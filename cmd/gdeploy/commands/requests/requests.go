@@ -0,0 +1,12 @@
+package requests
+
+import "github.com/urfave/cli/v2"
+
+var Command = cli.Command{
+	Name:        "requests",
+	Description: "Manage access requests",
+	Usage:       "Manage access requests",
+	Subcommands: []*cli.Command{
+		&DryRunCommand,
+	},
+}
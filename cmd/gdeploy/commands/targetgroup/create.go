@@ -3,6 +3,7 @@ package targetgroup
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/common-fate/clio"
@@ -25,6 +26,7 @@ var CreateCommand = cli.Command{
 		&cli.StringFlag{Name: "id"},
 		&cli.StringFlag{Name: "kind", Usage: "the target kind that the provider grants access to"},
 		&cli.StringFlag{Name: "provider", Usage: "publisher/name@version"},
+		&cli.StringFlag{Name: "routing-strategy", Usage: "how requests are routed between linked handlers: PRIORITY or WEIGHTED"},
 		&cli.BoolFlag{Name: "ok-if-exists", Value: false},
 	},
 	Action: func(c *cli.Context) error {
@@ -85,7 +87,7 @@ var CreateCommand = cli.Command{
 			return err
 		}
 
-		res, err := cf.AdminCreateTargetGroupWithResponse(ctx, types.AdminCreateTargetGroupJSONRequestBody{
+		body := types.AdminCreateTargetGroupJSONRequestBody{
 			Id: id,
 			From: types.TargetGroupFrom{
 				Kind:      kind,
@@ -93,7 +95,16 @@ var CreateCommand = cli.Command{
 				Publisher: provider.Publisher,
 				Version:   provider.Version,
 			},
-		})
+		}
+		if rs := c.String("routing-strategy"); rs != "" {
+			strategy := types.RoutingStrategy(strings.ToUpper(rs))
+			if strategy != types.PRIORITY && strategy != types.WEIGHTED {
+				return clierr.New(fmt.Sprintf("invalid routing strategy '%s'", rs), clierr.Info("The routing strategy must be PRIORITY or WEIGHTED"))
+			}
+			body.RoutingStrategy = &strategy
+		}
+
+		res, err := cf.AdminCreateTargetGroupWithResponse(ctx, body)
 		if err != nil {
 			return err
 		}
@@ -19,6 +19,7 @@ var LinkCommand = cli.Command{
 		&cli.StringFlag{Name: "handler-id"},
 		&cli.StringFlag{Name: "kind", Required: true},
 		&cli.IntFlag{Name: "priority", Value: 100},
+		&cli.IntFlag{Name: "weight", Value: 1, Usage: "the relative share of requests sent to the handler when the target group uses weighted routing"},
 	},
 	Action: func(c *cli.Context) error {
 
@@ -57,9 +58,11 @@ var LinkCommand = cli.Command{
 			}
 		}
 
+		weight := c.Int("weight")
 		_, err = cf.AdminCreateTargetGroupLinkWithResponse(ctx, tgID, types.AdminCreateTargetGroupLinkJSONRequestBody{
 			DeploymentId: hID,
 			Priority:     c.Int("priority"),
+			Weight:       &weight,
 			Kind:         kind,
 		})
 		if err != nil {
@@ -56,9 +56,13 @@ var ListRoutesCommand = cli.Command{
 			return err
 		}
 		tbl := table.New(os.Stderr)
-		tbl.Columns("Target Group", "Handler", "Kind", "Priority", "Valid", "Diagnostics")
+		tbl.Columns("Target Group", "Handler", "Kind", "Priority", "Weight", "Valid", "Diagnostics")
 		for _, route := range res.JSON200.Routes {
-			tbl.Row(route.TargetGroupId, route.HandlerId, route.Kind, strconv.Itoa(route.Priority), strconv.FormatBool(route.Valid), fmt.Sprintf("%v", route.Diagnostics))
+			weight := 1
+			if route.Weight != nil {
+				weight = *route.Weight
+			}
+			tbl.Row(route.TargetGroupId, route.HandlerId, route.Kind, strconv.Itoa(route.Priority), strconv.Itoa(weight), strconv.FormatBool(route.Valid), fmt.Sprintf("%v", route.Diagnostics))
 		}
 		return tbl.Flush()
 	}),
@@ -6,11 +6,13 @@ import (
 	"github.com/common-fate/clio"
 	"github.com/common-fate/clio/clierr"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands"
+	"github.com/common-fate/common-fate/cmd/gdeploy/commands/autoapproval"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/backup"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/bootstrap"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/cache"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/config"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/dashboard"
+	"github.com/common-fate/common-fate/cmd/gdeploy/commands/grants"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/handler"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/identity"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/legacyprovider"
@@ -18,6 +20,7 @@ import (
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/notifications"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/provider"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/release"
+	"github.com/common-fate/common-fate/cmd/gdeploy/commands/requests"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/restore"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/rules"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/targetgroup"
@@ -63,6 +66,7 @@ func main() {
 			mw.WithBeforeFuncs(&restore.Command, mw.RequireDeploymentConfig(), mw.PreventDevUsage(), mw.VerifyGDeployCompatibility(), mw.RequireAWSCredentials()),
 			mw.WithBeforeFuncs(&legacyprovider.Command, mw.RequireDeploymentConfig(), mw.VerifyGDeployCompatibility(), mw.RequireAWSCredentials()),
 			mw.WithBeforeFuncs(&notifications.Command, mw.RequireDeploymentConfig(), mw.VerifyGDeployCompatibility(), mw.RequireAWSCredentials()),
+			mw.WithBeforeFuncs(&autoapproval.Command, mw.RequireDeploymentConfig(), mw.VerifyGDeployCompatibility(), mw.RequireAWSCredentials()),
 			mw.WithBeforeFuncs(&dashboard.Command, mw.RequireDeploymentConfig(), mw.VerifyGDeployCompatibility(), mw.RequireAWSCredentials()),
 			mw.WithBeforeFuncs(&cache.Command, mw.RequireDeploymentConfig(), mw.RequireAWSCredentials()),
 			mw.WithBeforeFuncs(&commands.InitCommand, mw.RequireAWSCredentials()),
@@ -74,6 +78,8 @@ func main() {
 			&rules.Command,
 			&provider.Command,
 			&targetgroup.Command,
+			&grants.Command,
+			&requests.Command,
 			&handler.Command,
 			mw.WithBeforeFuncs(&bootstrap.Command, mw.RequireAWSCredentials()),
 		},
@@ -14,6 +14,7 @@ import (
 	"github.com/common-fate/common-fate/internal/build"
 	"github.com/common-fate/common-fate/pkg/api"
 	"github.com/common-fate/common-fate/pkg/auth"
+	"github.com/common-fate/common-fate/pkg/autoapproval"
 	"github.com/common-fate/common-fate/pkg/config"
 	"github.com/common-fate/common-fate/pkg/deploy"
 	"github.com/common-fate/common-fate/pkg/gevent"
@@ -78,6 +79,10 @@ func buildHandler() (*Lambda, error) {
 	if err != nil {
 		panic(err)
 	}
+	sources, err := deploy.UnmarshalIdentitySources(cfg.IdentitySources)
+	if err != nil {
+		panic(err)
+	}
 
 	idsync, err := identitysync.NewIdentitySyncer(ctx, identitysync.SyncOpts{
 		TableName:           cfg.DynamoTable,
@@ -85,6 +90,7 @@ func buildHandler() (*Lambda, error) {
 		IdpType:             cfg.IdpProvider,
 		IdentityConfig:      ic,
 		IdentityGroupFilter: cfg.IdentityGroupFilter,
+		IdentitySources:     sources,
 	})
 	if err != nil {
 		return nil, err
@@ -93,23 +99,37 @@ func buildHandler() (*Lambda, error) {
 	if err != nil {
 		return nil, err
 	}
+	autoApproval, err := autoapproval.New(ctx, autoapproval.Opts{
+		Policies:      cfg.AutoApprovalPolicies,
+		LambdaARN:     cfg.AutoApprovalLambdaArn,
+		WebhookURL:    cfg.AutoApprovalWebhookURL,
+		WebhookSecret: cfg.AutoApprovalWebhookSecret,
+	})
+	if err != nil {
+		return nil, err
+	}
 	api, err := api.New(ctx, api.Opts{
-		Log:                    log,
-		DynamoTable:            cfg.DynamoTable,
-		PaginationKMSKeyARN:    cfg.PaginationKMSKeyARN,
-		AccessHandlerClient:    ahc,
-		EventSender:            eventBus,
-		AdminGroup:             cfg.AdminGroup,
-		TemplateData:           td,
-		DeploymentSuffix:       cfg.DeploymentSuffix,
-		IdentitySyncer:         idsync,
-		CognitoUserPoolID:      cfg.CognitoUserPoolID,
-		IDPType:                cfg.IdpProvider,
-		AdminGroupID:           cfg.AdminGroup,
-		DeploymentConfig:       dc,
-		ProviderRegistryClient: registryClient,
-		StateMachineARN:        cfg.StateMachineARN,
-		FrontendURL:            cfg.FrontendURL,
+		Log:                       log,
+		DynamoTable:               cfg.DynamoTable,
+		PaginationKMSKeyARN:       cfg.PaginationKMSKeyARN,
+		AccessHandlerClient:       ahc,
+		EventSender:               eventBus,
+		AdminGroup:                cfg.AdminGroup,
+		TemplateData:              td,
+		DeploymentSuffix:          cfg.DeploymentSuffix,
+		IdentitySyncer:            idsync,
+		IdentitySyncPreview:       idsync,
+		CognitoUserPoolID:         cfg.CognitoUserPoolID,
+		IDPType:                   cfg.IdpProvider,
+		AdminGroupID:              cfg.AdminGroup,
+		DeploymentConfig:          dc,
+		ProviderRegistryClient:    registryClient,
+		StateMachineARN:           cfg.StateMachineARN,
+		EventBusARN:               cfg.EventBusArn,
+		FrontendURL:               cfg.FrontendURL,
+		AutoApproval:              autoApproval,
+		GrantVerificationTimeout:  cfg.GrantVerificationTimeout,
+		GrantVerificationInterval: cfg.GrantVerificationInterval,
 	})
 	if err != nil {
 		return nil, err
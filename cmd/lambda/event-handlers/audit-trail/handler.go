@@ -4,10 +4,17 @@ import (
 	"context"
 
 	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/benbjohnson/clock"
 
 	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/internal"
 	"github.com/common-fate/common-fate/pkg/config"
 	"github.com/common-fate/common-fate/pkg/eventhandler"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/service/bulkrevokesvc"
+	"github.com/common-fate/common-fate/pkg/service/requestroutersvc"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc/runtimes/live"
 
 	"github.com/common-fate/ddb"
 	"github.com/joho/godotenv"
@@ -27,7 +34,37 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	eventHandler, err := eventhandler.New(ctx, db)
+	eventBus, err := gevent.NewSender(ctx, gevent.SenderOpts{
+		EventBusARN: cfg.EventBusArn,
+	})
+	if err != nil {
+		panic(err)
+	}
+	ahc, err := internal.BuildAccessHandlerClient(ctx, internal.BuildAccessHandlerClientOpts{Region: cfg.Region, AccessHandlerURL: cfg.AccessHandlerURL})
+	if err != nil {
+		panic(err)
+	}
+	clk := clock.New()
+	bulkRevoke := &bulkrevokesvc.Service{
+		Clock: clk,
+		DB:    db,
+		Workflow: &workflowsvc.Service{
+			Runtime: &live.Runtime{
+				StateMachineARN: cfg.StateMachineARN,
+				AHClient:        ahc,
+				Eventbus:        eventBus,
+				DB:              db,
+				RequestRouter: &requestroutersvc.Service{
+					DB: db,
+				},
+			},
+			DB:       db,
+			Clk:      clk,
+			Eventbus: eventBus,
+		},
+		EventPutter: eventBus,
+	}
+	eventHandler, err := eventhandler.New(ctx, db, eventhandler.WithBulkRevoker(bulkRevoke))
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/internal"
+	"github.com/common-fate/common-fate/pkg/config"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/service/driftsvc"
+	"github.com/common-fate/ddb"
+	"github.com/sethvargo/go-envconfig"
+	"go.uber.org/zap"
+)
+
+func main() {
+	var cfg config.GrantReconcilerConfig
+	ctx := context.Background()
+
+	err := envconfig.Process(ctx, &cfg)
+	if err != nil {
+		panic(err)
+	}
+	db, err := ddb.New(ctx, cfg.TableName)
+	if err != nil {
+		panic(err)
+	}
+	eventBus, err := gevent.NewSender(ctx, gevent.SenderOpts{
+		EventBusARN: cfg.EventBusArn,
+	})
+	if err != nil {
+		panic(err)
+	}
+	log, err := logger.Build(cfg.LogLevel)
+	if err != nil {
+		panic(err)
+	}
+	zap.ReplaceGlobals(log.Desugar())
+
+	ahc, err := internal.BuildAccessHandlerClient(ctx, internal.BuildAccessHandlerClientOpts{Region: cfg.Region, AccessHandlerURL: cfg.AccessHandlerURL})
+	if err != nil {
+		panic(err)
+	}
+
+	drift := driftsvc.Service{
+		Clock:       clock.New(),
+		DB:          db,
+		AHClient:    ahc,
+		EventPutter: eventBus,
+	}
+
+	zap.S().Infow("starting grant reconciler", "config", cfg)
+	lambda.Start(func(ctx context.Context) error {
+		res, err := drift.Reconcile(ctx)
+		if res != nil {
+			zap.S().Infow("reconciled grants", "detected.count", len(res.Detected), "resolved.count", len(res.Resolved), "unchecked.count", res.Unchecked)
+		}
+		return err
+	})
+}
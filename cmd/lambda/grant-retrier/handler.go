@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/internal"
+	"github.com/common-fate/common-fate/pkg/config"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/service/grantretrysvc"
+	"github.com/common-fate/common-fate/pkg/service/requestroutersvc"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc/runtimes/live"
+	"github.com/common-fate/common-fate/pkg/targetgroupgranter"
+	"github.com/common-fate/ddb"
+	"github.com/sethvargo/go-envconfig"
+	"go.uber.org/zap"
+)
+
+func main() {
+	var cfg config.GrantRetrierConfig
+	ctx := context.Background()
+
+	err := envconfig.Process(ctx, &cfg)
+	if err != nil {
+		panic(err)
+	}
+	db, err := ddb.New(ctx, cfg.TableName)
+	if err != nil {
+		panic(err)
+	}
+	eventBus, err := gevent.NewSender(ctx, gevent.SenderOpts{
+		EventBusARN: cfg.EventBusArn,
+	})
+	if err != nil {
+		panic(err)
+	}
+	log, err := logger.Build(cfg.LogLevel)
+	if err != nil {
+		panic(err)
+	}
+	zap.ReplaceGlobals(log.Desugar())
+
+	ahc, err := internal.BuildAccessHandlerClient(ctx, internal.BuildAccessHandlerClientOpts{Region: cfg.Region, AccessHandlerURL: cfg.AccessHandlerURL})
+	if err != nil {
+		panic(err)
+	}
+	clk := clock.New()
+
+	retries := grantretrysvc.Service{
+		Clock: clk,
+		DB:    db,
+		Workflow: &workflowsvc.Service{
+			Runtime: &live.Runtime{
+				StateMachineARN: cfg.StateMachineARN,
+				AHClient:        ahc,
+				Eventbus:        eventBus,
+				DB:              db,
+				RequestRouter: &requestroutersvc.Service{
+					DB: db,
+				},
+			},
+			DB:       db,
+			Clk:      clk,
+			Eventbus: eventBus,
+		},
+		Granter: &targetgroupgranter.Granter{
+			Cfg: config.TargetGroupGranterConfig{
+				EventBusArn:               cfg.EventBusArn,
+				GrantVerificationTimeout:  cfg.GrantVerificationTimeout,
+				GrantVerificationInterval: cfg.GrantVerificationInterval,
+			},
+			Clock: clk,
+			DB:    db,
+			RequestRouter: &requestroutersvc.Service{
+				DB: db,
+			},
+		},
+	}
+
+	zap.S().Infow("starting grant retrier", "config", cfg)
+	lambda.Start(func(ctx context.Context) error {
+		res, err := retries.ProcessDueRetries(ctx)
+		if res != nil {
+			zap.S().Infow("retried grant changes", "succeeded.count", len(res.Succeeded), "failed.count", len(res.Failed), "abandoned.count", len(res.Abandoned))
+		}
+		return err
+	})
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/internal"
+	"github.com/common-fate/common-fate/pkg/config"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/service/requestroutersvc"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc/runtimes/live"
+	"github.com/common-fate/ddb"
+	"github.com/hashicorp/go-multierror"
+	"github.com/sethvargo/go-envconfig"
+	"go.uber.org/zap"
+)
+
+func main() {
+	var cfg config.RequestSweeperConfig
+	ctx := context.Background()
+
+	err := envconfig.Process(ctx, &cfg)
+	if err != nil {
+		panic(err)
+	}
+	db, err := ddb.New(ctx, cfg.TableName)
+	if err != nil {
+		panic(err)
+	}
+	eventBus, err := gevent.NewSender(ctx, gevent.SenderOpts{
+		EventBusARN: cfg.EventBusArn,
+	})
+	if err != nil {
+		panic(err)
+	}
+	log, err := logger.Build(cfg.LogLevel)
+	if err != nil {
+		panic(err)
+	}
+	zap.ReplaceGlobals(log.Desugar())
+
+	ahc, err := internal.BuildAccessHandlerClient(ctx, internal.BuildAccessHandlerClientOpts{Region: cfg.Region, AccessHandlerURL: cfg.AccessHandlerURL})
+	if err != nil {
+		panic(err)
+	}
+	clk := clock.New()
+
+	workflow := &workflowsvc.Service{
+		Runtime: &live.Runtime{
+			StateMachineARN: cfg.StateMachineARN,
+			AHClient:        ahc,
+			Eventbus:        eventBus,
+			DB:              db,
+			RequestRouter: &requestroutersvc.Service{
+				DB: db,
+			},
+		},
+		DB:       db,
+		Clk:      clk,
+		Eventbus: eventBus,
+	}
+	access := accesssvc.Service{
+		Clock:       clk,
+		DB:          db,
+		EventPutter: eventBus,
+		AHClient:    ahc,
+		Workflow:    workflow,
+	}
+
+	zap.S().Infow("starting request sweeper", "config", cfg)
+	lambda.Start(func(ctx context.Context) error {
+		res, err := access.SweepPendingRequests(ctx, accesssvc.SweepPendingRequestsOpts{
+			ReminderInterval: cfg.ReminderInterval,
+		})
+		if res != nil {
+			zap.S().Infow("swept pending requests", "reminded.count", len(res.Reminded), "expired.count", len(res.Expired))
+		}
+		var result *multierror.Error
+		result = multierror.Append(result, err)
+
+		spawned, err := access.SpawnRecurringRequests(ctx, accesssvc.SpawnRecurringRequestsOpts{
+			LeadTime: cfg.RecurringRequestLeadTime,
+		})
+		if spawned != nil {
+			zap.S().Infow("spawned recurring requests", "requests.count", len(spawned.Requests), "cancelled.count", len(spawned.Cancelled))
+		}
+		result = multierror.Append(result, err)
+
+		delegated, err := access.ApplyDelegations(ctx)
+		if delegated != nil {
+			zap.S().Infow("applied delegations", "reviewers.count", len(delegated.Reviewers))
+		}
+		result = multierror.Append(result, err)
+		return result.ErrorOrNil()
+	})
+}
@@ -27,6 +27,10 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
+	sources, err := deploy.UnmarshalIdentitySources(cfg.IdentitySources)
+	if err != nil {
+		panic(err)
+	}
 	//set up the sync handler
 	syncer, err := identitysync.NewIdentitySyncer(ctx, identitysync.SyncOpts{
 		TableName:           cfg.TableName,
@@ -34,6 +38,8 @@ func main() {
 		UserPoolId:          cfg.UserPoolId,
 		IdentityConfig:      ic,
 		IdentityGroupFilter: cfg.IdentityGroupFilter,
+		IdentitySources:     sources,
+		FullSyncInterval:    cfg.IdentityFullSyncInterval,
 	})
 	if err != nil {
 		panic(err)
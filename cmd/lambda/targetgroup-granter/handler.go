@@ -6,6 +6,7 @@ import (
 	"github.com/aws/aws-lambda-go/lambda"
 	"go.uber.org/zap"
 
+	"github.com/benbjohnson/clock"
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/common-fate/pkg/config"
 	"github.com/common-fate/common-fate/pkg/service/requestroutersvc"
@@ -26,8 +27,9 @@ func main() {
 		panic(err)
 	}
 	granter := targetgroupgranter.Granter{
-		Cfg: cfg,
-		DB:  db,
+		Cfg:   cfg,
+		DB:    db,
+		Clock: clock.New(),
 		RequestRouter: &requestroutersvc.Service{
 			DB: db,
 		},
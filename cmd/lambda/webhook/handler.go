@@ -8,9 +8,12 @@ import (
 	"github.com/aws/aws-lambda-go/events"
 	"github.com/aws/aws-lambda-go/lambda"
 	"github.com/awslabs/aws-lambda-go-api-proxy/handlerfunc"
+	"github.com/benbjohnson/clock"
 	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/scim"
+	"github.com/common-fate/common-fate/pkg/service/sessionactivitysvc"
 	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/ddb"
 	"github.com/go-chi/chi/v5"
@@ -58,7 +61,8 @@ type Config struct {
 }
 
 type Server struct {
-	db *ddb.Client
+	db              *ddb.Client
+	sessionActivity *sessionactivitysvc.Service
 }
 
 func NewServer(ctx context.Context, cfg Config) (*Server, error) {
@@ -67,7 +71,8 @@ func NewServer(ctx context.Context, cfg Config) (*Server, error) {
 		return nil, err
 	}
 	s := Server{
-		db: db,
+		db:              db,
+		sessionActivity: &sessionactivitysvc.Service{Clock: clock.New(), DB: db},
 	}
 	return &s, nil
 }
@@ -117,57 +122,78 @@ func (s *Server) Routes() http.Handler {
 		w.WriteHeader(http.StatusOK)
 	})
 
+	// events-recorder is kept for session recorders which were built before session activity could be streamed in batches.
 	r.Post("/webhook/v1/events-recorder", func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
-		token := r.Header.Get("X-CommonFate-Access-Token")
-		if token == "" {
-			logger.Get(ctx).Infow("X-CommonFate-Access-Token was empty")
-			apio.ErrorString(ctx, w, "access token must be provided", http.StatusBadRequest)
-			return
-		}
-
-		q := storage.GetAccessTokenByToken{Token: token}
-		_, err := s.db.Query(ctx, &q)
-		if err != nil {
-			apio.Error(ctx, w, errors.Wrap(err, "querying for access token"))
+		token, ok := s.authenticateAccessToken(w, r)
+		if !ok {
 			return
 		}
 
 		var b RecordingEventBody
-		err = apio.DecodeJSONBody(w, r, &b)
+		err := apio.DecodeJSONBody(w, r, &b)
 		if err != nil {
 			apio.Error(ctx, w, err)
 			return
 		}
-
-		gr := storage.GetRequest{ID: q.Result.RequestID}
-		_, err = s.db.Query(ctx, &gr)
+		source := b.Source
+		if source == "" {
+			source = "events-recorder"
+		}
+		events, err := s.sessionActivity.Record(ctx, []access.SessionActivity{{
+			GrantID:   token.RequestID,
+			Source:    source,
+			SessionID: b.SessionID,
+			Data:      b.Data,
+		}})
 		if err != nil {
-			apio.Error(ctx, w, errors.Wrap(err, "querying for request"))
+			apio.Error(ctx, w, sessionActivityError(err))
 			return
 		}
 
-		//validate token
-		now := time.Now()
-		err = q.Result.Validate(now)
-		if err != nil {
-			// log the error message and return an opaque response.
-			logger.Get(ctx).Infow("invalid access token", zap.Error(err))
-			apio.ErrorString(ctx, w, "invalid access token", http.StatusUnauthorized)
+		zap.S().Infow("recorded event", "request.id", token.RequestID, "event.id", events[0].ID)
+
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	r.Post("/webhook/v1/session-activity", func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		token, ok := s.authenticateAccessToken(w, r)
+		if !ok {
 			return
 		}
 
-		e := access.NewRecordedEvent(gr.Result.ID, &gr.Result.RequestedBy, time.Now(), b.Data)
-		err = s.db.Put(ctx, &e)
+		var b SessionActivityBody
+		err := apio.DecodeJSONBody(w, r, &b)
 		if err != nil {
 			apio.Error(ctx, w, err)
 			return
 		}
+		// access tokens are issued for a single request, so activity can only be recorded for that request's grant.
+		activity := make([]access.SessionActivity, len(b.Activity))
+		for i, a := range b.Activity {
+			activity[i] = access.SessionActivity{
+				GrantID:    token.RequestID,
+				Source:     a.Source,
+				SessionID:  a.SessionID,
+				OccurredAt: a.OccurredAt,
+				Data:       a.Data,
+			}
+		}
+		_, err = s.sessionActivity.Record(ctx, activity)
+		if err != nil {
+			apio.Error(ctx, w, sessionActivityError(err))
+			return
+		}
 
-		zap.S().Infow("recorded event", "request.id", gr.Result.ID, "event.id", e.ID)
+		zap.S().Infow("recorded session activity", "request.id", token.RequestID, "count", len(activity))
 
 		w.WriteHeader(http.StatusCreated)
 	})
+
+	// identity providers push users and groups to the SCIM server as they change.
+	scimServer := scim.Server{DB: s.db, Clock: clock.New()}
+	r.Mount("/webhook/v1/scim/v2", scimServer.Routes())
 	return r
 }
 
@@ -180,6 +206,65 @@ func (h *Lambda) Handler(ctx context.Context, req events.APIGatewayProxyRequest)
 	return adapter.ProxyWithContext(ctx, req)
 }
 
+// authenticateAccessToken returns the valid access token sent in the X-CommonFate-Access-Token header.
+// If the token is missing or invalid, an error response is written and ok is false.
+func (s *Server) authenticateAccessToken(w http.ResponseWriter, r *http.Request) (token *access.AccessToken, ok bool) {
+	ctx := r.Context()
+	t := r.Header.Get("X-CommonFate-Access-Token")
+	if t == "" {
+		logger.Get(ctx).Infow("X-CommonFate-Access-Token was empty")
+		apio.ErrorString(ctx, w, "access token must be provided", http.StatusBadRequest)
+		return nil, false
+	}
+
+	q := storage.GetAccessTokenByToken{Token: t}
+	_, err := s.db.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		apio.ErrorString(ctx, w, "invalid access token", http.StatusUnauthorized)
+		return nil, false
+	}
+	if err != nil {
+		apio.Error(ctx, w, errors.Wrap(err, "querying for access token"))
+		return nil, false
+	}
+
+	err = q.Result.Validate(time.Now())
+	if err != nil {
+		// log the error message and return an opaque response.
+		logger.Get(ctx).Infow("invalid access token", zap.Error(err))
+		apio.ErrorString(ctx, w, "invalid access token", http.StatusUnauthorized)
+		return nil, false
+	}
+	return q.Result, true
+}
+
+// sessionActivityError converts errors from recording session activity into API errors.
+func sessionActivityError(err error) error {
+	var invalidErr sessionactivitysvc.InvalidActivityError
+	if err == sessionactivitysvc.ErrNoActivity || err == sessionactivitysvc.ErrTooManyActivity || errors.As(err, &invalidErr) {
+		return apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	if err == sessionactivitysvc.ErrGrantNotFound {
+		return apio.NewRequestError(err, http.StatusNotFound)
+	}
+	return err
+}
+
 type RecordingEventBody struct {
 	Data map[string]string `json:"data"`
+	// Source and SessionID are optional, and are shown on the request timeline if they're provided.
+	Source    string `json:"source,omitempty"`
+	SessionID string `json:"sessionId,omitempty"`
+}
+
+type SessionActivityBody struct {
+	Activity []SessionActivityRecord `json:"activity"`
+}
+
+type SessionActivityRecord struct {
+	// Source is the provider or handler which recorded the activity, such as 'ecs-shell'.
+	Source     string            `json:"source"`
+	SessionID  string            `json:"sessionId,omitempty"`
+	OccurredAt time.Time         `json:"occurredAt,omitempty"`
+	Data       map[string]string `json:"data"`
 }
@@ -2,8 +2,10 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
 
+	"github.com/benbjohnson/clock"
 	"github.com/common-fate/apikit/logger"
 	ahConfig "github.com/common-fate/common-fate/accesshandler/pkg/config"
 	"github.com/common-fate/common-fate/accesshandler/pkg/psetup"
@@ -14,9 +16,14 @@ import (
 	"github.com/common-fate/common-fate/pkg/auth"
 	"github.com/common-fate/common-fate/pkg/auth/localauth"
 	"github.com/common-fate/common-fate/pkg/auth/nolocalauth"
+	"github.com/common-fate/common-fate/pkg/autoapproval"
 	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/eventhandler"
 	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/common-fate/common-fate/pkg/identity/identitysync"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc/runtimes/local"
+	"github.com/common-fate/ddb"
 	"github.com/common-fate/provider-registry-sdk-go/pkg/providerregistrysdk"
 
 	"github.com/common-fate/common-fate/pkg/config"
@@ -109,6 +116,10 @@ func run() error {
 	if err != nil {
 		panic(err)
 	}
+	sources, err := deploy.UnmarshalIdentitySources(cfg.IdentitySources)
+	if err != nil {
+		panic(err)
+	}
 
 	idsync, err := identitysync.NewIdentitySyncer(ctx, identitysync.SyncOpts{
 		TableName:           cfg.DynamoTable,
@@ -116,6 +127,7 @@ func run() error {
 		IdpType:             cfg.IdpProvider,
 		IdentityConfig:      ic,
 		IdentityGroupFilter: cfg.IdentityGroupFilter,
+		IdentitySources:     sources,
 	})
 
 	if err != nil {
@@ -126,23 +138,42 @@ func run() error {
 	if err != nil {
 		return err
 	}
+	autoApproval, err := autoapproval.New(ctx, autoapproval.Opts{
+		Policies:      cfg.AutoApprovalPolicies,
+		LambdaARN:     cfg.AutoApprovalLambdaArn,
+		WebhookURL:    cfg.AutoApprovalWebhookURL,
+		WebhookSecret: cfg.AutoApprovalWebhookSecret,
+	})
+	if err != nil {
+		return err
+	}
+	workflowRuntime, err := buildWorkflowRuntime(ctx, cfg)
+	if err != nil {
+		return err
+	}
 	api, err := api.New(ctx, api.Opts{
-		Log:                    log,
-		DynamoTable:            cfg.DynamoTable,
-		PaginationKMSKeyARN:    cfg.PaginationKMSKeyARN,
-		AccessHandlerClient:    ahc,
-		EventSender:            eventBus,
-		AdminGroup:             cfg.AdminGroup,
-		DeploymentSuffix:       cfg.DeploymentSuffix,
-		IdentitySyncer:         idsync,
-		CognitoUserPoolID:      cfg.CognitoUserPoolID,
-		IDPType:                cfg.IdpProvider,
-		AdminGroupID:           cfg.AdminGroup,
-		DeploymentConfig:       dc,
-		TemplateData:           td,
-		ProviderRegistryClient: registryClient,
-		StateMachineARN:        cfg.StateMachineARN,
-		FrontendURL:            cfg.FrontendURL,
+		Log:                       log,
+		DynamoTable:               cfg.DynamoTable,
+		PaginationKMSKeyARN:       cfg.PaginationKMSKeyARN,
+		AccessHandlerClient:       ahc,
+		EventSender:               eventBus,
+		AdminGroup:                cfg.AdminGroup,
+		DeploymentSuffix:          cfg.DeploymentSuffix,
+		IdentitySyncer:            idsync,
+		IdentitySyncPreview:       idsync,
+		CognitoUserPoolID:         cfg.CognitoUserPoolID,
+		IDPType:                   cfg.IdpProvider,
+		AdminGroupID:              cfg.AdminGroup,
+		DeploymentConfig:          dc,
+		TemplateData:              td,
+		ProviderRegistryClient:    registryClient,
+		StateMachineARN:           cfg.StateMachineARN,
+		EventBusARN:               cfg.EventBusArn,
+		FrontendURL:               cfg.FrontendURL,
+		AutoApproval:              autoApproval,
+		GrantVerificationTimeout:  cfg.GrantVerificationTimeout,
+		GrantVerificationInterval: cfg.GrantVerificationInterval,
+		WorkflowRuntime:           workflowRuntime,
 	})
 	if err != nil {
 		return err
@@ -161,6 +192,33 @@ func run() error {
 	return s.Start(ctx)
 }
 
+// buildWorkflowRuntime returns the runtime for grants when COMMONFATE_WORKFLOW_RUNTIME is local.
+// The local runtime runs grants in process, and its events are handled in process by the event handler,
+// as they aren't sent to EventBridge. If the live runtime is used, nil is returned.
+func buildWorkflowRuntime(ctx context.Context, cfg config.Config) (workflowsvc.Runtime, error) {
+	switch cfg.WorkflowRuntime {
+	case "live":
+		return nil, nil
+	case "local":
+		db, err := ddb.New(ctx, cfg.DynamoTable)
+		if err != nil {
+			return nil, err
+		}
+		eh, err := eventhandler.New(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+		clk := clock.New()
+		zap.S().Info("running grants with the local workflow runtime")
+		return &local.Runtime{
+			Clock:    clk,
+			Eventbus: &gevent.LocalSender{Clock: clk, Handler: eh},
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid workflow runtime: %s. valid runtimes are: live, local", cfg.WorkflowRuntime)
+	}
+}
+
 // runAccessHandler runs a version of the access handler locally if COMMONFATE_RUN_ACCESS_HANDLER env var is not false, if not set it defaults to true
 func runAccessHandler() error {
 	ctx := context.Background()
@@ -19,6 +19,20 @@ const adminGroupId = app.node.tryGetContext("adminGroupId");
 const providerConfig = app.node.tryGetContext("providerConfiguration");
 const identityConfig = app.node.tryGetContext("identityConfiguration");
 const autoApprovalLambdaARN = app.node.tryGetContext("autoApprovalLambdaARN");
+const autoApprovalPolicies = app.node.tryGetContext("autoApprovalPolicies");
+const autoApprovalWebhookURL = app.node.tryGetContext("autoApprovalWebhookURL");
+const autoApprovalWebhookSecret = app.node.tryGetContext(
+  "autoApprovalWebhookSecret"
+);
+const requestReminderInterval = app.node.tryGetContext(
+  "requestReminderInterval"
+);
+const recurringRequestLeadTime = app.node.tryGetContext(
+  "recurringRequestLeadTime"
+);
+const grantVerificationTimeout = app.node.tryGetContext(
+  "grantVerificationTimeout"
+);
 const notificationsConfiguration = app.node.tryGetContext(
   "notificationsConfiguration"
 );
@@ -46,6 +60,7 @@ const analyticsDeploymentStage = app.node.tryGetContext(
   "analyticsDeploymentStage"
 );
 const identityGroupFilter = app.node.tryGetContext("identityGroupFilter");
+const identitySources = app.node.tryGetContext("identitySources");
 const subnetIds = app.node.tryGetContext("subnetIds");
 const securityGroups = app.node.tryGetContext("securityGroups");
 
@@ -105,10 +120,17 @@ if (stackTarget === "dev") {
     shouldRunCronHealthCheckCacheSync:
       shouldRunCronHealthCheckCacheSync || false,
     identityGroupFilter: identityGroupFilter || "",
+    identitySources: identitySources || "",
     idpSyncMemory: idpSyncMemory || 128,
     idpSyncSchedule: idpSyncSchedule || "rate(5 minutes)",
     idpSyncTimeoutSeconds: idpSyncTimeoutSeconds || 30,
     autoApprovalLambdaARN: autoApprovalLambdaARN || "",
+    autoApprovalPolicies: autoApprovalPolicies || "",
+    autoApprovalWebhookURL: autoApprovalWebhookURL || "",
+    autoApprovalWebhookSecret: autoApprovalWebhookSecret || "",
+    requestReminderInterval: requestReminderInterval || "24h",
+    recurringRequestLeadTime: recurringRequestLeadTime || "24h",
+    grantVerificationTimeout: grantVerificationTimeout || "0",
     subnetIds: subnetIds || "",
     securityGroups: securityGroups || "",
   });
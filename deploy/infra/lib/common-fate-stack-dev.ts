@@ -38,10 +38,17 @@ interface Props extends cdk.StackProps {
   analyticsDeploymentStage: string;
   shouldRunCronHealthCheckCacheSync: boolean;
   identityGroupFilter: string;
+  identitySources: string;
   idpSyncTimeoutSeconds: number;
   idpSyncSchedule: string;
   idpSyncMemory: number;
   autoApprovalLambdaARN: string;
+  autoApprovalPolicies: string;
+  autoApprovalWebhookURL: string;
+  autoApprovalWebhookSecret: string;
+  requestReminderInterval: string;
+  recurringRequestLeadTime: string;
+  grantVerificationTimeout: string;
   subnetIds: string;
   securityGroups: string;
 }
@@ -69,10 +76,18 @@ export class CommonFateStackDev extends cdk.Stack {
       analyticsLogLevel,
       analyticsDeploymentStage,
       identityGroupFilter,
+      identitySources,
+      identitySources,
       idpSyncTimeoutSeconds,
       idpSyncSchedule,
       idpSyncMemory,
       autoApprovalLambdaARN,
+      autoApprovalPolicies,
+      autoApprovalWebhookURL,
+      autoApprovalWebhookSecret,
+      requestReminderInterval,
+      recurringRequestLeadTime,
+      grantVerificationTimeout,
     } = props;
     const appName = `common-fate-${stage}`;
     const attachLambdaToVpcCondition = new CfnCondition(
@@ -155,6 +170,7 @@ export class CommonFateStackDev extends cdk.Stack {
         eventBusSourceName: events.getEventBusSourceName(),
         dynamoTable: db.getTable(),
         vpcConfig: vpcConfig,
+        grantVerificationTimeout: grantVerificationTimeout,
       }
     );
     const appBackend = new AppBackend(this, "API", {
@@ -187,6 +203,11 @@ export class CommonFateStackDev extends cdk.Stack {
       targetGroupGranter: targetGroupGranter,
       identityGroupFilter,
       autoApprovalLambdaARN: autoApprovalLambdaARN,
+      autoApprovalPolicies: autoApprovalPolicies,
+      autoApprovalWebhookURL: autoApprovalWebhookURL,
+      autoApprovalWebhookSecret: autoApprovalWebhookSecret,
+      requestReminderInterval: requestReminderInterval,
+      recurringRequestLeadTime: recurringRequestLeadTime,
       vpcConfig: vpcConfig,
     });
 
@@ -231,6 +252,13 @@ export class CommonFateStackDev extends cdk.Stack {
       CLIAppClientID: userPool.getCLIAppClient().userPoolClientId,
       HealthcheckFunctionName: appBackend.getHealthChecker().getFunctionName(),
       HealthcheckLogGroupName: appBackend.getHealthChecker().getLogGroupName(),
+      RequestSweeperLogGroupName: appBackend
+        .getRequestSweeper()
+        .getLogGroupName(),
+      GrantReconcilerLogGroupName: appBackend
+        .getGrantReconciler()
+        .getLogGroupName(),
+      GrantRetrierLogGroupName: appBackend.getGrantRetrier().getLogGroupName(),
       GranterV2StateMachineArn: targetGroupGranter.getStateMachineARN(),
     });
   }
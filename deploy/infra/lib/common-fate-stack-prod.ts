@@ -130,6 +130,13 @@ export class CommonFateStackProd extends cdk.Stack {
       default: "",
     });
 
+    const identitySources = new CfnParameter(this, "IdentitySources", {
+      type: "String",
+      description:
+        "If provided, a JSON array of identity providers to sync users and groups from, in order of precedence, each with an optional group filter.",
+      default: "",
+    });
+
     const remoteConfigHeaders = new CfnParameter(
       this,
       "ExperimentalRemoteConfigHeaders",
@@ -214,6 +221,66 @@ export class CommonFateStackProd extends cdk.Stack {
         }
     );
 
+    const autoApprovalPolicies = new CfnParameter(
+        this,
+        "AutoApprovalPolicies",
+        {
+          type: "String",
+          description: "A JSON array of policies which are evaluated to decide whether an access request can be approved automatically.",
+          default: "",
+        }
+    );
+
+    const autoApprovalWebhookURL = new CfnParameter(
+        this,
+        "AutoApprovalWebhookURL",
+        {
+          type: "String",
+          description: "URL of an HTTP endpoint which is called to decide whether an access request can be approved automatically.",
+          default: "",
+        }
+    );
+
+    const autoApprovalWebhookSecret = new CfnParameter(
+        this,
+        "AutoApprovalWebhookSecret",
+        {
+          type: "String",
+          description: "Reference to the SSM parameter containing the secret used to sign requests to the auto-approval webhook, in 'awsssm://' format.",
+          default: "",
+        }
+    );
+
+    const requestReminderInterval = new CfnParameter(
+        this,
+        "RequestReminderInterval",
+        {
+          type: "String",
+          description: "How often reviewers are reminded about pending access requests, such as '24h'. Set to '0' to disable reminders.",
+          default: "24h",
+        }
+    );
+
+    const recurringRequestLeadTime = new CfnParameter(
+        this,
+        "RecurringRequestLeadTime",
+        {
+          type: "String",
+          description: "How far ahead of its start time each occurrence of a recurring access request is requested, such as '24h'.",
+          default: "24h",
+        }
+    );
+
+    const grantVerificationTimeout = new CfnParameter(
+        this,
+        "GrantVerificationTimeout",
+        {
+          type: "String",
+          description: "How long to wait for access granted by target group handlers to become visible in the provider before the grant fails, such as '2m'. Must be less than the 5 minute granter function timeout, Only handlers which advertise that they can check grants are verified. Set to '0' to disable verification.",
+          default: "0",
+        }
+    );
+
     const subnetIds = new CfnParameter(this, "SubnetIds", {
       type: "String",
       description: "A list of subnet ids that are used by lambda functions",
@@ -315,6 +382,7 @@ export class CommonFateStackProd extends cdk.Stack {
         eventBusSourceName: events.getEventBusSourceName(),
         dynamoTable: db.getTable(),
         vpcConfig: vpcConfig,
+        grantVerificationTimeout: grantVerificationTimeout.valueAsString,
       }
     );
     const appBackend = new AppBackend(this, "API", {
@@ -345,7 +413,13 @@ export class CommonFateStackProd extends cdk.Stack {
       idpSyncTimeoutSeconds: idpSyncTimeoutSeconds.valueAsNumber,
       targetGroupGranter: targetGroupGranter,
       identityGroupFilter: identityGroupFilter.valueAsString,
+      identitySources: identitySources.valueAsString,
       autoApprovalLambdaARN: autoApprovalLambdaARN.valueAsString,
+      autoApprovalPolicies: autoApprovalPolicies.valueAsString,
+      autoApprovalWebhookURL: autoApprovalWebhookURL.valueAsString,
+      autoApprovalWebhookSecret: autoApprovalWebhookSecret.valueAsString,
+      requestReminderInterval: requestReminderInterval.valueAsString,
+      recurringRequestLeadTime: recurringRequestLeadTime.valueAsString,
       vpcConfig: vpcConfig,
     });
 
@@ -405,6 +479,13 @@ export class CommonFateStackProd extends cdk.Stack {
       CLIAppClientID: userPool.getCLIAppClient().userPoolClientId,
       HealthcheckFunctionName: appBackend.getHealthChecker().getFunctionName(),
       HealthcheckLogGroupName: appBackend.getHealthChecker().getLogGroupName(),
+      RequestSweeperLogGroupName: appBackend
+        .getRequestSweeper()
+        .getLogGroupName(),
+      GrantReconcilerLogGroupName: appBackend
+        .getGrantReconciler()
+        .getLogGroupName(),
+      GrantRetrierLogGroupName: appBackend.getGrantRetrier().getLogGroupName(),
       GranterV2StateMachineArn: targetGroupGranter.getStateMachineARN(),
     });
   }
@@ -17,6 +17,9 @@ import { Governance } from "./governance";
 import { IdpSync } from "./idp-sync";
 import { Notifiers } from "./notifiers";
 import { HealthChecker } from "./healthchecker";
+import { RequestSweeper } from "./request-sweeper";
+import { GrantReconciler } from "./grant-reconciler";
+import { GrantRetrier } from "./grant-retrier";
 import { TargetGroupGranter } from "./targetgroup-granter";
 import {
   grantAssumeHandlerRole,
@@ -52,7 +55,13 @@ interface Props {
   idpSyncMemory: number;
   targetGroupGranter: TargetGroupGranter;
   identityGroupFilter: string;
+  identitySources: string;
   autoApprovalLambdaARN: string;
+  autoApprovalPolicies: string;
+  autoApprovalWebhookURL: string;
+  autoApprovalWebhookSecret: string;
+  requestReminderInterval: string;
+  recurringRequestLeadTime: string;
   vpcConfig: VpcConfig;
 }
 
@@ -66,6 +75,9 @@ export class AppBackend extends Construct {
   private _idpSync: IdpSync;
   private _cacheSync: CacheSync;
   private _healthChecker: HealthChecker;
+  private _requestSweeper: RequestSweeper;
+  private _grantReconciler: GrantReconciler;
+  private _grantRetrier: GrantRetrier;
   private _KMSkey: cdk.aws_kms.Key;
   private _webhook: apigateway.Resource;
   private _webhookLambda: lambda.Function;
@@ -140,6 +152,8 @@ export class AppBackend extends Construct {
           COMMONFATE_DEPLOYMENT_SUFFIX: props.deploymentSuffix,
           COMMONFATE_GRANTER_V2_STATE_MACHINE_ARN:
             props.targetGroupGranter.getStateMachineARN(),
+          COMMONFATE_GRANT_VERIFICATION_TIMEOUT:
+            props.targetGroupGranter.getGrantVerificationTimeout(),
           COMMONFATE_ACCESS_REMOTE_CONFIG_URL: props.remoteConfigUrl,
           COMMONFATE_REMOTE_CONFIG_HEADERS: props.remoteConfigHeaders,
           CF_ANALYTICS_DISABLED: props.analyticsDisabled,
@@ -147,7 +161,12 @@ export class AppBackend extends Construct {
           CF_ANALYTICS_LOG_LEVEL: props.analyticsLogLevel,
           CF_ANALYTICS_DEPLOYMENT_STAGE: props.analyticsDeploymentStage,
           COMMONFATE_IDENTITY_GROUP_FILTER: props.identityGroupFilter,
+          COMMONFATE_IDENTITY_SOURCES: props.identitySources,
           COMMONFATE_AUTO_APPROVAL_LAMBDA_ARN: props.autoApprovalLambdaARN,
+          COMMONFATE_AUTO_APPROVAL_POLICIES: props.autoApprovalPolicies,
+          COMMONFATE_AUTO_APPROVAL_WEBHOOK_URL: props.autoApprovalWebhookURL,
+          COMMONFATE_AUTO_APPROVAL_WEBHOOK_SECRET:
+            props.autoApprovalWebhookSecret,
         },
         runtime: lambda.Runtime.PROVIDED_AL2,
         handler: "commonfate",
@@ -219,6 +238,18 @@ export class AppBackend extends Construct {
       })
     );
 
+    // the auto-approval webhook secret is read from SSM when the API starts.
+    this._lambda.addToRolePolicy(
+      new iam.PolicyStatement({
+        actions: ["ssm:GetParameter"],
+        resources: [
+          `arn:aws:ssm:${Stack.of(this).region}:${
+            Stack.of(this).account
+          }:parameter/granted/secrets/autoapproval/*`,
+        ],
+      })
+    );
+
     // allow the Common Fate API to write SSM parameters as part of the guided setup workflow.
     this._lambda.addToRolePolicy(
       new iam.PolicyStatement({
@@ -335,6 +366,8 @@ export class AppBackend extends Construct {
       dynamoTable: this._dynamoTable,
       eventBus: props.eventBus,
       eventBusSourceName: props.eventBusSourceName,
+      accessHandler: props.accessHandler,
+      targetGroupGranter: props.targetGroupGranter,
       vpcConfig: props.vpcConfig,
     });
     this._notifiers = new Notifiers(this, "Notifiers", {
@@ -359,6 +392,7 @@ export class AppBackend extends Construct {
       analyticsDisabled: props.analyticsDisabled,
       analyticsUrl: props.analyticsUrl,
       identityGroupFilter: props.identityGroupFilter,
+      identitySources: props.identitySources,
       idpSyncMemory: props.idpSyncMemory,
       idpSyncSchedule: props.idpSyncSchedule,
       idpSyncTimeoutSeconds: props.idpSyncTimeoutSeconds,
@@ -376,6 +410,28 @@ export class AppBackend extends Construct {
       shouldRunAsCron: props.shouldRunCronHealthCheckCacheSync,
       vpcConfig: props.vpcConfig,
     });
+    this._requestSweeper = new RequestSweeper(this, "RequestSweeper", {
+      dynamoTable: this._dynamoTable,
+      eventBus: props.eventBus,
+      reminderInterval: props.requestReminderInterval,
+      recurringRequestLeadTime: props.recurringRequestLeadTime,
+      accessHandler: props.accessHandler,
+      targetGroupGranter: props.targetGroupGranter,
+      vpcConfig: props.vpcConfig,
+    });
+    this._grantReconciler = new GrantReconciler(this, "GrantReconciler", {
+      dynamoTable: this._dynamoTable,
+      eventBus: props.eventBus,
+      accessHandler: props.accessHandler,
+      vpcConfig: props.vpcConfig,
+    });
+    this._grantRetrier = new GrantRetrier(this, "GrantRetrier", {
+      dynamoTable: this._dynamoTable,
+      eventBus: props.eventBus,
+      accessHandler: props.accessHandler,
+      targetGroupGranter: props.targetGroupGranter,
+      vpcConfig: props.vpcConfig,
+    });
   }
 
   /**
@@ -448,6 +504,15 @@ export class AppBackend extends Construct {
   getHealthChecker(): HealthChecker {
     return this._healthChecker;
   }
+  getRequestSweeper(): RequestSweeper {
+    return this._requestSweeper;
+  }
+  getGrantReconciler(): GrantReconciler {
+    return this._grantReconciler;
+  }
+  getGrantRetrier(): GrantRetrier {
+    return this._grantRetrier;
+  }
 
   getKmsKeyArn(): string {
     return this._KMSkey.keyArn;
@@ -22,6 +22,7 @@ export class Database extends Construct {
       sortKey: { name: "SK", type: dynamodb.AttributeType.STRING },
       billingMode: dynamodb.BillingMode.PAY_PER_REQUEST,
       pointInTimeRecovery: true,
+      timeToLiveAttribute: "ttl",
     });
 
     const gsi1: dynamodb.GlobalSecondaryIndexProps = {
@@ -2,15 +2,21 @@ import { Duration } from "aws-cdk-lib";
 import { Table } from "aws-cdk-lib/aws-dynamodb";
 import { EventBus, Rule } from "aws-cdk-lib/aws-events";
 import { LambdaFunction } from "aws-cdk-lib/aws-events-targets";
+import { PolicyStatement } from "aws-cdk-lib/aws-iam";
 import * as lambda from "aws-cdk-lib/aws-lambda";
 import { Construct } from "constructs";
 import * as path from "path";
 import { BaseLambdaFunction, VpcConfig } from "../helpers/base-lambda";
+import { grantAssumeHandlerRole } from "../helpers/permissions";
+import { AccessHandler } from "./access-handler";
+import { TargetGroupGranter } from "./targetgroup-granter";
 
 interface Props {
   eventBusSourceName: string;
   eventBus: EventBus;
   dynamoTable: Table;
+  accessHandler: AccessHandler;
+  targetGroupGranter: TargetGroupGranter;
   vpcConfig: VpcConfig;
 }
 export class EventHandler extends Construct {
@@ -23,9 +29,14 @@ export class EventHandler extends Construct {
     this._lambda = new BaseLambdaFunction(this, "Function", {
       functionProps: {
         code,
-        timeout: Duration.seconds(20),
+        // bulk revocations revoke every queued grant in a single invocation.
+        timeout: Duration.minutes(5),
         environment: {
           COMMONFATE_TABLE_NAME: props.dynamoTable.tableName,
+          COMMONFATE_EVENT_BUS_ARN: props.eventBus.eventBusArn,
+          COMMONFATE_ACCESS_HANDLER_URL: props.accessHandler.getApiUrl(),
+          COMMONFATE_GRANTER_V2_STATE_MACHINE_ARN:
+            props.targetGroupGranter.getStateMachineARN(),
         },
         runtime: lambda.Runtime.PROVIDED_AL2,
         handler: "event-handler",
@@ -43,6 +54,27 @@ export class EventHandler extends Construct {
       ],
     });
     props.dynamoTable.grantReadWriteData(this._lambda);
+    props.eventBus.grantPutEventsTo(this._lambda);
+
+    // grants queued by bulk revocations are revoked by the event handler,
+    // which revokes target group grants by stopping their workflow.
+    this._lambda.addToRolePolicy(
+      new PolicyStatement({
+        resources: [props.accessHandler.getApiGateway().arnForExecuteApi()],
+        actions: ["execute-api:Invoke"],
+      })
+    );
+    this._lambda.addToRolePolicy(
+      new PolicyStatement({
+        actions: [
+          "states:StopExecution",
+          "states:DescribeExecution",
+          "states:GetExecutionHistory",
+        ],
+        resources: ["*"],
+      })
+    );
+    grantAssumeHandlerRole(this._lambda);
   }
   getLogGroupName(): string {
     return this._lambda.logGroup.logGroupName;
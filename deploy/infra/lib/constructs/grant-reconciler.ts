@@ -0,0 +1,75 @@
+import { Duration } from "aws-cdk-lib";
+import { Table } from "aws-cdk-lib/aws-dynamodb";
+import * as events from "aws-cdk-lib/aws-events";
+import { EventBus } from "aws-cdk-lib/aws-events";
+import * as targets from "aws-cdk-lib/aws-events-targets";
+import { PolicyStatement } from "aws-cdk-lib/aws-iam";
+import * as lambda from "aws-cdk-lib/aws-lambda";
+import { Construct } from "constructs";
+import * as path from "path";
+import { BaseLambdaFunction, VpcConfig } from "../helpers/base-lambda";
+import { AccessHandler } from "./access-handler";
+
+interface Props {
+  dynamoTable: Table;
+  eventBus: EventBus;
+  accessHandler: AccessHandler;
+  vpcConfig: VpcConfig;
+}
+
+// GrantReconciler periodically checks that the access for active grants still exists in providers,
+// and reports access in providers which no grant explains. Grants made through target groups aren't checked.
+export class GrantReconciler extends Construct {
+  private _lambda: lambda.Function;
+  private eventRule: events.Rule;
+
+  constructor(scope: Construct, id: string, props: Props) {
+    super(scope, id);
+    const code = lambda.Code.fromAsset(
+      path.join(__dirname, "..", "..", "..", "..", "bin", "grant-reconciler.zip")
+    );
+
+    this._lambda = new BaseLambdaFunction(this, "HandlerFunction", {
+      functionProps: {
+        code,
+        timeout: Duration.minutes(15),
+        environment: {
+          COMMONFATE_TABLE_NAME: props.dynamoTable.tableName,
+          COMMONFATE_EVENT_BUS_ARN: props.eventBus.eventBusArn,
+          COMMONFATE_ACCESS_HANDLER_URL: props.accessHandler.getApiUrl(),
+        },
+        runtime: lambda.Runtime.PROVIDED_AL2,
+        handler: "grant-reconciler",
+      },
+      vpcConfig: props.vpcConfig,
+    });
+
+    props.dynamoTable.grantReadWriteData(this._lambda);
+    props.eventBus.grantPutEventsTo(this._lambda);
+
+    // grants are checked by the access handler.
+    this._lambda.addToRolePolicy(
+      new PolicyStatement({
+        resources: [props.accessHandler.getApiGateway().arnForExecuteApi()],
+        actions: ["execute-api:Invoke"],
+      })
+    );
+
+    // reconciliation calls provider APIs for every active grant, so it runs hourly rather than every few minutes.
+    this.eventRule = new events.Rule(this, "EventBridgeCronRule", {
+      schedule: events.Schedule.cron({ minute: "30" }),
+    });
+
+    // add the Lambda function as a target for the Event Rule
+    this.eventRule.addTarget(new targets.LambdaFunction(this._lambda));
+
+    // allow the Event Rule to invoke the Lambda function
+    targets.addLambdaPermission(this.eventRule, this._lambda);
+  }
+  getLogGroupName(): string {
+    return this._lambda.logGroup.logGroupName;
+  }
+  getFunctionName(): string {
+    return this._lambda.functionName;
+  }
+}
@@ -0,0 +1,96 @@
+import { Duration } from "aws-cdk-lib";
+import { Table } from "aws-cdk-lib/aws-dynamodb";
+import * as events from "aws-cdk-lib/aws-events";
+import { EventBus } from "aws-cdk-lib/aws-events";
+import * as targets from "aws-cdk-lib/aws-events-targets";
+import { PolicyStatement } from "aws-cdk-lib/aws-iam";
+import * as lambda from "aws-cdk-lib/aws-lambda";
+import { Construct } from "constructs";
+import * as path from "path";
+import { BaseLambdaFunction, VpcConfig } from "../helpers/base-lambda";
+import { grantAssumeHandlerRole } from "../helpers/permissions";
+import { AccessHandler } from "./access-handler";
+import { TargetGroupGranter } from "./targetgroup-granter";
+
+interface Props {
+  dynamoTable: Table;
+  eventBus: EventBus;
+  accessHandler: AccessHandler;
+  targetGroupGranter: TargetGroupGranter;
+  vpcConfig: VpcConfig;
+}
+
+// GrantRetrier periodically retries changes to grants which failed, such as a grant which couldn't be revoked.
+export class GrantRetrier extends Construct {
+  private _lambda: lambda.Function;
+  private eventRule: events.Rule;
+
+  constructor(scope: Construct, id: string, props: Props) {
+    super(scope, id);
+    const code = lambda.Code.fromAsset(
+      path.join(__dirname, "..", "..", "..", "..", "bin", "grant-retrier.zip")
+    );
+
+    this._lambda = new BaseLambdaFunction(this, "HandlerFunction", {
+      functionProps: {
+        code,
+        timeout: Duration.minutes(5),
+        environment: {
+          COMMONFATE_TABLE_NAME: props.dynamoTable.tableName,
+          COMMONFATE_EVENT_BUS_ARN: props.eventBus.eventBusArn,
+          COMMONFATE_ACCESS_HANDLER_URL: props.accessHandler.getApiUrl(),
+          COMMONFATE_GRANTER_V2_STATE_MACHINE_ARN:
+            props.targetGroupGranter.getStateMachineARN(),
+          COMMONFATE_GRANT_VERIFICATION_TIMEOUT:
+            props.targetGroupGranter.getGrantVerificationTimeout(),
+        },
+        runtime: lambda.Runtime.PROVIDED_AL2,
+        handler: "grant-retrier",
+      },
+      vpcConfig: props.vpcConfig,
+    });
+
+    props.dynamoTable.grantReadWriteData(this._lambda);
+    props.eventBus.grantPutEventsTo(this._lambda);
+
+    // provider grants are retried through the access handler.
+    this._lambda.addToRolePolicy(
+      new PolicyStatement({
+        resources: [props.accessHandler.getApiGateway().arnForExecuteApi()],
+        actions: ["execute-api:Invoke"],
+      })
+    );
+    props.targetGroupGranter
+      .getStateMachine()
+      .grantStartExecution(this._lambda);
+    // target group grants are revoked by stopping their workflow.
+    this._lambda.addToRolePolicy(
+      new PolicyStatement({
+        actions: [
+          "states:StopExecution",
+          "states:DescribeExecution",
+          "states:GetExecutionHistory",
+        ],
+        resources: ["*"],
+      })
+    );
+    grantAssumeHandlerRole(this._lambda);
+
+    //add event bridge trigger to lambda every 5 minutes
+    this.eventRule = new events.Rule(this, "EventBridgeCronRule", {
+      schedule: events.Schedule.cron({ minute: "0/5" }),
+    });
+
+    // add the Lambda function as a target for the Event Rule
+    this.eventRule.addTarget(new targets.LambdaFunction(this._lambda));
+
+    // allow the Event Rule to invoke the Lambda function
+    targets.addLambdaPermission(this.eventRule, this._lambda);
+  }
+  getLogGroupName(): string {
+    return this._lambda.logGroup.logGroupName;
+  }
+  getFunctionName(): string {
+    return this._lambda.functionName;
+  }
+}
@@ -44,8 +44,35 @@ export class Granter extends Construct {
     });
 
     const definition = {
-      StartAt: "Validate End is in the Future",
+      StartAt: "Create Grant",
       States: {
+        "Create Grant": {
+          Type: "Task",
+          Resource: "arn:aws:states:::lambda:invoke",
+          Parameters: {
+            FunctionName: this._lambda.functionArn,
+            Payload: {
+              "action": "CREATE",
+              "grant.$": "$.grant",
+            },
+          },
+          Retry: [
+            {
+              ErrorEquals: [
+                "Lambda.ServiceException",
+                "Lambda.AWSLambdaException",
+                "Lambda.SdkClientException",
+              ],
+              IntervalSeconds: 2,
+              MaxAttempts: 6,
+              BackoffRate: 2,
+            },
+          ],
+          Next: "Validate End is in the Future",
+          ResultPath: "$",
+          OutputPath: "$.Payload",
+          Comment: "Emits the grant created event before the grant can be activated",
+        },
         "Validate End is in the Future": {
           Type: "Choice",
           Choices: [
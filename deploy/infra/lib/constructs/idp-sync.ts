@@ -19,6 +19,7 @@ interface Props {
   analyticsLogLevel: string;
   analyticsDeploymentStage: string;
   identityGroupFilter: string;
+  identitySources: string;
   idpSyncTimeoutSeconds: number;
   idpSyncSchedule: string;
   idpSyncMemory: number;
@@ -51,6 +52,7 @@ export class IdpSync extends Construct {
           CF_ANALYTICS_LOG_LEVEL: props.analyticsLogLevel,
           CF_ANALYTICS_DEPLOYMENT_STAGE: props.analyticsDeploymentStage,
           COMMONFATE_IDENTITY_GROUP_FILTER: props.identityGroupFilter,
+          COMMONFATE_IDENTITY_SOURCES: props.identitySources,
         },
         runtime: lambda.Runtime.PROVIDED_AL2,
         handler: "syncer",
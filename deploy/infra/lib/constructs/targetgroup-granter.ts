@@ -14,12 +14,16 @@ interface Props {
   dynamoTable: Table;
   eventBus: EventBus;
   vpcConfig: VpcConfig;
+  // how long to wait for activated access to become visible in the provider, such as "2m". "0" disables verification.
+  grantVerificationTimeout: string;
 }
 export class TargetGroupGranter extends Construct {
   private _stateMachine: sfn.StateMachine;
   private _lambda: lambda.Function;
+  private _grantVerificationTimeout: string;
   constructor(scope: Construct, id: string, props: Props) {
     super(scope, id);
+    this._grantVerificationTimeout = props.grantVerificationTimeout;
     const code = lambda.Code.fromAsset(
       path.join(
         __dirname,
@@ -40,6 +44,7 @@ export class TargetGroupGranter extends Construct {
           COMMONFATE_EVENT_BUS_ARN: props.eventBus.eventBusArn,
           COMMONFATE_EVENT_BUS_SOURCE: props.eventBusSourceName,
           COMMONFATE_TABLE_NAME: props.dynamoTable.tableName,
+          COMMONFATE_GRANT_VERIFICATION_TIMEOUT: props.grantVerificationTimeout,
         },
         runtime: lambda.Runtime.PROVIDED_AL2,
         handler: "targetgroup-granter",
@@ -54,8 +59,35 @@ export class TargetGroupGranter extends Construct {
 
     // this lambda needs to be able to invoke provider deployments
     const definition = {
-      StartAt: "Validate End is in the Future",
+      StartAt: "Create Grant",
       States: {
+        "Create Grant": {
+          Type: "Task",
+          Resource: "arn:aws:states:::lambda:invoke",
+          Parameters: {
+            FunctionName: this._lambda.functionArn,
+            Payload: {
+              "action": "CREATE",
+              "grant.$": "$.grant",
+            },
+          },
+          Retry: [
+            {
+              ErrorEquals: [
+                "Lambda.ServiceException",
+                "Lambda.AWSLambdaException",
+                "Lambda.SdkClientException",
+              ],
+              IntervalSeconds: 2,
+              MaxAttempts: 6,
+              BackoffRate: 2,
+            },
+          ],
+          Next: "Validate End is in the Future",
+          ResultPath: "$",
+          OutputPath: "$.Payload",
+          Comment: "Emits the grant created event before the grant can be activated",
+        },
         "Validate End is in the Future": {
           Type: "Choice",
           Choices: [
@@ -130,7 +162,30 @@ export class TargetGroupGranter extends Construct {
             },
           ],
           ResultPath: "$",
-          End: true,
+          Next: "Check for Extension",
+        },
+        "Check for Extension": {
+          Type: "Choice",
+          Choices: [
+            {
+              And: [
+                {
+                  Variable: "$.extended",
+                  IsPresent: true,
+                },
+                {
+                  Variable: "$.extended",
+                  BooleanEquals: true,
+                },
+              ],
+              Next: "Wait for Window End",
+            },
+          ],
+          Default: "Done",
+          Comment: "If the grant was extended, the granter returns the new end time and access is not expired yet",
+        },
+        "Done": {
+          Type: "Succeed",
         },
         "Fail": {
           Type: "Fail",
@@ -161,6 +216,10 @@ export class TargetGroupGranter extends Construct {
   getStateMachine(): sfn.StateMachine {
     return this._stateMachine;
   }
+  // grants which are retried outside of the state machine are verified with the same timeout.
+  getGrantVerificationTimeout(): string {
+    return this._grantVerificationTimeout;
+  }
   getLogGroupName(): string {
     return this._lambda.logGroup.logGroupName;
   }
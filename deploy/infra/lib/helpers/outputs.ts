@@ -37,6 +37,9 @@ export type StackOutputs = {
   CLIAppClientID: string;
   HealthcheckFunctionName: string;
   HealthcheckLogGroupName: string;
+  RequestSweeperLogGroupName: string;
+  GrantReconcilerLogGroupName: string;
+  GrantRetrierLogGroupName: string;
   GranterV2StateMachineArn: string;
 };
 /**
@@ -1308,25 +1308,25 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xX0W7bNhT9FYLboyY5WwdkftOcNDO2okOQ9aXLw410bbOhSPaScmsE+veBpBxLspI4",
-	"TbpmwJ7iSOTh4T2H51I3vNCV0QqVs3x6wwk/1mjdr7oUGB7MCMFhXhRo7Xkt8TwO8K8KrRyq8BOMkaIA",
-	"J7TKPlit/DNbrLAC/8uQNkiuRQRjSK9B+t/fEy74lKeZNqjAiHRTye+yHaUsgtgsD5OQZlotxJI3CS/R",
-	"FiSMX9Ij4WeojEQ+5XlZCcUgMGZOs7fXDnjC3cb4t9aRUAFgSbo2gVEPil+skIV3bH5imVuBY26FW0Cq",
-	"JbKwXfToKU+4cFgFnL0l2gdABBv/v4IK+2Q9OQae8RhFB7RE96hCDfW6iBAeTFQ408o6AtGqfTDqxWBu",
-	"0yTBKoKw5NP321omO3HbzfZ1ut3RPpvL2+3rqw9YON40fpG/TPmfsN897nq8PfYHPqd0Ca9DUd+gtbAc",
-	"W3Ag7nD15FC5RzUN4NZoZaMep0SaztsnT9AVPc7Dm4nDxpgNNOW5YmEwI3Q1KSzZgnQVssAirUWBqS/m",
-	"H8K6nT/tCToQ8hn2AzvMnosO9+wtQOQ06jj8HIipWkq48onkqMbkgRp2qbUYhxT0NKYe28o/Ur2XVbdv",
-	"XrGcSWEd0wsWSbGAkMahQi30tk5QRFIhQPhMV5VW7DU4fyJrknzKV84ZO838hiutFuAwFZqPmT7/c84W",
-	"mpghvSSoKnCiACk3rAIFS6GW3VZomVDsjEA5LFnepoFNQ8C72I63D9mZT1AFqkC/Bk/4GsnGZY/SiefS",
-	"SsOn/Kd0kk54wg24VZAvW+p1tj7K4to/0Fbftj/2d+FdxUDKHlMe8Cm4aF7yKT/T64H9woIEFToky6fv",
-	"h7ivhXRIPTHY1YYBM0BOFLUEYtaBq0MFhJ/ysUbabOPR+8O/5UnHvqjqynskn13M353yhOfns9/m705P",
-	"OhbZ2WpICVVBG+Or7/Q1KhbcIJSXyXi1wnZZ8Mo4I2/GCz+1R2q47uUgtX+cTDqHqneObsdl90ej97Ct",
-	"qwpos5WsW9hwWVh6FfjOOPyySbjRdkTzePFhoLqij2k+vCHxpHPr3RwUFb1bcnbXFbnZq9nRo3LtCUm/",
-	"HyWRZOlJveppd98ut0L2e3SAOHo6xKuHHbQ36+dDfDeY1fNZa5OO0+4yWpOMhk524//My+bO9DlD523Y",
-	"WSId8+EZuoEJ98/Xt/HK29+/isZfANGTztf1AN32Mjyknm8ku9CLEvJuk45t/N4ENPWI2vELxQ4VZ+F5",
-	"6Jbxm7EIxrMMmMJPrG19o8YYfvP8iwH1Akw3+YJU6Fv1hSdQlPc5EigDKlZiHW7LX83zo832DdC1HfRa",
-	"Bpa1hMr0b5WrDTOoSn8EWjvacK90K2F78z4JKdkVssJvW0osR09FHqH/j8xHOvSpqdvW/SC/+olI660J",
-	"d18e0yyTugC50tZNj4+Pf+HeWy3I7XdLB6y5bP4JAAD//5tkSE2bFAAA",
+	"H4sIAAAAAAAC/+xXXW/bNhT9K8TdHjXJ2Tog05vnpJmxFR2CrC+dH26ka5sNRbIk5dYI9N8HkvKHZCVx",
+	"mnTNgD3FocjDw3uOzqVuoVCVVpKks5DfgqGPNVn3qyo5hYGJIXQ0Lgqy9rIWdBkn+EeFko5k+IlaC16g",
+	"40pmH6ySfswWS6rQ/9JGaTKuRUStjVqh8L+/NzSHHNJMaZKoebquxHfZjlIWQWw2DovITJSc8wU0CZRk",
+	"C8O139Ij0WestCDIYVxWXDIMjJlT7O2NQ0jArbV/ap3hMgAsjKp1YNSBgqslsfCMTc8sc0t0zC1pA2hq",
+	"QSwclzx6CglwR1XAOdiiHUBjcO3/l1hRl6wnx9AzHqLo0CzIPapQfb2uIoQH4xVNlLTOIG/VPhr1qre2",
+	"aZJgFW6ohPz9ppbJTtz2sF2dtic6ZDPbHl9df6DCQdP4Tf7S5X/Cfve46/H2OJz4nNIlUIeiviFrcTG0",
+	"YU/c/u7JsXIPahrArVbSRj3OjVHmsh15gq7kcR4+TJw2xKynKYwlC5OZIVcbSSWbG1WFLLBkVryg1Bfz",
+	"D27dzp/2jBxy8QznwR1mx0XHe3YLEDkNOo4+B2KyFgKvfSI5U1PyQA33qbUYxxT0PKYe28g/UL2XVbdv",
+	"XrExE9w6puYskmIBIY1TuZyrTZ2wiKRCgMBEVZWS7DU6ggRqIyCHpXPa5pk/cKXkHB2lXMGQ6cd/Ttlc",
+	"GaaNWhisKnS8QCHWrEKJCy4X+63QMi7ZhUHpqGTjNg1sGgLexXa8GWQXPkElyoL8HpDAioyN256kI8+l",
+	"lQZy+CkdpSNIQKNbBvmyhVplq5Ms7v2D2ejb9sfuKbyrGArRYQoB3wQXTUvI4UKtevYLGxqsyJGxkL/v",
+	"477mwpHpiMGu1wyZRuN4UQs0zDp0dagA90s+1mTWm3jMIT6FZM++JOvKe2Q8uZq+O4cExpeT36bvzs9g",
+	"NmCrPiWShVlrX32nbkiy4AYuvUzaqxWOy4JXhhl5M175pR1S/X1nvdT+cTTae6k679F2XnZ/NHoP27qq",
+	"0Kw3ku0XNlwWFl4F2BkHZk0CWtkBzePFh6HcF31I8/4NCZK9W+/6qKjo3JKzu67IzUHNTh6Va09I+sMo",
+	"iSRLT+rVaHQU/E7Ibo8OECdPh3j1sIMOVv08Gj16VcdnrU32nHaX0ZpkMHSyW/9nWjZ3ps8FOW/DvS3S",
+	"IR9ekOuZ8PD9+jZeefv7V9H4CyA60vm6HqHbQYaH1PONZBd6UULYb9Kxjd+bgLoeUDt+odi+4iyMh24Z",
+	"vxmLYDzLkEn6xNrWN2iM/jfPvxhQL8B0oy9Iha5VX3gCRXmfI4EyNMWSr8Jt+at5frDZvkFzY3u9lqFl",
+	"LaEy/VuO5ZppkqV/BVo72nCvdEtuO+s+cSHYNbHCH1sIKgffinGE/j8yH+nQp6ZuW/ej/OoXklltTLj7",
+	"8sizTKgCxVJZl5+env4CzWwLsv1u2QNrZs0/AwCbZEhNmxQAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
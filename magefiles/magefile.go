@@ -122,6 +122,27 @@ func (Build) HealthChecker() error {
 	}
 	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/healthcheck/bootstrap", "cmd/lambda/healthcheck/handler.go")
 }
+func (Build) RequestSweeper() error {
+	env := map[string]string{
+		"GOOS":   "linux",
+		"GOARCH": "amd64",
+	}
+	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/request-sweeper/bootstrap", "cmd/lambda/request-sweeper/handler.go")
+}
+func (Build) GrantReconciler() error {
+	env := map[string]string{
+		"GOOS":   "linux",
+		"GOARCH": "amd64",
+	}
+	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/grant-reconciler/bootstrap", "cmd/lambda/grant-reconciler/handler.go")
+}
+func (Build) GrantRetrier() error {
+	env := map[string]string{
+		"GOOS":   "linux",
+		"GOARCH": "amd64",
+	}
+	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/grant-retrier/bootstrap", "cmd/lambda/grant-retrier/handler.go")
+}
 func (Build) CacheSyncer() error {
 	env := map[string]string{
 		"GOOS":   "linux",
@@ -228,10 +249,28 @@ func PackageHealthChecker() error {
 	return sh.Run("zip", "--junk-paths", "bin/healthcheck.zip", "bin/healthcheck/bootstrap")
 }
 
+// PackageRequestSweeper zips the Go request sweeper so that it can be deployed to Lambda.
+func PackageRequestSweeper() error {
+	mg.Deps(Build.RequestSweeper)
+	return sh.Run("zip", "--junk-paths", "bin/request-sweeper.zip", "bin/request-sweeper/bootstrap")
+}
+
+// PackageGrantReconciler zips the Go grant reconciler so that it can be deployed to Lambda.
+func PackageGrantReconciler() error {
+	mg.Deps(Build.GrantReconciler)
+	return sh.Run("zip", "--junk-paths", "bin/grant-reconciler.zip", "bin/grant-reconciler/bootstrap")
+}
+
+// PackageGrantRetrier zips the Go grant retrier so that it can be deployed to Lambda.
+func PackageGrantRetrier() error {
+	mg.Deps(Build.GrantRetrier)
+	return sh.Run("zip", "--junk-paths", "bin/grant-retrier.zip", "bin/grant-retrier/bootstrap")
+}
+
 func Package() {
 	mg.Deps(PackageBackend, PackageGranter, PackageAccessHandler, PackageSlackNotifier)
 	mg.Deps(PackageEventHandler, PackageSyncer, PackageWebhook, PackageGovernance, PackageFrontendDeployer)
-	mg.Deps(PackageCacheSyncer, PackageHealthChecker, PackageTargetGroupGranter)
+	mg.Deps(PackageCacheSyncer, PackageHealthChecker, PackageTargetGroupGranter, PackageRequestSweeper, PackageGrantReconciler, PackageGrantRetrier)
 }
 
 // PackageGranter zips the Go granter so that it can be deployed to Lambda.
@@ -371,6 +410,13 @@ func Dotenv() error {
 	myEnv["COMMONFATE_ACCESS_REMOTE_CONFIG_URL"] = cfg.Deployment.Parameters.ExperimentalRemoteConfigURL
 	myEnv["COMMONFATE_REMOTE_CONFIG_HEADERS"] = cfg.Deployment.Parameters.ExperimentalRemoteConfigHeaders
 	myEnv["COMMONFATE_IDENTITY_GROUP_FILTER"] = cfg.Deployment.Parameters.IdentityGroupFilter
+	if len(cfg.Deployment.Parameters.IdentitySources) > 0 {
+		identitySources, err := json.Marshal(cfg.Deployment.Parameters.IdentitySources)
+		if err != nil {
+			return err
+		}
+		myEnv["COMMONFATE_IDENTITY_SOURCES"] = string(identitySources)
+	}
 	myEnv["COMMONFATE_GRANTER_V2_STATE_MACHINE_ARN"] = o.GranterV2StateMachineArn
 
 	err = godotenv.Write(myEnv, ".env")
@@ -0,0 +1,109 @@
+package access
+
+import (
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// ApprovalStage tracks the approvals collected for a stage of an access rule's approval policy.
+type ApprovalStage struct {
+	Name string `json:"name" dynamodbav:"name"`
+	// Quorum is the number of approvals required to complete the stage.
+	Quorum int `json:"quorum" dynamodbav:"quorum"`
+	// ApprovedBy holds the IDs of the reviewers who approved this stage.
+	ApprovedBy []string `json:"approvedBy" dynamodbav:"approvedBy"`
+}
+
+// Complete is true if the stage has reached its quorum.
+func (s ApprovalStage) Complete() bool {
+	return len(s.ApprovedBy) >= s.Quorum
+}
+
+func (s ApprovalStage) ToAPI() types.RequestApprovalStage {
+	approvedBy := []string{}
+	approvedBy = append(approvedBy, s.ApprovedBy...)
+	return types.RequestApprovalStage{
+		Name:       s.Name,
+		Quorum:     s.Quorum,
+		ApprovedBy: approvedBy,
+		Complete:   s.Complete(),
+	}
+}
+
+// NewApprovalStages builds the approval progress for a new request from the access rule's approval policy.
+// Each stage starts without any approvals.
+func NewApprovalStages(a rule.Approval) []ApprovalStage {
+	var stages []ApprovalStage
+	for _, s := range a.GetStages() {
+		stages = append(stages, ApprovalStage{
+			Name:       s.Name,
+			Quorum:     s.RequiredApprovals(),
+			ApprovedBy: []string{},
+		})
+	}
+	return stages
+}
+
+// CurrentApprovalStage returns the index of the first incomplete approval stage.
+// It returns -1 if every stage is complete.
+func (r *Request) CurrentApprovalStage() int {
+	return CurrentApprovalStage(r.ApprovalStages)
+}
+
+// HasApproved is true if the reviewer has already approved any stage of the request.
+func (r *Request) HasApproved(reviewerID string) bool {
+	return HasApproved(r.ApprovalStages, reviewerID)
+}
+
+// CurrentApprovalStage returns the index of the first incomplete stage.
+// It returns -1 if every stage is complete.
+func CurrentApprovalStage(stages []ApprovalStage) int {
+	for i, s := range stages {
+		if !s.Complete() {
+			return i
+		}
+	}
+	return -1
+}
+
+// HasApproved is true if the reviewer has already approved any of the stages.
+func HasApproved(stages []ApprovalStage, reviewerID string) bool {
+	for _, s := range stages {
+		for _, id := range s.ApprovedBy {
+			if id == reviewerID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *Request) approvalStagesToAPI() *[]types.RequestApprovalStage {
+	if len(r.ApprovalStages) == 0 {
+		return nil
+	}
+	res := make([]types.RequestApprovalStage, len(r.ApprovalStages))
+	for i, s := range r.ApprovalStages {
+		res[i] = s.ToAPI()
+	}
+	return &res
+}
+
+// Arguments returns the value of each argument of the request, keyed by argument ID.
+// This includes the arguments which are fixed by the access rule as well as those selected by the user.
+func (r *Request) Arguments(accessRule rule.AccessRule) map[string]string {
+	arguments := make(map[string]string)
+	for k, v := range accessRule.Target.With {
+		arguments[k] = v
+	}
+	for k, v := range r.SelectedWith {
+		arguments[k] = v.Value
+	}
+	return arguments
+}
+
+// ResolveApproval returns the approval which applies to the request,
+// taking into account any approval conditions on the access rule.
+func (r *Request) ResolveApproval(accessRule rule.AccessRule) rule.Approval {
+	return accessRule.Approval.ForArguments(r.Arguments(accessRule))
+}
@@ -0,0 +1,38 @@
+package access
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// BreakGlassReview is the review of a break-glass request.
+// Break-glass requests are approved immediately, so an approver reviews the access after it has been granted.
+type BreakGlassReview struct {
+	// ReviewID is the ID of the Review which was stored for the decision.
+	ReviewID   string    `json:"reviewId" dynamodbav:"reviewId"`
+	ReviewerID string    `json:"reviewerId" dynamodbav:"reviewerId"`
+	Decision   Decision  `json:"decision" dynamodbav:"decision"`
+	Comment    *string   `json:"comment,omitempty" dynamodbav:"comment,omitempty"`
+	ReviewedAt time.Time `json:"reviewedAt" dynamodbav:"reviewedAt"`
+}
+
+func (b BreakGlassReview) ToAPI() types.BreakGlassReview {
+	return types.BreakGlassReview{
+		ReviewerId: b.ReviewerID,
+		Decision:   types.ReviewDecision(b.Decision),
+		Comment:    b.Comment,
+		ReviewedAt: b.ReviewedAt,
+	}
+}
+
+// IsBreakGlass returns true if the request was approved using break-glass access.
+func (r *Request) IsBreakGlass() bool {
+	return r.ApprovalMethod != nil && *r.ApprovalMethod == types.BREAKGLASS
+}
+
+// RequiresBreakGlassReview returns true if the request was approved using break-glass access
+// and hasn't been reviewed by an approver yet.
+func (r *Request) RequiresBreakGlassReview() bool {
+	return r.IsBreakGlass() && r.BreakGlassReview == nil
+}
@@ -0,0 +1,76 @@
+package access
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/ddb"
+)
+
+// BulkRevocationStatus is the progress of a bulk revocation.
+type BulkRevocationStatus string
+
+const (
+	// BulkRevocationPending bulk revocations have been queued but haven't started revoking grants.
+	BulkRevocationPending    BulkRevocationStatus = "PENDING"
+	BulkRevocationInProgress BulkRevocationStatus = "IN_PROGRESS"
+	// BulkRevocationCompleted bulk revocations have attempted to revoke every grant.
+	BulkRevocationCompleted BulkRevocationStatus = "COMPLETED"
+)
+
+// BulkRevocation is the audit record of an administrator revoking every grant matching a filter at once,
+// such as during a security incident. The outcome for each grant is saved as a BulkRevocationGrant.
+type BulkRevocation struct {
+	ID string `json:"id" dynamodbav:"id"`
+	// Actor is the ID of the user who revoked the grants.
+	Actor      string `json:"actor" dynamodbav:"actor"`
+	ActorEmail string `json:"actorEmail" dynamodbav:"actorEmail"`
+	Reason     string `json:"reason,omitempty" dynamodbav:"reason,omitempty"`
+	// Filter is the filter which the revoked grants matched, such as {"userId": "usr_123"}.
+	Filter map[string]string    `json:"filter" dynamodbav:"filter"`
+	Status BulkRevocationStatus `json:"status" dynamodbav:"status"`
+	// Count is the number of grants being revoked.
+	Count       int        `json:"count" dynamodbav:"count"`
+	CreatedAt   time.Time  `json:"createdAt" dynamodbav:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt" dynamodbav:"updatedAt"`
+	CompletedAt *time.Time `json:"completedAt,omitempty" dynamodbav:"completedAt,omitempty"`
+}
+
+func (b *BulkRevocation) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.BulkRevocation.PK1,
+		SK: keys.BulkRevocation.SK1(b.ID),
+	}
+	return keys, nil
+}
+
+// BulkRevocationGrantStatus is the outcome of revoking a single grant as part of a bulk revocation.
+type BulkRevocationGrantStatus string
+
+const (
+	BulkRevocationGrantPending BulkRevocationGrantStatus = "PENDING"
+	BulkRevocationGrantRevoked BulkRevocationGrantStatus = "REVOKED"
+	// BulkRevocationGrantFailed grants couldn't be revoked. Failed revocations are retried by the grant retry queue.
+	BulkRevocationGrantFailed BulkRevocationGrantStatus = "FAILED"
+)
+
+// BulkRevocationGrant is the outcome of revoking the grant for a single request as part of a bulk revocation.
+type BulkRevocationGrant struct {
+	BulkRevocationID string                    `json:"bulkRevocationId" dynamodbav:"bulkRevocationId"`
+	RequestID        string                    `json:"requestId" dynamodbav:"requestId"`
+	UserID           string                    `json:"userId" dynamodbav:"userId"`
+	AccessRuleID     string                    `json:"accessRuleId" dynamodbav:"accessRuleId"`
+	Provider         string                    `json:"provider" dynamodbav:"provider"`
+	Status           BulkRevocationGrantStatus `json:"status" dynamodbav:"status"`
+	// Error is set if the grant couldn't be revoked.
+	Error     string    `json:"error,omitempty" dynamodbav:"error,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt" dynamodbav:"updatedAt"`
+}
+
+func (b *BulkRevocationGrant) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.BulkRevocationGrant.PK1,
+		SK: keys.BulkRevocationGrant.SK1(b.BulkRevocationID, b.RequestID),
+	}
+	return keys, nil
+}
@@ -0,0 +1,38 @@
+package access
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// Comment is a message in the discussion on a Request.
+// Requestors and reviewers can use comments to ask and answer questions before the request is reviewed.
+// Comments are not updated once created.
+type Comment struct {
+	ID        string    `json:"id" dynamodbav:"id"`
+	RequestID string    `json:"requestId" dynamodbav:"requestId"`
+	AuthorID  string    `json:"authorId" dynamodbav:"authorId"`
+	Body      string    `json:"body" dynamodbav:"body"`
+	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
+}
+
+func (c *Comment) ToAPI() types.RequestComment {
+	return types.RequestComment{
+		Id:        c.ID,
+		RequestId: c.RequestID,
+		AuthorId:  c.AuthorID,
+		Body:      c.Body,
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+func (c *Comment) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.AccessRequestComment.PK1,
+		SK: keys.AccessRequestComment.SK1(c.RequestID, c.ID),
+	}
+	return keys, nil
+}
@@ -0,0 +1,47 @@
+package access
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// Delegation is a time-boxed delegation of a user's reviews to another user,
+// such as while an approver is on leave.
+// Each user can have a single delegate at a time.
+type Delegation struct {
+	// UserID is the ID of the user whose reviews are delegated.
+	UserID string `json:"userId" dynamodbav:"userId"`
+	// DelegateID is the ID of the user who reviews requests on their behalf.
+	DelegateID string    `json:"delegateId" dynamodbav:"delegateId"`
+	StartsAt   time.Time `json:"startsAt" dynamodbav:"startsAt"`
+	EndsAt     time.Time `json:"endsAt" dynamodbav:"endsAt"`
+	Reason     *string   `json:"reason,omitempty" dynamodbav:"reason,omitempty"`
+	CreatedAt  time.Time `json:"createdAt" dynamodbav:"createdAt"`
+}
+
+// IsActive is true if the delegation applies at the given time.
+func (d *Delegation) IsActive(t time.Time) bool {
+	return !t.Before(d.StartsAt) && t.Before(d.EndsAt)
+}
+
+func (d *Delegation) ToAPI() types.Delegation {
+	return types.Delegation{
+		UserId:     d.UserID,
+		DelegateId: d.DelegateID,
+		StartsAt:   d.StartsAt,
+		EndsAt:     d.EndsAt,
+		Reason:     d.Reason,
+		CreatedAt:  d.CreatedAt,
+	}
+}
+
+func (d *Delegation) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.Delegation.PK1,
+		SK: keys.Delegation.SK1(d.UserID),
+	}
+	return keys, nil
+}
@@ -0,0 +1,69 @@
+package access
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/ddb"
+)
+
+// DriftKind describes how the access in a provider differs from the grants made by Common Fate.
+type DriftKind string
+
+const (
+	// DriftMissing means that a grant is active, but the access has been removed from the provider,
+	// such as by an administrator removing the user from an Okta group directly.
+	DriftMissing DriftKind = "MISSING"
+	// DriftUnexpected means that a user has access in the provider which no active grant explains.
+	DriftUnexpected DriftKind = "UNEXPECTED"
+)
+
+// Drift is a difference between the access granted by Common Fate and the access which exists in a provider.
+//
+// Drift is saved when it's first detected so that it is only reported once,
+// and is removed once reconciliation finds that it has been resolved.
+type Drift struct {
+	// ID is derived from the other fields, so that the same drift has the same ID each time it's detected.
+	ID       string            `json:"id" dynamodbav:"id"`
+	Kind     DriftKind         `json:"kind" dynamodbav:"kind"`
+	Provider string            `json:"provider" dynamodbav:"provider"`
+	With     map[string]string `json:"with" dynamodbav:"with"`
+	// Subject is the email address of the user whose access has drifted.
+	Subject string `json:"subject" dynamodbav:"subject"`
+	// RequestID is the request which the drift relates to.
+	// For unexpected access it is the user's most recent request for the same access, and is nil if they have never requested it.
+	RequestID  *string   `json:"requestId,omitempty" dynamodbav:"requestId,omitempty"`
+	DetectedAt time.Time `json:"detectedAt" dynamodbav:"detectedAt"`
+}
+
+// NewDrift creates a Drift with an ID derived from the kind, target and subject of the drift.
+func NewDrift(kind DriftKind, provider string, with map[string]string, subject string, requestID *string, detectedAt time.Time) Drift {
+	h := sha256.Sum256([]byte(string(kind) + "#" + TargetKey(provider, with) + "#" + subject))
+	return Drift{
+		ID:         "drf_" + hex.EncodeToString(h[:16]),
+		Kind:       kind,
+		Provider:   provider,
+		With:       with,
+		Subject:    subject,
+		RequestID:  requestID,
+		DetectedAt: detectedAt,
+	}
+}
+
+// TargetKey uniquely identifies the access described by a provider and its arguments.
+func TargetKey(provider string, with map[string]string) string {
+	// maps are marshalled with sorted keys, so the key is stable.
+	b, _ := json.Marshal(with)
+	return provider + "#" + string(b)
+}
+
+func (d *Drift) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.Drift.PK1,
+		SK: keys.Drift.SK1(d.ID),
+	}
+	return keys, nil
+}
@@ -0,0 +1,20 @@
+package access
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDrift(t *testing.T) {
+	a := NewDrift(DriftMissing, "okta", map[string]string{"a": "1", "b": "2"}, "alice@example.com", nil, time.Now())
+	b := NewDrift(DriftMissing, "okta", map[string]string{"b": "2", "a": "1"}, "alice@example.com", nil, time.Now().Add(time.Hour))
+	c := NewDrift(DriftUnexpected, "okta", map[string]string{"a": "1", "b": "2"}, "alice@example.com", nil, time.Now())
+	d := NewDrift(DriftMissing, "okta", map[string]string{"a": "1", "b": "2"}, "bob@example.com", nil, time.Now())
+
+	// the same drift has the same ID each time it's detected.
+	assert.Equal(t, a.ID, b.ID)
+	assert.NotEqual(t, a.ID, c.ID)
+	assert.NotEqual(t, a.ID, d.ID)
+}
@@ -0,0 +1,35 @@
+package access
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// Extension is a request to extend an active grant which is awaiting approval.
+type Extension struct {
+	// Duration is the amount of time that the grant will be extended by.
+	Duration    time.Duration `json:"duration" dynamodbav:"duration"`
+	Reason      *string       `json:"reason,omitempty" dynamodbav:"reason,omitempty"`
+	RequestedAt time.Time     `json:"requestedAt" dynamodbav:"requestedAt"`
+	// ApprovalStages tracks the approvals collected for the extension.
+	// Extensions are approved in the same stages, with the same quorums, as the request itself.
+	ApprovalStages []ApprovalStage `json:"approvalStages,omitempty" dynamodbav:"approvalStages,omitempty"`
+}
+
+func (e Extension) ToAPI() types.RequestExtension {
+	return types.RequestExtension{
+		DurationSeconds: int(e.Duration.Seconds()),
+		Reason:          e.Reason,
+		RequestedAt:     e.RequestedAt,
+	}
+}
+
+// EffectiveTiming returns the override timing if one has been set by an approver,
+// otherwise the requested timing.
+func (r *Request) EffectiveTiming() Timing {
+	if r.OverrideTiming != nil {
+		return *r.OverrideTiming
+	}
+	return r.RequestedTiming
+}
@@ -0,0 +1,66 @@
+package access
+
+import (
+	"time"
+
+	ac_types "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/ddb"
+)
+
+// grantStatusTransitions are the statuses which a grant can move to from each status.
+// A grant in the ERROR status can still become active or expire, as failed changes to grants are retried.
+// A pending grant can expire without becoming active, as the expiry event may be delivered before the activation event.
+var grantStatusTransitions = map[ac_types.GrantStatus][]ac_types.GrantStatus{
+	ac_types.GrantStatusPENDING: {ac_types.GrantStatusACTIVE, ac_types.GrantStatusEXPIRED, ac_types.GrantStatusERROR, ac_types.GrantStatusREVOKED},
+	ac_types.GrantStatusACTIVE:  {ac_types.GrantStatusEXPIRED, ac_types.GrantStatusERROR, ac_types.GrantStatusREVOKED},
+	ac_types.GrantStatusERROR:   {ac_types.GrantStatusACTIVE, ac_types.GrantStatusEXPIRED, ac_types.GrantStatusREVOKED},
+}
+
+// CanTransitionGrantStatus is true if a grant can move from one status to another.
+//
+// Grant events can be delivered more than once and out of order, so an event which would move a grant
+// back to an earlier status, such as an activation arriving after the grant was revoked, is ignored.
+func CanTransitionGrantStatus(from, to ac_types.GrantStatus) bool {
+	for _, s := range grantStatusTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ProcessedGrantEventRetention is how long a ProcessedGrantEvent is kept for.
+// EventBridge stops retrying an event after 24 hours, so duplicates aren't delivered after this.
+const ProcessedGrantEventRetention = 7 * 24 * time.Hour
+
+// ProcessedGrantEvent records that a grant event has been handled,
+// so that the event is ignored if it is delivered again.
+type ProcessedGrantEvent struct {
+	IdempotencyKey string    `json:"idempotencyKey" dynamodbav:"idempotencyKey"`
+	RequestID      string    `json:"requestId" dynamodbav:"requestId"`
+	EventType      string    `json:"eventType" dynamodbav:"eventType"`
+	ProcessedAt    time.Time `json:"processedAt" dynamodbav:"processedAt"`
+	// TTL is when DynamoDB deletes the record, in seconds since the Unix epoch.
+	TTL int64 `json:"ttl" dynamodbav:"ttl"`
+}
+
+// NewProcessedGrantEvent returns a record of a grant event being processed at processedAt,
+// which expires after ProcessedGrantEventRetention.
+func NewProcessedGrantEvent(idempotencyKey, requestID, eventType string, processedAt time.Time) ProcessedGrantEvent {
+	return ProcessedGrantEvent{
+		IdempotencyKey: idempotencyKey,
+		RequestID:      requestID,
+		EventType:      eventType,
+		ProcessedAt:    processedAt,
+		TTL:            processedAt.Add(ProcessedGrantEventRetention).Unix(),
+	}
+}
+
+func (p *ProcessedGrantEvent) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.ProcessedGrantEvent.PK1,
+		SK: keys.ProcessedGrantEvent.SK1(p.IdempotencyKey),
+	}
+	return keys, nil
+}
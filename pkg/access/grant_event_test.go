@@ -0,0 +1,37 @@
+package access
+
+import (
+	"testing"
+
+	ac_types "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanTransitionGrantStatus(t *testing.T) {
+	type testcase struct {
+		name string
+		from ac_types.GrantStatus
+		to   ac_types.GrantStatus
+		want bool
+	}
+
+	testcases := []testcase{
+		{name: "pending to active", from: ac_types.GrantStatusPENDING, to: ac_types.GrantStatusACTIVE, want: true},
+		{name: "active to expired", from: ac_types.GrantStatusACTIVE, to: ac_types.GrantStatusEXPIRED, want: true},
+		{name: "active to revoked", from: ac_types.GrantStatusACTIVE, to: ac_types.GrantStatusREVOKED, want: true},
+		{name: "retried activation succeeds", from: ac_types.GrantStatusERROR, to: ac_types.GrantStatusACTIVE, want: true},
+		{name: "duplicate activation", from: ac_types.GrantStatusACTIVE, to: ac_types.GrantStatusACTIVE, want: false},
+		{name: "activation after expiry", from: ac_types.GrantStatusEXPIRED, to: ac_types.GrantStatusACTIVE, want: false},
+		{name: "activation after revoke", from: ac_types.GrantStatusREVOKED, to: ac_types.GrantStatusACTIVE, want: false},
+		{name: "back to pending", from: ac_types.GrantStatusACTIVE, to: ac_types.GrantStatusPENDING, want: false},
+		{name: "expired before activated", from: ac_types.GrantStatusPENDING, to: ac_types.GrantStatusEXPIRED, want: true},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			got := CanTransitionGrantStatus(tc.from, tc.to)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package access
+
+import (
+	"strings"
+	"time"
+
+	ac_types "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// GrantRetryAction is the change to a grant which is being retried.
+type GrantRetryAction string
+
+const (
+	// GrantRetryActivate retries provisioning access for a target group grant.
+	GrantRetryActivate GrantRetryAction = "ACTIVATE"
+	// GrantRetryDeactivate retries removing access for a target group grant when it ends.
+	GrantRetryDeactivate GrantRetryAction = "DEACTIVATE"
+	// GrantRetryRevoke retries revoking a grant before it ends.
+	GrantRetryRevoke GrantRetryAction = "REVOKE"
+)
+
+// GrantRetryStatus is the status of a grant retry.
+type GrantRetryStatus string
+
+const (
+	// GrantRetryPending retries are attempted automatically once they are due.
+	GrantRetryPending GrantRetryStatus = "PENDING"
+	// GrantRetryExhausted retries have failed too many times to be attempted automatically.
+	// They can be retried or abandoned by an administrator.
+	GrantRetryExhausted GrantRetryStatus = "EXHAUSTED"
+	// GrantRetrySucceeded retries have been completed.
+	GrantRetrySucceeded GrantRetryStatus = "SUCCEEDED"
+	// GrantRetryAbandoned retries won't be attempted again.
+	GrantRetryAbandoned GrantRetryStatus = "ABANDONED"
+)
+
+const (
+	// DefaultGrantRetryMaxAttempts is the number of failed attempts after which a retry is no longer attempted automatically.
+	DefaultGrantRetryMaxAttempts = 10
+	grantRetryInitialBackoff     = time.Minute
+	grantRetryMaxBackoff         = time.Hour
+)
+
+// GrantRetry is a change to a grant which failed and is retried with exponential backoff,
+// so that a grant isn't left in an inconsistent state by a temporary failure.
+//
+// A grant has at most one retry for each action.
+type GrantRetry struct {
+	// ID is derived from the request ID and the action.
+	ID        string           `json:"id" dynamodbav:"id"`
+	RequestID string           `json:"requestId" dynamodbav:"requestId"`
+	Action    GrantRetryAction `json:"action" dynamodbav:"action"`
+	Status    GrantRetryStatus `json:"status" dynamodbav:"status"`
+	// Attempts is the number of times the change has failed.
+	Attempts    int `json:"attempts" dynamodbav:"attempts"`
+	MaxAttempts int `json:"maxAttempts" dynamodbav:"maxAttempts"`
+	// LastError is the error from the most recent failed attempt.
+	LastError     string    `json:"lastError" dynamodbav:"lastError"`
+	NextAttemptAt time.Time `json:"nextAttemptAt" dynamodbav:"nextAttemptAt"`
+	// Actor is the ID of the user who revoked the grant, for revocation retries.
+	Actor *string `json:"actor,omitempty" dynamodbav:"actor,omitempty"`
+	// Grant and State are passed to the target group granter when activation or deactivation is retried.
+	Grant     *ac_types.Grant `json:"grant,omitempty" dynamodbav:"grant,omitempty"`
+	State     map[string]any  `json:"state,omitempty" dynamodbav:"state,omitempty"`
+	CreatedAt time.Time       `json:"createdAt" dynamodbav:"createdAt"`
+	UpdatedAt time.Time       `json:"updatedAt" dynamodbav:"updatedAt"`
+}
+
+// NewGrantRetry creates a pending retry for a change to the grant of a request.
+func NewGrantRetry(requestID string, action GrantRetryAction, now time.Time) GrantRetry {
+	return GrantRetry{
+		ID:            GrantRetryID(requestID, action),
+		RequestID:     requestID,
+		Action:        action,
+		Status:        GrantRetryPending,
+		MaxAttempts:   DefaultGrantRetryMaxAttempts,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+// GrantRetryID returns the ID of the retry for a change to the grant of a request.
+func GrantRetryID(requestID string, action GrantRetryAction) string {
+	return "rty_" + strings.TrimPrefix(requestID, "req_") + "_" + strings.ToLower(string(action))
+}
+
+// IsOpen is true if the retry hasn't succeeded or been abandoned.
+func (r *GrantRetry) IsOpen() bool {
+	return r.Status == GrantRetryPending || r.Status == GrantRetryExhausted
+}
+
+// RecordFailure records a failed attempt and schedules the next attempt.
+// The delay between attempts doubles after each failure, up to an hour.
+// Once the maximum number of attempts is reached, the retry is marked as exhausted.
+func (r *GrantRetry) RecordFailure(err error, now time.Time) {
+	r.Attempts++
+	r.LastError = err.Error()
+	r.UpdatedAt = now
+
+	backoff := grantRetryInitialBackoff
+	for i := 1; i < r.Attempts && backoff < grantRetryMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > grantRetryMaxBackoff {
+		backoff = grantRetryMaxBackoff
+	}
+	r.NextAttemptAt = now.Add(backoff)
+
+	if r.Attempts >= r.MaxAttempts {
+		r.Status = GrantRetryExhausted
+	} else {
+		r.Status = GrantRetryPending
+	}
+}
+
+func (r *GrantRetry) ToAPI() types.GrantRetry {
+	res := types.GrantRetry{
+		Id:            r.ID,
+		RequestId:     r.RequestID,
+		Action:        types.GrantRetryAction(r.Action),
+		Status:        types.GrantRetryStatus(r.Status),
+		Attempts:      r.Attempts,
+		MaxAttempts:   r.MaxAttempts,
+		NextAttemptAt: r.NextAttemptAt,
+		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
+	}
+	if r.LastError != "" {
+		e := r.LastError
+		res.LastError = &e
+	}
+	return res
+}
+
+func (r *GrantRetry) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK:     keys.GrantRetry.PK1,
+		SK:     keys.GrantRetry.SK1(r.ID),
+		GSI1PK: keys.GrantRetry.GSI1PK(string(r.Status)),
+		GSI1SK: keys.GrantRetry.GSI1SK(r.NextAttemptAt, r.ID),
+	}
+	return keys, nil
+}
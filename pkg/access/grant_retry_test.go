@@ -0,0 +1,39 @@
+package access
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrantRetryRecordFailure(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := NewGrantRetry("req_123", GrantRetryRevoke, now)
+	assert.Equal(t, "rty_123_revoke", r.ID)
+
+	wantBackoff := []time.Duration{
+		time.Minute,
+		2 * time.Minute,
+		4 * time.Minute,
+		8 * time.Minute,
+		16 * time.Minute,
+		32 * time.Minute,
+		time.Hour,
+		time.Hour,
+		time.Hour,
+	}
+	for i, want := range wantBackoff {
+		r.RecordFailure(errors.New("provider unavailable"), now)
+		assert.Equal(t, i+1, r.Attempts)
+		assert.Equal(t, now.Add(want), r.NextAttemptAt)
+		assert.Equal(t, GrantRetryPending, r.Status)
+	}
+
+	r.RecordFailure(errors.New("provider unavailable"), now)
+	assert.Equal(t, DefaultGrantRetryMaxAttempts, r.Attempts)
+	assert.Equal(t, GrantRetryExhausted, r.Status)
+	assert.Equal(t, "provider unavailable", r.LastError)
+	assert.True(t, r.IsOpen())
+}
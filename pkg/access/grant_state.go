@@ -0,0 +1,41 @@
+package access
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// GrantState is the state returned by a target group handler when it provisioned access for a grant.
+// It is passed back to the handler when access is removed, so that the handler can find what it provisioned.
+//
+// It is saved when the grant is activated, so that it doesn't only exist in the grant workflow execution.
+type GrantState struct {
+	RequestID string `json:"requestId" dynamodbav:"requestId"`
+	// HandlerID and Kind are the route which provisioned the grant.
+	HandlerID string `json:"handlerId" dynamodbav:"handlerId"`
+	Kind      string `json:"kind" dynamodbav:"kind"`
+	// State is opaque to Common Fate, its contents are defined by the handler.
+	State     map[string]any `json:"state" dynamodbav:"state"`
+	UpdatedAt time.Time      `json:"updatedAt" dynamodbav:"updatedAt"`
+}
+
+func (g *GrantState) ToAPI() types.GrantState {
+	return types.GrantState{
+		RequestId: g.RequestID,
+		HandlerId: g.HandlerID,
+		Kind:      g.Kind,
+		State:     types.GrantState_State{AdditionalProperties: g.State},
+		UpdatedAt: g.UpdatedAt,
+	}
+}
+
+func (g *GrantState) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.AccessRequestGrantState.PK1,
+		SK: keys.AccessRequestGrantState.SK1(g.RequestID),
+	}
+	return keys, nil
+}
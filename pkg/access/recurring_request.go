@@ -0,0 +1,198 @@
+package access
+
+import (
+	"errors"
+	"strings"
+	"time"
+	// Lambda runtimes don't include a time zone database, which is needed to schedule recurrences.
+	_ "time/tzdata"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// RecurringRequestStatus is the status of a series of recurring requests.
+type RecurringRequestStatus string
+
+const (
+	// RecurringRequestActive series spawn new requests on schedule.
+	RecurringRequestActive RecurringRequestStatus = "ACTIVE"
+	// RecurringRequestCancelled series don't spawn any further requests.
+	RecurringRequestCancelled RecurringRequestStatus = "CANCELLED"
+)
+
+// RecurringRequest is a series of access requests which are made on a weekly schedule,
+// such as every weekday from 09:00 to 17:00 for an on-call rotation.
+//
+// Each occurrence is a regular Request which is spawned ahead of its start time.
+// Once an occurrence has been approved by a reviewer, the approval is reused for later occurrences
+// for as long as the Access Rule allows.
+type RecurringRequest struct {
+	ID string `json:"id" dynamodbav:"id"`
+	// RequestedBy is the ID of the user who made the recurring request.
+	RequestedBy string `json:"requestedBy" dynamodbav:"requestedBy"`
+	// Rule is the ID of the Access Rule which the requests relate to.
+	Rule string      `json:"rule" dynamodbav:"rule"`
+	Data RequestData `json:"data" dynamodbav:"data"`
+	// SelectedWith are the argument options which were selected when the recurring request was made.
+	// Each occurrence is requested with the same options.
+	SelectedWith map[string]Option      `json:"selectedWith" dynamodbav:"selectedWith"`
+	Recurrence   Recurrence             `json:"recurrence" dynamodbav:"recurrence"`
+	Status       RecurringRequestStatus `json:"status" dynamodbav:"status"`
+	// NextOccurrence is the start time of the next occurrence, which hasn't been requested yet.
+	NextOccurrence time.Time `json:"nextOccurrence" dynamodbav:"nextOccurrence"`
+	// ApprovedAt is the last time a reviewer approved an occurrence of the series.
+	ApprovedAt *time.Time `json:"approvedAt,omitempty" dynamodbav:"approvedAt,omitempty"`
+	// ApprovedRuleVersion is the version of the Access Rule which the last approved occurrence was requested for.
+	// The approval is only reused while the rule hasn't changed.
+	ApprovedRuleVersion string `json:"approvedRuleVersion,omitempty" dynamodbav:"approvedRuleVersion,omitempty"`
+	// ApprovalStages records who approved each stage of the last approved occurrence.
+	ApprovalStages []ApprovalStage `json:"approvalStages,omitempty" dynamodbav:"approvalStages,omitempty"`
+	CreatedAt      time.Time       `json:"createdAt" dynamodbav:"createdAt"`
+	UpdatedAt      time.Time       `json:"updatedAt" dynamodbav:"updatedAt"`
+}
+
+// ApprovalIsValid is true if a previous approval of the series can be reused at the given time,
+// for an occurrence requested against the given version of the Access Rule.
+// A zero validity means that every occurrence must be approved.
+func (r *RecurringRequest) ApprovalIsValid(ruleVersion string, validity time.Duration, t time.Time) bool {
+	return r.ApprovedAt != nil && r.ApprovedRuleVersion == ruleVersion && validity > 0 && t.Before(r.ApprovedAt.Add(validity))
+}
+
+// OccurrenceTiming returns the timing of an occurrence starting at the given time.
+func (r *RecurringRequest) OccurrenceTiming(start time.Time) Timing {
+	return Timing{
+		Duration:  r.Recurrence.Duration,
+		StartTime: &start,
+	}
+}
+
+func (r *RecurringRequest) ToAPI() types.RecurringRequest {
+	req := types.RecurringRequest{
+		ID:             r.ID,
+		AccessRuleId:   r.Rule,
+		Requestor:      r.RequestedBy,
+		Reason:         r.Data.Reason,
+		Recurrence:     r.Recurrence.ToAPI(),
+		Status:         types.RecurringRequestStatus(r.Status),
+		NextOccurrence: r.NextOccurrence,
+		ApprovedAt:     r.ApprovedAt,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+	}
+	return req
+}
+
+func (r *RecurringRequest) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK:     keys.RecurringRequest.PK1,
+		SK:     keys.RecurringRequest.SK1(r.ID),
+		GSI1PK: keys.RecurringRequest.GSI1PK(r.RequestedBy),
+		GSI1SK: keys.RecurringRequest.GSI1SK(r.ID),
+		GSI2PK: keys.RecurringRequest.GSI2PK(string(r.Status)),
+		GSI2SK: keys.RecurringRequest.GSI2SK(r.ID),
+	}
+	return keys, nil
+}
+
+// Recurrence is a weekly schedule for access.
+type Recurrence struct {
+	// Weekdays are the days of the week which access starts on.
+	Weekdays []time.Weekday `json:"weekdays" dynamodbav:"weekdays"`
+	// StartTime is the time of day that access starts, in 24 hour "15:04" format.
+	StartTime string `json:"startTime" dynamodbav:"startTime"`
+	// Duration is how long access lasts for each occurrence.
+	Duration time.Duration `json:"duration" dynamodbav:"duration"`
+	// Timezone is the IANA name of the time zone that StartTime is in, such as "Australia/Sydney".
+	// If it is empty, UTC is used.
+	Timezone string `json:"timezone,omitempty" dynamodbav:"timezone,omitempty"`
+}
+
+// Validate returns an error if the recurrence can't be scheduled.
+func (r Recurrence) Validate() error {
+	if len(r.Weekdays) == 0 {
+		return errors.New("recurrence must include at least one day of the week")
+	}
+	if r.Duration <= 0 {
+		return errors.New("recurrence duration must be greater than zero")
+	}
+	if r.Duration > time.Hour*24*7 {
+		return errors.New("recurrence duration must not be longer than a week")
+	}
+	_, err := time.Parse("15:04", r.StartTime)
+	if err != nil {
+		return errors.New("recurrence start time must be in 24 hour HH:MM format")
+	}
+	_, err = time.LoadLocation(r.Timezone)
+	if err != nil {
+		return errors.New("recurrence timezone is not a valid IANA time zone")
+	}
+	return nil
+}
+
+// Next returns the first start time of the recurrence which is not before the given time.
+func (r Recurrence) Next(after time.Time) (time.Time, error) {
+	err := r.Validate()
+	if err != nil {
+		return time.Time{}, err
+	}
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	tod, err := time.Parse("15:04", r.StartTime)
+	if err != nil {
+		return time.Time{}, err
+	}
+	days := make(map[time.Weekday]bool)
+	for _, d := range r.Weekdays {
+		days[d] = true
+	}
+
+	local := after.In(loc)
+	// the recurrence is weekly, so the next start time is at most 7 days away.
+	for i := 0; i <= 7; i++ {
+		day := local.AddDate(0, 0, i)
+		start := time.Date(day.Year(), day.Month(), day.Day(), tod.Hour(), tod.Minute(), 0, 0, loc)
+		if days[start.Weekday()] && !start.Before(after) {
+			return start.UTC(), nil
+		}
+	}
+	return time.Time{}, errors.New("could not find the next occurrence of the recurrence")
+}
+
+// RecurrenceFromAPI converts the API recurrence to a Recurrence.
+func RecurrenceFromAPI(in types.Recurrence) Recurrence {
+	r := Recurrence{
+		StartTime: in.StartTime,
+		Duration:  time.Second * time.Duration(in.DurationSeconds),
+	}
+	if in.Timezone != nil {
+		r.Timezone = *in.Timezone
+	}
+	for _, d := range in.Weekdays {
+		for wd := time.Sunday; wd <= time.Saturday; wd++ {
+			if strings.EqualFold(wd.String(), string(d)) {
+				r.Weekdays = append(r.Weekdays, wd)
+			}
+		}
+	}
+	return r
+}
+
+func (r Recurrence) ToAPI() types.Recurrence {
+	res := types.Recurrence{
+		Weekdays:        []types.Weekday{},
+		StartTime:       r.StartTime,
+		DurationSeconds: int(r.Duration.Seconds()),
+	}
+	if r.Timezone != "" {
+		tz := r.Timezone
+		res.Timezone = &tz
+	}
+	for _, d := range r.Weekdays {
+		res.Weekdays = append(res.Weekdays, types.Weekday(strings.ToUpper(d.String())))
+	}
+	return res
+}
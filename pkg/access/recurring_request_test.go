@@ -0,0 +1,98 @@
+package access
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecurrenceNext(t *testing.T) {
+	type testcase struct {
+		name       string
+		recurrence Recurrence
+		after      time.Time
+		want       time.Time
+		wantErr    bool
+	}
+
+	// 2022-01-03 is a Monday.
+	monday := time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)
+	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+	testcases := []testcase{
+		{
+			name:       "later the same day",
+			recurrence: Recurrence{Weekdays: weekdays, StartTime: "09:00", Duration: time.Hour * 8},
+			after:      monday,
+			want:       monday.Add(time.Hour * 9),
+		},
+		{
+			name:       "start time is included",
+			recurrence: Recurrence{Weekdays: weekdays, StartTime: "09:00", Duration: time.Hour * 8},
+			after:      monday.Add(time.Hour * 9),
+			want:       monday.Add(time.Hour * 9),
+		},
+		{
+			name:       "friday evening skips the weekend",
+			recurrence: Recurrence{Weekdays: weekdays, StartTime: "09:00", Duration: time.Hour * 8},
+			after:      monday.AddDate(0, 0, 4).Add(time.Hour * 18),
+			want:       monday.AddDate(0, 0, 7).Add(time.Hour * 9),
+		},
+		{
+			name:       "same weekday next week",
+			recurrence: Recurrence{Weekdays: []time.Weekday{time.Monday}, StartTime: "09:00", Duration: time.Hour},
+			after:      monday.Add(time.Hour * 10),
+			want:       monday.AddDate(0, 0, 7).Add(time.Hour * 9),
+		},
+		{
+			name:       "timezone",
+			recurrence: Recurrence{Weekdays: []time.Weekday{time.Monday}, StartTime: "09:00", Duration: time.Hour, Timezone: "Australia/Sydney"},
+			// 09:00 on Monday in Sydney is 22:00 on Sunday in UTC.
+			after: monday.AddDate(0, 0, -1),
+			want:  monday.AddDate(0, 0, -1).Add(time.Hour * 22),
+		},
+		{
+			name:       "no weekdays",
+			recurrence: Recurrence{StartTime: "09:00", Duration: time.Hour},
+			after:      monday,
+			wantErr:    true,
+		},
+		{
+			name:       "invalid start time",
+			recurrence: Recurrence{Weekdays: weekdays, StartTime: "9am", Duration: time.Hour},
+			after:      monday,
+			wantErr:    true,
+		},
+		{
+			name:       "invalid timezone",
+			recurrence: Recurrence{Weekdays: weekdays, StartTime: "09:00", Duration: time.Hour, Timezone: "Mars/Olympus_Mons"},
+			after:      monday,
+			wantErr:    true,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.recurrence.Next(tc.after)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestRecurringRequestApprovalIsValid(t *testing.T) {
+	now := time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)
+	dayAgo := now.Add(-time.Hour * 24)
+
+	assert.False(t, (&RecurringRequest{}).ApprovalIsValid("1", time.Hour*48, now), "never approved")
+	assert.False(t, (&RecurringRequest{ApprovedAt: &dayAgo, ApprovedRuleVersion: "1"}).ApprovalIsValid("1", 0, now), "reuse disabled")
+	assert.False(t, (&RecurringRequest{ApprovedAt: &dayAgo, ApprovedRuleVersion: "1"}).ApprovalIsValid("1", time.Hour, now), "approval expired")
+	assert.False(t, (&RecurringRequest{ApprovedAt: &dayAgo, ApprovedRuleVersion: "1"}).ApprovalIsValid("2", time.Hour*48, now), "rule updated")
+	assert.True(t, (&RecurringRequest{ApprovedAt: &dayAgo, ApprovedRuleVersion: "1"}).ApprovalIsValid("1", time.Hour*48, now))
+}
@@ -21,6 +21,8 @@ const (
 	DECLINED  Status = "DECLINED"
 	CANCELLED Status = "CANCELLED"
 	PENDING   Status = "PENDING"
+	// EXPIRED requests were closed automatically because they were not reviewed in time.
+	EXPIRED Status = "EXPIRED"
 )
 
 type Grant struct {
@@ -34,6 +36,15 @@ type Grant struct {
 	Status    ac_types.GrantStatus `json:"status" dynamodbav:"status"`
 	CreatedAt time.Time            `json:"createdAt" dynamodbav:"createdAt"`
 	UpdatedAt time.Time            `json:"updatedAt" dynamodbav:"updatedAt"`
+	// Route is the target group route which provisioned the grant.
+	// It is set once the grant has been activated by a handler.
+	Route *GrantRoute `json:"route,omitempty" dynamodbav:"route,omitempty"`
+}
+
+// GrantRoute identifies the handler and kind which a grant was provisioned with.
+type GrantRoute struct {
+	HandlerID string `json:"handlerId" dynamodbav:"handlerId"`
+	Kind      string `json:"kind" dynamodbav:"kind"`
 }
 
 func (g *Grant) ToAHGrant(requestID string) ac_types.Grant {
@@ -55,6 +66,12 @@ func (g *Grant) ToAPI() types.Grant {
 		Subject:  openapi_types.Email(g.Subject),
 		Status:   types.GrantStatus(g.Status),
 	}
+	if g.Route != nil {
+		req.Route = &types.GrantRoute{
+			HandlerId: g.Route.HandlerID,
+			Kind:      g.Route.Kind,
+		}
+	}
 
 	return req
 }
@@ -90,6 +107,18 @@ type Request struct {
 	Grant *Grant `json:"grant,omitempty" dynamodbav:"grant,omitempty"`
 	// ApprovalMethod explains whether an approval was AUTOMATIC, or REVIEWED
 	ApprovalMethod *types.ApprovalMethod `json:"approvalMethod,omitempty" dynamodbav:"approvalMethod,omitempty"`
+	// ApprovalStages tracks the approvals collected so far for rules which require approval.
+	// Requests created before approval stages were introduced will not have this field set.
+	ApprovalStages []ApprovalStage `json:"approvalStages,omitempty" dynamodbav:"approvalStages,omitempty"`
+	// PendingExtension is set when the requestor has asked to extend an active grant
+	// and the extension is awaiting approval.
+	PendingExtension *Extension `json:"pendingExtension,omitempty" dynamodbav:"pendingExtension,omitempty"`
+	// BreakGlassReview is set once an approver has reviewed a request which was approved using break-glass access.
+	BreakGlassReview *BreakGlassReview `json:"breakGlassReview,omitempty" dynamodbav:"breakGlassReview,omitempty"`
+	// RemindedAt is the last time the reviewers of a pending request were reminded to review it.
+	RemindedAt *time.Time `json:"remindedAt,omitempty" dynamodbav:"remindedAt,omitempty"`
+	// RecurringRequestID is the ID of the recurring request which created this request, if any.
+	RecurringRequestID *string `json:"recurringRequestId,omitempty" dynamodbav:"recurringRequestId,omitempty"`
 	// CreatedAt is a read-only field after the request has been created.
 	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt" dynamodbav:"updatedAt"`
@@ -127,16 +156,17 @@ func (r *Request) IsScheduled() bool {
 
 func (r *Request) ToAPI() types.Request {
 	req := types.Request{
-		AccessRuleId:      r.Rule,
-		AccessRuleVersion: r.RuleVersion,
-		Timing:            r.RequestedTiming.ToAPI(),
-		Reason:            r.Data.Reason,
-		ID:                r.ID,
-		RequestedAt:       r.CreatedAt,
-		Requestor:         r.RequestedBy,
-		Status:            types.RequestStatus(r.Status),
-		UpdatedAt:         r.UpdatedAt,
-		ApprovalMethod:    r.ApprovalMethod,
+		AccessRuleId:       r.Rule,
+		AccessRuleVersion:  r.RuleVersion,
+		Timing:             r.RequestedTiming.ToAPI(),
+		Reason:             r.Data.Reason,
+		ID:                 r.ID,
+		RequestedAt:        r.CreatedAt,
+		Requestor:          r.RequestedBy,
+		Status:             types.RequestStatus(r.Status),
+		UpdatedAt:          r.UpdatedAt,
+		ApprovalMethod:     r.ApprovalMethod,
+		RecurringRequestId: r.RecurringRequestID,
 	}
 	if r.Grant != nil {
 		g := r.Grant.ToAPI()
@@ -163,6 +193,7 @@ func (r *Request) ToAPIDetail(accessRule rule.AccessRule, canReview bool, reques
 		UpdatedAt:      r.UpdatedAt,
 		CanReview:      canReview,
 		ApprovalMethod: r.ApprovalMethod,
+		ApprovalStages: r.approvalStagesToAPI(),
 		Arguments: types.RequestDetail_Arguments{
 			AdditionalProperties: make(map[string]types.With),
 		},
@@ -207,6 +238,14 @@ func (r *Request) ToAPIDetail(accessRule rule.AccessRule, canReview bool, reques
 	if r.OverrideTiming != nil {
 		req.Timing = r.OverrideTiming.ToAPI()
 	}
+	if r.PendingExtension != nil {
+		e := r.PendingExtension.ToAPI()
+		req.PendingExtension = &e
+	}
+	if r.BreakGlassReview != nil {
+		b := r.BreakGlassReview.ToAPI()
+		req.BreakGlassReview = &b
+	}
 
 	return req
 }
@@ -26,6 +26,25 @@ type RequestEvent struct {
 	GrantFailureReason *string               `json:"grantFailureReason,omitempty" dynamodbav:"grantFailureReason,omitempty"`
 	RequestCreated     *bool                 `json:"requestCreated,omitempty" dynamodbav:"requestCreated,omitempty"`
 	RecordedEvent      *map[string]string    `json:"recordedEvent,omitempty" dynamodbav:"recordedEvent,omitempty"`
+	// RecordedEventSource is the provider or handler which recorded the event, such as 'ecs-shell'.
+	RecordedEventSource *string `json:"recordedEventSource,omitempty" dynamodbav:"recordedEventSource,omitempty"`
+	// SessionID is the session which the recorded event occurred in, if the source tracks sessions.
+	SessionID *string `json:"sessionId,omitempty" dynamodbav:"sessionId,omitempty"`
+	// ApprovalStage is a snapshot of an approval stage's progress after a reviewer approved it.
+	ApprovalStage *ApprovalStage `json:"approvalStage,omitempty" dynamodbav:"approvalStage,omitempty"`
+	// BreakGlass is true if the request was approved by the requestor using break-glass access.
+	BreakGlass *bool `json:"breakGlass,omitempty" dynamodbav:"breakGlass,omitempty"`
+	// Comment is a comment which was posted on the request.
+	Comment *Comment `json:"comment,omitempty" dynamodbav:"comment,omitempty"`
+	// DelegatedFrom is set if the event was caused by a review made by a delegate.
+	// It is the ID of the approver who delegated their reviews.
+	DelegatedFrom *string `json:"delegatedFrom,omitempty" dynamodbav:"delegatedFrom,omitempty"`
+	// ReminderSent is true if the reviewers of a pending request were reminded to review it.
+	ReminderSent *bool `json:"reminderSent,omitempty" dynamodbav:"reminderSent,omitempty"`
+	// GrantDrift is set if reconciliation found that the access in the provider differs from the grant.
+	GrantDrift *DriftKind `json:"grantDrift,omitempty" dynamodbav:"grantDrift,omitempty"`
+	// BulkRevocationID is set if the grant was revoked as part of a bulk revocation.
+	BulkRevocationID *string `json:"bulkRevocationId,omitempty" dynamodbav:"bulkRevocationId,omitempty"`
 }
 
 func NewRequestCreatedEvent(requestID string, createdAt time.Time, actor *string) RequestEvent {
@@ -58,6 +77,32 @@ func NewRecordedEvent(requestID string, actor *string, createdAt time.Time, even
 	return RequestEvent{ID: types.NewHistoryID(), Actor: actor, CreatedAt: createdAt, RequestID: requestID, RecordedEvent: &event}
 }
 
+func NewApprovalStageEvent(requestID string, createdAt time.Time, actor *string, stage ApprovalStage) RequestEvent {
+	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, Actor: actor, RequestID: requestID, ApprovalStage: &stage}
+}
+
+func NewBreakGlassEvent(requestID string, createdAt time.Time, actor *string) RequestEvent {
+	t := true
+	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, Actor: actor, RequestID: requestID, BreakGlass: &t}
+}
+
+func NewCommentEvent(comment Comment) RequestEvent {
+	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: comment.CreatedAt, Actor: &comment.AuthorID, RequestID: comment.RequestID, Comment: &comment}
+}
+
+func NewReminderSentEvent(requestID string, createdAt time.Time) RequestEvent {
+	t := true
+	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, RequestID: requestID, ReminderSent: &t}
+}
+
+func NewGrantDriftEvent(requestID string, createdAt time.Time, kind DriftKind) RequestEvent {
+	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, RequestID: requestID, GrantDrift: &kind}
+}
+
+func NewBulkRevokeEvent(requestID string, createdAt time.Time, actor string, bulkRevocationID string) RequestEvent {
+	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, Actor: &actor, RequestID: requestID, BulkRevocationID: &bulkRevocationID}
+}
+
 func (r *RequestEvent) ToAPI() types.RequestEvent {
 	var toTiming *types.RequestTiming
 	var fromTiming *types.RequestTiming
@@ -69,21 +114,40 @@ func (r *RequestEvent) ToAPI() types.RequestEvent {
 		ft := r.FromTiming.ToAPI()
 		fromTiming = &ft
 	}
+	var approvalStage *types.RequestApprovalStage
+	if r.ApprovalStage != nil {
+		as := r.ApprovalStage.ToAPI()
+		approvalStage = &as
+	}
+	var comment *types.RequestComment
+	if r.Comment != nil {
+		c := r.Comment.ToAPI()
+		comment = &c
+	}
 	return types.RequestEvent{
-		Id:                 r.ID,
-		RequestId:          r.RequestID,
-		CreatedAt:          r.CreatedAt,
-		Actor:              r.Actor,
-		FromGrantStatus:    (*types.RequestEventFromGrantStatus)(r.FromGrantStatus),
-		FromStatus:         (*types.RequestStatus)(r.FromStatus),
-		FromTiming:         fromTiming,
-		ToGrantStatus:      (*types.RequestEventToGrantStatus)(r.ToGrantStatus),
-		ToStatus:           (*types.RequestStatus)(r.ToStatus),
-		ToTiming:           toTiming,
-		GrantCreated:       r.GrantCreated,
-		RequestCreated:     r.RequestCreated,
-		GrantFailureReason: r.GrantFailureReason,
-		RecordedEvent:      r.RecordedEvent,
+		Id:                  r.ID,
+		RequestId:           r.RequestID,
+		CreatedAt:           r.CreatedAt,
+		Actor:               r.Actor,
+		FromGrantStatus:     (*types.RequestEventFromGrantStatus)(r.FromGrantStatus),
+		FromStatus:          (*types.RequestStatus)(r.FromStatus),
+		FromTiming:          fromTiming,
+		ToGrantStatus:       (*types.RequestEventToGrantStatus)(r.ToGrantStatus),
+		ToStatus:            (*types.RequestStatus)(r.ToStatus),
+		ToTiming:            toTiming,
+		GrantCreated:        r.GrantCreated,
+		RequestCreated:      r.RequestCreated,
+		GrantFailureReason:  r.GrantFailureReason,
+		RecordedEvent:       r.RecordedEvent,
+		RecordedEventSource: r.RecordedEventSource,
+		SessionId:           r.SessionID,
+		ApprovalStage:       approvalStage,
+		BreakGlass:          r.BreakGlass,
+		Comment:             comment,
+		DelegatedFrom:       r.DelegatedFrom,
+		ReminderSent:        r.ReminderSent,
+		GrantDrift:          (*types.GrantDriftKind)(r.GrantDrift),
+		BulkRevocationId:    r.BulkRevocationID,
 	}
 }
 
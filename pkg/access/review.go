@@ -1,6 +1,8 @@
 package access
 
 import (
+	"time"
+
 	"github.com/common-fate/common-fate/pkg/storage/keys"
 	"github.com/common-fate/ddb"
 )
@@ -25,6 +27,14 @@ type Review struct {
 	Decision        Decision `json:"decision" dynamodbav:"decision"`
 	Comment         *string  `json:"comment,omitempty" dynamodbav:"comment,omitempty"`
 	OverrideTimings *Timing  `json:"overrideTimings,omitempty" dynamodbav:"overrideTimings,omitempty"`
+	// DelegatedFrom is the ID of the approver who delegated their reviews to the reviewer, if the review was made by a delegate.
+	DelegatedFrom *string `json:"delegatedFrom,omitempty" dynamodbav:"delegatedFrom,omitempty"`
+	// RuleID is the ID of the Access Rule which the reviewed request relates to.
+	// Reviews made before this field was introduced will not have it set.
+	RuleID string `json:"ruleId,omitempty" dynamodbav:"ruleId,omitempty"`
+	// ReviewedAt is the time the review was made.
+	// Reviews made before this field was introduced have a zero value.
+	ReviewedAt time.Time `json:"reviewedAt" dynamodbav:"reviewedAt"`
 }
 
 func (r *Review) DDBKeys() (ddb.Keys, error) {
@@ -32,5 +42,10 @@ func (r *Review) DDBKeys() (ddb.Keys, error) {
 		PK: keys.AccessReview.PK1(r.ReviewerID),
 		SK: keys.AccessReview.SK1(r.RequestID, r.ID),
 	}
+	// reviews made before RuleID was introduced aren't indexed by rule.
+	if r.RuleID != "" {
+		k.GSI1PK = keys.AccessReview.GSI1PK(r.ReviewerID, r.RuleID)
+		k.GSI1SK = keys.AccessReview.GSI1SK(r.ReviewedAt, r.ID)
+	}
 	return k, nil
 }
@@ -13,6 +13,8 @@ type Reviewer struct {
 	// Request is the associated request.
 	Request       Request       `json:"request" dynamodbav:"request"`
 	Notifications Notifications `json:"notifications" dynamodbav:"notifications"`
+	// Delegation is set if the reviewer was added to the request as the delegate of an approver.
+	Delegation *Delegation `json:"delegation,omitempty" dynamodbav:"delegation,omitempty"`
 }
 
 type Notifications struct {
@@ -0,0 +1,56 @@
+package access
+
+import (
+	"errors"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// SessionActivity is an action a user performed while using a grant, such as a command run in a shell session.
+// Providers and handlers stream session activity so that it's shown on the request timeline.
+type SessionActivity struct {
+	// GrantID is the ID of the grant the activity was performed with. It's the same as the ID of the request.
+	GrantID string `json:"grantId"`
+	// Source is the provider or handler which recorded the activity, such as 'ecs-shell'.
+	Source string `json:"source"`
+	// SessionID is optional, and groups activity which happened in the same session.
+	SessionID string `json:"sessionId,omitempty"`
+	// OccurredAt is when the activity happened. The time it was recorded is used if it's not set.
+	OccurredAt time.Time         `json:"occurredAt,omitempty"`
+	Data       map[string]string `json:"data"`
+}
+
+func (a SessionActivity) Validate() error {
+	if a.GrantID == "" {
+		return errors.New("grant ID must be provided")
+	}
+	if a.Source == "" {
+		return errors.New("source must be provided")
+	}
+	if len(a.Data) == 0 {
+		return errors.New("data must be provided")
+	}
+	return nil
+}
+
+// NewSessionActivityEvent stores session activity as a recorded event on the request which the grant belongs to.
+// actor is the user who performed the activity.
+func NewSessionActivityEvent(activity SessionActivity, actor *string, recordedAt time.Time) RequestEvent {
+	createdAt := activity.OccurredAt
+	if createdAt.IsZero() {
+		createdAt = recordedAt
+	}
+	e := RequestEvent{
+		ID:                  types.NewHistoryID(),
+		Actor:               actor,
+		CreatedAt:           createdAt,
+		RequestID:           activity.GrantID,
+		RecordedEvent:       &activity.Data,
+		RecordedEventSource: &activity.Source,
+	}
+	if activity.SessionID != "" {
+		e.SessionID = &activity.SessionID
+	}
+	return e
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/benbjohnson/clock"
 	registry_types "github.com/common-fate/provider-registry-sdk-go/pkg/providerregistrysdk"
@@ -15,6 +16,7 @@ import (
 	"github.com/common-fate/common-fate/pkg/access"
 	"github.com/common-fate/common-fate/pkg/auth"
 	"github.com/common-fate/common-fate/pkg/cache"
+	"github.com/common-fate/common-fate/pkg/config"
 	"github.com/common-fate/common-fate/pkg/deploy"
 	"github.com/common-fate/common-fate/pkg/gconfig"
 	"github.com/common-fate/common-fate/pkg/gevent"
@@ -24,8 +26,10 @@ import (
 	"github.com/common-fate/common-fate/pkg/providersetup"
 	"github.com/common-fate/common-fate/pkg/rule"
 	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/service/bulkrevokesvc"
 	"github.com/common-fate/common-fate/pkg/service/cachesvc"
 	"github.com/common-fate/common-fate/pkg/service/cognitosvc"
+	"github.com/common-fate/common-fate/pkg/service/grantretrysvc"
 	"github.com/common-fate/common-fate/pkg/service/healthchecksvc"
 	"github.com/common-fate/common-fate/pkg/service/requestroutersvc"
 
@@ -37,6 +41,7 @@ import (
 	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
 	"github.com/common-fate/common-fate/pkg/service/workflowsvc/runtimes/live"
 	"github.com/common-fate/common-fate/pkg/target"
+	"github.com/common-fate/common-fate/pkg/targetgroupgranter"
 
 	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb"
@@ -76,6 +81,8 @@ type API struct {
 
 	Cache          CacheService
 	IdentitySyncer auth.IdentitySyncer
+	// IdentitySyncPreview is used to preview the changes the identity sync would make.
+	IdentitySyncPreview IdentitySyncPreviewer
 	// Set this to nil if cognito is not configured as the IDP for the deployment
 	Cognito            CognitoService
 	InternalIdentity   InternalIdentityService
@@ -83,6 +90,8 @@ type API struct {
 	HandlerService     HandlerService
 	Workflow           Workflow
 	HealthcheckService HealthcheckService
+	GrantRetries       GrantRetryService
+	BulkRevoke         BulkRevokeService
 }
 
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_cognito_service.go -package=mocks . CognitoService
@@ -104,8 +113,16 @@ type ProviderSetupService interface {
 // RequestServices can create Access Requests.
 type AccessService interface {
 	CreateRequests(ctx context.Context, in accesssvc.CreateRequestsOpts) ([]accesssvc.CreateRequestResult, error)
+	DryRunRequests(ctx context.Context, in accesssvc.CreateRequestsOpts) ([]accesssvc.DryRunResult, error)
 	AddReviewAndGrantAccess(ctx context.Context, opts accesssvc.AddReviewOpts) (*accesssvc.AddReviewResult, error)
 	CancelRequest(ctx context.Context, opts accesssvc.CancelRequestOpts) error
+	ExtendRequest(ctx context.Context, opts accesssvc.ExtendRequestOpts) (*accesssvc.ExtendRequestResult, error)
+	ReviewExtension(ctx context.Context, opts accesssvc.ReviewExtensionOpts) (*access.Request, error)
+	AddComment(ctx context.Context, opts accesssvc.AddCommentOpts) (*access.Comment, error)
+	SetDelegate(ctx context.Context, opts accesssvc.SetDelegateOpts) (*access.Delegation, error)
+	RemoveDelegate(ctx context.Context, userID string) error
+	CreateRecurringRequest(ctx context.Context, in accesssvc.CreateRecurringRequestOpts) (*accesssvc.CreateRecurringRequestResult, error)
+	CancelRecurringRequest(ctx context.Context, opts accesssvc.CancelRecurringRequestOpts) (*access.RecurringRequest, error)
 	CreateFavorite(ctx context.Context, in accesssvc.CreateFavoriteOpts) (*access.Favorite, error)
 	UpdateFavorite(ctx context.Context, in accesssvc.UpdateFavoriteOpts) (*access.Favorite, error)
 }
@@ -154,6 +171,29 @@ type Workflow interface {
 	Revoke(ctx context.Context, request access.Request, revokerID string, revokerEmail string) (*access.Request, error)
 }
 
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_grantretry_service.go -package=mocks . GrantRetryService
+
+// GrantRetryService lets administrators resolve changes to grants which failed.
+type GrantRetryService interface {
+	Retry(ctx context.Context, retryID string) (*access.GrantRetry, error)
+	Abandon(ctx context.Context, retryID string) (*access.GrantRetry, error)
+}
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_bulkrevoke_service.go -package=mocks . BulkRevokeService
+
+// BulkRevokeService queues every grant matching a filter to be revoked at once.
+type BulkRevokeService interface {
+	Revoke(ctx context.Context, opts bulkrevokesvc.RevokeOpts) (*bulkrevokesvc.RevokeResult, error)
+	Get(ctx context.Context, bulkRevocationID string) (*bulkrevokesvc.RevokeResult, error)
+}
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_identitysyncpreviewer.go -package=mocks . IdentitySyncPreviewer
+
+// IdentitySyncPreviewer returns the changes an identity sync would make, without making them.
+type IdentitySyncPreviewer interface {
+	DryRun(ctx context.Context, opts identitysync.DryRunOpts) (*identitysync.Diff, error)
+}
+
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_healthcheck_service.go -package=mocks . HealthcheckService
 type HealthcheckService interface {
 	Check(ctx context.Context) error
@@ -168,6 +208,7 @@ type Opts struct {
 	ProviderRegistryClient registry_types.ClientWithResponsesInterface
 	EventSender            *gevent.Sender
 	IdentitySyncer         auth.IdentitySyncer
+	IdentitySyncPreview    IdentitySyncPreviewer
 	DeploymentConfig       deploy.DeployConfigReader
 	DynamoTable            string
 	PaginationKMSKeyARN    string
@@ -178,7 +219,15 @@ type Opts struct {
 	IDPType                string
 	AdminGroupID           string
 	StateMachineARN        string
+	EventBusARN            string
 	FrontendURL            string
+	// GrantVerificationTimeout and GrantVerificationInterval are used when retrying target group grants.
+	GrantVerificationTimeout  time.Duration
+	GrantVerificationInterval time.Duration
+	// AutoApproval is optional, if it is nil requests which require approval are always reviewed.
+	AutoApproval accesssvc.AutoApprover
+	// WorkflowRuntime is optional, if it is nil grants are run by AWS Step Functions.
+	WorkflowRuntime workflowsvc.Runtime
 }
 
 // New creates a new API.
@@ -209,6 +258,19 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 		return nil, err
 	}
 
+	runtime := opts.WorkflowRuntime
+	if runtime == nil {
+		runtime = &live.Runtime{
+			StateMachineARN: opts.StateMachineARN,
+			AHClient:        opts.AccessHandlerClient,
+			Eventbus:        opts.EventSender,
+			DB:              db,
+			RequestRouter: &requestroutersvc.Service{
+				DB: db,
+			},
+		}
+	}
+
 	a := API{
 		DeploymentConfig: opts.DeploymentConfig,
 		AdminGroup:       opts.AdminGroup,
@@ -236,17 +298,10 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 					AccessHandlerClient:  opts.AccessHandlerClient,
 				},
 			},
-			AHClient: opts.AccessHandlerClient,
+			AHClient:     opts.AccessHandlerClient,
+			AutoApproval: opts.AutoApproval,
 			Workflow: &workflowsvc.Service{
-				Runtime: &live.Runtime{
-					StateMachineARN: opts.StateMachineARN,
-					AHClient:        opts.AccessHandlerClient,
-					Eventbus:        opts.EventSender,
-					DB:              db,
-					RequestRouter: &requestroutersvc.Service{
-						DB: db,
-					},
-				},
+				Runtime:  runtime,
 				DB:       db,
 				Clk:      clk,
 				Eventbus: opts.EventSender,
@@ -275,6 +330,7 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 		AccessHandlerClient: opts.AccessHandlerClient,
 		DB:                  db,
 		IdentitySyncer:      opts.IdentitySyncer,
+		IdentitySyncPreview: opts.IdentitySyncPreview,
 		IdentityProvider:    opts.IDPType,
 		TargetService: &targetsvc.Service{
 			DB:                     db,
@@ -286,15 +342,7 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 			Clock: clk,
 		},
 		Workflow: &workflowsvc.Service{
-			Runtime: &live.Runtime{
-				StateMachineARN: opts.StateMachineARN,
-				AHClient:        opts.AccessHandlerClient,
-				Eventbus:        opts.EventSender,
-				DB:              db,
-				RequestRouter: &requestroutersvc.Service{
-					DB: db,
-				},
-			},
+			Runtime:  runtime,
 			DB:       db,
 			Clk:      clk,
 			Eventbus: opts.EventSender,
@@ -305,6 +353,31 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 		},
 	}
 
+	a.GrantRetries = &grantretrysvc.Service{
+		Clock:    clk,
+		DB:       db,
+		Workflow: a.Workflow,
+		Granter: &targetgroupgranter.Granter{
+			Cfg: config.TargetGroupGranterConfig{
+				EventBusArn:               opts.EventBusARN,
+				GrantVerificationTimeout:  opts.GrantVerificationTimeout,
+				GrantVerificationInterval: opts.GrantVerificationInterval,
+			},
+			Clock: clk,
+			DB:    db,
+			RequestRouter: &requestroutersvc.Service{
+				DB: db,
+			},
+		},
+	}
+
+	a.BulkRevoke = &bulkrevokesvc.Service{
+		Clock:       clk,
+		DB:          db,
+		Workflow:    a.Workflow,
+		EventPutter: opts.EventSender,
+	}
+
 	// only initialise this if cognito is the IDP
 	if opts.IDPType == identitysync.IDPTypeCognito {
 		cog := &identitysync.CognitoSync{}
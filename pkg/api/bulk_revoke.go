@@ -0,0 +1,87 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/auth"
+	"github.com/common-fate/common-fate/pkg/service/bulkrevokesvc"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// Bulk revoke grants
+// (POST /api/v1/admin/bulk-revoke)
+func (a *API) AdminBulkRevoke(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+	var b types.AdminBulkRevokeJSONRequestBody
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	opts := bulkrevokesvc.RevokeOpts{
+		Filter: bulkrevokesvc.Filter{
+			UserID:        aws.ToString(b.UserId),
+			AccessRuleID:  aws.ToString(b.AccessRuleId),
+			TargetGroupID: aws.ToString(b.TargetGroupId),
+			ProviderID:    aws.ToString(b.ProviderId),
+		},
+		DryRun:       b.DryRun != nil && *b.DryRun,
+		Reason:       aws.ToString(b.Reason),
+		RevokerID:    u.ID,
+		RevokerEmail: u.Email,
+	}
+	result, err := a.BulkRevoke.Revoke(ctx, opts)
+	if err == bulkrevokesvc.ErrNoFilter {
+		err = apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, bulkRevokeResponse(result), http.StatusOK)
+}
+
+// Get bulk revocation
+// (GET /api/v1/admin/bulk-revoke/{bulkRevocationId})
+func (a *API) AdminGetBulkRevocation(w http.ResponseWriter, r *http.Request, bulkRevocationId string) {
+	ctx := r.Context()
+	result, err := a.BulkRevoke.Get(ctx, bulkRevocationId)
+	if err == bulkrevokesvc.ErrBulkRevocationNotFound {
+		err = apio.NewRequestError(err, http.StatusNotFound)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, bulkRevokeResponse(result), http.StatusOK)
+}
+
+func bulkRevokeResponse(result *bulkrevokesvc.RevokeResult) types.BulkRevokeResponse {
+	res := types.BulkRevokeResponse{
+		Id:          result.ID,
+		DryRun:      result.DryRun,
+		CompletedAt: result.CompletedAt,
+		Revocations: make([]types.BulkRevocation, len(result.Revocations)),
+	}
+	if result.Status != "" {
+		status := types.BulkRevokeStatus(result.Status)
+		res.Status = &status
+	}
+	for i, rev := range result.Revocations {
+		res.Revocations[i] = types.BulkRevocation{
+			RequestId:    rev.RequestID,
+			UserId:       rev.UserID,
+			AccessRuleId: rev.AccessRuleID,
+			Provider:     rev.Provider,
+			Status:       types.BulkRevocationStatus(rev.Status),
+		}
+		if rev.Error != "" {
+			e := rev.Error
+			res.Revocations[i].Error = &e
+		}
+	}
+	return res
+}
@@ -0,0 +1,171 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/api/mocks"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/service/bulkrevokesvc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminBulkRevoke(t *testing.T) {
+	type testcase struct {
+		name       string
+		give       string
+		wantOpts   *bulkrevokesvc.RevokeOpts
+		withResult *bulkrevokesvc.RevokeResult
+		withErr    error
+		wantCode   int
+		wantBody   string
+	}
+
+	admin := identity.User{ID: "usr_admin", Email: "admin@example.com"}
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			give: `{"userId":"usr_1","reason":"incident"}`,
+			wantOpts: &bulkrevokesvc.RevokeOpts{
+				Filter:       bulkrevokesvc.Filter{UserID: "usr_1"},
+				Reason:       "incident",
+				RevokerID:    "usr_admin",
+				RevokerEmail: "admin@example.com",
+			},
+			withResult: &bulkrevokesvc.RevokeResult{
+				ID:     "brv_1",
+				Status: access.BulkRevocationPending,
+				Revocations: []bulkrevokesvc.Revocation{
+					{RequestID: "req_1", UserID: "usr_1", AccessRuleID: "rul_1", Provider: "tg_1", Status: bulkrevokesvc.RevocationPending},
+				},
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"dryRun":false,"id":"brv_1","revocations":[{"accessRuleId":"rul_1","provider":"tg_1","requestId":"req_1","status":"PENDING","userId":"usr_1"}],"status":"PENDING"}`,
+		},
+		{
+			name: "dry run",
+			give: `{"targetGroupId":"tg_1","dryRun":true}`,
+			wantOpts: &bulkrevokesvc.RevokeOpts{
+				Filter:       bulkrevokesvc.Filter{TargetGroupID: "tg_1"},
+				DryRun:       true,
+				RevokerID:    "usr_admin",
+				RevokerEmail: "admin@example.com",
+			},
+			withResult: &bulkrevokesvc.RevokeResult{
+				ID:     "brv_1",
+				DryRun: true,
+				Revocations: []bulkrevokesvc.Revocation{
+					{RequestID: "req_1", UserID: "usr_1", AccessRuleID: "rul_1", Provider: "tg_1", Status: bulkrevokesvc.RevocationDryRun},
+				},
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"dryRun":true,"id":"brv_1","revocations":[{"accessRuleId":"rul_1","provider":"tg_1","requestId":"req_1","status":"DRY_RUN","userId":"usr_1"}]}`,
+		},
+		{
+			name:     "no filter",
+			give:     `{}`,
+			withErr:  bulkrevokesvc.ErrNoFilter,
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"error":"at least one of user, access rule, target group or provider must be provided"}`,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			bulk := mocks.NewMockBulkRevokeService(ctrl)
+			opts := gomock.Any()
+			if tc.wantOpts != nil {
+				opts = gomock.Eq(*tc.wantOpts)
+			}
+			bulk.EXPECT().Revoke(gomock.Any(), opts).Return(tc.withResult, tc.withErr)
+
+			a := API{BulkRevoke: bulk}
+			handler := newTestServer(t, &a, withRequestUser(admin), withIsAdmin(true))
+
+			req, err := http.NewRequest("POST", "/api/v1/admin/bulk-revoke", strings.NewReader(tc.give))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.wantBody, string(data))
+		})
+	}
+}
+
+func TestAdminGetBulkRevocation(t *testing.T) {
+	type testcase struct {
+		name       string
+		withResult *bulkrevokesvc.RevokeResult
+		withErr    error
+		wantCode   int
+		wantBody   string
+	}
+
+	completedAt := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			withResult: &bulkrevokesvc.RevokeResult{
+				ID:          "brv_1",
+				Status:      access.BulkRevocationCompleted,
+				CompletedAt: &completedAt,
+				Revocations: []bulkrevokesvc.Revocation{
+					{RequestID: "req_1", UserID: "usr_1", AccessRuleID: "rul_1", Provider: "tg_1", Status: bulkrevokesvc.RevocationSucceeded},
+					{RequestID: "req_2", UserID: "usr_1", AccessRuleID: "rul_1", Provider: "tg_1", Status: bulkrevokesvc.RevocationFailed, Error: "provider unavailable"},
+				},
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"completedAt":"2022-01-01T10:00:00Z","dryRun":false,"id":"brv_1","revocations":[{"accessRuleId":"rul_1","provider":"tg_1","requestId":"req_1","status":"REVOKED","userId":"usr_1"},{"accessRuleId":"rul_1","error":"provider unavailable","provider":"tg_1","requestId":"req_2","status":"FAILED","userId":"usr_1"}],"status":"COMPLETED"}`,
+		},
+		{
+			name:     "not found",
+			withErr:  bulkrevokesvc.ErrBulkRevocationNotFound,
+			wantCode: http.StatusNotFound,
+			wantBody: `{"error":"bulk revocation not found"}`,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			bulk := mocks.NewMockBulkRevokeService(ctrl)
+			bulk.EXPECT().Get(gomock.Any(), "brv_1").Return(tc.withResult, tc.withErr)
+
+			a := API{BulkRevoke: bulk}
+			handler := newTestServer(t, &a, withIsAdmin(true))
+
+			req, err := http.NewRequest("GET", "/api/v1/admin/bulk-revoke/brv_1", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.wantBody, string(data))
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/auth"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// List request comments
+// (GET /api/v1/requests/{requestId}/comments)
+func (a *API) UserListRequestComments(w http.ResponseWriter, r *http.Request, requestId string) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+
+	q := storage.GetRequest{ID: requestId}
+	_, err := a.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		err = apio.NewRequestError(err, http.StatusNotFound)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	if !auth.IsAdmin(ctx) && q.Result.RequestedBy != u.ID {
+		qrv := storage.GetRequestReviewer{RequestID: requestId, ReviewerID: u.ID}
+		_, err = a.DB.Query(ctx, &qrv)
+		if err == ddb.ErrNoItems {
+			// user is not a reviewer of this request or the requestor
+			err = apio.NewRequestError(err, http.StatusNotFound)
+		}
+		if err != nil {
+			apio.Error(ctx, w, err)
+			return
+		}
+	}
+
+	qc := storage.ListRequestComments{RequestID: requestId}
+	_, err = a.DB.Query(ctx, &qc)
+	if err != nil && err != ddb.ErrNoItems {
+		apio.Error(ctx, w, err)
+		return
+	}
+	res := types.ListRequestCommentsResponse{
+		Comments: make([]types.RequestComment, len(qc.Result)),
+	}
+	for i, c := range qc.Result {
+		res.Comments[i] = c.ToAPI()
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// Comment on a request
+// (POST /api/v1/requests/{requestId}/comments)
+func (a *API) UserCreateRequestComment(w http.ResponseWriter, r *http.Request, requestId string) {
+	ctx := r.Context()
+	var b types.UserCreateRequestCommentJSONRequestBody
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	u := auth.UserFromContext(ctx)
+
+	q := storage.GetRequest{ID: requestId}
+	_, err = a.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		err = apio.NewRequestError(err, http.StatusNotFound)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	reviewers := storage.ListRequestReviewers{RequestID: requestId}
+	_, err = a.DB.Query(ctx, &reviewers)
+	if err != nil && err != ddb.ErrNoItems {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	comment, err := a.Access.AddComment(ctx, accesssvc.AddCommentOpts{
+		AuthorID:      u.ID,
+		AuthorEmail:   u.Email,
+		AuthorIsAdmin: auth.IsAdmin(ctx),
+		Request:       *q.Result,
+		Reviewers:     reviewers.Result,
+		Body:          b.Body,
+	})
+	if err == accesssvc.ErrUserNotAuthorized {
+		// don't reveal that the request exists to users who can't view it.
+		err = apio.NewRequestError(err, http.StatusNotFound)
+	}
+	if err == accesssvc.ErrCommentEmpty {
+		err = apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, comment.ToAPI(), http.StatusCreated)
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/auth"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// Get your delegate
+// (GET /api/v1/users/me/delegate)
+func (a *API) UserGetDelegate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+
+	q := storage.GetDelegation{UserID: u.ID}
+	_, err := a.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		err = apio.NewRequestError(err, http.StatusNotFound)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, q.Result.ToAPI(), http.StatusOK)
+}
+
+// Set your delegate
+// (PUT /api/v1/users/me/delegate)
+func (a *API) UserSetDelegate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b types.UserSetDelegateJSONRequestBody
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	u := auth.UserFromContext(ctx)
+
+	d, err := a.Access.SetDelegate(ctx, accesssvc.SetDelegateOpts{
+		UserID:     u.ID,
+		DelegateID: b.DelegateId,
+		StartsAt:   b.StartsAt,
+		EndsAt:     b.EndsAt,
+		Reason:     b.Reason,
+	})
+	switch err {
+	case accesssvc.ErrCannotDelegateToSelf, accesssvc.ErrInvalidDelegationPeriod, accesssvc.ErrDelegateNotFound:
+		err = apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, d.ToAPI(), http.StatusOK)
+}
+
+// Remove your delegate
+// (DELETE /api/v1/users/me/delegate)
+func (a *API) UserRemoveDelegate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+
+	err := a.Access.RemoveDelegate(ctx, u.ID)
+	if err == ddb.ErrNoItems {
+		err = apio.NewRequestError(err, http.StatusNotFound)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, nil, http.StatusNoContent)
+}
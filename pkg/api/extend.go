@@ -0,0 +1,145 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/auth"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// Extend an active request
+// (POST /api/v1/requests/{requestId}/extend)
+func (a *API) UserExtendRequest(w http.ResponseWriter, r *http.Request, requestId string) {
+	ctx := r.Context()
+	var b types.UserExtendRequestJSONRequestBody
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	u := auth.UserFromContext(ctx)
+
+	q := storage.GetRequest{ID: requestId}
+	_, err = a.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		err = apio.NewRequestError(err, http.StatusNotFound)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	ruleq := storage.GetAccessRuleVersion{ID: q.Result.Rule, VersionID: q.Result.RuleVersion}
+	_, err = a.DB.Query(ctx, &ruleq)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	result, err := a.Access.ExtendRequest(ctx, accesssvc.ExtendRequestOpts{
+		UserID:     u.ID,
+		UserEmail:  u.Email,
+		Request:    *q.Result,
+		AccessRule: *ruleq.Result,
+		Extension:  time.Duration(b.ExtensionDurationSeconds) * time.Second,
+		Reason:     b.Reason,
+	})
+	if err != nil {
+		apio.Error(ctx, w, extendErrorToAPI(err))
+		return
+	}
+
+	res := types.ExtendRequestResponse{
+		Request:          result.Request.ToAPI(),
+		ExtensionPending: result.ExtensionPending,
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// Review a request extension
+// (POST /api/v1/requests/{requestId}/extend/review)
+func (a *API) UserReviewExtension(w http.ResponseWriter, r *http.Request, requestId string) {
+	ctx := r.Context()
+	var b types.UserReviewExtensionJSONRequestBody
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	u := auth.UserFromContext(ctx)
+
+	q := storage.GetRequest{ID: requestId}
+	_, err = a.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		err = apio.NewRequestError(err, http.StatusNotFound)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	ruleq := storage.GetAccessRuleVersion{ID: q.Result.Rule, VersionID: q.Result.RuleVersion}
+	_, err = a.DB.Query(ctx, &ruleq)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	reviewers := storage.ListRequestReviewers{RequestID: requestId}
+	_, err = a.DB.Query(ctx, &reviewers)
+	if err != nil && err != ddb.ErrNoItems {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	req, err := a.Access.ReviewExtension(ctx, accesssvc.ReviewExtensionOpts{
+		ReviewerID:      u.ID,
+		ReviewerEmail:   u.Email,
+		ReviewerIsAdmin: u.BelongsToGroup(a.AdminGroup),
+		Reviewers:       reviewers.Result,
+		Decision:        access.Decision(b.Decision),
+		Request:         *q.Result,
+		AccessRule:      *ruleq.Result,
+	})
+	if err != nil {
+		apio.Error(ctx, w, extendErrorToAPI(err))
+		return
+	}
+
+	res := types.ExtendRequestResponse{
+		Request:          req.ToAPI(),
+		ExtensionPending: req.PendingExtension != nil,
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// extendErrorToAPI wraps the errors returned when extending a request with the appropriate status code.
+func extendErrorToAPI(err error) error {
+	var sharedGroupErr accesssvc.SharedExclusiveGroupError
+	if errors.As(err, &sharedGroupErr) {
+		return apio.NewRequestError(err, http.StatusForbidden)
+	}
+	switch err {
+	case accesssvc.ErrUserNotAuthorized:
+		return apio.NewRequestError(err, http.StatusUnauthorized)
+	case accesssvc.ErrCannotReviewOwnRequest, accesssvc.ErrApprovalLimitReached:
+		return apio.NewRequestError(err, http.StatusForbidden)
+	case accesssvc.ErrRequestModified:
+		return apio.NewRequestError(err, http.StatusConflict)
+	case accesssvc.ErrRequestCannotBeExtended,
+		accesssvc.ErrExtensionAlreadyPending,
+		accesssvc.ErrExtensionNotSupported,
+		accesssvc.ErrNoPendingExtension,
+		accesssvc.ErrReviewerAlreadyApproved,
+		accesssvc.ErrRequestOverlapsExistingGrant,
+		workflowsvc.ErrGrantCannotBeExtended,
+		workflowsvc.ErrExtensionNotSupported:
+		return apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	return err
+}
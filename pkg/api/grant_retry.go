@@ -0,0 +1,80 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/service/grantretrysvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// List grant retries
+// (GET /api/v1/admin/grant-retries)
+func (a *API) AdminListGrantRetries(w http.ResponseWriter, r *http.Request, params types.AdminListGrantRetriesParams) {
+	ctx := r.Context()
+	var retries []access.GrantRetry
+	if params.Status != nil {
+		q := storage.ListGrantRetriesForStatus{Status: access.GrantRetryStatus(*params.Status)}
+		_, err := a.DB.Query(ctx, &q)
+		if err != nil && err != ddb.ErrNoItems {
+			apio.Error(ctx, w, err)
+			return
+		}
+		retries = q.Result
+	} else {
+		q := storage.ListGrantRetries{}
+		_, err := a.DB.Query(ctx, &q)
+		if err != nil && err != ddb.ErrNoItems {
+			apio.Error(ctx, w, err)
+			return
+		}
+		retries = q.Result
+	}
+
+	res := types.ListGrantRetriesResponse{
+		GrantRetries: make([]types.GrantRetry, len(retries)),
+	}
+	for i, retry := range retries {
+		res.GrantRetries[i] = retry.ToAPI()
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// Retry a grant change
+// (POST /api/v1/admin/grant-retries/{retryId}/retry)
+func (a *API) AdminRetryGrantRetry(w http.ResponseWriter, r *http.Request, retryId string) {
+	ctx := r.Context()
+	retry, err := a.GrantRetries.Retry(ctx, retryId)
+	if err == ddb.ErrNoItems {
+		err = apio.NewRequestError(err, http.StatusNotFound)
+	}
+	if err == grantretrysvc.ErrGrantRetryClosed {
+		err = apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, retry.ToAPI(), http.StatusOK)
+}
+
+// Abandon a grant change
+// (POST /api/v1/admin/grant-retries/{retryId}/abandon)
+func (a *API) AdminAbandonGrantRetry(w http.ResponseWriter, r *http.Request, retryId string) {
+	ctx := r.Context()
+	retry, err := a.GrantRetries.Abandon(ctx, retryId)
+	if err == ddb.ErrNoItems {
+		err = apio.NewRequestError(err, http.StatusNotFound)
+	}
+	if err == grantretrysvc.ErrGrantRetryClosed {
+		err = apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, retry.ToAPI(), http.StatusOK)
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/api/mocks"
+	"github.com/common-fate/common-fate/pkg/service/grantretrysvc"
+	"github.com/common-fate/ddb"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminRetryGrantRetry(t *testing.T) {
+	type testcase struct {
+		name      string
+		withRetry *access.GrantRetry
+		withErr   error
+		wantCode  int
+		wantBody  string
+	}
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	succeeded := access.NewGrantRetry("req_1", access.GrantRetryRevoke, now)
+	succeeded.Attempts = 1
+	succeeded.Status = access.GrantRetrySucceeded
+
+	testcases := []testcase{
+		{
+			name:      "ok",
+			withRetry: &succeeded,
+			wantCode:  http.StatusOK,
+			wantBody:  `{"action":"REVOKE","attempts":1,"createdAt":"2022-01-01T00:00:00Z","id":"rty_1_revoke","maxAttempts":10,"nextAttemptAt":"2022-01-01T00:00:00Z","requestId":"req_1","status":"SUCCEEDED","updatedAt":"2022-01-01T00:00:00Z"}`,
+		},
+		{
+			name:     "not found",
+			withErr:  ddb.ErrNoItems,
+			wantCode: http.StatusNotFound,
+			wantBody: `{"error":"item query returned no items"}`,
+		},
+		{
+			name:     "already closed",
+			withErr:  grantretrysvc.ErrGrantRetryClosed,
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"error":"this grant retry has already succeeded or been abandoned"}`,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			retries := mocks.NewMockGrantRetryService(ctrl)
+			retries.EXPECT().Retry(gomock.Any(), "rty_1_revoke").Return(tc.withRetry, tc.withErr)
+
+			a := API{GrantRetries: retries}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("POST", "/api/v1/admin/grant-retries/rty_1_revoke/retry", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.wantBody, string(data))
+		})
+	}
+}
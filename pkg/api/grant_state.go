@@ -0,0 +1,27 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+)
+
+// Get grant state
+// (GET /api/v1/admin/requests/{requestId}/grant-state)
+func (a *API) AdminGetRequestGrantState(w http.ResponseWriter, r *http.Request, requestId string) {
+	ctx := r.Context()
+	q := storage.GetRequestGrantState{RequestID: requestId}
+	_, err := a.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		apio.Error(ctx, w, apio.NewRequestError(errors.New("no grant state has been saved for this request"), http.StatusNotFound))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, q.Result.ToAPI(), http.StatusOK)
+}
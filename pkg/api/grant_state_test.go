@@ -0,0 +1,71 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminGetRequestGrantState(t *testing.T) {
+	type testcase struct {
+		name      string
+		withState *access.GrantState
+		withErr   error
+		wantCode  int
+		wantBody  string
+	}
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			withState: &access.GrantState{
+				RequestID: "req_1",
+				HandlerID: "hnd_1",
+				Kind:      "Account",
+				State:     map[string]any{"assignmentId": "abc"},
+				UpdatedAt: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"handlerId":"hnd_1","kind":"Account","requestId":"req_1","state":{"assignmentId":"abc"},"updatedAt":"2022-01-01T00:00:00Z"}`,
+		},
+		{
+			name:     "not found",
+			withErr:  ddb.ErrNoItems,
+			wantCode: http.StatusNotFound,
+			wantBody: `{"error":"no grant state has been saved for this request"}`,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQueryWithErr(&storage.GetRequestGrantState{Result: tc.withState}, tc.withErr)
+
+			a := API{DB: db}
+			handler := newTestServer(t, &a, withIsAdmin(true))
+
+			req, err := http.NewRequest("GET", "/api/v1/admin/requests/req_1/grant-state", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.wantBody, string(data))
+		})
+	}
+}
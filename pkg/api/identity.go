@@ -1,9 +1,11 @@
 package api
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/identity/identitysync"
 	"github.com/common-fate/common-fate/pkg/types"
 )
 
@@ -18,6 +20,58 @@ func (a *API) AdminSyncIdentity(w http.ResponseWriter, r *http.Request) {
 	apio.JSON(ctx, w, nil, http.StatusOK)
 }
 
+// Dry run Identity Sync
+// (POST /api/v1/admin/identity/sync/dry-run)
+func (a *API) AdminDryRunIdentitySync(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b types.AdminDryRunIdentitySyncJSONRequestBody
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	opts := identitysync.DryRunOpts{GroupFilter: b.GroupFilter}
+	if b.Source != nil {
+		opts.Source = *b.Source
+	}
+	diff, err := a.IdentitySyncPreview.DryRun(ctx, opts)
+	if errors.Is(err, identitysync.ErrInvalidGroupFilter) || errors.Is(err, identitysync.ErrSourceNotFound) {
+		err = apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	res := types.IdentitySyncDryRunResponse{
+		IdpType:     diff.IdpType,
+		GroupFilter: diff.GroupFilter,
+		Sources:     []types.IdentitySyncSource{},
+		Users:       []types.IdentitySyncUserChange{},
+		Groups:      []types.IdentitySyncGroupChange{},
+	}
+	for _, src := range diff.Sources {
+		res.Sources = append(res.Sources, types.IdentitySyncSource{IdpType: src.IdpType, GroupFilter: src.GroupFilter})
+	}
+	for _, c := range diff.Users {
+		res.Users = append(res.Users, types.IdentitySyncUserChange{
+			Action:        types.IdentitySyncChangeAction(c.Action),
+			User:          c.User.ToAPI(),
+			AddedGroups:   c.AddedGroups,
+			RemovedGroups: c.RemovedGroups,
+		})
+	}
+	for _, c := range diff.Groups {
+		res.Groups = append(res.Groups, types.IdentitySyncGroupChange{
+			Action:       types.IdentitySyncChangeAction(c.Action),
+			Group:        c.Group.ToAPI(),
+			AddedUsers:   c.AddedUsers,
+			RemovedUsers: c.RemovedUsers,
+		})
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
 // Get identity configuration
 // (GET /api/v1/admin/identity)
 func (a *API) AdminGetIdentityConfiguration(w http.ResponseWriter, r *http.Request) {
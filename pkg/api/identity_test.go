@@ -0,0 +1,119 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/api/mocks"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/identity/identitysync"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminDryRunIdentitySync(t *testing.T) {
+	type testcase struct {
+		name       string
+		give       string
+		wantOpts   identitysync.DryRunOpts
+		withResult *identitysync.Diff
+		withErr    error
+		wantCode   int
+		wantBody   string
+	}
+
+	filter := "admins"
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			give: `{"groupFilter":"admins"}`,
+			wantOpts: identitysync.DryRunOpts{
+				GroupFilter: &filter,
+			},
+			withResult: &identitysync.Diff{
+				IdpType:     "okta",
+				GroupFilter: "admins",
+				Sources:     []identitysync.DiffSource{{IdpType: "okta", GroupFilter: "admins"}, {IdpType: "azure"}},
+				Users: []identitysync.UserChange{
+					{
+						Action:        identitysync.ChangeActionArchive,
+						User:          identity.User{ID: "usr_1", Email: "chris@example.com", Groups: []string{}, Status: types.IdpStatusARCHIVED},
+						AddedGroups:   []string{},
+						RemovedGroups: []string{"devops"},
+					},
+				},
+				Groups: []identitysync.GroupChange{
+					{
+						Action:       identitysync.ChangeActionArchive,
+						Group:        identity.Group{ID: "devops", Name: "devops", Users: []string{}, Status: types.IdpStatusARCHIVED, Source: "okta"},
+						AddedUsers:   []string{},
+						RemovedUsers: []string{"usr_1"},
+					},
+				},
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"groupFilter":"admins","groups":[{"action":"ARCHIVE","addedUsers":[],"group":{"description":"","id":"devops","memberCount":0,"members":[],"name":"devops","source":"okta"},"removedUsers":["usr_1"]}],"idpType":"okta","sources":[{"groupFilter":"admins","idpType":"okta"},{"groupFilter":"","idpType":"azure"}],"users":[{"action":"ARCHIVE","addedGroups":[],"removedGroups":["devops"],"user":{"email":"chris@example.com","firstName":"","groups":[],"id":"usr_1","lastName":"","picture":"","status":"ARCHIVED","updatedAt":"0001-01-01T00:00:00Z"}}]}`,
+		},
+		{
+			name:       "uses configured filter",
+			give:       `{}`,
+			withResult: &identitysync.Diff{IdpType: "okta"},
+			wantCode:   http.StatusOK,
+			wantBody:   `{"groupFilter":"","groups":[],"idpType":"okta","sources":[],"users":[]}`,
+		},
+		{
+			name: "invalid filter",
+			give: `{"groupFilter":"admins"}`,
+			wantOpts: identitysync.DryRunOpts{
+				GroupFilter: &filter,
+			},
+			withErr:  fmt.Errorf("%w: missing closing )", identitysync.ErrInvalidGroupFilter),
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"error":"group filter is not a valid regular expression: missing closing )"}`,
+		},
+		{
+			name: "source not configured",
+			give: `{"groupFilter":"admins","source":"google"}`,
+			wantOpts: identitysync.DryRunOpts{
+				GroupFilter: &filter,
+				Source:      "google",
+			},
+			withErr:  fmt.Errorf("%w: google", identitysync.ErrSourceNotFound),
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"error":"identity provider is not configured: google"}`,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			preview := mocks.NewMockIdentitySyncPreviewer(ctrl)
+			preview.EXPECT().DryRun(gomock.Any(), gomock.Eq(tc.wantOpts)).Return(tc.withResult, tc.withErr)
+
+			a := API{IdentitySyncPreview: preview}
+			handler := newTestServer(t, &a, withIsAdmin(true))
+
+			req, err := http.NewRequest("POST", "/api/v1/admin/identity/sync/dry-run", strings.NewReader(tc.give))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.wantBody, string(data))
+		})
+	}
+}
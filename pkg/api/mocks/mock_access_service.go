@@ -36,6 +36,21 @@ func (m *MockAccessService) EXPECT() *MockAccessServiceMockRecorder {
 	return m.recorder
 }
 
+// AddComment mocks base method.
+func (m *MockAccessService) AddComment(arg0 context.Context, arg1 accesssvc.AddCommentOpts) (*access.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddComment", arg0, arg1)
+	ret0, _ := ret[0].(*access.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddComment indicates an expected call of AddComment.
+func (mr *MockAccessServiceMockRecorder) AddComment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddComment", reflect.TypeOf((*MockAccessService)(nil).AddComment), arg0, arg1)
+}
+
 // AddReviewAndGrantAccess mocks base method.
 func (m *MockAccessService) AddReviewAndGrantAccess(arg0 context.Context, arg1 accesssvc.AddReviewOpts) (*accesssvc.AddReviewResult, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +66,21 @@ func (mr *MockAccessServiceMockRecorder) AddReviewAndGrantAccess(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReviewAndGrantAccess", reflect.TypeOf((*MockAccessService)(nil).AddReviewAndGrantAccess), arg0, arg1)
 }
 
+// CancelRecurringRequest mocks base method.
+func (m *MockAccessService) CancelRecurringRequest(arg0 context.Context, arg1 accesssvc.CancelRecurringRequestOpts) (*access.RecurringRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelRecurringRequest", arg0, arg1)
+	ret0, _ := ret[0].(*access.RecurringRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelRecurringRequest indicates an expected call of CancelRecurringRequest.
+func (mr *MockAccessServiceMockRecorder) CancelRecurringRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelRecurringRequest", reflect.TypeOf((*MockAccessService)(nil).CancelRecurringRequest), arg0, arg1)
+}
+
 // CancelRequest mocks base method.
 func (m *MockAccessService) CancelRequest(arg0 context.Context, arg1 accesssvc.CancelRequestOpts) error {
 	m.ctrl.T.Helper()
@@ -80,6 +110,21 @@ func (mr *MockAccessServiceMockRecorder) CreateFavorite(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFavorite", reflect.TypeOf((*MockAccessService)(nil).CreateFavorite), arg0, arg1)
 }
 
+// CreateRecurringRequest mocks base method.
+func (m *MockAccessService) CreateRecurringRequest(arg0 context.Context, arg1 accesssvc.CreateRecurringRequestOpts) (*accesssvc.CreateRecurringRequestResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecurringRequest", arg0, arg1)
+	ret0, _ := ret[0].(*accesssvc.CreateRecurringRequestResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRecurringRequest indicates an expected call of CreateRecurringRequest.
+func (mr *MockAccessServiceMockRecorder) CreateRecurringRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecurringRequest", reflect.TypeOf((*MockAccessService)(nil).CreateRecurringRequest), arg0, arg1)
+}
+
 // CreateRequests mocks base method.
 func (m *MockAccessService) CreateRequests(arg0 context.Context, arg1 accesssvc.CreateRequestsOpts) ([]accesssvc.CreateRequestResult, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +140,80 @@ func (mr *MockAccessServiceMockRecorder) CreateRequests(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRequests", reflect.TypeOf((*MockAccessService)(nil).CreateRequests), arg0, arg1)
 }
 
+// DryRunRequests mocks base method.
+func (m *MockAccessService) DryRunRequests(arg0 context.Context, arg1 accesssvc.CreateRequestsOpts) ([]accesssvc.DryRunResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRunRequests", arg0, arg1)
+	ret0, _ := ret[0].([]accesssvc.DryRunResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DryRunRequests indicates an expected call of DryRunRequests.
+func (mr *MockAccessServiceMockRecorder) DryRunRequests(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRunRequests", reflect.TypeOf((*MockAccessService)(nil).DryRunRequests), arg0, arg1)
+}
+
+// ExtendRequest mocks base method.
+func (m *MockAccessService) ExtendRequest(arg0 context.Context, arg1 accesssvc.ExtendRequestOpts) (*accesssvc.ExtendRequestResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendRequest", arg0, arg1)
+	ret0, _ := ret[0].(*accesssvc.ExtendRequestResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtendRequest indicates an expected call of ExtendRequest.
+func (mr *MockAccessServiceMockRecorder) ExtendRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendRequest", reflect.TypeOf((*MockAccessService)(nil).ExtendRequest), arg0, arg1)
+}
+
+// RemoveDelegate mocks base method.
+func (m *MockAccessService) RemoveDelegate(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDelegate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDelegate indicates an expected call of RemoveDelegate.
+func (mr *MockAccessServiceMockRecorder) RemoveDelegate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDelegate", reflect.TypeOf((*MockAccessService)(nil).RemoveDelegate), arg0, arg1)
+}
+
+// ReviewExtension mocks base method.
+func (m *MockAccessService) ReviewExtension(arg0 context.Context, arg1 accesssvc.ReviewExtensionOpts) (*access.Request, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewExtension", arg0, arg1)
+	ret0, _ := ret[0].(*access.Request)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewExtension indicates an expected call of ReviewExtension.
+func (mr *MockAccessServiceMockRecorder) ReviewExtension(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewExtension", reflect.TypeOf((*MockAccessService)(nil).ReviewExtension), arg0, arg1)
+}
+
+// SetDelegate mocks base method.
+func (m *MockAccessService) SetDelegate(arg0 context.Context, arg1 accesssvc.SetDelegateOpts) (*access.Delegation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDelegate", arg0, arg1)
+	ret0, _ := ret[0].(*access.Delegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDelegate indicates an expected call of SetDelegate.
+func (mr *MockAccessServiceMockRecorder) SetDelegate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDelegate", reflect.TypeOf((*MockAccessService)(nil).SetDelegate), arg0, arg1)
+}
+
 // UpdateFavorite mocks base method.
 func (m *MockAccessService) UpdateFavorite(arg0 context.Context, arg1 accesssvc.UpdateFavoriteOpts) (*access.Favorite, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,121 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/auth"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// List my recurring requests
+// (GET /api/v1/recurring-requests)
+func (a *API) UserListRecurringRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+
+	q := storage.ListRecurringRequestsForUser{UserID: u.ID}
+	_, err := a.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		apio.Error(ctx, w, err)
+		return
+	}
+	res := types.ListRecurringRequestsResponse{
+		RecurringRequests: []types.RecurringRequest{},
+	}
+	for _, rr := range q.Result {
+		res.RecurringRequests = append(res.RecurringRequests, rr.ToAPI())
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// Create a recurring request
+// (POST /api/v1/recurring-requests)
+func (a *API) UserCreateRecurringRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b types.UserCreateRecurringRequestJSONRequestBody
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	u := auth.UserFromContext(ctx)
+
+	result, err := a.Access.CreateRecurringRequest(ctx, accesssvc.CreateRecurringRequestOpts{
+		User:         *u,
+		AccessRuleID: b.AccessRuleId,
+		Reason:       b.Reason,
+		With:         b.With,
+		Recurrence:   access.RecurrenceFromAPI(b.Recurrence),
+	})
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, result.RecurringRequest.ToAPI(), http.StatusCreated)
+}
+
+// Get a recurring request
+// (GET /api/v1/recurring-requests/{recurringRequestId})
+func (a *API) UserGetRecurringRequest(w http.ResponseWriter, r *http.Request, recurringRequestId string) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+
+	q := storage.GetRecurringRequest{ID: recurringRequestId}
+	_, err := a.DB.Query(ctx, &q)
+	// users can only view their own recurring requests.
+	if err == nil && q.Result.RequestedBy != u.ID {
+		err = ddb.ErrNoItems
+	}
+	if err == ddb.ErrNoItems {
+		err = apio.NewRequestError(err, http.StatusNotFound)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	rq := storage.ListRequestsForUser{UserId: u.ID}
+	_, err = a.DB.Query(ctx, &rq)
+	if err != nil && err != ddb.ErrNoItems {
+		apio.Error(ctx, w, err)
+		return
+	}
+	res := types.RecurringRequestDetailResponse{
+		RecurringRequest: q.Result.ToAPI(),
+		Requests:         []types.Request{},
+	}
+	for _, req := range rq.Result {
+		if req.RecurringRequestID != nil && *req.RecurringRequestID == recurringRequestId {
+			res.Requests = append(res.Requests, req.ToAPI())
+		}
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// Cancel a recurring request
+// (POST /api/v1/recurring-requests/{recurringRequestId}/cancel)
+func (a *API) UserCancelRecurringRequest(w http.ResponseWriter, r *http.Request, recurringRequestId string) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+
+	rr, err := a.Access.CancelRecurringRequest(ctx, accesssvc.CancelRecurringRequestOpts{
+		UserID:             u.ID,
+		RecurringRequestID: recurringRequestId,
+	})
+	if err == ddb.ErrNoItems {
+		err = apio.NewRequestError(err, http.StatusNotFound)
+	}
+	if err == accesssvc.ErrRecurringRequestAlreadyCancelled {
+		err = apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, rr.ToAPI(), http.StatusOK)
+}
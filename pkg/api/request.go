@@ -229,6 +229,7 @@ func (a *API) UserCreateRequest(w http.ResponseWriter, r *http.Request) {
 			Reason:       incomingRequest.Reason,
 			Timing:       incomingRequest.Timing,
 			With:         incomingRequest.With,
+			BreakGlass:   incomingRequest.BreakGlass != nil && *incomingRequest.BreakGlass,
 		},
 	})
 	var me *multierror.Error
@@ -251,6 +252,57 @@ func (a *API) UserCreateRequest(w http.ResponseWriter, r *http.Request) {
 	apio.JSON(ctx, w, nil, http.StatusOK)
 }
 
+// Dry run a request
+// (POST /api/v1/requests/dry-run)
+func (a *API) UserDryRunRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+
+	var incomingRequest types.CreateRequestRequest
+	err := apio.DecodeJSONBody(w, r, &incomingRequest)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	result, err := a.Access.DryRunRequests(ctx, accesssvc.CreateRequestsOpts{
+		User: *u,
+		Create: accesssvc.CreateRequests{
+			AccessRuleId: incomingRequest.AccessRuleId,
+			Reason:       incomingRequest.Reason,
+			Timing:       incomingRequest.Timing,
+			With:         incomingRequest.With,
+			BreakGlass:   incomingRequest.BreakGlass != nil && *incomingRequest.BreakGlass,
+		},
+	})
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	res := types.DryRunRequestResponse{
+		Requests: []types.DryRunRequest{},
+	}
+	for _, r := range result {
+		dr := types.DryRunRequest{
+			With:                r.With,
+			Start:               r.Start,
+			End:                 r.End,
+			RequiresApproval:    r.RequiresApproval,
+			Approvers:           r.Approvers,
+			OverlappingRequests: []string{},
+			ValidationSupported: r.ValidationSupported,
+			Validations:         r.Validations,
+		}
+		for _, o := range r.OverlappingRequests {
+			dr.OverlappingRequests = append(dr.OverlappingRequests, o.ID)
+		}
+		res.Requests = append(res.Requests, dr)
+	}
+
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
 func (a *API) UserCancelRequest(w http.ResponseWriter, r *http.Request, requestId string) {
 	ctx := r.Context()
 	uid := auth.UserIDFromContext(ctx)
@@ -409,7 +461,7 @@ func (a *API) UserGetAccessInstructions(w http.ResponseWriter, r *http.Request,
 
 }
 
-func (a *API) UserListRequestEvents(w http.ResponseWriter, r *http.Request, requestId string) {
+func (a *API) UserListRequestEvents(w http.ResponseWriter, r *http.Request, requestId string, params types.UserListRequestEventsParams) {
 	ctx := r.Context()
 	u := auth.UserFromContext(ctx)
 	canView := auth.IsAdmin(ctx)
@@ -444,16 +496,25 @@ func (a *API) UserListRequestEvents(w http.ResponseWriter, r *http.Request, requ
 		return
 	}
 
+	queryOpts := []func(*ddb.QueryOpts){ddb.Limit(100)}
+	if params.NextToken != nil {
+		queryOpts = append(queryOpts, ddb.Page(*params.NextToken))
+	}
 	qre := &storage.ListRequestEvents{
 		RequestID: requestId,
 	}
-	_, err = a.DB.Query(ctx, qre)
+	qr, err := a.DB.Query(ctx, qre, queryOpts...)
 	if err != nil && err != ddb.ErrNoItems {
 		apio.Error(ctx, w, err)
 		return
 	}
+	var next *string
+	if qr != nil && qr.NextPage != "" {
+		next = &qr.NextPage
+	}
 	res := types.ListRequestEventsResponse{
 		Events: make([]types.RequestEvent, len(qre.Result)),
+		Next:   next,
 	}
 	for i, re := range qre.Result {
 		res.Events[i] = re.ToAPI()
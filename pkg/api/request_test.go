@@ -8,8 +8,11 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/common-fate/apikit/apio"
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
 	"github.com/common-fate/common-fate/pkg/access"
 	"github.com/common-fate/common-fate/pkg/api/mocks"
 	"github.com/common-fate/common-fate/pkg/cache"
@@ -101,6 +104,78 @@ func TestUserCreateRequest(t *testing.T) {
 
 }
 
+func TestUserDryRunRequest(t *testing.T) {
+	type testcase struct {
+		name       string
+		give       string
+		withResult []accesssvc.DryRunResult
+		withErr    error
+		wantCode   int
+		wantBody   string
+	}
+
+	start := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			give: `{"timing":{"durationSeconds": 3600}, "accessRuleId": "rul_123"}`,
+			withResult: []accesssvc.DryRunResult{
+				{
+					With:                map[string]string{"group": "Admins"},
+					Start:               start,
+					End:                 start.Add(time.Hour),
+					RequiresApproval:    true,
+					Approvers:           []string{"usr_2"},
+					OverlappingRequests: []access.Request{{ID: "req_1"}},
+					ValidationSupported: true,
+					Validations: []ahTypes.GrantValidation{
+						{Id: "user-exists", Name: "We couldn't find your user", Status: ahTypes.PASSED, Logs: []ahTypes.Log{{Level: ahTypes.LogLevelINFO, Msg: "user exists"}}},
+					},
+				},
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"requests":[{"approvers":["usr_2"],"end":"2022-01-01T11:00:00Z","overlappingRequests":["req_1"],"requiresApproval":true,"start":"2022-01-01T10:00:00Z","validationSupported":true,"validations":[{"id":"user-exists","logs":[{"level":"INFO","msg":"user exists"}],"name":"We couldn't find your user","status":"PASSED"}],"with":{"group":"Admins"}}]}`,
+		},
+		{
+			name:     "rule not found",
+			give:     `{"timing":{"durationSeconds": 3600}, "accessRuleId": "rul_123"}`,
+			withErr:  apio.NewRequestError(accesssvc.ErrRuleNotFound, http.StatusBadRequest),
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"error":"access rule not found"}`,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAccess := mocks.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().DryRunRequests(gomock.Any(), gomock.Any()).Return(tc.withResult, tc.withErr)
+			a := API{Access: mockAccess}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("POST", "/api/v1/requests/dry-run", strings.NewReader(tc.give))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tc.wantBody, string(data))
+		})
+	}
+}
+
 func TestUserCancelRequest(t *testing.T) {
 	type testcase struct {
 		name          string
@@ -574,6 +649,7 @@ func TestUserListRequestEvents(t *testing.T) {
 		mockGetRequestReviewerErr error
 		mockListEvents            storage.ListRequestEvents
 		mockListEventsErr         error
+		mockListEventsResult      *ddb.QueryResult
 		apiUserID                 string
 		apiUserIsAdmin            bool
 		// expected HTTP response code
@@ -654,6 +730,26 @@ func TestUserListRequestEvents(t *testing.T) {
 			apiUserIsAdmin: true,
 			wantBody:       `{"events":[{"createdAt":"0001-01-01T00:00:00Z","id":"event","requestId":"1234"}],"next":null}`,
 		},
+		{
+			name:     "more events to load",
+			wantCode: http.StatusOK,
+			mockGetRequest: storage.GetRequest{
+				ID: "1234",
+				Result: &access.Request{
+					ID:          "1234",
+					RequestedBy: "abcd",
+				},
+			},
+			mockListEvents: storage.ListRequestEvents{
+				RequestID: "1234",
+				Result: []access.RequestEvent{
+					{ID: "event", RequestID: "1234", RecordedEvent: &map[string]string{"command": "ls"}, RecordedEventSource: aws.String("ecs-shell"), SessionID: aws.String("ses_1")},
+				},
+			},
+			mockListEventsResult: &ddb.QueryResult{NextPage: "page2"},
+			apiUserID:            "abcd",
+			wantBody:             `{"events":[{"createdAt":"0001-01-01T00:00:00Z","id":"event","recordedEvent":{"command":"ls"},"recordedEventSource":"ecs-shell","requestId":"1234","sessionId":"ses_1"}],"next":"page2"}`,
+		},
 		{
 			name:              "not found",
 			wantCode:          http.StatusUnauthorized,
@@ -669,7 +765,7 @@ func TestUserListRequestEvents(t *testing.T) {
 
 			db := ddbmock.New(t)
 			db.MockQueryWithErr(&tc.mockGetRequest, tc.mockGetRequestErr)
-			db.MockQueryWithErr(&tc.mockListEvents, tc.mockListEventsErr)
+			db.MockQueryWithErrWithResult(&tc.mockListEvents, tc.mockListEventsResult, tc.mockListEventsErr)
 			db.MockQueryWithErr(&tc.mockGetRequestReviewer, tc.mockGetRequestReviewerErr)
 			a := API{DB: db}
 			handler := newTestServer(t, &a, withRequestUser(identity.User{ID: tc.apiUserID}), withIsAdmin(tc.apiUserIsAdmin))
@@ -93,6 +93,17 @@ func (a *API) UserReviewRequest(w http.ResponseWriter, r *http.Request, requestI
 		// wrap the error in a 400 status code
 		err = apio.NewRequestError(err, http.StatusBadRequest)
 	}
+	if err == accesssvc.ErrReviewerAlreadyApproved || err == accesssvc.ErrBreakGlassAlreadyReviewed {
+		err = apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	if err == accesssvc.ErrRequestModified {
+		err = apio.NewRequestError(err, http.StatusConflict)
+	}
+	var sharedGroupErr accesssvc.SharedExclusiveGroupError
+	if err == accesssvc.ErrCannotReviewOwnRequest || err == accesssvc.ErrApprovalLimitReached || errors.As(err, &sharedGroupErr) {
+		// the reviewer is blocked by the separation-of-duties constraints
+		err = apio.NewRequestError(err, http.StatusForbidden)
+	}
 	if err == accesssvc.ErrUserNotAuthorized {
 		// wrap the error in a 401 status code
 		err = apio.NewRequestError(errors.New("you are not a reviewer of this request"), http.StatusUnauthorized)
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/auth"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// Create SCIM token
+// (POST /api/v1/admin/identity/scim-token)
+func (a *API) AdminCreateScimToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+	token, scimToken, err := identity.NewSCIMToken(u.ID, time.Now())
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	// there is a single SCIM token, so saving the new token replaces the existing one.
+	err = a.DB.Put(ctx, &scimToken)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, types.SCIMTokenResponse{Token: token}, http.StatusCreated)
+}
+
+// Delete SCIM token
+// (DELETE /api/v1/admin/identity/scim-token)
+func (a *API) AdminDeleteScimToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	err := a.DB.Delete(ctx, &identity.SCIMToken{})
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
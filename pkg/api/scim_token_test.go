@@ -0,0 +1,64 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminCreateScimToken(t *testing.T) {
+	type testcase struct {
+		name     string
+		withErr  error
+		wantCode int
+	}
+
+	testcases := []testcase{
+		{
+			name:     "ok",
+			wantCode: http.StatusCreated,
+		},
+		{
+			name:     "database error",
+			withErr:  errors.New("database unavailable"),
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.PutErr = tc.withErr
+
+			a := API{DB: db}
+			handler := newTestServer(t, &a, withIsAdmin(true), withRequestUser(identity.User{ID: "usr_admin"}))
+
+			req, err := http.NewRequest("POST", "/api/v1/admin/identity/scim-token", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			if tc.wantCode != http.StatusCreated {
+				return
+			}
+			var got types.SCIMTokenResponse
+			err = json.NewDecoder(rr.Body).Decode(&got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// the token is random, so only check that one was returned.
+			assert.NotEmpty(t, got.Token)
+		})
+	}
+}
@@ -1,15 +1,16 @@
 package autoapproval
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
+	"strings"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gconfig"
 	"github.com/common-fate/common-fate/pkg/identity"
 	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/types"
 )
 
 type Status string
@@ -17,60 +18,110 @@ type Status string
 const (
 	AUTO_APPROVED     Status = "AUTO_APPROVED"
 	REQUIRES_APPROVAL Status = "REQUIRES_APPROVAL"
+	DENIED            Status = "DENIED"
 )
 
+// Valid returns an error if the status is not one of the supported decisions.
+func (s Status) Valid() error {
+	switch s {
+	case AUTO_APPROVED, REQUIRES_APPROVAL, DENIED:
+		return nil
+	}
+	return fmt.Errorf("invalid auto-approval decision %q, decision must be one of [%s, %s, %s]", s, AUTO_APPROVED, REQUIRES_APPROVAL, DENIED)
+}
+
+// ResponseBody is the decision returned by an auto-approval backend.
 type ResponseBody struct {
 	Decision      Status `json:"decision"`
 	Justification string `json:"justification,omitempty"`
 }
 
+// RequestBody is the input to an auto-approval backend.
 type RequestBody struct {
 	User identity.User   `json:"user"`
 	Rule rule.AccessRule `json:"rule"`
+	// Arguments are the values of the arguments selected for the request, keyed by argument ID.
+	Arguments map[string]string   `json:"arguments"`
+	Timing    types.RequestTiming `json:"timing"`
+	// History contains the user's most recent access requests, newest first.
+	History []access.Request `json:"history"`
+	// DryRun is true if the request is only being previewed and won't be created.
+	// Backends with side effects, such as recording the decision, should skip them for dry runs.
+	DryRun bool `json:"dryRun"`
 }
 
-type Service struct {
+// Evaluator decides whether an access request can be approved automatically.
+type Evaluator interface {
+	Evaluate(ctx context.Context, in RequestBody) (ResponseBody, error)
 }
 
-func (s Service) Autoapprove(user identity.User, rule rule.AccessRule, lambdaArn string) (bool, error) {
-	sess, err := session.NewSession()
-
-	if err != nil {
-		return false, err
-	}
-
-	req := RequestBody{User: user, Rule: rule}
-
-	payload, err := json.Marshal(req)
-
-	if err != nil {
-		return false, err
-	}
+// Service evaluates each of the configured backends in order.
+// The first backend to return a decision other than REQUIRES_APPROVAL decides the outcome of the request.
+type Service struct {
+	Evaluators []Evaluator
+}
 
-	params := &lambda.InvokeInput{
-		FunctionName:   aws.String(lambdaArn), // Lambda arn
-		Payload:        payload,
-		InvocationType: aws.String("RequestResponse"), // Get synchronous output
+func (s *Service) Evaluate(ctx context.Context, in RequestBody) (ResponseBody, error) {
+	for _, e := range s.Evaluators {
+		res, err := e.Evaluate(ctx, in)
+		if err != nil {
+			return ResponseBody{}, err
+		}
+		if err := res.Decision.Valid(); err != nil {
+			return ResponseBody{}, err
+		}
+		if res.Decision != REQUIRES_APPROVAL {
+			return res, nil
+		}
 	}
+	return ResponseBody{Decision: REQUIRES_APPROVAL}, nil
+}
 
-	svc := lambda.New(sess)
-
-	resp, err := svc.Invoke(params)
+type Opts struct {
+	// Policies is a JSON array of in-process policies, see Policy for the format.
+	Policies   string
+	LambdaARN  string
+	WebhookURL string
+	// WebhookSecret is an 'awsssm://' reference to the secret used to sign requests to the webhook, see WebhookSecretConfig.
+	WebhookSecret string
+}
 
-	if err != nil {
-		fmt.Println("Error happened when Invoke lambda ", err)
+// New configures the auto-approval backends.
+// Policies are evaluated first, followed by the Lambda function and then the webhook, if they are configured.
+// If no backends are configured, every request requires approval.
+func New(ctx context.Context, opts Opts) (*Service, error) {
+	var s Service
+	if opts.Policies != "" {
+		policies, err := ParsePolicies(opts.Policies)
+		if err != nil {
+			return nil, err
+		}
+		p, err := NewPolicyEvaluator(policies)
+		if err != nil {
+			return nil, err
+		}
+		s.Evaluators = append(s.Evaluators, p)
 	}
-
-	var output ResponseBody
-	err = json.Unmarshal(resp.Payload, &output)
-
-	if err != nil {
-		return false, err
+	if opts.LambdaARN != "" {
+		l, err := NewLambdaEvaluator(opts.LambdaARN)
+		if err != nil {
+			return nil, err
+		}
+		s.Evaluators = append(s.Evaluators, l)
 	}
-
-	if resp.FunctionError != nil {
-		return false, errors.New("Error happened when calling lambda: " + *resp.FunctionError)
+	if opts.WebhookURL != "" {
+		var secret gconfig.SecretStringValue
+		if opts.WebhookSecret != "" {
+			// the secret must not be stored in plaintext in the deployment configuration or the environment.
+			if !strings.HasPrefix(opts.WebhookSecret, "awsssm://") {
+				return nil, errors.New("the auto-approval webhook secret must be a reference to an SSM parameter in the 'awsssm://' format")
+			}
+			err := WebhookSecretConfig(&secret).Load(ctx, &gconfig.MapLoader{Values: map[string]string{"webhookSecret": opts.WebhookSecret}})
+			if err != nil {
+				return nil, err
+			}
+		}
+		s.Evaluators = append(s.Evaluators, &WebhookEvaluator{URL: opts.WebhookURL, Secret: secret.Get()})
 	}
-
-	return output.Decision == AUTO_APPROVED, nil
+	return &s, nil
 }
@@ -0,0 +1,52 @@
+package autoapproval
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+)
+
+// LambdaEvaluator invokes an AWS Lambda function to decide whether a request is approved automatically.
+type LambdaEvaluator struct {
+	FunctionARN string
+	Client      lambdaiface.LambdaAPI
+}
+
+func NewLambdaEvaluator(functionARN string) (*LambdaEvaluator, error) {
+	sess, err := session.NewSession()
+	if err != nil {
+		return nil, err
+	}
+	return &LambdaEvaluator{FunctionARN: functionARN, Client: lambda.New(sess)}, nil
+}
+
+func (l *LambdaEvaluator) Evaluate(ctx context.Context, in RequestBody) (ResponseBody, error) {
+	payload, err := json.Marshal(in)
+	if err != nil {
+		return ResponseBody{}, err
+	}
+
+	resp, err := l.Client.InvokeWithContext(ctx, &lambda.InvokeInput{
+		FunctionName:   aws.String(l.FunctionARN),
+		Payload:        payload,
+		InvocationType: aws.String("RequestResponse"), // Get synchronous output
+	})
+	if err != nil {
+		return ResponseBody{}, err
+	}
+	if resp.FunctionError != nil {
+		return ResponseBody{}, errors.New("Error happened when calling lambda: " + *resp.FunctionError)
+	}
+
+	var output ResponseBody
+	err = json.Unmarshal(resp.Payload, &output)
+	if err != nil {
+		return ResponseBody{}, err
+	}
+	return output, nil
+}
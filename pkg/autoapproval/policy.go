@@ -0,0 +1,119 @@
+package autoapproval
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+)
+
+// Policy is an auto-approval rule which is evaluated in-process.
+//
+// When is a CEL expression (https://github.com/google/cel-spec) which must return a bool.
+// The fields of the RequestBody are available as the variables user, rule, arguments, timing and history,
+// using their JSON names, for example:
+//
+//	rule.id == 'rul_123' && timing.durationSeconds <= 3600
+//
+// The policy matches if the expression returns true.
+type Policy struct {
+	Name          string `json:"name"`
+	When          string `json:"when"`
+	Decision      Status `json:"decision"`
+	Justification string `json:"justification,omitempty"`
+}
+
+// ParsePolicies parses a JSON array of policies.
+func ParsePolicies(in string) ([]Policy, error) {
+	var policies []Policy
+	err := json.Unmarshal([]byte(in), &policies)
+	if err != nil {
+		return nil, fmt.Errorf("parsing auto-approval policies: %w", err)
+	}
+	return policies, nil
+}
+
+type compiledPolicy struct {
+	Policy
+	program cel.Program
+}
+
+// PolicyEvaluator evaluates policies in order.
+// The first matching policy determines the decision, if no policies match the request requires approval.
+type PolicyEvaluator struct {
+	policies []compiledPolicy
+}
+
+// NewPolicyEvaluator validates and compiles the policies.
+func NewPolicyEvaluator(policies []Policy) (*PolicyEvaluator, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("user", cel.DynType),
+		cel.Variable("rule", cel.DynType),
+		cel.Variable("arguments", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("timing", cel.DynType),
+		cel.Variable("history", cel.ListType(cel.DynType)),
+		// numbers in the JSON input are doubles, this allows them to be compared with integers in policies.
+		cel.CrossTypeNumericComparisons(true),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var p PolicyEvaluator
+	for _, policy := range policies {
+		if policy.Name == "" {
+			return nil, fmt.Errorf("auto-approval policy is missing a name")
+		}
+		if err := policy.Decision.Valid(); err != nil {
+			return nil, fmt.Errorf("auto-approval policy %s: %w", policy.Name, err)
+		}
+		ast, iss := env.Compile(policy.When)
+		if iss.Err() != nil {
+			return nil, fmt.Errorf("compiling auto-approval policy %s: %w", policy.Name, iss.Err())
+		}
+		if !cel.BoolType.IsAssignableType(ast.OutputType()) {
+			return nil, fmt.Errorf("auto-approval policy %s must return a bool, but returns %s", policy.Name, ast.OutputType())
+		}
+		program, err := env.Program(ast)
+		if err != nil {
+			return nil, fmt.Errorf("compiling auto-approval policy %s: %w", policy.Name, err)
+		}
+		p.policies = append(p.policies, compiledPolicy{Policy: policy, program: program})
+	}
+	return &p, nil
+}
+
+func (p *PolicyEvaluator) Evaluate(ctx context.Context, in RequestBody) (ResponseBody, error) {
+	// policies are evaluated against the JSON representation of the input,
+	// so that they can refer to fields by their JSON names.
+	b, err := json.Marshal(in)
+	if err != nil {
+		return ResponseBody{}, err
+	}
+	var vars map[string]any
+	err = json.Unmarshal(b, &vars)
+	if err != nil {
+		return ResponseBody{}, err
+	}
+
+	for _, policy := range p.policies {
+		out, _, err := policy.program.ContextEval(ctx, vars)
+		if err != nil {
+			return ResponseBody{}, fmt.Errorf("evaluating auto-approval policy %s: %w", policy.Name, err)
+		}
+		matched, ok := out.Value().(bool)
+		if !ok {
+			return ResponseBody{}, fmt.Errorf("auto-approval policy %s returned %v, but must return a bool", policy.Name, out.Value())
+		}
+		if !matched {
+			continue
+		}
+		justification := policy.Justification
+		if justification == "" {
+			justification = fmt.Sprintf("matched policy %s", policy.Name)
+		}
+		return ResponseBody{Decision: policy.Decision, Justification: justification}, nil
+	}
+	return ResponseBody{Decision: REQUIRES_APPROVAL}, nil
+}
@@ -0,0 +1,170 @@
+package autoapproval
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyEvaluator(t *testing.T) {
+	type testcase struct {
+		name     string
+		policies []Policy
+		in       RequestBody
+		want     ResponseBody
+		wantErr  string
+	}
+
+	in := RequestBody{
+		User:      identity.User{ID: "usr_1", Email: "test@commonfate.io", Groups: []string{"developers"}},
+		Rule:      rule.AccessRule{ID: "rul_1"},
+		Arguments: map[string]string{"accountId": "123456789012"},
+		Timing:    types.RequestTiming{DurationSeconds: 1800},
+		History: []access.Request{
+			{ID: "req_1", Status: access.DECLINED, CreatedAt: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	testcases := []testcase{
+		{
+			name: "no policies",
+			in:   in,
+			want: ResponseBody{Decision: REQUIRES_APPROVAL},
+		},
+		{
+			name: "policy matches on rule and timing",
+			policies: []Policy{
+				{Name: "short", When: "rule.id == 'rul_1' && timing.durationSeconds <= 3600", Decision: AUTO_APPROVED},
+			},
+			in:   in,
+			want: ResponseBody{Decision: AUTO_APPROVED, Justification: "matched policy short"},
+		},
+		{
+			name: "policy does not match",
+			policies: []Policy{
+				{Name: "short", When: "timing.durationSeconds <= 60", Decision: AUTO_APPROVED},
+			},
+			in:   in,
+			want: ResponseBody{Decision: REQUIRES_APPROVAL},
+		},
+		{
+			name: "first matching policy wins",
+			policies: []Policy{
+				{Name: "prod", When: "arguments.accountId == '123456789012'", Decision: DENIED, Justification: "production account"},
+				{Name: "developers", When: "'developers' in user.groups", Decision: AUTO_APPROVED},
+			},
+			in:   in,
+			want: ResponseBody{Decision: DENIED, Justification: "production account"},
+		},
+		{
+			name: "policy matches on history",
+			policies: []Policy{
+				{Name: "previously-declined", When: "history.exists(r, r.status == 'DECLINED')", Decision: DENIED},
+			},
+			in:   in,
+			want: ResponseBody{Decision: DENIED, Justification: "matched policy previously-declined"},
+		},
+		{
+			name: "invalid decision",
+			policies: []Policy{
+				{Name: "invalid", When: "true", Decision: "MAYBE"},
+			},
+			wantErr: `auto-approval policy invalid: invalid auto-approval decision "MAYBE", decision must be one of [AUTO_APPROVED, REQUIRES_APPROVAL, DENIED]`,
+		},
+		{
+			name: "invalid expression",
+			policies: []Policy{
+				{Name: "invalid", When: "rule.id ==", Decision: AUTO_APPROVED},
+			},
+			wantErr: "compiling auto-approval policy invalid: ERROR: <input>:1:11: Syntax error",
+		},
+		{
+			name: "expression does not return a bool",
+			policies: []Policy{
+				{Name: "invalid", When: "'a' + 'b'", Decision: AUTO_APPROVED},
+			},
+			wantErr: "auto-approval policy invalid must return a bool, but returns string",
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := NewPolicyEvaluator(tc.policies)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			got, err := p.Evaluate(context.Background(), tc.in)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+type staticEvaluator ResponseBody
+
+func (s staticEvaluator) Evaluate(ctx context.Context, in RequestBody) (ResponseBody, error) {
+	return ResponseBody(s), nil
+}
+
+func TestServiceEvaluate(t *testing.T) {
+	type testcase struct {
+		name       string
+		evaluators []Evaluator
+		want       ResponseBody
+		wantErr    bool
+	}
+
+	testcases := []testcase{
+		{
+			name: "no evaluators",
+			want: ResponseBody{Decision: REQUIRES_APPROVAL},
+		},
+		{
+			name: "falls through to next evaluator",
+			evaluators: []Evaluator{
+				staticEvaluator{Decision: REQUIRES_APPROVAL},
+				staticEvaluator{Decision: AUTO_APPROVED, Justification: "webhook"},
+			},
+			want: ResponseBody{Decision: AUTO_APPROVED, Justification: "webhook"},
+		},
+		{
+			name: "first decision wins",
+			evaluators: []Evaluator{
+				staticEvaluator{Decision: DENIED, Justification: "policy"},
+				staticEvaluator{Decision: AUTO_APPROVED, Justification: "webhook"},
+			},
+			want: ResponseBody{Decision: DENIED, Justification: "policy"},
+		},
+		{
+			name: "invalid decision from backend",
+			evaluators: []Evaluator{
+				staticEvaluator{Decision: "approved"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			s := Service{Evaluators: tc.evaluators}
+			got, err := s.Evaluate(context.Background(), RequestBody{})
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package autoapproval
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/gconfig"
+)
+
+// SignatureHeader is the header which contains the signature of a webhook request body,
+// in the format "sha256=<hex encoded HMAC-SHA256 of the body>".
+const SignatureHeader = "X-CommonFate-Signature"
+
+// webhookTimeout bounds how long request creation waits for the webhook to respond.
+const webhookTimeout = 10 * time.Second
+
+// WebhookSecretConfig configures the secret which is used to sign webhook requests.
+// The secret is stored in SSM Parameter Store, and only an 'awsssm://' reference to it is saved in the deployment configuration.
+func WebhookSecretConfig(secret *gconfig.SecretStringValue) gconfig.Config {
+	return gconfig.Config{
+		gconfig.SecretStringField("webhookSecret", secret, "the secret used to sign auto-approval webhook requests", gconfig.WithNoArgs("/granted/secrets/autoapproval/webhookSecret")),
+	}
+}
+
+// WebhookEvaluator sends the request to an HTTP endpoint to decide whether it is approved automatically.
+// The endpoint receives a RequestBody as a JSON POST and must respond with a ResponseBody.
+type WebhookEvaluator struct {
+	URL string
+	// Secret is optional. If it is set, requests are signed with it so that the endpoint can verify they were sent by Common Fate.
+	Secret string
+	// Client is optional, a client with a 10 second timeout is used if it is nil.
+	Client *http.Client
+}
+
+func (w *WebhookEvaluator) Evaluate(ctx context.Context, in RequestBody) (ResponseBody, error) {
+	payload, err := json.Marshal(in)
+	if err != nil {
+		return ResponseBody{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(payload))
+	if err != nil {
+		return ResponseBody{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(w.Secret, payload))
+	}
+
+	client := w.Client
+	if client == nil {
+		client = &http.Client{Timeout: webhookTimeout}
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return ResponseBody{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		body, _ := io.ReadAll(res.Body)
+		return ResponseBody{}, fmt.Errorf("auto-approval webhook returned status %d: %s", res.StatusCode, string(body))
+	}
+
+	var output ResponseBody
+	err = json.NewDecoder(res.Body).Decode(&output)
+	if err != nil {
+		return ResponseBody{}, err
+	}
+	return output, nil
+}
+
+// Sign returns the value of the SignatureHeader for a webhook request body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
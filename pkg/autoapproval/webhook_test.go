@@ -0,0 +1,61 @@
+package autoapproval
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookEvaluator(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.Header.Get(SignatureHeader) != Sign("secret", body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var in RequestBody
+		err = json.Unmarshal(body, &in)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if in.Rule.ID != "rul_1" || in.DryRun {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(ResponseBody{Decision: AUTO_APPROVED, Justification: "approved by webhook"})
+	}))
+	defer srv.Close()
+
+	w := WebhookEvaluator{URL: srv.URL, Secret: "secret"}
+
+	got, err := w.Evaluate(context.Background(), RequestBody{Rule: rule.AccessRule{ID: "rul_1"}})
+	assert.NoError(t, err)
+	assert.Equal(t, ResponseBody{Decision: AUTO_APPROVED, Justification: "approved by webhook"}, got)
+
+	_, err = w.Evaluate(context.Background(), RequestBody{Rule: rule.AccessRule{ID: "rul_2"}})
+	assert.Error(t, err)
+
+	// the webhook is told when a request is only being previewed.
+	_, err = w.Evaluate(context.Background(), RequestBody{Rule: rule.AccessRule{ID: "rul_1"}, DryRun: true})
+	assert.Error(t, err)
+
+	unsigned := WebhookEvaluator{URL: srv.URL}
+	_, err = unsigned.Evaluate(context.Background(), RequestBody{Rule: rule.AccessRule{ID: "rul_1"}})
+	assert.Error(t, err)
+}
+
+func TestNewRejectsPlaintextWebhookSecret(t *testing.T) {
+	_, err := New(context.Background(), Opts{WebhookURL: "https://example.com", WebhookSecret: "secret"})
+	assert.EqualError(t, err, "the auto-approval webhook secret must be a reference to an SSM parameter in the 'awsssm://' format")
+}
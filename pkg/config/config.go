@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 type Config struct {
 	Host              string `env:"COMMONFATE_HOST,default=0.0.0.0:8080"`
 	LogLevel          string `env:"LOG_LEVEL,default=info"`
@@ -25,10 +27,26 @@ type Config struct {
 	RemoteConfigURL               string `env:"COMMONFATE_ACCESS_REMOTE_CONFIG_URL"`
 	RemoteConfigHeaders           string `env:"COMMONFATE_REMOTE_CONFIG_HEADERS"`
 	// a regex string that is used to filter the identity groups that are returned from the IDP
-	IdentityGroupFilter   string `env:"COMMONFATE_IDENTITY_GROUP_FILTER"`
+	IdentityGroupFilter string `env:"COMMONFATE_IDENTITY_GROUP_FILTER"`
+	// A JSON array of deploy.IdentitySource, use deploy.UnmarshalIdentitySources to unmarshal it.
+	// If provided, users and groups are synced from each source rather than from IdpProvider.
+	IdentitySources       string `env:"COMMONFATE_IDENTITY_SOURCES"`
 	NoAuthEmail           string `env:"NO_AUTH_EMAIL"`
 	StateMachineARN       string `env:"COMMONFATE_GRANTER_V2_STATE_MACHINE_ARN"`
 	AutoApprovalLambdaArn string `env:"COMMONFATE_AUTO_APPROVAL_LAMBDA_ARN"`
+	// A JSON array of auto-approval policies which are evaluated in-process, see autoapproval.Policy
+	AutoApprovalPolicies   string `env:"COMMONFATE_AUTO_APPROVAL_POLICIES"`
+	AutoApprovalWebhookURL string `env:"COMMONFATE_AUTO_APPROVAL_WEBHOOK_URL"`
+	// An 'awsssm://' reference to the secret used to sign requests to the auto-approval webhook,
+	// so that it can verify they were sent by Common Fate.
+	AutoApprovalWebhookSecret string `env:"COMMONFATE_AUTO_APPROVAL_WEBHOOK_SECRET"`
+	// WorkflowRuntime is either "live", which runs grants with AWS Step Functions,
+	// or "local", which runs grants in process and handles their events without EventBridge.
+	WorkflowRuntime string `env:"COMMONFATE_WORKFLOW_RUNTIME,default=live"`
+	// GrantVerificationTimeout and GrantVerificationInterval are used when retrying target group grants,
+	// see TargetGroupGranterConfig.
+	GrantVerificationTimeout  time.Duration `env:"COMMONFATE_GRANT_VERIFICATION_TIMEOUT,default=0"`
+	GrantVerificationInterval time.Duration `env:"COMMONFATE_GRANT_VERIFICATION_INTERVAL,default=5s"`
 }
 
 type NotificationsConfig struct {
@@ -45,6 +63,11 @@ type NotificationsConfig struct {
 type EventHandlerConfig struct {
 	LogLevel    string `env:"LOG_LEVEL,default=info"`
 	DynamoTable string `env:"COMMONFATE_TABLE_NAME,required"`
+	// the access handler and granter are used to revoke the grants queued by bulk revocations.
+	EventBusArn      string `env:"COMMONFATE_EVENT_BUS_ARN,required"`
+	Region           string `env:"AWS_REGION,required"`
+	AccessHandlerURL string `env:"COMMONFATE_ACCESS_HANDLER_URL,default=http://0.0.0.0:9092"`
+	StateMachineARN  string `env:"COMMONFATE_GRANTER_V2_STATE_MACHINE_ARN"`
 }
 
 type SyncConfig struct {
@@ -56,6 +79,10 @@ type SyncConfig struct {
 	// Use deploy.UnmarshalFeatureMap to unmarshal this data into a FeatureMap
 	IdentitySettings    string `env:"COMMONFATE_IDENTITY_SETTINGS,default={}"`
 	IdentityGroupFilter string `env:"COMMONFATE_IDENTITY_GROUP_FILTER"`
+	// A JSON array of deploy.IdentitySource, see Config.IdentitySources.
+	IdentitySources string `env:"COMMONFATE_IDENTITY_SOURCES"`
+	// IdentityFullSyncInterval is how often every user and group is synced, for identity providers which can sync only the users and groups which changed.
+	IdentityFullSyncInterval time.Duration `env:"COMMONFATE_IDENTITY_FULL_SYNC_INTERVAL,default=24h"`
 }
 type CacheSyncConfig struct {
 	TableName        string `env:"COMMONFATE_TABLE_NAME,required"`
@@ -69,6 +96,42 @@ type HealthCheckerConfig struct {
 	Region    string `env:"AWS_REGION,required"`
 }
 
+type RequestSweeperConfig struct {
+	TableName   string `env:"COMMONFATE_TABLE_NAME,required"`
+	LogLevel    string `env:"LOG_LEVEL,default=info"`
+	EventBusArn string `env:"COMMONFATE_EVENT_BUS_ARN,required"`
+	// ReminderInterval is how often reviewers are reminded about pending requests. Set to 0 to disable reminders.
+	ReminderInterval time.Duration `env:"COMMONFATE_REQUEST_REMINDER_INTERVAL,default=24h"`
+	// RecurringRequestLeadTime is how far ahead of its start time each occurrence of a recurring request is requested.
+	RecurringRequestLeadTime time.Duration `env:"COMMONFATE_RECURRING_REQUEST_LEAD_TIME,default=24h"`
+	// the access handler and granter are used to grant occurrences of recurring requests which are approved automatically.
+	Region           string `env:"AWS_REGION,required"`
+	AccessHandlerURL string `env:"COMMONFATE_ACCESS_HANDLER_URL,default=http://0.0.0.0:9092"`
+	StateMachineARN  string `env:"COMMONFATE_GRANTER_V2_STATE_MACHINE_ARN"`
+}
+
+type GrantRetrierConfig struct {
+	TableName   string `env:"COMMONFATE_TABLE_NAME,required"`
+	LogLevel    string `env:"LOG_LEVEL,default=info"`
+	EventBusArn string `env:"COMMONFATE_EVENT_BUS_ARN,required"`
+	// the access handler and granter are used to retry failed changes to grants.
+	Region           string `env:"AWS_REGION,required"`
+	AccessHandlerURL string `env:"COMMONFATE_ACCESS_HANDLER_URL,default=http://0.0.0.0:9092"`
+	StateMachineARN  string `env:"COMMONFATE_GRANTER_V2_STATE_MACHINE_ARN"`
+	// GrantVerificationTimeout and GrantVerificationInterval are used when retrying target group grants,
+	// see TargetGroupGranterConfig.
+	GrantVerificationTimeout  time.Duration `env:"COMMONFATE_GRANT_VERIFICATION_TIMEOUT,default=0"`
+	GrantVerificationInterval time.Duration `env:"COMMONFATE_GRANT_VERIFICATION_INTERVAL,default=5s"`
+}
+
+type GrantReconcilerConfig struct {
+	TableName        string `env:"COMMONFATE_TABLE_NAME,required"`
+	LogLevel         string `env:"LOG_LEVEL,default=info"`
+	EventBusArn      string `env:"COMMONFATE_EVENT_BUS_ARN,required"`
+	Region           string `env:"AWS_REGION,required"`
+	AccessHandlerURL string `env:"COMMONFATE_ACCESS_HANDLER_URL,default=http://0.0.0.0:9092"`
+}
+
 type FrontendDeployerConfig struct {
 	LogLevel                             string `env:"LOG_LEVEL,default=info"`
 	Region                               string `env:"AWS_REGION,required"`
@@ -97,4 +160,10 @@ type TargetGroupGranterConfig struct {
 	EventBusArn    string `env:"COMMONFATE_EVENT_BUS_ARN"`
 	EventBusSource string `env:"COMMONFATE_EVENT_BUS_SOURCE"`
 	DynamoTable    string `env:"COMMONFATE_TABLE_NAME,required"`
+	// GrantVerificationTimeout is how long to wait for activated access to become visible in the provider
+	// before the grant is failed. Set to 0 to disable verification.
+	// Grants are only verified for handlers which advertise that they can check grants in their describe response.
+	GrantVerificationTimeout time.Duration `env:"COMMONFATE_GRANT_VERIFICATION_TIMEOUT,default=0"`
+	// GrantVerificationInterval is how often the provider is checked while verifying a grant.
+	GrantVerificationInterval time.Duration `env:"COMMONFATE_GRANT_VERIFICATION_INTERVAL,default=5s"`
 }
@@ -64,6 +64,14 @@ func (c Config) CDKContextArgs() []string {
 	if c.Deployment.Parameters.IdentityGroupFilter != "" {
 		args = append(args, "-c", fmt.Sprintf("identityGroupFilter=%s", string(c.Deployment.Parameters.IdentityGroupFilter)))
 	}
+	if len(c.Deployment.Parameters.IdentitySources) > 0 {
+		cfg, err := json.Marshal(c.Deployment.Parameters.IdentitySources)
+		if err != nil {
+			panic(err)
+		}
+
+		args = append(args, "-c", fmt.Sprintf("identitySources=%s", string(cfg)))
+	}
 	if c.Deployment.Parameters.CloudfrontWAFACLARN != "" {
 		args = append(args, "-c", fmt.Sprintf("cloudfrontWafAclArn=%s", string(c.Deployment.Parameters.CloudfrontWAFACLARN)))
 	}
@@ -104,6 +112,24 @@ func (c Config) CDKContextArgs() []string {
 	if c.Deployment.Parameters.AutoApprovalLambdaARN != "" {
 		args = append(args, "-c", fmt.Sprintf("autoApprovalLambdaARN=%s", string(c.Deployment.Parameters.AutoApprovalLambdaARN)))
 	}
+	if c.Deployment.Parameters.AutoApprovalPolicies != "" {
+		args = append(args, "-c", fmt.Sprintf("autoApprovalPolicies=%s", c.Deployment.Parameters.AutoApprovalPolicies))
+	}
+	if c.Deployment.Parameters.AutoApprovalWebhookURL != "" {
+		args = append(args, "-c", fmt.Sprintf("autoApprovalWebhookURL=%s", c.Deployment.Parameters.AutoApprovalWebhookURL))
+	}
+	if c.Deployment.Parameters.AutoApprovalWebhookSecret != "" {
+		args = append(args, "-c", fmt.Sprintf("autoApprovalWebhookSecret=%s", c.Deployment.Parameters.AutoApprovalWebhookSecret))
+	}
+	if c.Deployment.Parameters.RequestReminderInterval != "" {
+		args = append(args, "-c", fmt.Sprintf("requestReminderInterval=%s", c.Deployment.Parameters.RequestReminderInterval))
+	}
+	if c.Deployment.Parameters.RecurringRequestLeadTime != "" {
+		args = append(args, "-c", fmt.Sprintf("recurringRequestLeadTime=%s", c.Deployment.Parameters.RecurringRequestLeadTime))
+	}
+	if c.Deployment.Parameters.GrantVerificationTimeout != "" {
+		args = append(args, "-c", fmt.Sprintf("grantVerificationTimeout=%s", c.Deployment.Parameters.GrantVerificationTimeout))
+	}
 
 	// CDK deploys always use the dev analytics endpoint and debug mode
 	args = append(args, "-c", "analyticsUrl=https://t-dev.commonfate.io")
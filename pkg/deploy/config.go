@@ -185,13 +185,48 @@ type Parameters struct {
 	AnalyticsLogLevel               string         `yaml:"AnalyticsLogLevel,omitempty"`
 	AnalyticsDeploymentStage        string         `yaml:"AnalyticsDeploymentStage,omitempty"`
 	IdentityGroupFilter             string         `yaml:"IdentityGroupFilter,omitempty"`
-	EnableCronHealthCheckInDev      string         `yaml:"EnableCronHealthCheckInDev,omitempty"`
-	IDPSyncTimeoutSeconds           string         `yaml:"IDPSyncTimeoutSeconds,omitempty"`
-	IDPSyncSchedule                 string         `yaml:"IDPSyncSchedule,omitempty"`
-	IDPSyncMemory                   string         `yaml:"IDPSyncMemory,omitempty"`
-	AutoApprovalLambdaARN           string         `yaml:"AutoApprovalLambdaARN,omitempty"`
-	LambdaSubnetIds                 []string       `yaml:"LambdaSubnetIds,omitempty"`
-	LambdaSecurityGroups            []string       `yaml:"LambdaSecurityGroups,omitempty"`
+	// IdentitySources are synced in place of IdentityProviderType and IdentityGroupFilter if provided.
+	// Sources earlier in the list take precedence when a user exists in more than one identity provider.
+	IdentitySources            []IdentitySource `yaml:"IdentitySources,omitempty"`
+	EnableCronHealthCheckInDev string           `yaml:"EnableCronHealthCheckInDev,omitempty"`
+	IDPSyncTimeoutSeconds      string           `yaml:"IDPSyncTimeoutSeconds,omitempty"`
+	IDPSyncSchedule            string           `yaml:"IDPSyncSchedule,omitempty"`
+	IDPSyncMemory              string           `yaml:"IDPSyncMemory,omitempty"`
+	AutoApprovalLambdaARN      string           `yaml:"AutoApprovalLambdaARN,omitempty"`
+	AutoApprovalPolicies       string           `yaml:"AutoApprovalPolicies,omitempty"`
+	AutoApprovalWebhookURL     string           `yaml:"AutoApprovalWebhookURL,omitempty"`
+	// AutoApprovalWebhookSecret is an 'awsssm://' reference to the secret used to sign auto-approval webhook requests.
+	// It is set by 'gdeploy auto-approval webhook configure', which stores the secret in SSM Parameter Store.
+	AutoApprovalWebhookSecret string   `yaml:"AutoApprovalWebhookSecret,omitempty"`
+	RequestReminderInterval   string   `yaml:"RequestReminderInterval,omitempty"`
+	RecurringRequestLeadTime  string   `yaml:"RecurringRequestLeadTime,omitempty"`
+	GrantVerificationTimeout  string   `yaml:"GrantVerificationTimeout,omitempty"`
+	LambdaSubnetIds           []string `yaml:"LambdaSubnetIds,omitempty"`
+	LambdaSecurityGroups      []string `yaml:"LambdaSecurityGroups,omitempty"`
+}
+
+// IdentitySource is an identity provider which users and groups are synced from.
+// The configuration for the identity provider is read from IdentityConfiguration using its Type.
+type IdentitySource struct {
+	Type        string `yaml:"Type" json:"type"`
+	GroupFilter string `yaml:"GroupFilter,omitempty" json:"groupFilter,omitempty"`
+}
+
+// UnmarshalIdentitySources parses the JSON configuration data and returns
+// the identity sources. If `data` is an empty string, nil is returned.
+//
+// Unlike UnmarshalFeatureMap, backslashes aren't removed from the data,
+// as they are part of the regular expressions used to filter groups.
+func UnmarshalIdentitySources(data string) ([]IdentitySource, error) {
+	if data == "" {
+		return nil, nil
+	}
+	var i []IdentitySource
+	err := json.Unmarshal([]byte(data), &i)
+	if err != nil {
+		return nil, err
+	}
+	return i, nil
 }
 
 // UnmarshalFeatureMap parses the JSON configuration data and returns
@@ -465,6 +500,17 @@ func (c *Config) CfnParams() ([]types.Parameter, error) {
 		})
 	}
 
+	if len(p.IdentitySources) > 0 {
+		b, err := json.Marshal(p.IdentitySources)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("IdentitySources"),
+			ParameterValue: aws.String(string(b)),
+		})
+	}
+
 	if c.Deployment.Parameters.FrontendCertificateARN != "" {
 		res = append(res, types.Parameter{
 			ParameterKey:   aws.String("FrontendCertificateARN"),
@@ -565,6 +611,42 @@ func (c *Config) CfnParams() ([]types.Parameter, error) {
 			ParameterValue: aws.String(p.AutoApprovalLambdaARN),
 		})
 	}
+	if len(c.Deployment.Parameters.AutoApprovalPolicies) != 0 {
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("AutoApprovalPolicies"),
+			ParameterValue: aws.String(p.AutoApprovalPolicies),
+		})
+	}
+	if len(c.Deployment.Parameters.AutoApprovalWebhookURL) != 0 {
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("AutoApprovalWebhookURL"),
+			ParameterValue: aws.String(p.AutoApprovalWebhookURL),
+		})
+	}
+	if len(c.Deployment.Parameters.AutoApprovalWebhookSecret) != 0 {
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("AutoApprovalWebhookSecret"),
+			ParameterValue: aws.String(p.AutoApprovalWebhookSecret),
+		})
+	}
+	if len(c.Deployment.Parameters.RequestReminderInterval) != 0 {
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("RequestReminderInterval"),
+			ParameterValue: aws.String(p.RequestReminderInterval),
+		})
+	}
+	if len(c.Deployment.Parameters.RecurringRequestLeadTime) != 0 {
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("RecurringRequestLeadTime"),
+			ParameterValue: aws.String(p.RecurringRequestLeadTime),
+		})
+	}
+	if len(c.Deployment.Parameters.GrantVerificationTimeout) != 0 {
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("GrantVerificationTimeout"),
+			ParameterValue: aws.String(p.GrantVerificationTimeout),
+		})
+	}
 	return res, nil
 }
 
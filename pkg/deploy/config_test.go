@@ -230,6 +230,18 @@ func TestFeatureMap(t *testing.T) {
 	assert.Equal(t, FeatureMap{"test": map[string]string{}, "test2": map[string]string{}}, p.IdentityConfiguration)
 }
 
+func TestUnmarshalIdentitySources(t *testing.T) {
+	sources := []IdentitySource{{Type: "okta", GroupFilter: `^eng\.team-\d+$`}, {Type: "azure"}}
+	// the sources are passed to the stack as JSON, which escapes the backslashes in the group filter.
+	data, err := json.Marshal(sources)
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"type":"okta","groupFilter":"^eng\\.team-\\d+$"},{"type":"azure"}]`, string(data))
+
+	got, err := UnmarshalIdentitySources(string(data))
+	assert.NoError(t, err)
+	assert.Equal(t, sources, got)
+}
+
 func TestGetIDForNewProvider(t *testing.T) {
 	type testcase struct {
 		name   string
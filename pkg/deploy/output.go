@@ -53,6 +53,9 @@ type Output struct {
 	CLIAppClientID                string `json:"CLIAppClientID"`
 	HealthcheckFunctionName       string `json:"HealthcheckFunctionName"`
 	HealthcheckLogGroupName       string `json:"HealthcheckLogGroupName"`
+	RequestSweeperLogGroupName    string `json:"RequestSweeperLogGroupName"`
+	GrantReconcilerLogGroupName   string `json:"GrantReconcilerLogGroupName"`
+	GrantRetrierLogGroupName      string `json:"GrantRetrierLogGroupName"`
 	GranterV2StateMachineArn      string `json:"GranterV2StateMachineArn"`
 }
 
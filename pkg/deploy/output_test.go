@@ -71,6 +71,9 @@ func TestOutputStructMatchesTSType(t *testing.T) {
 		CLIAppClientID:                "abcdefg",
 		HealthcheckFunctionName:       "abcdefg",
 		HealthcheckLogGroupName:       "abcdefg",
+		RequestSweeperLogGroupName:    "abcdefg",
+		GrantReconcilerLogGroupName:   "abcdefg",
+		GrantRetrierLogGroupName:      "abcdefg",
 		GranterV2StateMachineArn:      "abcdefg",
 	}
 	b, err := json.Marshal(output)
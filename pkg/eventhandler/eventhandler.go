@@ -3,12 +3,16 @@ package eventhandler
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-lambda-go/events"
+	"github.com/benbjohnson/clock"
 	"github.com/common-fate/common-fate/pkg/access"
 	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/service/sessionactivitysvc"
 	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
 	"github.com/common-fate/ddb"
@@ -17,63 +21,136 @@ import (
 
 // EventHandler provides handler methods for updating items in Db in response to external events such as from teh access handler
 type EventHandler struct {
-	db ddb.Storage
+	db              ddb.Storage
+	sessionActivity *sessionactivitysvc.Service
+	bulkRevoke      BulkRevoker
 }
 
-func New(ctx context.Context, db ddb.Storage) (*EventHandler, error) {
-	return &EventHandler{db: db}, nil
+// BulkRevoker revokes the grants which were queued by a bulk revocation.
+type BulkRevoker interface {
+	Process(ctx context.Context, bulkRevocationID string) error
+}
+
+// WithBulkRevoker handles bulk revocation events with b. Bulk revocation events are ignored if it isn't set.
+func WithBulkRevoker(b BulkRevoker) func(*EventHandler) {
+	return func(n *EventHandler) {
+		n.bulkRevoke = b
+	}
+}
+
+func New(ctx context.Context, db ddb.Storage, opts ...func(*EventHandler)) (*EventHandler, error) {
+	n := &EventHandler{
+		db:              db,
+		sessionActivity: &sessionactivitysvc.Service{Clock: clock.New(), DB: db},
+	}
+	for _, opt := range opts {
+		opt(n)
+	}
+	return n, nil
 }
 
 func (n *EventHandler) HandleEvent(ctx context.Context, event events.CloudWatchEvent) (err error) {
 	log := zap.S().With("event", event)
 	log.Info("received event from eventbridge")
-	if strings.HasPrefix(event.DetailType, "grant") {
+	if event.DetailType == gevent.GrantDriftType {
+		err = n.HandleGrantDriftEvent(ctx, log, event)
+		if err != nil {
+			return err
+		}
+	} else if strings.HasPrefix(event.DetailType, "grant") {
 		err = n.HandleGrantEvent(ctx, log, event)
 		if err != nil {
 			return err
 		}
+	} else if event.DetailType == gevent.SessionActivityRecordedType {
+		err = n.HandleSessionActivityEvent(ctx, log, event)
+		if err != nil {
+			return err
+		}
+	} else if event.DetailType == gevent.BulkRevokeRequestedType {
+		err = n.HandleBulkRevokeEvent(ctx, log, event)
+		if err != nil {
+			return err
+		}
 	} else {
 		log.Info("ignoring unhandled event type")
 	}
 	return nil
 }
 
-// HandleGrantEvent will update the status of a grant in response to events emitted by the access handler
+// maxGrantEventAttempts is how many times a grant event is applied when the grant is updated concurrently,
+// before the event is returned as an error to be retried later.
+const maxGrantEventAttempts = 3
+
+// HandleGrantEvent will update the status of a grant in response to events emitted by the grant workflows.
+//
+// Grant events are delivered at least once and may arrive out of order. Events with an idempotency key
+// which has already been processed are ignored, as are events which would move the grant to an earlier status.
+//
+// The grant is only updated if it hasn't changed since it was read, so that concurrent events for the same grant
+// can't move it to an earlier status. If it has changed, the event is applied again to the updated grant.
 func (n *EventHandler) HandleGrantEvent(ctx context.Context, log *zap.SugaredLogger, event events.CloudWatchEvent) error {
 	var grantEvent gevent.GrantEventPayload
 	err := json.Unmarshal(event.Detail, &grantEvent)
 	if err != nil {
 		return err
 	}
+	if event.DetailType == gevent.GrantRevokedType {
+		log.Infow("Ignored grant revoke event")
+		return nil
+	}
+	for attempt := 1; ; attempt++ {
+		err = n.applyGrantEvent(ctx, log, event, grantEvent)
+		if err != dbupdate.ErrConditionFailed || attempt == maxGrantEventAttempts {
+			return err
+		}
+		log.Infow("grant was updated while handling grant event, retrying", "attempt", attempt)
+	}
+}
+
+// applyGrantEvent applies a grant event to the request as it is currently saved.
+// dbupdate.ErrConditionFailed is returned if the grant was updated before the changes could be saved.
+func (n *EventHandler) applyGrantEvent(ctx context.Context, log *zap.SugaredLogger, event events.CloudWatchEvent, grantEvent gevent.GrantEventPayload) error {
 	gq := storage.GetRequest{ID: grantEvent.Grant.ID}
-	_, err = n.db.Query(ctx, &gq)
+	_, err := n.db.Query(ctx, &gq)
 	if err != nil {
 		return err
 	}
-	// This would indicate a race condition or a major error
+	// The request is saved after the grant workflow is started, so the grant may not have been saved yet.
+	// Returning an error causes the event to be retried.
 	if gq.Result.Grant == nil {
 		return fmt.Errorf("request: %s does not have a grant", grantEvent.Grant.ID)
 	}
-	if event.DetailType == gevent.GrantRevokedType {
-		log.Infow("Ignored grant revoke event")
-		return nil
+
+	var processed *access.ProcessedGrantEvent
+	if grantEvent.IdempotencyKey != "" {
+		p := access.NewProcessedGrantEvent(grantEvent.IdempotencyKey, gq.Result.ID, event.DetailType, time.Now())
+		processed = &p
 	}
-	oldStatus := gq.Result.Grant.Status
-	newStatus := grantEvent.Grant.Status
-	gq.Result.Grant.Status = newStatus
-	gq.Result.Grant.UpdatedAt = event.Time
-	// I anticipate that this would be succeptible to a race condition, recoverable if the eventbridge retries the event handler
-	// this is because the grant events are sourced from the access handler prior to the request being saved to dynamodb on creation
-	// we could solve this by saving the request to the DB prior to making the call to the access handler?
+
 	if event.DetailType == gevent.GrantCreatedType {
 		requestEvent := access.NewGrantCreatedEvent(gq.Result.ID, event.Time)
 		log.Infow("inserting request event for grant created")
-		return n.db.Put(ctx, &requestEvent)
+		return n.putOnce(ctx, log, processed, func() error {
+			return n.db.Put(ctx, &requestEvent)
+		})
 	}
-	var requestEvent access.RequestEvent
 
+	read := *gq.Result.Grant
+	oldStatus := read.Status
+	newStatus := grantEvent.Grant.Status
+	if !access.CanTransitionGrantStatus(oldStatus, newStatus) {
+		log.Infow("ignoring grant event which would not move the grant forward", "from", oldStatus, "to", newStatus)
+		return nil
+	}
+	// copy the grant so that the grant which was read isn't modified
+	grant := read
+	grant.Status = newStatus
+	grant.UpdatedAt = event.Time
+	gq.Result.Grant = &grant
+
+	var requestEvent access.RequestEvent
 	if event.DetailType == gevent.GrantFailedType {
-		// Grant revoked events have an actor which should be included in the audit trail
 		var grantFailedEvent gevent.GrantFailed
 		err := json.Unmarshal(event.Detail, &grantFailedEvent)
 		if err != nil {
@@ -83,14 +160,118 @@ func (n *EventHandler) HandleGrantEvent(ctx context.Context, log *zap.SugaredLog
 		log.Infow("inserting request event for grant failed")
 
 	} else {
+		if event.DetailType == gevent.GrantActivatedType {
+			// record which route provisioned the grant, as it may not be the highest priority route if a handler failed.
+			var grantActivatedEvent gevent.GrantActivated
+			err := json.Unmarshal(event.Detail, &grantActivatedEvent)
+			if err != nil {
+				return err
+			}
+			gq.Result.Grant.Route = grantActivatedEvent.Route
+		}
 		requestEvent = access.NewGrantStatusChangeEvent(gq.Result.ID, event.Time, nil, oldStatus, newStatus)
 		log.Infow("inserting request event for grant status change")
 	}
-	items, err := dbupdate.GetUpdateRequestItems(ctx, n.db, *gq.Result)
+	items, err := dbupdate.GetUpdateReviewerItems(ctx, n.db, *gq.Result)
 	if err != nil {
 		return err
 	}
 	items = append(items, &requestEvent)
 	// Updates the grant status
-	return n.db.PutBatch(ctx, items...)
+	return n.putOnce(ctx, log, processed, func() error {
+		err := n.putRequestIfGrantUnchanged(ctx, *gq.Result, read)
+		if err != nil {
+			return err
+		}
+		return n.db.PutBatch(ctx, items...)
+	})
+}
+
+// putRequestIfGrantUnchanged saves a request, conditional on its grant having the status and updatedAt time which were read.
+func (n *EventHandler) putRequestIfGrantUnchanged(ctx context.Context, request access.Request, read access.Grant) error {
+	return dbupdate.PutIf(ctx, n.db, &request, dbupdate.Condition{
+		Expression: "#grant.#status = :grantStatus AND #grant.updatedAt = :grantUpdatedAt",
+		Names:      map[string]string{"#grant": "grant", "#status": "status"},
+		Values: map[string]any{
+			":grantStatus":    read.Status,
+			":grantUpdatedAt": read.UpdatedAt,
+		},
+	})
+}
+
+// putOnce saves the changes made by a grant event with put, unless the event has already been processed.
+//
+// The processed event is saved first with a conditional write, so that the changes aren't saved twice
+// when the same event is delivered concurrently. If the changes then fail to save, the processed event
+// is removed so that the event is applied when it is retried.
+func (n *EventHandler) putOnce(ctx context.Context, log *zap.SugaredLogger, processed *access.ProcessedGrantEvent, put func() error) error {
+	if processed == nil {
+		return put()
+	}
+	err := dbupdate.PutIf(ctx, n.db, processed, dbupdate.ItemNotExists)
+	if err == dbupdate.ErrConditionFailed {
+		log.Infow("ignoring grant event which has already been processed", "idempotencyKey", processed.IdempotencyKey)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	err = put()
+	if err != nil {
+		if deleteErr := n.db.Delete(ctx, processed); deleteErr != nil {
+			log.Errorw("failed to remove processed grant event, the event will be ignored if it is retried", "idempotencyKey", processed.IdempotencyKey, "error", deleteErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// HandleGrantDriftEvent records drift between a grant and its provider in the audit log of the related request.
+// Drift which isn't related to any request, such as access which was never requested through Common Fate, is ignored.
+func (n *EventHandler) HandleGrantDriftEvent(ctx context.Context, log *zap.SugaredLogger, event events.CloudWatchEvent) error {
+	var driftEvent gevent.GrantDrift
+	err := json.Unmarshal(event.Detail, &driftEvent)
+	if err != nil {
+		return err
+	}
+	if driftEvent.Drift.RequestID == nil {
+		log.Infow("ignoring grant drift event which isn't related to a request")
+		return nil
+	}
+	requestEvent := access.NewGrantDriftEvent(*driftEvent.Drift.RequestID, event.Time, driftEvent.Drift.Kind)
+	log.Infow("inserting request event for grant drift")
+	return n.db.Put(ctx, &requestEvent)
+}
+
+// HandleSessionActivityEvent saves session activity streamed by a provider or handler to the timeline of the requests it was performed for.
+//
+// Activity which is invalid is dropped rather than retried, as retrying the event wouldn't allow it to be saved.
+func (n *EventHandler) HandleSessionActivityEvent(ctx context.Context, log *zap.SugaredLogger, event events.CloudWatchEvent) error {
+	var activityEvent gevent.SessionActivityRecorded
+	err := json.Unmarshal(event.Detail, &activityEvent)
+	if err != nil {
+		return err
+	}
+	_, err = n.sessionActivity.Record(ctx, activityEvent.Activity)
+	var invalidErr sessionactivitysvc.InvalidActivityError
+	if err == sessionactivitysvc.ErrNoActivity || err == sessionactivitysvc.ErrTooManyActivity || err == sessionactivitysvc.ErrGrantNotFound || errors.As(err, &invalidErr) {
+		log.Warnw("dropping session activity which can't be recorded", "error", err)
+		return nil
+	}
+	return err
+}
+
+// HandleBulkRevokeEvent revokes the grants which were queued by a bulk revocation.
+// If revoking the grants is interrupted, the event is retried and the grants which haven't been attempted are revoked.
+func (n *EventHandler) HandleBulkRevokeEvent(ctx context.Context, log *zap.SugaredLogger, event events.CloudWatchEvent) error {
+	if n.bulkRevoke == nil {
+		log.Errorw("ignoring bulk revocation as the event handler isn't configured to revoke grants")
+		return nil
+	}
+	var bulkEvent gevent.BulkRevokeRequested
+	err := json.Unmarshal(event.Detail, &bulkEvent)
+	if err != nil {
+		return err
+	}
+	return n.bulkRevoke.Process(ctx, bulkEvent.ID)
 }
@@ -0,0 +1,161 @@
+package eventhandler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	ac_types "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// processedEventDB sends the conditional write of the processed grant event to a fake DynamoDB endpoint,
+// and records the other writes.
+type processedEventDB struct {
+	ddb.Storage
+	client      *dynamodb.Client
+	putBatchErr error
+	batches     [][]ddb.Keyer
+	deleted     []ddb.Keyer
+}
+
+func (d *processedEventDB) Client() *dynamodb.Client { return d.client }
+
+func (d *processedEventDB) PutBatch(ctx context.Context, items ...ddb.Keyer) error {
+	d.batches = append(d.batches, items)
+	return d.putBatchErr
+}
+
+func (d *processedEventDB) Delete(ctx context.Context, item ddb.Keyer) error {
+	d.deleted = append(d.deleted, item)
+	return nil
+}
+
+// newProcessedEventDB returns storage which fails the conditional write if alreadyProcessed is true.
+func newProcessedEventDB(t *testing.T, db ddb.Storage, alreadyProcessed bool) *processedEventDB {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		if alreadyProcessed {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"com.amazonaws.dynamodb.v20120810#ConditionalCheckFailedException","message":"The conditional request failed"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return &processedEventDB{
+		Storage: db,
+		client: dynamodb.New(dynamodb.Options{
+			Region:       "us-east-1",
+			BaseEndpoint: aws.String(server.URL),
+			Credentials:  credentials.NewStaticCredentialsProvider("test", "test", ""),
+		}),
+	}
+}
+
+func TestHandleGrantEvent(t *testing.T) {
+	type testcase struct {
+		name             string
+		alreadyProcessed bool
+		putBatchErr      error
+		grantStatus      ac_types.GrantStatus
+		wantErr          error
+		wantBatches      int
+		wantDeleted      bool
+	}
+
+	putErr := errors.New("failed to write")
+
+	testcases := []testcase{
+		{
+			name:        "ok",
+			grantStatus: ac_types.GrantStatusACTIVE,
+			wantBatches: 1,
+		},
+		{
+			name:             "already processed",
+			alreadyProcessed: true,
+			grantStatus:      ac_types.GrantStatusACTIVE,
+		},
+		{
+			name:        "expired before activated",
+			grantStatus: ac_types.GrantStatusPENDING,
+			wantBatches: 1,
+		},
+		{
+			name:        "processed event is removed if the grant fails to save",
+			grantStatus: ac_types.GrantStatusACTIVE,
+			putBatchErr: putErr,
+			wantErr:     putErr,
+			wantBatches: 1,
+			wantDeleted: true,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			c := ddbmock.New(t)
+			c.MockQuery(&storage.GetRequest{Result: &access.Request{ID: "req_1", Grant: &access.Grant{Status: tc.grantStatus}}})
+			c.MockQuery(&storage.ListRequestReviewers{})
+			db := newProcessedEventDB(t, c, tc.alreadyProcessed)
+			db.putBatchErr = tc.putBatchErr
+
+			detail, err := json.Marshal(gevent.GrantExpired{
+				Grant:          ac_types.Grant{ID: "req_1", Subject: "a@example.com", Status: ac_types.GrantStatusEXPIRED},
+				IdempotencyKey: "req_1#grant.expired#DEACTIVATE",
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			n := EventHandler{db: db}
+			err = n.HandleGrantEvent(context.Background(), zap.S(), events.CloudWatchEvent{DetailType: gevent.GrantExpiredType, Detail: detail})
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tc.wantErr)
+			}
+			assert.Len(t, db.batches, tc.wantBatches)
+			if tc.wantDeleted {
+				assert.Len(t, db.deleted, 1)
+				assert.Equal(t, "req_1#grant.expired#DEACTIVATE", db.deleted[0].(*access.ProcessedGrantEvent).IdempotencyKey)
+			} else {
+				assert.Empty(t, db.deleted)
+			}
+		})
+	}
+}
+
+type bulkRevokerFunc func(ctx context.Context, bulkRevocationID string) error
+
+func (f bulkRevokerFunc) Process(ctx context.Context, bulkRevocationID string) error {
+	return f(ctx, bulkRevocationID)
+}
+
+func TestHandleBulkRevokeEvent(t *testing.T) {
+	var got string
+	n, err := New(context.Background(), ddbmock.New(t), WithBulkRevoker(bulkRevokerFunc(func(ctx context.Context, bulkRevocationID string) error {
+		got = bulkRevocationID
+		return nil
+	})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = n.HandleEvent(context.Background(), events.CloudWatchEvent{DetailType: gevent.BulkRevokeRequestedType, Detail: json.RawMessage(`{"id":"brv_1"}`)})
+	assert.NoError(t, err)
+	assert.Equal(t, "brv_1", got)
+}
@@ -0,0 +1,41 @@
+package gevent
+
+import "time"
+
+const (
+	BulkRevokeRequestedType = "bulk_revoke.requested"
+	BulkRevokeCompletedType = "bulk_revoke.completed"
+)
+
+// BulkRevokeRequested is emitted when an administrator queues every grant matching a filter to be revoked.
+// The grants are revoked by the event handler when it receives the event.
+type BulkRevokeRequested struct {
+	ID string `json:"id"`
+}
+
+func (BulkRevokeRequested) EventType() string {
+	return BulkRevokeRequestedType
+}
+
+// BulkRevokeCompleted is emitted when an administrator revokes
+// every grant matching a filter at once, such as during a security incident.
+//
+// It is emitted once every grant in the bulk revocation has been attempted, and lists each
+// request which was revoked or failed to be revoked. The bulk revocation is also saved as an access.BulkRevocation.
+type BulkRevokeCompleted struct {
+	ID string `json:"id"`
+	// the commonfate internal id of the actor who revoked the grants
+	Actor string `json:"actor"`
+	// the email address of the actor who revoked the grants
+	ActorEmail string `json:"actorEmail"`
+	Reason     string `json:"reason,omitempty"`
+	// Filter is the filter which the revoked grants matched.
+	Filter      map[string]string `json:"filter"`
+	Revoked     []string          `json:"revoked"`
+	Failed      []string          `json:"failed"`
+	CompletedAt time.Time         `json:"completedAt"`
+}
+
+func (BulkRevokeCompleted) EventType() string {
+	return BulkRevokeCompletedType
+}
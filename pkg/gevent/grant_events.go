@@ -1,6 +1,11 @@
 package gevent
 
-import "github.com/common-fate/common-fate/accesshandler/pkg/types"
+import (
+	"fmt"
+
+	"github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+)
 
 const (
 	GrantCreatedType   = "grant.created"
@@ -8,12 +13,14 @@ const (
 	GrantExpiredType   = "grant.expired"
 	GrantRevokedType   = "grant.revoked"
 	GrantFailedType    = "grant.failed"
+	GrantDriftType     = "grant.drift"
 )
 
 // GrantCreated is emitted when a new grant is
-// created by the Access Handler.
+// created, by the first step of the grant workflow.
 type GrantCreated struct {
-	Grant types.Grant `json:"grant"`
+	Grant          types.Grant `json:"grant"`
+	IdempotencyKey string      `json:"idempotencyKey,omitempty"`
 }
 
 func (GrantCreated) EventType() string {
@@ -26,6 +33,10 @@ func (GrantCreated) EventType() string {
 // resource was completed successfully.
 type GrantActivated struct {
 	Grant types.Grant `json:"grant"`
+	// Route is the target group route which provisioned the grant.
+	// It is only set for grants provisioned by a target group handler.
+	Route          *access.GrantRoute `json:"route,omitempty"`
+	IdempotencyKey string             `json:"idempotencyKey,omitempty"`
 }
 
 func (GrantActivated) EventType() string {
@@ -38,7 +49,8 @@ func (GrantActivated) EventType() string {
 // resource was removed successfully, at the
 // time that the grant was supposed to end.
 type GrantExpired struct {
-	Grant types.Grant `json:"grant"`
+	Grant          types.Grant `json:"grant"`
+	IdempotencyKey string      `json:"idempotencyKey,omitempty"`
 }
 
 func (GrantExpired) EventType() string {
@@ -57,6 +69,9 @@ func (GrantExpired) EventType() string {
 // in the provider directly (such as removing
 // the user from the Okta group which they were granted
 // access to), this event will not be emitted.
+// Instead, a GrantDrift event is emitted when the
+// grant is next reconciled. Grants made through
+// target groups aren't reconciled.
 type GrantRevoked struct {
 	Grant types.Grant `json:"grant"`
 	// the commonfate internal id of the actor who revoked the grant
@@ -75,16 +90,43 @@ func (GrantRevoked) EventType() string {
 type GrantFailed struct {
 	Grant types.Grant `json:"grant"`
 	// Reason contains details about why the grant failed.
-	Reason string `json:"reason"`
+	Reason         string `json:"reason"`
+	IdempotencyKey string `json:"idempotencyKey,omitempty"`
 }
 
 func (GrantFailed) EventType() string {
 	return GrantFailedType
 }
 
+// GrantDrift is emitted when reconciliation finds that
+// the access in a provider differs from the grants made
+// by Common Fate, such as when an assignment is removed
+// from the provider directly.
+//
+// Each drift is only emitted once, when it is first detected.
+type GrantDrift struct {
+	Drift access.Drift `json:"drift"`
+}
+
+func (GrantDrift) EventType() string {
+	return GrantDriftType
+}
+
 // GrantEventPayload is a payload which is common to
 // all Grant events. It is used to conveniently unmarshal
 // the Grant payloads in our event handler code.
 type GrantEventPayload struct {
 	Grant types.Grant `json:"grant"`
+	// IdempotencyKey identifies the change to the grant which the event is for.
+	// It is empty for events emitted by older versions of the Access Handler.
+	IdempotencyKey string `json:"idempotencyKey,omitempty"`
+}
+
+// GrantIdempotencyKey returns the idempotency key for a grant event.
+//
+// Grant events are delivered at least once, and the workflow steps which emit them
+// may be retried, so the key identifies the step of the workflow rather than the delivery.
+// The event handler ignores events with a key it has already processed.
+func GrantIdempotencyKey(grantID string, eventType string, action string) string {
+	return fmt.Sprintf("%s#%s#%s", grantID, eventType, action)
 }
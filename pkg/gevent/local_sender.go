@@ -0,0 +1,66 @@
+package gevent
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/logger"
+)
+
+const (
+	localRetryInterval = time.Second
+	localMaxAttempts   = 10
+)
+
+// EventHandler handles events delivered by a LocalSender, such as the Common Fate event handler.
+type EventHandler interface {
+	HandleEvent(ctx context.Context, event events.CloudWatchEvent) error
+}
+
+// LocalSender delivers events to an event handler in process rather than through EventBridge,
+// for running Common Fate locally and in tests.
+//
+// Like EventBridge, an event which fails to be handled is delivered again, up to 10 times.
+// Retries are scheduled on Clock, so when Clock is a mock clock they are only delivered when the clock is advanced.
+type LocalSender struct {
+	Clock   clock.Clock
+	Handler EventHandler
+}
+
+// Put delivers the event to the handler. Errors from the handler aren't returned, as the event is retried instead.
+func (s *LocalSender) Put(ctx context.Context, e EventTyper) error {
+	// return early if we don't have an event to send.
+	if e == nil {
+		return nil
+	}
+	d, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	event := events.CloudWatchEvent{
+		DetailType: e.EventType(),
+		Source:     "commonfate.io/granted",
+		Time:       s.Clock.Now(),
+		Detail:     d,
+	}
+	s.deliver(ctx, event, 1)
+	return nil
+}
+
+func (s *LocalSender) deliver(ctx context.Context, event events.CloudWatchEvent, attempt int) {
+	err := s.Handler.HandleEvent(ctx, event)
+	if err == nil {
+		return
+	}
+	log := logger.Get(ctx).With("event.type", event.DetailType, "attempt", attempt)
+	if attempt >= localMaxAttempts {
+		log.Errorw("dropping event which failed to be handled", "error", err)
+		return
+	}
+	log.Warnw("failed to handle event, retrying", "error", err)
+	// retries aren't made with the context of the caller, which may have been cancelled by then.
+	s.Clock.AfterFunc(localRetryInterval, func() { s.deliver(context.Background(), event, attempt+1) })
+}
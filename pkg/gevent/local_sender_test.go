@@ -0,0 +1,85 @@
+package gevent
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/benbjohnson/clock"
+	"github.com/stretchr/testify/assert"
+)
+
+// failingHandler fails the first attempts to handle an event, up to fail attempts.
+type failingHandler struct {
+	fail     int
+	attempts int
+	handled  []events.CloudWatchEvent
+}
+
+func (h *failingHandler) HandleEvent(ctx context.Context, event events.CloudWatchEvent) error {
+	h.attempts++
+	if h.attempts <= h.fail {
+		return errors.New("request not found")
+	}
+	h.handled = append(h.handled, event)
+	return nil
+}
+
+func TestLocalSender(t *testing.T) {
+	type testcase struct {
+		name string
+		fail int
+		// advance is how far the clock is moved after the event is sent.
+		advance      time.Duration
+		wantAttempts int
+		wantHandled  int
+	}
+
+	testcases := []testcase{
+		{
+			name:         "delivered straight away",
+			wantAttempts: 1,
+			wantHandled:  1,
+		},
+		{
+			name:         "retried when the clock is advanced",
+			fail:         2,
+			advance:      2 * time.Second,
+			wantAttempts: 3,
+			wantHandled:  1,
+		},
+		{
+			name:         "not retried until the clock is advanced",
+			fail:         1,
+			wantAttempts: 1,
+		},
+		{
+			name:         "dropped after the maximum attempts",
+			fail:         localMaxAttempts,
+			advance:      time.Minute,
+			wantAttempts: localMaxAttempts,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			clk := clock.NewMock()
+			h := &failingHandler{fail: tc.fail}
+			s := LocalSender{Clock: clk, Handler: h}
+
+			err := s.Put(context.Background(), testEvent{Data: "testing"})
+			assert.NoError(t, err)
+			clk.Add(tc.advance)
+
+			assert.Equal(t, tc.wantAttempts, h.attempts)
+			assert.Len(t, h.handled, tc.wantHandled)
+			for _, e := range h.handled {
+				assert.Equal(t, "event.test", e.DetailType)
+				assert.JSONEq(t, `{"data":"testing"}`, string(e.Detail))
+			}
+		})
+	}
+}
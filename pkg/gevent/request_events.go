@@ -7,6 +7,19 @@ const (
 	RequestApprovedType  = "request.approved"
 	RequestCancelledType = "request.cancelled"
 	RequestDeclinedType  = "request.declined"
+
+	RequestStageApprovedType = "request.stage_approved"
+
+	RequestExtensionRequestedType = "request.extension_requested"
+	RequestExtendedType           = "request.extended"
+
+	RequestBreakGlassType         = "request.break_glass"
+	RequestBreakGlassReviewedType = "request.break_glass_reviewed"
+
+	RequestCommentedType = "request.commented"
+
+	RequestReminderType = "request.reminder"
+	RequestExpiredType  = "request.expired"
 )
 
 // RequestCreated is emitted when a user requests access
@@ -32,6 +45,19 @@ func (RequestApproved) EventType() string {
 	return RequestApprovedType
 }
 
+// RequestStageApproved is emitted when a reviewer approves a
+// request which still requires further approvals before access is granted.
+type RequestStageApproved struct {
+	Request       access.Request       `json:"request"`
+	ReviewerID    string               `json:"reviewerId"`
+	ReviewerEmail string               `json:"reviewerEmail"`
+	Stage         access.ApprovalStage `json:"stage"`
+}
+
+func (RequestStageApproved) EventType() string {
+	return RequestStageApprovedType
+}
+
 type RequestCancelled struct {
 	Request access.Request `json:"request"`
 }
@@ -50,6 +76,88 @@ func (RequestDeclined) EventType() string {
 	return RequestDeclinedType
 }
 
+// RequestExtensionRequested is emitted when a user asks to
+// extend an active grant and the extension requires approval.
+type RequestExtensionRequested struct {
+	Request        access.Request `json:"request"`
+	RequestorEmail string         `json:"requestorEmail"`
+}
+
+func (RequestExtensionRequested) EventType() string {
+	return RequestExtensionRequestedType
+}
+
+// RequestExtended is emitted when the grant for a
+// request has been extended.
+type RequestExtended struct {
+	Request access.Request `json:"request"`
+	// ReviewerID is the ID of the reviewer who approved the extension,
+	// it is empty if the extension did not require approval.
+	ReviewerID    string `json:"reviewerId"`
+	ReviewerEmail string `json:"reviewerEmail"`
+}
+
+func (RequestExtended) EventType() string {
+	return RequestExtendedType
+}
+
+// RequestBreakGlass is emitted when a user skips approval
+// by requesting break-glass access during an emergency.
+type RequestBreakGlass struct {
+	Request        access.Request `json:"request"`
+	RequestorEmail string         `json:"requestorEmail"`
+}
+
+func (RequestBreakGlass) EventType() string {
+	return RequestBreakGlassType
+}
+
+// RequestBreakGlassReviewed is emitted when an approver
+// reviews a break-glass request after access was granted.
+type RequestBreakGlassReviewed struct {
+	Request       access.Request  `json:"request"`
+	ReviewerID    string          `json:"reviewerId"`
+	ReviewerEmail string          `json:"reviewerEmail"`
+	Decision      access.Decision `json:"decision"`
+}
+
+func (RequestBreakGlassReviewed) EventType() string {
+	return RequestBreakGlassReviewedType
+}
+
+// RequestCommented is emitted when a user comments on a request.
+type RequestCommented struct {
+	Request     access.Request `json:"request"`
+	Comment     access.Comment `json:"comment"`
+	AuthorEmail string         `json:"authorEmail"`
+}
+
+func (RequestCommented) EventType() string {
+	return RequestCommentedType
+}
+
+// RequestReminder is emitted when the reviewers of a
+// pending request are reminded to review it.
+type RequestReminder struct {
+	Request access.Request `json:"request"`
+	// ReviewerIDs are the IDs of the reviewers who haven't yet approved the request.
+	ReviewerIDs []string `json:"reviewerIds"`
+}
+
+func (RequestReminder) EventType() string {
+	return RequestReminderType
+}
+
+// RequestExpired is emitted when a pending request is closed
+// because it wasn't reviewed before the Access Rule's expiry.
+type RequestExpired struct {
+	Request access.Request `json:"request"`
+}
+
+func (RequestExpired) EventType() string {
+	return RequestExpiredType
+}
+
 // RequestEventPayload is a payload which is common to
 // all Request events. It is used to conveniently unmarshal
 // the Request payloads in our event handler code.
@@ -0,0 +1,21 @@
+package gevent
+
+import "github.com/common-fate/common-fate/pkg/access"
+
+const (
+	SessionActivityRecordedType = "session.activity_recorded"
+)
+
+// SessionActivityRecorded is emitted by providers and handlers
+// which record what users do while using their grants, such as
+// the commands run in a shell session.
+//
+// The activity is saved by the event handler and shown on the timeline
+// of the request which each grant belongs to.
+type SessionActivityRecorded struct {
+	Activity []access.SessionActivity `json:"activity"`
+}
+
+func (SessionActivityRecorded) EventType() string {
+	return SessionActivityRecordedType
+}
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/common-fate/provider-registry-sdk-go/pkg/handlerclient"
+	"github.com/common-fate/provider-registry-sdk-go/pkg/msg"
+)
+
+// checkGrant asks a handler whether a grant's access exists.
+//
+// The check message isn't part of the provider registry protocol, which only defines grant, revoke, describe and load.
+// It is only sent to handlers which advertise that they support it in their describe response, see SupportsCheckGrant.
+type checkGrant struct {
+	Subject string            `json:"subject"`
+	Target  msg.Target        `json:"target"`
+	Request msg.AccessRequest `json:"request"`
+}
+
+func (checkGrant) Type() msg.RequestType { return "check" }
+
+type checkGrantResponse struct {
+	Active bool `json:"active"`
+}
+
+// describeCapabilities are the optional capabilities which a handler advertises in its describe response.
+// They aren't part of the provider registry schema, so handlers which don't advertise them support none of them.
+type describeCapabilities struct {
+	Capabilities struct {
+		CheckGrant bool `json:"checkGrant"`
+	} `json:"capabilities"`
+}
+
+// SupportsCheckGrant describes the handler to find out whether it can check grants with CheckGrant.
+func SupportsCheckGrant(ctx context.Context, runtime *handlerclient.Client) (bool, error) {
+	res, err := runtime.Executor.Execute(ctx, msg.Describe{})
+	if err != nil {
+		return false, err
+	}
+	var out describeCapabilities
+	err = json.Unmarshal(res.Response, &out)
+	if err != nil {
+		return false, err
+	}
+	return out.Capabilities.CheckGrant, nil
+}
+
+// CheckGrant asks the handler whether the access for a grant exists.
+// It must only be called for handlers which support it, see SupportsCheckGrant.
+func CheckGrant(ctx context.Context, runtime *handlerclient.Client, grant msg.Grant) (bool, error) {
+	res, err := runtime.Executor.Execute(ctx, checkGrant{
+		Subject: grant.Subject,
+		Target:  grant.Target,
+		Request: grant.Request,
+	})
+	if err != nil {
+		return false, err
+	}
+	var out checkGrantResponse
+	err = json.Unmarshal(res.Response, &out)
+	if err != nil {
+		return false, err
+	}
+	return out.Active, nil
+}
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/common-fate/provider-registry-sdk-go/pkg/handlerclient"
+	"github.com/common-fate/provider-registry-sdk-go/pkg/msg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSupportsCheckGrant(t *testing.T) {
+	type testcase struct {
+		name     string
+		describe string
+		want     bool
+	}
+
+	testcases := []testcase{
+		{
+			name:     "handler advertises check grant",
+			describe: `{"healthy":true,"capabilities":{"checkGrant":true}}`,
+			want:     true,
+		},
+		{
+			name:     "handler without capabilities",
+			describe: `{"healthy":true,"provider":{"publisher":"common-fate","name":"aws","version":"v0.1.0"}}`,
+			want:     false,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			runtime := &handlerclient.Client{Executor: handlerclient.MockExecutor{Result: &msg.Result{Response: []byte(tc.describe)}}}
+			got, err := SupportsCheckGrant(context.Background(), runtime)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
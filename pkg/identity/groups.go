@@ -10,6 +10,10 @@ import (
 
 const INTERNAL = "internal"
 
+// SCIM is the source of groups which are created by an identity provider using SCIM.
+// The pull-based identity sync doesn't modify these groups, as they're kept up to date by the identity provider.
+const SCIM = "scim"
+
 type IDPGroup struct {
 	ID          string
 	Name        string
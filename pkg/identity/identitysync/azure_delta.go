@@ -0,0 +1,224 @@
+package identitysync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+var _ DeltaIdentityProvider = &AzureSync{}
+
+// azureCursor holds the delta links returned by the Graph API for users and groups.
+//
+// https://learn.microsoft.com/en-us/graph/delta-query-overview
+type azureCursor struct {
+	Users  string `json:"users"`
+	Groups string `json:"groups"`
+}
+
+type azureDeltaResponse struct {
+	OdataNextLink  *string                  `json:"@odata.nextLink,omitempty"`
+	OdataDeltaLink *string                  `json:"@odata.deltaLink,omitempty"`
+	Value          []map[string]interface{} `json:"value"`
+}
+
+// userSelect returns the user properties to track changes for.
+// Changes to properties which aren't selected aren't returned by the delta query.
+func (a *AzureSync) userSelect() string {
+	props := []string{"id", "givenName", "surname", "userPrincipalName", "mail"}
+	if e := a.emailIdentifier.Get(); e != "" && !contains(props, e) {
+		props = append(props, e)
+	}
+	return strings.Join(props, ",")
+}
+
+const azureGroupSelect = "id,displayName,description,members"
+
+// graphGet makes a GET request to the Graph API and unmarshals the response into out.
+// ErrCursorExpired is returned if the Graph API requires the delta query to be restarted.
+func (a *AzureSync) graphGet(url string, out interface{}) error {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+a.token.Get())
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	// the Graph API returns 410 Gone if the delta token has expired and a full sync is required.
+	if res.StatusCode == http.StatusGone {
+		return ErrCursorExpired
+	}
+	//return the error if its anything but a 200
+	if res.StatusCode != 200 {
+		return fmt.Errorf(string(b))
+	}
+	return json.Unmarshal(b, out)
+}
+
+// listDelta follows the pages of a delta query, returning the changed objects and the delta link for the next query.
+func (a *AzureSync) listDelta(url string) ([]map[string]interface{}, string, error) {
+	var entries []map[string]interface{}
+	for {
+		var res azureDeltaResponse
+		err := a.graphGet(url, &res)
+		if err != nil {
+			return nil, "", err
+		}
+		entries = append(entries, res.Value...)
+		if res.OdataNextLink != nil {
+			url = *res.OdataNextLink
+			continue
+		}
+		if res.OdataDeltaLink == nil {
+			return nil, "", fmt.Errorf("graph API delta query returned no delta link")
+		}
+		return entries, *res.OdataDeltaLink, nil
+	}
+}
+
+// Cursor returns delta links for changes to users and groups made from now on.
+func (a *AzureSync) Cursor(ctx context.Context) (string, error) {
+	_, usersLink, err := a.listDelta(MSGraphBaseURL + "/users/delta?$select=" + a.userSelect() + "&$deltatoken=latest")
+	if err != nil {
+		return "", err
+	}
+	_, groupsLink, err := a.listDelta(MSGraphBaseURL + "/groups/delta?$select=" + azureGroupSelect + "&$deltatoken=latest")
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(azureCursor{Users: usersLink, Groups: groupsLink})
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// ListChanges lists the users and groups which changed using Graph API delta queries.
+//
+// Group memberships are the direct members of each group, whereas a full sync includes nested group memberships.
+// Changes to nested group memberships are picked up by the next full sync.
+func (a *AzureSync) ListChanges(ctx context.Context, cursor string) (*Delta, error) {
+	var c azureCursor
+	err := json.Unmarshal([]byte(cursor), &c)
+	if err != nil || c.Users == "" || c.Groups == "" {
+		return nil, ErrCursorExpired
+	}
+
+	userEntries, usersLink, err := a.listDelta(c.Users)
+	if err != nil {
+		return nil, err
+	}
+	groupEntries, groupsLink, err := a.listDelta(c.Groups)
+	if err != nil {
+		return nil, err
+	}
+
+	var delta Delta
+	changedUsers, deletedUsers := parseAzureUserDelta(userEntries)
+	delta.DeletedUsers = deletedUsers
+	// delta queries only return the properties which changed, so the full user is fetched.
+	for _, id := range changedUsers {
+		var u map[string]interface{}
+		err = a.graphGet(MSGraphBaseURL+"/users/"+id+"?$select="+a.userSelect(), &u)
+		if err != nil {
+			return nil, err
+		}
+		user, err := a.idpUserFromAzureUser(ctx, u, nil)
+		if err != nil {
+			return nil, err
+		}
+		delta.Users = append(delta.Users, user)
+	}
+
+	changedGroups, deletedGroups, memberships := parseAzureGroupDelta(groupEntries)
+	delta.DeletedGroups = deletedGroups
+	delta.Memberships = memberships
+	for _, id := range changedGroups {
+		var g AzureGroup
+		err = a.graphGet(MSGraphBaseURL+"/groups/"+id+"?$select=id,displayName,description", &g)
+		if err != nil {
+			return nil, err
+		}
+		delta.Groups = append(delta.Groups, idpGroupFromAzureGroup(g))
+	}
+
+	b, err := json.Marshal(azureCursor{Users: usersLink, Groups: groupsLink})
+	if err != nil {
+		return nil, err
+	}
+	delta.Cursor = string(b)
+	return &delta, nil
+}
+
+// parseAzureUserDelta returns the IDs of users which were changed and removed.
+// Users which are soft deleted are returned with an "@removed" property and are treated as removed.
+func parseAzureUserDelta(entries []map[string]interface{}) (changed []string, removed []string) {
+	for _, e := range entries {
+		id := safeMapGet(e, "id")
+		if _, ok := e["@removed"]; ok {
+			removed = append(removed, id)
+		} else {
+			changed = append(changed, id)
+		}
+	}
+	return changed, removed
+}
+
+// parseAzureGroupDelta returns the IDs of groups which had their properties changed or were removed, along with membership changes.
+//
+// A group which only had its members changed is returned as:
+//
+//	{
+//		"id": "2f0ed5d0-a09d-4bd9-9c5e-5b2b3f6a4b8e",
+//		"members@delta": [
+//			{ "@odata.type": "#microsoft.graph.user", "id": "4562bcc8-c436-4f95-b7c0-4f8ce89dca5e" },
+//			{ "@odata.type": "#microsoft.graph.user", "id": "87d349ed-44d7-43e1-9a83-5f2406dee5bd", "@removed": { "reason": "deleted" } }
+//		]
+//	}
+func parseAzureGroupDelta(entries []map[string]interface{}) (changed []string, removed []string, memberships []MembershipChange) {
+	for _, e := range entries {
+		id := safeMapGet(e, "id")
+		if _, ok := e["@removed"]; ok {
+			removed = append(removed, id)
+			continue
+		}
+		for k := range e {
+			if k != "id" && k != "members@delta" && !strings.HasPrefix(k, "@odata") {
+				changed = append(changed, id)
+				break
+			}
+		}
+
+		members, ok := e["members@delta"].([]interface{})
+		if !ok {
+			continue
+		}
+		m := MembershipChange{GroupID: id}
+		for _, member := range members {
+			mm, ok := member.(map[string]interface{})
+			// nested groups are included as members, but only users are synced.
+			if !ok || safeMapGet(mm, "@odata.type") != "#microsoft.graph.user" {
+				continue
+			}
+			if _, ok := mm["@removed"]; ok {
+				m.Removed = append(m.Removed, safeMapGet(mm, "id"))
+			} else {
+				m.Added = append(m.Added, safeMapGet(mm, "id"))
+			}
+		}
+		if len(m.Added) > 0 || len(m.Removed) > 0 {
+			memberships = append(memberships, m)
+		}
+	}
+	return changed, removed, memberships
+}
@@ -0,0 +1,57 @@
+package identitysync
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAzureGroupDelta(t *testing.T) {
+	// an example delta query response, from https://learn.microsoft.com/en-us/graph/api/group-delta
+	res := `[
+		{
+			"id": "grp_renamed",
+			"displayName": "Engineering",
+			"description": "Engineering team"
+		},
+		{
+			"id": "grp_members",
+			"members@delta": [
+				{ "@odata.type": "#microsoft.graph.user", "id": "user1" },
+				{ "@odata.type": "#microsoft.graph.user", "id": "user2", "@removed": { "reason": "deleted" } },
+				{ "@odata.type": "#microsoft.graph.group", "id": "grp_nested" }
+			]
+		},
+		{
+			"id": "grp_deleted",
+			"@removed": { "reason": "changed" }
+		}
+	]`
+	var entries []map[string]interface{}
+	err := json.Unmarshal([]byte(res), &entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	changed, removed, memberships := parseAzureGroupDelta(entries)
+	assert.Equal(t, []string{"grp_renamed"}, changed)
+	assert.Equal(t, []string{"grp_deleted"}, removed)
+	assert.Equal(t, []MembershipChange{{GroupID: "grp_members", Added: []string{"user1"}, Removed: []string{"user2"}}}, memberships)
+}
+
+func TestParseAzureUserDelta(t *testing.T) {
+	res := `[
+		{ "id": "user1", "givenName": "Josh" },
+		{ "id": "user2", "@removed": { "reason": "deleted" } }
+	]`
+	var entries []map[string]interface{}
+	err := json.Unmarshal([]byte(res), &entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	changed, removed := parseAzureUserDelta(entries)
+	assert.Equal(t, []string{"user1"}, changed)
+	assert.Equal(t, []string{"user2"}, removed)
+}
@@ -0,0 +1,286 @@
+package identitysync
+
+import (
+	"context"
+	"errors"
+	"regexp"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// ErrCursorExpired is returned by ListChanges if the identity provider no longer accepts the cursor.
+// The syncer falls back to a full sync when this happens.
+var ErrCursorExpired = errors.New("identity provider sync cursor has expired")
+
+// DeltaIdentityProvider is implemented by identity providers which can list only the users and groups
+// which changed since a previous sync, such as by using the Azure AD Graph delta queries.
+//
+// The syncer saves the cursor between runs, and still does a full sync periodically to pick up any
+// changes which the identity provider doesn't report.
+type DeltaIdentityProvider interface {
+	IdentityProvider
+	// Cursor returns a cursor for changes made after it is called.
+	// It is called before a full sync, so that changes made during the full sync are listed by the next sync.
+	// An empty cursor means that the identity provider can't list changes with its current configuration.
+	Cursor(ctx context.Context) (string, error)
+	// ListChanges returns the users and groups which changed since the cursor was returned.
+	ListChanges(ctx context.Context, cursor string) (*Delta, error)
+}
+
+// Delta is the set of changes made in an identity provider since a cursor was returned.
+type Delta struct {
+	// Users were created or updated. Group memberships are given by Memberships rather than IDPUser.Groups.
+	Users []identity.IDPUser
+	// DeletedUsers are the IdP IDs of users which were removed.
+	DeletedUsers []string
+	// Groups were created or updated.
+	Groups []identity.IDPGroup
+	// DeletedGroups are the IdP IDs of groups which were removed.
+	DeletedGroups []string
+	Memberships   []MembershipChange
+	// Cursor is passed to ListChanges on the next sync.
+	Cursor string
+}
+
+// MembershipChange is a change to the members of a group. Users are referred to by their IdP ID.
+type MembershipChange struct {
+	GroupID string
+	// Replace is true if Added contains every member of the group, rather than only the users which were added.
+	Replace bool
+	Added   []string
+	Removed []string
+}
+
+// applyDelta applies the changes from the identity provider to the users and groups in the database.
+// It returns only the users and groups which were modified, ready to be inserted to the database.
+//
+// Users and groups created with SCIM or internally aren't modified, matching the behaviour of processUsersAndGroups.
+// Groups which don't match the group filter are archived if they exist, and are otherwise ignored.
+// When a group filter is used, users who are no longer in any group are archived, and archived users who are added to a group are restored.
+func applyDelta(idpType string, delta Delta, internalUsers []identity.User, internalGroups []identity.Group, groupFilter *regexp.Regexp) ([]identity.User, []identity.Group) {
+	users := make(map[string]*identity.User)
+	usersByEmail := make(map[string]*identity.User)
+	usersByIdpID := make(map[string]*identity.User)
+	for i := range internalUsers {
+		u := internalUsers[i]
+		users[u.ID] = &u
+		usersByEmail[u.Email] = &u
+		if u.IdpID != "" {
+			usersByIdpID[u.IdpID] = &u
+		}
+	}
+	groups := make(map[string]*identity.Group)
+	groupsByIdpID := make(map[string]*identity.Group)
+	for i := range internalGroups {
+		g := internalGroups[i]
+		groups[g.ID] = &g
+		groupsByIdpID[g.IdpID] = &g
+	}
+
+	changedUsers := make(map[string]bool)
+	createdUsers := make(map[string]bool)
+	changedGroups := make(map[string]bool)
+
+	managed := func(g *identity.Group) bool {
+		return g.Source != identity.INTERNAL && g.Source != identity.SCIM
+	}
+
+	addMember := func(g *identity.Group, u *identity.User) {
+		if !u.BelongsToGroup(g.ID) {
+			u.AddGroup(g.ID)
+			changedUsers[u.ID] = true
+		}
+		if !contains(g.Users, u.ID) {
+			g.Users = append(g.Users, u.ID)
+			changedGroups[g.ID] = true
+		}
+	}
+	removeMember := func(g *identity.Group, u *identity.User) {
+		if u.BelongsToGroup(g.ID) {
+			u.RemoveGroup(g.ID)
+			changedUsers[u.ID] = true
+		}
+		if contains(g.Users, u.ID) {
+			g.Users = remove(g.Users, u.ID)
+			changedGroups[g.ID] = true
+		}
+	}
+	archiveGroup := func(g *identity.Group) {
+		for _, uid := range g.Users {
+			if u, ok := users[uid]; ok && u.BelongsToGroup(g.ID) {
+				u.RemoveGroup(g.ID)
+				changedUsers[u.ID] = true
+			}
+		}
+		g.Status = types.IdpStatusARCHIVED
+		g.Users = []string{}
+		changedGroups[g.ID] = true
+	}
+
+	// create/update users
+	for _, idpUser := range delta.Users {
+		existing, ok := usersByIdpID[idpUser.ID]
+		if !ok {
+			existing, ok = usersByEmail[idpUser.Email]
+		}
+		if !ok {
+			u := idpUser.ToInternalUser()
+			users[u.ID] = &u
+			usersByEmail[u.Email] = &u
+			usersByIdpID[u.IdpID] = &u
+			changedUsers[u.ID] = true
+			createdUsers[u.ID] = true
+			continue
+		}
+		if existing.Email != idpUser.Email {
+			if _, taken := usersByEmail[idpUser.Email]; taken {
+				// another user already has this email address, so leave the conflict for the next full sync to resolve.
+				continue
+			}
+			delete(usersByEmail, existing.Email)
+			existing.Email = idpUser.Email
+			usersByEmail[existing.Email] = existing
+		}
+		existing.IdpID = idpUser.ID
+		existing.FirstName = idpUser.FirstName
+		existing.LastName = idpUser.LastName
+		existing.Status = types.IdpStatusACTIVE
+		usersByIdpID[existing.IdpID] = existing
+		changedUsers[existing.ID] = true
+	}
+
+	// archive deleted users
+	for _, id := range delta.DeletedUsers {
+		u, ok := usersByIdpID[id]
+		if !ok || u.Source == identity.SCIM {
+			continue
+		}
+		for _, gid := range u.Groups {
+			if g, ok := groups[gid]; ok && contains(g.Users, u.ID) {
+				g.Users = remove(g.Users, u.ID)
+				changedGroups[g.ID] = true
+			}
+		}
+		u.Status = types.IdpStatusARCHIVED
+		u.Groups = []string{}
+		changedUsers[u.ID] = true
+	}
+
+	// create/update groups
+	for _, idpGroup := range delta.Groups {
+		existing, ok := groupsByIdpID[idpGroup.ID]
+		if ok && !managed(existing) {
+			continue
+		}
+		if groupFilter != nil && !groupFilter.MatchString(idpGroup.Name) {
+			if ok && existing.Status == types.IdpStatusACTIVE {
+				archiveGroup(existing)
+			}
+			continue
+		}
+		if !ok {
+			g := idpGroup.ToInternalGroup(idpType)
+			groups[g.ID] = &g
+			groupsByIdpID[g.IdpID] = &g
+			changedGroups[g.ID] = true
+			continue
+		}
+		existing.Name = idpGroup.Name
+		existing.Description = idpGroup.Description
+		existing.Status = types.IdpStatusACTIVE
+		existing.Source = idpType
+		changedGroups[existing.ID] = true
+	}
+
+	// archive deleted groups
+	for _, id := range delta.DeletedGroups {
+		if g, ok := groupsByIdpID[id]; ok && managed(g) {
+			archiveGroup(g)
+		}
+	}
+
+	// update group memberships
+	for _, m := range delta.Memberships {
+		g, ok := groupsByIdpID[m.GroupID]
+		if !ok || !managed(g) || g.Status != types.IdpStatusACTIVE {
+			continue
+		}
+		if m.Replace {
+			members := make(map[string]bool)
+			for _, id := range m.Added {
+				if u, ok := usersByIdpID[id]; ok {
+					members[u.ID] = true
+				}
+			}
+			for _, uid := range append([]string{}, g.Users...) {
+				if u, ok := users[uid]; ok && !members[uid] {
+					removeMember(g, u)
+				}
+			}
+		}
+		for _, id := range m.Added {
+			u, ok := usersByIdpID[id]
+			if !ok {
+				continue
+			}
+			// users are archived when they aren't in any group matching the filter, so they are restored when they are added to one.
+			if u.Status != types.IdpStatusACTIVE && groupFilter != nil && u.Source != identity.SCIM {
+				u.Status = types.IdpStatusACTIVE
+				changedUsers[u.ID] = true
+			}
+			if u.Status == types.IdpStatusACTIVE {
+				addMember(g, u)
+			}
+		}
+		for _, id := range m.Removed {
+			if u, ok := usersByIdpID[id]; ok {
+				removeMember(g, u)
+			}
+		}
+	}
+
+	// archive users who are no longer in any group, matching the behaviour of processUsersAndGroups when groups are used as a filter.
+	if groupFilter != nil {
+		for id := range changedUsers {
+			u := users[id]
+			if u.Source != identity.SCIM && u.Status == types.IdpStatusACTIVE && len(u.Groups) == 0 {
+				u.Status = types.IdpStatusARCHIVED
+				u.Groups = []string{}
+				// users who are archived as soon as they are created don't need to be saved.
+				if createdUsers[id] {
+					delete(changedUsers, id)
+				}
+			}
+		}
+	}
+
+	resultUsers := make([]identity.User, 0, len(changedUsers))
+	for id := range changedUsers {
+		resultUsers = append(resultUsers, *users[id])
+	}
+	resultGroups := make([]identity.Group, 0, len(changedGroups))
+	for id := range changedGroups {
+		resultGroups = append(resultGroups, *groups[id])
+	}
+	return resultUsers, resultGroups
+}
+
+func contains(s []string, e string) bool {
+	for _, a := range s {
+		if a == e {
+			return true
+		}
+	}
+	return false
+}
+
+func remove(s []string, e string) []string {
+	out := []string{}
+	for _, a := range s {
+		if a != e {
+			out = append(out, a)
+		}
+	}
+	return out
+}
@@ -0,0 +1,223 @@
+package identitysync
+
+import (
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyDelta(t *testing.T) {
+	type testcase struct {
+		name               string
+		giveDelta          Delta
+		giveInternalUsers  []identity.User
+		giveInternalGroups []identity.Group
+		giveFilter         *regexp.Regexp
+		wantUsers          []identity.User
+		wantGroups         []identity.Group
+	}
+
+	testcases := []testcase{
+		{
+			name: "no changes",
+			giveInternalUsers: []identity.User{
+				{ID: "usr_1", IdpID: "user1", Email: "josh@test.go", Groups: []string{"admins"}, Status: types.IdpStatusACTIVE},
+			},
+			giveInternalGroups: []identity.Group{
+				{ID: "admins", IdpID: "admins", Name: "admins", Users: []string{"usr_1"}, Status: types.IdpStatusACTIVE, Source: "azure"},
+			},
+			wantUsers:  []identity.User{},
+			wantGroups: []identity.Group{},
+		},
+		{
+			name: "user updated and added to group",
+			giveDelta: Delta{
+				Users:       []identity.IDPUser{{ID: "user1", FirstName: "Josh", LastName: "Wilkes", Email: "josh@test.go"}},
+				Memberships: []MembershipChange{{GroupID: "admins", Added: []string{"user1"}}},
+			},
+			giveInternalUsers: []identity.User{
+				{ID: "usr_1", IdpID: "user1", FirstName: "josh", Email: "josh@test.go", Status: types.IdpStatusACTIVE},
+				{ID: "usr_2", IdpID: "user2", FirstName: "chris", Email: "chris@test.go", Status: types.IdpStatusACTIVE},
+			},
+			giveInternalGroups: []identity.Group{
+				{ID: "admins", IdpID: "admins", Name: "admins", Status: types.IdpStatusACTIVE, Source: "azure"},
+			},
+			wantUsers: []identity.User{
+				{ID: "usr_1", IdpID: "user1", FirstName: "Josh", LastName: "Wilkes", Email: "josh@test.go", Groups: []string{"admins"}, Status: types.IdpStatusACTIVE},
+			},
+			wantGroups: []identity.Group{
+				{ID: "admins", IdpID: "admins", Name: "admins", Users: []string{"usr_1"}, Status: types.IdpStatusACTIVE, Source: "azure"},
+			},
+		},
+		{
+			name: "deleted user is archived and removed from groups",
+			giveDelta: Delta{
+				DeletedUsers: []string{"user1"},
+			},
+			giveInternalUsers: []identity.User{
+				{ID: "usr_1", IdpID: "user1", Email: "josh@test.go", Groups: []string{"admins"}, Status: types.IdpStatusACTIVE},
+				{ID: "usr_2", IdpID: "user2", Email: "chris@test.go", Groups: []string{"admins"}, Status: types.IdpStatusACTIVE},
+			},
+			giveInternalGroups: []identity.Group{
+				{ID: "admins", IdpID: "admins", Name: "admins", Users: []string{"usr_1", "usr_2"}, Status: types.IdpStatusACTIVE, Source: "azure"},
+			},
+			wantUsers: []identity.User{
+				{ID: "usr_1", IdpID: "user1", Email: "josh@test.go", Groups: []string{}, Status: types.IdpStatusARCHIVED},
+			},
+			wantGroups: []identity.Group{
+				{ID: "admins", IdpID: "admins", Name: "admins", Users: []string{"usr_2"}, Status: types.IdpStatusACTIVE, Source: "azure"},
+			},
+		},
+		{
+			name: "replacing members removes users who are no longer members",
+			giveDelta: Delta{
+				Memberships: []MembershipChange{{GroupID: "admins", Replace: true, Added: []string{"user2"}}},
+			},
+			giveInternalUsers: []identity.User{
+				{ID: "usr_1", IdpID: "user1", Email: "josh@test.go", Groups: []string{"admins"}, Status: types.IdpStatusACTIVE},
+				{ID: "usr_2", IdpID: "user2", Email: "chris@test.go", Status: types.IdpStatusACTIVE},
+			},
+			giveInternalGroups: []identity.Group{
+				{ID: "admins", IdpID: "admins", Name: "admins", Users: []string{"usr_1"}, Status: types.IdpStatusACTIVE, Source: "okta"},
+			},
+			wantUsers: []identity.User{
+				{ID: "usr_1", IdpID: "user1", Email: "josh@test.go", Status: types.IdpStatusACTIVE},
+				{ID: "usr_2", IdpID: "user2", Email: "chris@test.go", Groups: []string{"admins"}, Status: types.IdpStatusACTIVE},
+			},
+			wantGroups: []identity.Group{
+				{ID: "admins", IdpID: "admins", Name: "admins", Users: []string{"usr_2"}, Status: types.IdpStatusACTIVE, Source: "okta"},
+			},
+		},
+		{
+			name: "deleted group is archived and removed from users",
+			giveDelta: Delta{
+				DeletedGroups: []string{"admins"},
+			},
+			giveInternalUsers: []identity.User{
+				{ID: "usr_1", IdpID: "user1", Email: "josh@test.go", Groups: []string{"admins", "internal"}, Status: types.IdpStatusACTIVE},
+			},
+			giveInternalGroups: []identity.Group{
+				{ID: "admins", IdpID: "admins", Name: "admins", Users: []string{"usr_1"}, Status: types.IdpStatusACTIVE, Source: "azure"},
+				{ID: "internal", IdpID: "internal", Name: "internal", Users: []string{"usr_1"}, Status: types.IdpStatusACTIVE, Source: identity.INTERNAL},
+			},
+			wantUsers: []identity.User{
+				{ID: "usr_1", IdpID: "user1", Email: "josh@test.go", Groups: []string{"internal"}, Status: types.IdpStatusACTIVE},
+			},
+			wantGroups: []identity.Group{
+				{ID: "admins", IdpID: "admins", Name: "admins", Users: []string{}, Status: types.IdpStatusARCHIVED, Source: "azure"},
+			},
+		},
+		{
+			name: "SCIM and internal groups are not modified",
+			giveDelta: Delta{
+				Groups:        []identity.IDPGroup{{ID: "internal", Name: "renamed"}},
+				DeletedGroups: []string{"grp_scim"},
+				Memberships:   []MembershipChange{{GroupID: "grp_scim", Replace: true}},
+			},
+			giveInternalUsers: []identity.User{
+				{ID: "usr_1", IdpID: "user1", Email: "josh@test.go", Groups: []string{"grp_scim"}, Status: types.IdpStatusACTIVE},
+			},
+			giveInternalGroups: []identity.Group{
+				{ID: "internal", IdpID: "internal", Name: "internal", Status: types.IdpStatusACTIVE, Source: identity.INTERNAL},
+				{ID: "grp_scim", IdpID: "grp_scim", Name: "engineering", Users: []string{"usr_1"}, Status: types.IdpStatusACTIVE, Source: identity.SCIM},
+			},
+			wantUsers:  []identity.User{},
+			wantGroups: []identity.Group{},
+		},
+		{
+			name: "group which no longer matches the filter is archived",
+			giveDelta: Delta{
+				Groups: []identity.IDPGroup{{ID: "admins", Name: "accounting"}, {ID: "devops", Name: "devops"}},
+			},
+			giveInternalUsers: []identity.User{
+				{ID: "usr_1", IdpID: "user1", Email: "josh@test.go", Groups: []string{"admins"}, Status: types.IdpStatusACTIVE},
+			},
+			giveInternalGroups: []identity.Group{
+				{ID: "admins", IdpID: "admins", Name: "admins", Users: []string{"usr_1"}, Status: types.IdpStatusACTIVE, Source: "okta"},
+			},
+			giveFilter: regexp.MustCompile("admins|devops"),
+			wantUsers: []identity.User{
+				// the user is no longer in any group which matches the filter.
+				{ID: "usr_1", IdpID: "user1", Email: "josh@test.go", Groups: []string{}, Status: types.IdpStatusARCHIVED},
+			},
+			wantGroups: []identity.Group{
+				{ID: "admins", IdpID: "admins", Name: "admins", Users: []string{}, Status: types.IdpStatusARCHIVED, Source: "okta"},
+				{ID: "devops", IdpID: "devops", Name: "devops", Status: types.IdpStatusACTIVE, Source: "okta"},
+			},
+		},
+		{
+			name: "user removed from their last group is archived when filtering groups",
+			giveDelta: Delta{
+				Memberships: []MembershipChange{{GroupID: "admins", Removed: []string{"user1", "user2"}}},
+			},
+			giveInternalUsers: []identity.User{
+				{ID: "usr_1", IdpID: "user1", Email: "josh@test.go", Groups: []string{"admins"}, Status: types.IdpStatusACTIVE},
+				{ID: "usr_2", IdpID: "user2", Email: "chris@test.go", Groups: []string{"admins", "internal"}, Status: types.IdpStatusACTIVE},
+			},
+			giveInternalGroups: []identity.Group{
+				{ID: "admins", IdpID: "admins", Name: "admins", Users: []string{"usr_1", "usr_2"}, Status: types.IdpStatusACTIVE, Source: "okta"},
+				{ID: "internal", IdpID: "internal", Name: "internal", Users: []string{"usr_2"}, Status: types.IdpStatusACTIVE, Source: identity.INTERNAL},
+			},
+			giveFilter: regexp.MustCompile("admins"),
+			wantUsers: []identity.User{
+				{ID: "usr_1", IdpID: "user1", Email: "josh@test.go", Groups: []string{}, Status: types.IdpStatusARCHIVED},
+				// users in an internal group are kept.
+				{ID: "usr_2", IdpID: "user2", Email: "chris@test.go", Groups: []string{"internal"}, Status: types.IdpStatusACTIVE},
+			},
+			wantGroups: []identity.Group{
+				{ID: "admins", IdpID: "admins", Name: "admins", Users: []string{}, Status: types.IdpStatusACTIVE, Source: "okta"},
+			},
+		},
+		{
+			name: "new user who isn't in any group is archived when filtering groups",
+			giveDelta: Delta{
+				Users: []identity.IDPUser{{ID: "user1", Email: "josh@test.go"}},
+			},
+			giveFilter: regexp.MustCompile("admins"),
+			wantUsers:  []identity.User{},
+			wantGroups: []identity.Group{},
+		},
+		{
+			name: "archived user added to a group is restored when filtering groups",
+			giveDelta: Delta{
+				Memberships: []MembershipChange{{GroupID: "admins", Added: []string{"user1"}}},
+			},
+			giveInternalUsers: []identity.User{
+				{ID: "usr_1", IdpID: "user1", Email: "josh@test.go", Groups: []string{}, Status: types.IdpStatusARCHIVED},
+			},
+			giveInternalGroups: []identity.Group{
+				{ID: "admins", IdpID: "admins", Name: "admins", Users: []string{}, Status: types.IdpStatusACTIVE, Source: "okta"},
+			},
+			giveFilter: regexp.MustCompile("admins"),
+			wantUsers: []identity.User{
+				{ID: "usr_1", IdpID: "user1", Email: "josh@test.go", Groups: []string{"admins"}, Status: types.IdpStatusACTIVE},
+			},
+			wantGroups: []identity.Group{
+				{ID: "admins", IdpID: "admins", Name: "admins", Users: []string{"usr_1"}, Status: types.IdpStatusACTIVE, Source: "okta"},
+			},
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			gotUsers, gotGroups := applyDelta("okta", tc.giveDelta, tc.giveInternalUsers, tc.giveInternalGroups, tc.giveFilter)
+
+			sort.Slice(gotUsers, func(i, j int) bool { return gotUsers[i].ID < gotUsers[j].ID })
+			sort.Slice(gotGroups, func(i, j int) bool { return gotGroups[i].ID < gotGroups[j].ID })
+			for i := range gotGroups {
+				// created and updated times are set when a group is created
+				gotGroups[i].CreatedAt = tc.wantGroups[i].CreatedAt
+				gotGroups[i].UpdatedAt = tc.wantGroups[i].UpdatedAt
+			}
+
+			assert.Equal(t, tc.wantUsers, gotUsers)
+			assert.Equal(t, tc.wantGroups, gotGroups)
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package identitysync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+type ChangeAction string
+
+const (
+	ChangeActionCreate ChangeAction = "CREATE"
+	// ChangeActionUpdate is used for users and groups which are re-mapped to different groups or users,
+	// have their details changed, or are reactivated.
+	ChangeActionUpdate  ChangeAction = "UPDATE"
+	ChangeActionArchive ChangeAction = "ARCHIVE"
+)
+
+// UserChange is a change which a sync would make to a user.
+type UserChange struct {
+	Action ChangeAction
+	// User is the user after the sync.
+	User identity.User
+	// AddedGroups and RemovedGroups are the IDs of the groups the user would be added to and removed from.
+	AddedGroups   []string
+	RemovedGroups []string
+}
+
+// GroupChange is a change which a sync would make to a group.
+type GroupChange struct {
+	Action ChangeAction
+	// Group is the group after the sync.
+	Group identity.Group
+	// AddedUsers and RemovedUsers are the IDs of the users who would be added to and removed from the group.
+	AddedUsers   []string
+	RemovedUsers []string
+}
+
+// Diff is the set of changes a sync would make to the users and groups in the database.
+type Diff struct {
+	// IdpType and GroupFilter are the type and group filter of the identity provider with the highest precedence.
+	IdpType     string
+	GroupFilter string
+	// Sources are the identity providers which were listed, in order of precedence.
+	Sources []DiffSource
+	Users   []UserChange
+	Groups  []GroupChange
+}
+
+// DiffSource is an identity provider which was listed for a dry run, along with the group filter which was applied.
+type DiffSource struct {
+	IdpType     string
+	GroupFilter string
+}
+
+var (
+	// ErrInvalidGroupFilter is returned by DryRun if the group filter is not a valid regular expression.
+	ErrInvalidGroupFilter = errors.New("group filter is not a valid regular expression")
+	// ErrSourceNotFound is returned by DryRun if the source isn't one of the configured identity providers.
+	ErrSourceNotFound = errors.New("identity provider is not configured")
+)
+
+type DryRunOpts struct {
+	// GroupFilter replaces the configured group filter, to preview the effect of changing it.
+	// If nil, the configured group filter is used. An empty string previews removing the filter.
+	GroupFilter *string
+	// Source is the type of the identity provider which GroupFilter is applied to.
+	// If empty, GroupFilter is applied to the identity provider with the highest precedence.
+	Source string
+}
+
+// DryRun lists every user and group from the identity providers and returns the changes a full sync would make,
+// without writing to the database.
+func (s *IdentitySyncer) DryRun(ctx context.Context, opts DryRunOpts) (*Diff, error) {
+	target := s.sources[0].idpType
+	if opts.Source != "" {
+		target = opts.Source
+	}
+
+	sources := make([]source, len(s.sources))
+	copy(sources, s.sources)
+	found := false
+	for i := range sources {
+		if sources[i].idpType != target {
+			continue
+		}
+		found = true
+		if opts.GroupFilter != nil {
+			sources[i].groupFilter = *opts.GroupFilter
+		}
+		_, err := regexp.Compile(sources[i].groupFilter)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidGroupFilter, err)
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrSourceNotFound, target)
+	}
+
+	res, err := s.listAndProcess(ctx, sources)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := diffUsersAndGroups(res.internalUsers, res.internalGroups, res.users, res.groups)
+	diff.IdpType = sources[0].idpType
+	diff.GroupFilter = sources[0].groupFilter
+	for _, src := range sources {
+		diff.Sources = append(diff.Sources, DiffSource{IdpType: src.idpType, GroupFilter: src.groupFilter})
+	}
+	return &diff, nil
+}
+
+// diffUsersAndGroups compares the users and groups in the database with the users and groups returned by processUsersAndGroups.
+// Users and groups which are unchanged are not included.
+func diffUsersAndGroups(internalUsers []identity.User, internalGroups []identity.Group, users map[string]identity.User, groups map[string]identity.Group) Diff {
+	existingUsers := make(map[string]identity.User)
+	for _, u := range internalUsers {
+		existingUsers[u.ID] = u
+	}
+	existingGroups := make(map[string]identity.Group)
+	for _, g := range internalGroups {
+		existingGroups[g.ID] = g
+	}
+
+	diff := Diff{Users: []UserChange{}, Groups: []GroupChange{}}
+
+	for _, u := range users {
+		before, ok := existingUsers[u.ID]
+		if !ok {
+			diff.Users = append(diff.Users, UserChange{Action: ChangeActionCreate, User: u, AddedGroups: sorted(u.Groups), RemovedGroups: []string{}})
+			continue
+		}
+		added, removed := compareSets(before.Groups, u.Groups)
+		change := UserChange{User: u, AddedGroups: added, RemovedGroups: removed}
+		switch {
+		case before.Status == types.IdpStatusACTIVE && u.Status == types.IdpStatusARCHIVED:
+			change.Action = ChangeActionArchive
+		case before.Status != u.Status || before.FirstName != u.FirstName || before.LastName != u.LastName || len(added) > 0 || len(removed) > 0:
+			change.Action = ChangeActionUpdate
+		default:
+			continue
+		}
+		diff.Users = append(diff.Users, change)
+	}
+
+	for _, g := range groups {
+		before, ok := existingGroups[g.ID]
+		if !ok {
+			diff.Groups = append(diff.Groups, GroupChange{Action: ChangeActionCreate, Group: g, AddedUsers: sorted(g.Users), RemovedUsers: []string{}})
+			continue
+		}
+		added, removed := compareSets(before.Users, g.Users)
+		change := GroupChange{Group: g, AddedUsers: added, RemovedUsers: removed}
+		switch {
+		case before.Status == types.IdpStatusACTIVE && g.Status == types.IdpStatusARCHIVED:
+			change.Action = ChangeActionArchive
+		case before.Status != g.Status || before.Name != g.Name || before.Description != g.Description || before.Source != g.Source || len(added) > 0 || len(removed) > 0:
+			change.Action = ChangeActionUpdate
+		default:
+			continue
+		}
+		diff.Groups = append(diff.Groups, change)
+	}
+
+	sort.Slice(diff.Users, func(i, j int) bool { return diff.Users[i].User.Email < diff.Users[j].User.Email })
+	sort.Slice(diff.Groups, func(i, j int) bool { return diff.Groups[i].Group.Name < diff.Groups[j].Group.Name })
+
+	return diff
+}
+
+// compareSets returns the items which are in after but not before, and the items which are in before but not after.
+func compareSets(before, after []string) (added []string, removed []string) {
+	added, removed = []string{}, []string{}
+	for _, a := range after {
+		if !contains(before, a) {
+			added = append(added, a)
+		}
+	}
+	for _, b := range before {
+		if !contains(after, b) {
+			removed = append(removed, b)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
+
+func sorted(s []string) []string {
+	out := append([]string{}, s...)
+	sort.Strings(out)
+	return out
+}
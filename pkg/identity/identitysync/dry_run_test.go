@@ -0,0 +1,132 @@
+package identitysync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffUsersAndGroups(t *testing.T) {
+	internalUsers := []identity.User{
+		{ID: "usr_1", Email: "josh@test.go", Groups: []string{"admins", "devops"}, Status: types.IdpStatusACTIVE},
+		{ID: "usr_2", Email: "chris@test.go", Groups: []string{"devops"}, Status: types.IdpStatusACTIVE},
+		{ID: "usr_3", Email: "jane@test.go", Groups: []string{"devops"}, Status: types.IdpStatusACTIVE},
+	}
+	internalGroups := []identity.Group{
+		{ID: "admins", IdpID: "admins", Name: "admins", Users: []string{"usr_1"}, Status: types.IdpStatusACTIVE, Source: "okta"},
+		{ID: "devops", IdpID: "devops", Name: "devops", Users: []string{"usr_1", "usr_2", "usr_3"}, Status: types.IdpStatusACTIVE, Source: "okta"},
+	}
+
+	// the result of applying the group filter "admins|accounting", where chris is only in devops.
+	users := map[string]identity.User{
+		"josh@test.go":  {ID: "usr_1", Email: "josh@test.go", Groups: []string{"admins"}, Status: types.IdpStatusACTIVE},
+		"chris@test.go": {ID: "usr_2", Email: "chris@test.go", Groups: []string{}, Status: types.IdpStatusARCHIVED},
+		"jane@test.go":  {ID: "usr_3", Email: "jane@test.go", Groups: []string{"accounting"}, Status: types.IdpStatusACTIVE},
+		"sam@test.go":   {ID: "usr_4", Email: "sam@test.go", Groups: []string{"accounting"}, Status: types.IdpStatusACTIVE},
+	}
+	groups := map[string]identity.Group{
+		"admins":     {ID: "admins", IdpID: "admins", Name: "admins", Users: []string{"usr_1"}, Status: types.IdpStatusACTIVE, Source: "okta"},
+		"devops":     {ID: "devops", IdpID: "devops", Name: "devops", Users: []string{}, Status: types.IdpStatusARCHIVED, Source: "okta"},
+		"accounting": {ID: "accounting", IdpID: "accounting", Name: "accounting", Users: []string{"usr_4", "usr_3"}, Status: types.IdpStatusACTIVE, Source: "okta"},
+	}
+
+	got := diffUsersAndGroups(internalUsers, internalGroups, users, groups)
+
+	want := Diff{
+		Users: []UserChange{
+			{Action: ChangeActionArchive, User: users["chris@test.go"], AddedGroups: []string{}, RemovedGroups: []string{"devops"}},
+			{Action: ChangeActionUpdate, User: users["jane@test.go"], AddedGroups: []string{"accounting"}, RemovedGroups: []string{"devops"}},
+			{Action: ChangeActionUpdate, User: users["josh@test.go"], AddedGroups: []string{}, RemovedGroups: []string{"devops"}},
+			{Action: ChangeActionCreate, User: users["sam@test.go"], AddedGroups: []string{"accounting"}, RemovedGroups: []string{}},
+		},
+		Groups: []GroupChange{
+			{Action: ChangeActionCreate, Group: groups["accounting"], AddedUsers: []string{"usr_3", "usr_4"}, RemovedUsers: []string{}},
+			{Action: ChangeActionArchive, Group: groups["devops"], AddedUsers: []string{}, RemovedUsers: []string{"usr_1", "usr_2", "usr_3"}},
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+// testIDP is an identity provider which returns a fixed list of users and groups.
+type testIDP struct {
+	IdentityProvider
+	users  []identity.IDPUser
+	groups []identity.IDPGroup
+}
+
+func (p *testIDP) ListUsers(ctx context.Context) ([]identity.IDPUser, error) {
+	return p.users, nil
+}
+
+func (p *testIDP) ListGroups(ctx context.Context) ([]identity.IDPGroup, error) {
+	return p.groups, nil
+}
+
+func TestDryRunSource(t *testing.T) {
+	okta := &testIDP{
+		users:  []identity.IDPUser{{ID: "okta_1", Email: "a@example.com", Groups: []string{"okta_admins"}}},
+		groups: []identity.IDPGroup{{ID: "okta_admins", Name: "admins"}},
+	}
+	azure := &testIDP{
+		users:  []identity.IDPUser{{ID: "azure_1", Email: "b@example.com", Groups: []string{"azure_devops"}}},
+		groups: []identity.IDPGroup{{ID: "azure_devops", Name: "devops"}},
+	}
+	s := IdentitySyncer{
+		db: &pagedDB{users: [][]identity.User{{}}, groups: [][]identity.Group{{}}},
+		sources: []source{
+			{idp: okta, idpType: "okta"},
+			{idp: azure, idpType: "azure"},
+		},
+	}
+	filter := "ops$"
+	invalid := "("
+
+	type testcase struct {
+		name        string
+		give        DryRunOpts
+		wantSources []DiffSource
+		wantErr     error
+	}
+
+	testcases := []testcase{
+		{
+			name:        "applies filter to highest precedence source by default",
+			give:        DryRunOpts{GroupFilter: &filter},
+			wantSources: []DiffSource{{IdpType: "okta", GroupFilter: "ops$"}, {IdpType: "azure"}},
+		},
+		{
+			name:        "applies filter to source",
+			give:        DryRunOpts{GroupFilter: &filter, Source: "azure"},
+			wantSources: []DiffSource{{IdpType: "okta"}, {IdpType: "azure", GroupFilter: "ops$"}},
+		},
+		{
+			name:    "source not configured",
+			give:    DryRunOpts{GroupFilter: &filter, Source: "google"},
+			wantErr: ErrSourceNotFound,
+		},
+		{
+			name:    "invalid filter",
+			give:    DryRunOpts{GroupFilter: &invalid, Source: "azure"},
+			wantErr: ErrInvalidGroupFilter,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := s.DryRun(context.Background(), tc.give)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantSources, got.Sources)
+			// the configured group filters are not changed by a dry run
+			assert.Equal(t, "", s.sources[0].groupFilter)
+			assert.Equal(t, "", s.sources[1].groupFilter)
+		})
+	}
+}
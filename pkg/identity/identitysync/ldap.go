@@ -0,0 +1,215 @@
+package identitysync
+
+import (
+	"context"
+	"strings"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultLDAPUserSearchFilter  = "(&(objectClass=person)(mail=*))"
+	defaultLDAPGroupSearchFilter = "(|(objectClass=groupOfNames)(objectClass=groupOfUniqueNames)(objectClass=group))"
+	defaultLDAPEmailAttribute    = "mail"
+	// ldapPageSize is below the default Active Directory MaxPageSize of 1000.
+	ldapPageSize = 500
+)
+
+// LDAPSync syncs users and groups from an LDAP directory such as Active Directory, FreeIPA or OpenLDAP.
+//
+// Users and groups are identified by their distinguished names, so a user or group which is renamed or moved is
+// archived and synced as a new user or group.
+// Group memberships are read from the member and uniqueMember attributes of each group. Nested groups are not expanded.
+type LDAPSync struct {
+	url               gconfig.StringValue
+	bindDN            gconfig.StringValue
+	bindPassword      gconfig.SecretStringValue
+	baseDN            gconfig.StringValue
+	userSearchFilter  gconfig.OptionalStringValue
+	groupSearchFilter gconfig.OptionalStringValue
+	emailAttribute    gconfig.OptionalStringValue
+}
+
+func (s *LDAPSync) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.StringField("url", &s.url, "the LDAP server URL (eg. ldaps://ldap.example.com:636)"),
+		gconfig.StringField("bindDn", &s.bindDN, "the distinguished name to bind as (eg. uid=commonfate,cn=users,dc=example,dc=com)"),
+		gconfig.SecretStringField("bindPassword", &s.bindPassword, "the password for the bind distinguished name", gconfig.WithNoArgs("/granted/secrets/identity/ldap/bindPassword")),
+		gconfig.StringField("baseDn", &s.baseDN, "the base distinguished name to search for users and groups (eg. dc=example,dc=com)"),
+		gconfig.OptionalStringField("userSearchFilter", &s.userSearchFilter, "the LDAP filter used to search for users. Defaults to "+defaultLDAPUserSearchFilter),
+		gconfig.OptionalStringField("groupSearchFilter", &s.groupSearchFilter, "the LDAP filter used to search for groups. Defaults to "+defaultLDAPGroupSearchFilter),
+		gconfig.OptionalStringField("emailAttribute", &s.emailAttribute, "the attribute containing user email addresses. Defaults to "+defaultLDAPEmailAttribute),
+	}
+}
+
+// Init checks that the search filters are valid.
+// A connection is opened for each sync rather than here, as LDAP servers close idle connections.
+func (s *LDAPSync) Init(ctx context.Context) error {
+	_, err := ldap.CompileFilter(s.userFilter())
+	if err != nil {
+		return errors.Wrap(err, "invalid LDAP user search filter")
+	}
+	_, err = ldap.CompileFilter(s.groupFilter())
+	if err != nil {
+		return errors.Wrap(err, "invalid LDAP group search filter")
+	}
+	return nil
+}
+
+func (s *LDAPSync) TestConfig(ctx context.Context) error {
+	_, err := s.ListUsers(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list users while testing ldap identity provider configuration")
+	}
+	_, err = s.ListGroups(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list groups while testing ldap identity provider configuration")
+	}
+	return nil
+}
+
+func (s *LDAPSync) userFilter() string {
+	if s.userSearchFilter.Get() != "" {
+		return s.userSearchFilter.Get()
+	}
+	return defaultLDAPUserSearchFilter
+}
+
+func (s *LDAPSync) groupFilter() string {
+	if s.groupSearchFilter.Get() != "" {
+		return s.groupSearchFilter.Get()
+	}
+	return defaultLDAPGroupSearchFilter
+}
+
+func (s *LDAPSync) emailAttr() string {
+	if s.emailAttribute.Get() != "" {
+		return s.emailAttribute.Get()
+	}
+	return defaultLDAPEmailAttribute
+}
+
+// connect opens a connection to the LDAP server and binds as the configured user.
+func (s *LDAPSync) connect() (*ldap.Conn, error) {
+	conn, err := ldap.DialURL(s.url.Get())
+	if err != nil {
+		return nil, errors.Wrap(err, "connecting to LDAP server")
+	}
+	err = conn.Bind(s.bindDN.Get(), s.bindPassword.Get())
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "binding to LDAP server")
+	}
+	return conn, nil
+}
+
+func (s *LDAPSync) search(conn *ldap.Conn, filter string, attributes []string) ([]*ldap.Entry, error) {
+	req := ldap.NewSearchRequest(s.baseDN.Get(), ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false, filter, attributes, nil)
+	res, err := conn.SearchWithPaging(req, ldapPageSize)
+	if err != nil {
+		return nil, errors.Wrapf(err, "searching LDAP with filter %s", filter)
+	}
+	return res.Entries, nil
+}
+
+func (s *LDAPSync) ListUsers(ctx context.Context) ([]identity.IDPUser, error) {
+	log := logger.Get(ctx)
+
+	conn, err := s.connect()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	userEntries, err := s.search(conn, s.userFilter(), []string{"givenName", "sn", s.emailAttr()})
+	if err != nil {
+		return nil, err
+	}
+	groupEntries, err := s.search(conn, s.groupFilter(), []string{"member", "uniqueMember"})
+	if err != nil {
+		return nil, err
+	}
+
+	// member attributes may not match the case or spacing of the user's distinguished name, so they are normalised.
+	userGroups := make(map[string][]string)
+	for _, g := range groupEntries {
+		var members []string
+		members = append(members, g.GetEqualFoldAttributeValues("member")...)
+		members = append(members, g.GetEqualFoldAttributeValues("uniqueMember")...)
+		for _, m := range members {
+			dn := normalizeDN(m)
+			if !contains(userGroups[dn], g.DN) {
+				userGroups[dn] = append(userGroups[dn], g.DN)
+			}
+		}
+	}
+
+	var idpUsers []identity.IDPUser
+	for _, e := range userEntries {
+		email := e.GetEqualFoldAttributeValue(s.emailAttr())
+		if email == "" {
+			log.Warnw("skipping LDAP user with no email address", "dn", e.DN, "emailAttribute", s.emailAttr())
+			continue
+		}
+		groups := userGroups[normalizeDN(e.DN)]
+		if groups == nil {
+			groups = []string{}
+		}
+		idpUsers = append(idpUsers, identity.IDPUser{
+			ID:        e.DN,
+			FirstName: e.GetEqualFoldAttributeValue("givenName"),
+			LastName:  e.GetEqualFoldAttributeValue("sn"),
+			Email:     email,
+			Groups:    groups,
+		})
+	}
+	return idpUsers, nil
+}
+
+func (s *LDAPSync) ListGroups(ctx context.Context) ([]identity.IDPGroup, error) {
+	conn, err := s.connect()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	entries, err := s.search(conn, s.groupFilter(), []string{"cn", "description"})
+	if err != nil {
+		return nil, err
+	}
+
+	idpGroups := []identity.IDPGroup{}
+	for _, e := range entries {
+		name := e.GetEqualFoldAttributeValue("cn")
+		if name == "" {
+			name = e.DN
+		}
+		idpGroups = append(idpGroups, identity.IDPGroup{
+			ID:          e.DN,
+			Name:        name,
+			Description: e.GetEqualFoldAttributeValue("description"),
+		})
+	}
+	return idpGroups, nil
+}
+
+// normalizeDN returns a distinguished name in a form which can be compared with other distinguished names.
+func normalizeDN(dn string) string {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil {
+		return strings.ToLower(dn)
+	}
+	rdns := make([]string, 0, len(parsed.RDNs))
+	for _, rdn := range parsed.RDNs {
+		attrs := make([]string, 0, len(rdn.Attributes))
+		for _, a := range rdn.Attributes {
+			attrs = append(attrs, strings.ToLower(a.Type)+"="+strings.ToLower(a.Value))
+		}
+		rdns = append(rdns, strings.Join(attrs, "+"))
+	}
+	return strings.Join(rdns, ",")
+}
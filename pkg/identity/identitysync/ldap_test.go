@@ -0,0 +1,274 @@
+package identitysync
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/common-fate/common-fate/pkg/identity"
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+// testLDAPServer is an embedded LDAP server which supports binding and searching a fixed set of entries.
+// Search filters support and, or, not, equality and presence, which is enough for the default LDAPSync filters.
+type testLDAPServer struct {
+	bindDN       string
+	bindPassword string
+	entries      []testLDAPEntry
+	listener     net.Listener
+}
+
+type testLDAPEntry struct {
+	dn         string
+	attributes map[string][]string
+}
+
+func newTestLDAPServer(t *testing.T, bindDN, bindPassword string, entries []testLDAPEntry) *testLDAPServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &testLDAPServer{bindDN: bindDN, bindPassword: bindPassword, entries: entries, listener: l}
+	go s.serve()
+	t.Cleanup(func() { l.Close() })
+	return s
+}
+
+func (s *testLDAPServer) URL() string {
+	return "ldap://" + s.listener.Addr().String()
+}
+
+func (s *testLDAPServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *testLDAPServer) handle(conn net.Conn) {
+	defer conn.Close()
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil {
+			return
+		}
+		messageID := packet.Children[0].Value.(int64)
+		op := packet.Children[1]
+		switch op.Tag {
+		case ldap.ApplicationBindRequest:
+			code := ldap.LDAPResultSuccess
+			if op.Children[1].Value.(string) != s.bindDN || op.Children[2].Data.String() != s.bindPassword {
+				code = ldap.LDAPResultInvalidCredentials
+			}
+			s.write(conn, messageID, ldapResult(ldap.ApplicationBindResponse, code))
+		case ldap.ApplicationSearchRequest:
+			baseDN := normalizeDN(op.Children[0].Value.(string))
+			for _, e := range s.entries {
+				if !strings.HasSuffix(normalizeDN(e.dn), baseDN) || !matchLDAPFilter(op.Children[6], e) {
+					continue
+				}
+				s.write(conn, messageID, ldapEntry(e))
+			}
+			s.write(conn, messageID, ldapResult(ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess))
+		case ldap.ApplicationUnbindRequest:
+			return
+		}
+	}
+}
+
+func (s *testLDAPServer) write(conn net.Conn, messageID int64, op *ber.Packet) {
+	envelope := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	envelope.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "MessageID"))
+	envelope.AppendChild(op)
+	_, _ = conn.Write(envelope.Bytes())
+}
+
+func ldapResult(tag ber.Tag, code int) *ber.Packet {
+	p := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Result")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), "resultCode"))
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "matchedDN"))
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "diagnosticMessage"))
+	return p
+}
+
+func ldapEntry(e testLDAPEntry) *ber.Packet {
+	p := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.dn, "objectName"))
+	attrs := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attributes")
+	for name, values := range e.attributes {
+		attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attribute")
+		attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "type"))
+		vals := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "vals")
+		for _, v := range values {
+			vals.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "value"))
+		}
+		attr.AppendChild(vals)
+		attrs.AppendChild(attr)
+	}
+	p.AppendChild(attrs)
+	return p
+}
+
+func (e testLDAPEntry) values(attribute string) []string {
+	for k, v := range e.attributes {
+		if strings.EqualFold(k, attribute) {
+			return v
+		}
+	}
+	return nil
+}
+
+func matchLDAPFilter(f *ber.Packet, e testLDAPEntry) bool {
+	switch f.Tag {
+	case ldap.FilterAnd:
+		for _, c := range f.Children {
+			if !matchLDAPFilter(c, e) {
+				return false
+			}
+		}
+		return true
+	case ldap.FilterOr:
+		for _, c := range f.Children {
+			if matchLDAPFilter(c, e) {
+				return true
+			}
+		}
+		return false
+	case ldap.FilterNot:
+		return !matchLDAPFilter(f.Children[0], e)
+	case ldap.FilterEqualityMatch:
+		for _, v := range e.values(f.Children[0].Value.(string)) {
+			if strings.EqualFold(v, f.Children[1].Value.(string)) {
+				return true
+			}
+		}
+		return false
+	case ldap.FilterPresent:
+		return len(e.values(f.Data.String())) > 0
+	}
+	return false
+}
+
+func TestLDAPSync(t *testing.T) {
+	entries := []testLDAPEntry{
+		{dn: "cn=admin,dc=example,dc=com", attributes: map[string][]string{"objectClass": {"organizationalRole"}}},
+		{dn: "uid=josh,ou=people,dc=example,dc=com", attributes: map[string][]string{"objectClass": {"top", "person", "inetOrgPerson"}, "uid": {"josh"}, "givenName": {"Josh"}, "sn": {"Wilkes"}, "mail": {"josh@example.com"}}},
+		{dn: "uid=chris,ou=people,dc=example,dc=com", attributes: map[string][]string{"objectClass": {"person", "inetOrgPerson"}, "uid": {"chris"}, "givenName": {"Chris"}, "sn": {"Norman"}, "mail": {"chris@example.com"}}},
+		// users without an email address are not synced
+		{dn: "uid=svc,ou=people,dc=example,dc=com", attributes: map[string][]string{"objectClass": {"person"}, "givenName": {"Service"}}},
+		{dn: "cn=admins,ou=groups,dc=example,dc=com", attributes: map[string][]string{"objectClass": {"groupOfNames"}, "cn": {"admins"}, "description": {"Administrators"}, "member": {"UID=josh, ou=People,dc=example,dc=com"}}},
+		{dn: "cn=devops,ou=groups,dc=example,dc=com", attributes: map[string][]string{"objectClass": {"groupOfUniqueNames"}, "cn": {"devops"}, "uniqueMember": {"uid=josh,ou=people,dc=example,dc=com", "uid=chris,ou=people,dc=example,dc=com"}}},
+		// entries outside of the base DN are not synced
+		{dn: "uid=jane,ou=people,dc=other,dc=com", attributes: map[string][]string{"objectClass": {"person"}, "mail": {"jane@other.com"}}},
+	}
+	server := newTestLDAPServer(t, "cn=admin,dc=example,dc=com", "password", entries)
+
+	type testcase struct {
+		name       string
+		giveConfig map[string]string
+		wantUsers  []identity.IDPUser
+		wantGroups []identity.IDPGroup
+		wantErr    bool
+	}
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			giveConfig: map[string]string{
+				"url":          server.URL(),
+				"bindDn":       "cn=admin,dc=example,dc=com",
+				"bindPassword": "password",
+				"baseDn":       "dc=example,dc=com",
+			},
+			wantUsers: []identity.IDPUser{
+				{ID: "uid=josh,ou=people,dc=example,dc=com", FirstName: "Josh", LastName: "Wilkes", Email: "josh@example.com", Groups: []string{"cn=admins,ou=groups,dc=example,dc=com", "cn=devops,ou=groups,dc=example,dc=com"}},
+				{ID: "uid=chris,ou=people,dc=example,dc=com", FirstName: "Chris", LastName: "Norman", Email: "chris@example.com", Groups: []string{"cn=devops,ou=groups,dc=example,dc=com"}},
+			},
+			wantGroups: []identity.IDPGroup{
+				{ID: "cn=admins,ou=groups,dc=example,dc=com", Name: "admins", Description: "Administrators"},
+				{ID: "cn=devops,ou=groups,dc=example,dc=com", Name: "devops"},
+			},
+		},
+		{
+			name: "custom search filters",
+			giveConfig: map[string]string{
+				"url":               server.URL(),
+				"bindDn":            "cn=admin,dc=example,dc=com",
+				"bindPassword":      "password",
+				"baseDn":            "dc=example,dc=com",
+				"userSearchFilter":  "(&(objectClass=person)(!(uid=chris)))",
+				"groupSearchFilter": "(cn=admins)",
+			},
+			wantUsers: []identity.IDPUser{
+				{ID: "uid=josh,ou=people,dc=example,dc=com", FirstName: "Josh", LastName: "Wilkes", Email: "josh@example.com", Groups: []string{"cn=admins,ou=groups,dc=example,dc=com"}},
+			},
+			wantGroups: []identity.IDPGroup{
+				{ID: "cn=admins,ou=groups,dc=example,dc=com", Name: "admins", Description: "Administrators"},
+			},
+		},
+		{
+			name: "invalid credentials",
+			giveConfig: map[string]string{
+				"url":          server.URL(),
+				"bindDn":       "cn=admin,dc=example,dc=com",
+				"bindPassword": "wrong",
+				"baseDn":       "dc=example,dc=com",
+			},
+			wantErr: true,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			var s LDAPSync
+			err := s.Config().Load(ctx, &gconfig.MapLoader{Values: tc.giveConfig})
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = s.Init(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotUsers, err := s.ListUsers(ctx)
+			if tc.wantErr {
+				var ldapErr *ldap.Error
+				assert.True(t, errors.As(err, &ldapErr))
+				return
+			}
+			assert.NoError(t, err)
+			gotGroups, err := s.ListGroups(ctx)
+			assert.NoError(t, err)
+
+			assert.ElementsMatch(t, tc.wantUsers, gotUsers)
+			assert.ElementsMatch(t, tc.wantGroups, gotGroups)
+		})
+	}
+}
+
+func TestLDAPSyncInvalidFilter(t *testing.T) {
+	ctx := context.Background()
+	var s LDAPSync
+	err := s.Config().Load(ctx, &gconfig.MapLoader{Values: map[string]string{
+		"url":              "ldap://127.0.0.1:389",
+		"bindDn":           "cn=admin,dc=example,dc=com",
+		"bindPassword":     "password",
+		"baseDn":           "dc=example,dc=com",
+		"userSearchFilter": "(objectClass=person",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.Init(ctx)
+	assert.Error(t, err)
+}
@@ -0,0 +1,133 @@
+package identitysync
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+	"github.com/pkg/errors"
+)
+
+var _ DeltaIdentityProvider = &OktaSync{}
+
+// oktaTimeLayout is the timestamp format used in Okta filter expressions.
+const oktaTimeLayout = "2006-01-02T15:04:05.000Z"
+
+// oktaCursorOverlap is subtracted from the cursor when listing changes, so that changes aren't missed
+// due to clock skew between Common Fate and Okta. Changes which are listed twice are applied twice without any effect.
+const oktaCursorOverlap = time.Minute
+
+// Cursor returns the current time, which is used to filter users and groups by their lastUpdated timestamps.
+// Listing changes isn't supported when users are synced based on their groups, so an empty cursor is returned.
+func (o *OktaSync) Cursor(ctx context.Context) (string, error) {
+	if o.groups.IsSet() {
+		return "", nil
+	}
+	return time.Now().UTC().Format(oktaTimeLayout), nil
+}
+
+// ListChanges lists the users and groups which were updated since the cursor.
+//
+// Okta doesn't list deleted groups, so these are archived by the next full sync.
+// Deleted users are deprovisioned before they're deleted, so they are archived when they are deprovisioned.
+func (o *OktaSync) ListChanges(ctx context.Context, cursor string) (*Delta, error) {
+	log := logger.Get(ctx)
+
+	since, err := time.Parse(oktaTimeLayout, cursor)
+	if err != nil {
+		return nil, ErrCursorExpired
+	}
+	next := time.Now().UTC()
+	filterSince := since.Add(-oktaCursorOverlap).Format(oktaTimeLayout)
+
+	delta := Delta{Cursor: next.Format(oktaTimeLayout)}
+
+	// unlike listing all users, filtering users includes users which are deprovisioned.
+	users, res, err := o.client.User.ListUsers(ctx, &query.Params{Filter: fmt.Sprintf(`lastUpdated gt "%s"`, filterSince)})
+	if err != nil {
+		logResponseErr(log, res, err)
+		return nil, errors.Wrap(err, "listing updated okta users from okta API")
+	}
+	for res.HasNextPage() {
+		var nextUsers []*okta.User
+		res, err = res.Next(ctx, &nextUsers)
+		if err != nil {
+			logResponseErr(log, res, err)
+			return nil, err
+		}
+		users = append(users, nextUsers...)
+	}
+	for _, u := range users {
+		if u.Status == "DEPROVISIONED" {
+			delta.DeletedUsers = append(delta.DeletedUsers, u.Id)
+			continue
+		}
+		// group memberships are listed from the groups which changed, so the groups for each user aren't fetched.
+		user, err := o.idpUserFromOktaUser(ctx, u, map[string][]string{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "converting okta user %s to internal user", u.Id)
+		}
+		delta.Users = append(delta.Users, user)
+	}
+
+	groups, res, err := o.client.Group.ListGroups(ctx, &query.Params{
+		Filter: fmt.Sprintf(`lastUpdated gt "%s" or lastMembershipUpdated gt "%s"`, filterSince, filterSince),
+	})
+	if err != nil {
+		logResponseErr(log, res, err)
+		return nil, errors.Wrap(err, "listing updated okta groups from okta API")
+	}
+	for res.HasNextPage() {
+		var nextGroups []*okta.Group
+		res, err = res.Next(ctx, &nextGroups)
+		if err != nil {
+			logResponseErr(log, res, err)
+			return nil, err
+		}
+		groups = append(groups, nextGroups...)
+	}
+	for _, g := range groups {
+		delta.Groups = append(delta.Groups, idpGroupFromOktaGroup(g))
+		if g.LastMembershipUpdated == nil || g.LastMembershipUpdated.Before(since.Add(-oktaCursorOverlap)) {
+			continue
+		}
+		members, err := o.listGroupMemberIDs(ctx, g.Id)
+		if err != nil {
+			return nil, err
+		}
+		delta.Memberships = append(delta.Memberships, MembershipChange{GroupID: g.Id, Replace: true, Added: members})
+	}
+
+	log.Debugw("listed okta changes", "since", filterSince, "users.count", len(users), "groups.count", len(groups))
+
+	return &delta, nil
+}
+
+// listGroupMemberIDs returns the IDs of every user in an Okta group.
+func (o *OktaSync) listGroupMemberIDs(ctx context.Context, groupID string) ([]string, error) {
+	log := logger.Get(ctx)
+
+	users, res, err := o.client.Group.ListGroupUsers(ctx, groupID, &query.Params{})
+	if err != nil {
+		logResponseErr(log, res, err)
+		return nil, errors.Wrapf(err, "listing members of okta group %s", groupID)
+	}
+	for res.HasNextPage() {
+		var nextUsers []*okta.User
+		res, err = res.Next(ctx, &nextUsers)
+		if err != nil {
+			logResponseErr(log, res, err)
+			return nil, err
+		}
+		users = append(users, nextUsers...)
+	}
+
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.Id)
+	}
+	return ids, nil
+}
@@ -15,6 +15,9 @@ const (
 	IDPTypeGoogle   = "google"
 	IDPTypeAWSSSO   = "aws-sso"
 	IDPTypeOneLogin = "one-login"
+	IDPTypeLDAP     = "ldap"
+	// IDPTypeSCIMClient is distinct from identity.SCIM, which is the source of users and groups provisioned by the SCIM server.
+	IDPTypeSCIMClient = "scim-client"
 )
 
 type RegisteredIdentityProvider struct {
@@ -63,6 +66,16 @@ func Registry() IdentityProviderRegistry {
 				Description:      "OneLogin",
 				DocsID:           "one-login",
 			},
+			IDPTypeLDAP: {
+				IdentityProvider: &LDAPSync{},
+				Description:      "LDAP or Active Directory",
+				DocsID:           "ldap",
+			},
+			IDPTypeSCIMClient: {
+				IdentityProvider: &SCIMClientSync{},
+				Description:      "Generic SCIM",
+				DocsID:           "scim-client",
+			},
 		},
 	}
 }
@@ -0,0 +1,200 @@
+package identitysync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/scim"
+	"github.com/pkg/errors"
+)
+
+// scimPageSize is the number of resources requested per page. Service providers may return fewer.
+const scimPageSize = 100
+
+// scimRequestTimeout bounds how long the sync waits for each page of users or groups.
+const scimRequestTimeout = 30 * time.Second
+
+// SCIMClientSync syncs users and groups from an identity provider which implements the SCIM 2.0 protocol as a
+// service provider, such as Keycloak with a SCIM extension.
+//
+// This is the reverse of the SCIM server in pkg/scim, where the identity provider pushes changes to Common Fate.
+type SCIMClientSync struct {
+	baseURL gconfig.StringValue
+	token   gconfig.SecretStringValue
+	client  *http.Client
+}
+
+func (s *SCIMClientSync) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.StringField("baseUrl", &s.baseURL, "the SCIM base URL of the identity provider (eg. https://keycloak.example.com/realms/example/scim/v2)"),
+		gconfig.SecretStringField("token", &s.token, "the SCIM bearer token", gconfig.WithNoArgs("/granted/secrets/identity/scim-client/token")),
+	}
+}
+
+func (s *SCIMClientSync) Init(ctx context.Context) error {
+	_, err := url.ParseRequestURI(s.baseURL.Get())
+	if err != nil {
+		return errors.Wrap(err, "invalid SCIM base URL")
+	}
+	s.client = &http.Client{Timeout: scimRequestTimeout}
+	return nil
+}
+
+func (s *SCIMClientSync) TestConfig(ctx context.Context) error {
+	_, err := s.ListUsers(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list users while testing scim identity provider configuration")
+	}
+	_, err = s.ListGroups(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list groups while testing scim identity provider configuration")
+	}
+	return nil
+}
+
+type scimListResponse[T any] struct {
+	TotalResults int `json:"totalResults"`
+	Resources    []T `json:"Resources"`
+}
+
+// listSCIMResources lists every page of a SCIM resource type, such as "Users" or "Groups".
+func listSCIMResources[T any](ctx context.Context, s *SCIMClientSync, resourceType string) ([]T, error) {
+	var resources []T
+	for {
+		q := url.Values{}
+		q.Set("startIndex", strconv.Itoa(len(resources)+1))
+		q.Set("count", strconv.Itoa(scimPageSize))
+		u := strings.TrimSuffix(s.baseURL.Get(), "/") + "/" + resourceType + "?" + q.Encode()
+
+		req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Add("Authorization", "Bearer "+s.token.Get())
+		req.Header.Add("Accept", "application/scim+json")
+
+		res, err := s.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		b, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if res.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("listing SCIM %s returned status %d: %s", resourceType, res.StatusCode, string(b))
+		}
+
+		var page scimListResponse[T]
+		err = json.Unmarshal(b, &page)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unmarshalling SCIM %s", resourceType)
+		}
+		resources = append(resources, page.Resources...)
+		if len(page.Resources) == 0 || len(resources) >= page.TotalResults {
+			return resources, nil
+		}
+	}
+}
+
+// ListUsers lists the active users from the identity provider.
+// Group memberships are read from both the groups attribute of each user and the members attribute of each group,
+// as service providers may only return one of them.
+func (s *SCIMClientSync) ListUsers(ctx context.Context) ([]identity.IDPUser, error) {
+	log := logger.Get(ctx)
+
+	users, err := listSCIMResources[scim.User](ctx, s, "Users")
+	if err != nil {
+		return nil, err
+	}
+	groups, err := listSCIMResources[scim.Group](ctx, s, "Groups")
+	if err != nil {
+		return nil, err
+	}
+
+	userGroups := make(map[string][]string)
+	for _, u := range users {
+		for _, g := range u.Groups {
+			if !contains(userGroups[u.ID], g.Value) {
+				userGroups[u.ID] = append(userGroups[u.ID], g.Value)
+			}
+		}
+	}
+	for _, g := range groups {
+		for _, m := range g.Members {
+			if !contains(userGroups[m.Value], g.ID) {
+				userGroups[m.Value] = append(userGroups[m.Value], g.ID)
+			}
+		}
+	}
+
+	var idpUsers []identity.IDPUser
+	for _, u := range users {
+		if u.Active != nil && !*u.Active {
+			continue
+		}
+		email := scimUserEmail(u)
+		if email == "" {
+			log.Warnw("skipping SCIM user with no email address", "scim.user_id", u.ID)
+			continue
+		}
+		groups := userGroups[u.ID]
+		if groups == nil {
+			groups = []string{}
+		}
+		user := identity.IDPUser{
+			ID:     u.ID,
+			Email:  email,
+			Groups: groups,
+		}
+		if u.Name != nil {
+			user.FirstName = u.Name.GivenName
+			user.LastName = u.Name.FamilyName
+		}
+		idpUsers = append(idpUsers, user)
+	}
+	return idpUsers, nil
+}
+
+func (s *SCIMClientSync) ListGroups(ctx context.Context) ([]identity.IDPGroup, error) {
+	groups, err := listSCIMResources[scim.Group](ctx, s, "Groups")
+	if err != nil {
+		return nil, err
+	}
+	idpGroups := []identity.IDPGroup{}
+	for _, g := range groups {
+		idpGroups = append(idpGroups, identity.IDPGroup{
+			ID:   g.ID,
+			Name: g.DisplayName,
+		})
+	}
+	return idpGroups, nil
+}
+
+// scimUserEmail returns the primary email address of a user, falling back to their first email address,
+// and then to their userName if it is an email address.
+func scimUserEmail(u scim.User) string {
+	for _, e := range u.Emails {
+		if e.Primary {
+			return e.Value
+		}
+	}
+	if len(u.Emails) > 0 {
+		return u.Emails[0].Value
+	}
+	if strings.Contains(u.UserName, "@") {
+		return u.UserName
+	}
+	return ""
+}
@@ -0,0 +1,127 @@
+package identitysync
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/scim"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestSCIMServer serves the users and groups as SCIM list responses, returning at most two resources per page.
+func newTestSCIMServer(t *testing.T, token string, users []scim.User, groups []scim.Group) *httptest.Server {
+	page := func(w http.ResponseWriter, r *http.Request, resources []any) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		start, _ := strconv.Atoi(r.URL.Query().Get("startIndex"))
+		res := scim.ListResponse{Schemas: []string{scim.ListResponseSchema}, TotalResults: len(resources), StartIndex: start, Resources: []any{}}
+		for i := start - 1; i >= 0 && i < len(resources) && len(res.Resources) < 2; i++ {
+			res.Resources = append(res.Resources, resources[i])
+		}
+		res.ItemsPerPage = len(res.Resources)
+		_ = json.NewEncoder(w).Encode(res)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/scim/v2/Users", func(w http.ResponseWriter, r *http.Request) {
+		var resources []any
+		for _, u := range users {
+			resources = append(resources, u)
+		}
+		page(w, r, resources)
+	})
+	mux.HandleFunc("/scim/v2/Groups", func(w http.ResponseWriter, r *http.Request) {
+		var resources []any
+		for _, g := range groups {
+			resources = append(resources, g)
+		}
+		page(w, r, resources)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestSCIMClientSync(t *testing.T) {
+	inactive := false
+	users := []scim.User{
+		{ID: "1", UserName: "josh", Name: &scim.Name{GivenName: "Josh", FamilyName: "Wilkes"}, Emails: []scim.Email{{Value: "josh@work.com"}, {Value: "josh@example.com", Primary: true}}, Groups: []scim.Reference{{Value: "admins"}}},
+		{ID: "2", UserName: "chris@example.com"},
+		{ID: "3", UserName: "jane@example.com", Active: &inactive},
+		// users without an email address are not synced
+		{ID: "4", UserName: "svc"},
+	}
+	groups := []scim.Group{
+		{ID: "admins", DisplayName: "Admins"},
+		// memberships are read from group members as well as user groups
+		{ID: "devops", DisplayName: "DevOps", Members: []scim.Reference{{Value: "1"}, {Value: "2"}}},
+	}
+	server := newTestSCIMServer(t, "secret", users, groups)
+
+	type testcase struct {
+		name       string
+		giveToken  string
+		wantUsers  []identity.IDPUser
+		wantGroups []identity.IDPGroup
+		wantErr    bool
+	}
+
+	testcases := []testcase{
+		{
+			name:      "ok",
+			giveToken: "secret",
+			wantUsers: []identity.IDPUser{
+				{ID: "1", FirstName: "Josh", LastName: "Wilkes", Email: "josh@example.com", Groups: []string{"admins", "devops"}},
+				{ID: "2", Email: "chris@example.com", Groups: []string{"devops"}},
+			},
+			wantGroups: []identity.IDPGroup{
+				{ID: "admins", Name: "Admins"},
+				{ID: "devops", Name: "DevOps"},
+			},
+		},
+		{
+			name:      "invalid token",
+			giveToken: "wrong",
+			wantErr:   true,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			var s SCIMClientSync
+			err := s.Config().Load(ctx, &gconfig.MapLoader{Values: map[string]string{
+				"baseUrl": server.URL + "/scim/v2/",
+				"token":   tc.giveToken,
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = s.Init(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotUsers, err := s.ListUsers(ctx)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			gotGroups, err := s.ListGroups(ctx)
+			assert.NoError(t, err)
+
+			assert.ElementsMatch(t, tc.wantUsers, gotUsers)
+			assert.ElementsMatch(t, tc.wantGroups, gotGroups)
+		})
+	}
+}
@@ -3,7 +3,11 @@ package identitysync
 import (
 	"context"
 	"errors"
+	"fmt"
+	"regexp"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/common-fate/analytics-go"
 	"github.com/common-fate/apikit/logger"
@@ -25,21 +29,37 @@ type IdentityProvider interface {
 }
 
 type IdentitySyncer struct {
-	db      ddb.Storage
-	idp     IdentityProvider
-	idpType string
+	db ddb.Storage
+	// sources are the identity providers which users and groups are synced from, in order of precedence.
+	sources []source
 	// used to prevent concurrent calls to sync
 	// prevents unexpected duplication of users and groups when used asyncronously
-	syncMutex   sync.Mutex
+	syncMutex sync.Mutex
+	// fullSyncInterval is how often every user and group is listed from identity providers which support listing changes.
+	fullSyncInterval time.Duration
+}
+
+// source is an identity provider which users and groups are synced from.
+type source struct {
+	idp         IdentityProvider
+	idpType     string
 	groupFilter string
 }
 
+// DefaultFullSyncInterval is used if SyncOpts.FullSyncInterval is not set.
+const DefaultFullSyncInterval = 24 * time.Hour
+
 type SyncOpts struct {
 	TableName           string
 	IdpType             string
 	UserPoolId          string
 	IdentityConfig      deploy.FeatureMap
 	IdentityGroupFilter string
+	// IdentitySources are synced in place of IdpType and IdentityGroupFilter if provided.
+	// Sources earlier in the list take precedence when a user exists in more than one identity provider.
+	IdentitySources []deploy.IdentitySource
+	// FullSyncInterval is how often a full sync is run for identity providers which can list only the changed users and groups.
+	FullSyncInterval time.Duration
 }
 
 func NewIdentitySyncer(ctx context.Context, opts SyncOpts) (*IdentitySyncer, error) {
@@ -48,13 +68,46 @@ func NewIdentitySyncer(ctx context.Context, opts SyncOpts) (*IdentitySyncer, err
 		return nil, err
 	}
 
-	idp, err := Registry().Lookup(opts.IdpType)
+	identitySources := opts.IdentitySources
+	if len(identitySources) == 0 {
+		identitySources = []deploy.IdentitySource{{Type: opts.IdpType, GroupFilter: opts.IdentityGroupFilter}}
+	}
+
+	var sources []source
+	seen := make(map[string]bool)
+	for _, is := range identitySources {
+		if seen[is.Type] {
+			return nil, fmt.Errorf("identity provider %s is configured more than once", is.Type)
+		}
+		seen[is.Type] = true
+
+		idp, err := loadIdentityProvider(ctx, is.Type, opts)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, source{idp: idp, idpType: is.Type, groupFilter: is.GroupFilter})
+	}
+
+	fullSyncInterval := opts.FullSyncInterval
+	if fullSyncInterval == 0 {
+		fullSyncInterval = DefaultFullSyncInterval
+	}
+	return &IdentitySyncer{
+		db:               db,
+		sources:          sources,
+		fullSyncInterval: fullSyncInterval,
+	}, nil
+}
+
+// loadIdentityProvider looks up the identity provider in the registry and loads its configuration.
+func loadIdentityProvider(ctx context.Context, idpType string, opts SyncOpts) (IdentityProvider, error) {
+	idp, err := Registry().Lookup(idpType)
 	if err != nil {
 		return nil, err
 	}
 	cfg := idp.IdentityProvider.Config()
 	var found bool
-	if opts.IdpType == IDPTypeCognito {
+	if idpType == IDPTypeCognito {
 		// Cognito has slightly different loading behaviour becauae it is the default provider
 		// config is provided directly via env vars when the stack is deployed, rather than via a cloudformation parameter
 		found = true
@@ -65,7 +118,7 @@ func NewIdentitySyncer(ctx context.Context, opts SyncOpts) (*IdentitySyncer, err
 			return nil, err
 		}
 	} else {
-		if idpCfg, ok := opts.IdentityConfig[opts.IdpType]; ok {
+		if idpCfg, ok := opts.IdentityConfig[idpType]; ok {
 			found = true
 			err = cfg.Load(ctx, &gconfig.MapLoader{Values: idpCfg})
 			if err != nil {
@@ -74,132 +127,320 @@ func NewIdentitySyncer(ctx context.Context, opts SyncOpts) (*IdentitySyncer, err
 		}
 	}
 	if !found {
-		return nil, errors.New("no matching configuration found for idp type")
+		return nil, fmt.Errorf("no matching configuration found for idp type %s", idpType)
 	}
 
 	err = idp.IdentityProvider.Init(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return &IdentitySyncer{
-		db:          db,
-		idp:         idp.IdentityProvider,
-		idpType:     opts.IdpType,
-		groupFilter: opts.IdentityGroupFilter,
-	}, nil
+	return idp.IdentityProvider, nil
+}
+
+// idpTypes returns the types of the identity providers which are synced, separated by commas.
+func (s *IdentitySyncer) idpTypes() string {
+	types := make([]string, len(s.sources))
+	for i, src := range s.sources {
+		types[i] = src.idpType
+	}
+	return strings.Join(types, ",")
 }
 
+// Sync updates the users and groups in the database from the identity providers.
+//
+// If there is a single identity provider which implements DeltaIdentityProvider, only the users and groups which changed since the
+// previous sync are listed and written, using the cursor saved by the previous sync.
+// A full sync is run if there is no cursor, if the cursor has expired, or if the last full sync is older than the full sync interval.
+//
+// When syncing from multiple identity providers a full sync is always run, as a user who is removed from one identity provider
+// may still exist in another.
 func (s *IdentitySyncer) Sync(ctx context.Context) error {
 	// prevent concurrent calls to sync
 	s.syncMutex.Lock()
 	defer s.syncMutex.Unlock()
 	log := logger.Get(ctx)
 
-	//Fetch all users from IDP
-	// The IDP should return the group mappings for users, these group IDs will be internal to the IDP
-	idpUsers, err := s.idp.ListUsers(ctx)
+	if len(s.sources) > 1 {
+		return s.fullSync(ctx)
+	}
+	src := s.sources[0]
+	dp, ok := src.idp.(DeltaIdentityProvider)
+	if !ok {
+		return s.fullSync(ctx)
+	}
+
+	now := time.Now()
+	sq := &storage.GetIdentitySyncState{IdpType: src.idpType}
+	_, err := s.db.Query(ctx, sq)
+	if err != nil && err != ddb.ErrNoItems {
+		return err
+	}
+	state := sq.Result
+	if state != nil && state.Cursor != "" && now.Sub(state.LastFullSyncAt) < s.fullSyncInterval {
+		err = s.deltaSync(ctx, src, dp, state)
+		if !errors.Is(err, ErrCursorExpired) {
+			return err
+		}
+		log.Infow("identity provider sync cursor has expired, running a full sync", "idp.type", src.idpType)
+	}
+
+	// the cursor is fetched before listing users and groups, so that changes made during the full sync are included in the next sync.
+	cursor, err := dp.Cursor(ctx)
 	if err != nil {
 		return err
 	}
-	// Fetch all groups from IDP
-	idpGroups, err := s.idp.ListGroups(ctx)
+	err = s.fullSync(ctx)
 	if err != nil {
 		return err
 	}
+	return s.db.Put(ctx, &identity.SyncState{
+		IdpType:        src.idpType,
+		Cursor:         cursor,
+		LastFullSyncAt: now,
+		UpdatedAt:      time.Now(),
+	})
+}
 
-	/*
+// deltaSync writes only the users and groups which changed since the cursor in state was saved.
+func (s *IdentitySyncer) deltaSync(ctx context.Context, src source, dp DeltaIdentityProvider, state *identity.SyncState) error {
+	log := logger.Get(ctx)
 
-		example regex filter: "admins|devops"
+	delta, err := dp.ListChanges(ctx, state.Cursor)
+	if err != nil {
+		return err
+	}
 
-		{{{ ORIGINAL INPUT }}}
+	var filter *regexp.Regexp
+	if src.groupFilter != "" {
+		filter, err = regexp.Compile(src.groupFilter)
+		if err != nil {
+			return err
+		}
+	}
 
-		groups
-		{name: "admins", id: "1"}
-		{name: "dev_ops", id: "2"}
-		{name: "accounting", id: "3"}
+	internalUsers, err := s.allUsers(ctx)
+	if err != nil {
+		return err
+	}
+	internalGroups, err := s.allGroups(ctx)
+	if err != nil {
+		return err
+	}
+	users, groups := applyDelta(src.idpType, *delta, internalUsers, internalGroups, filter)
 
-		users
-		{name: "bob", id: "1", groups: ["1", "2"]}  // grant access
-		{name: "alice", id: "2", groups: ["3"]}	// deny access
-		{name: "joe", id: "3", groups: ["1", "3"]} // grant access
-		{name: "jane", id: "4", groups: ["2"]} // grant access
+	log.Infow("fetched changed users and groups from IDP", "users.changed", len(users), "groups.changed", len(groups))
 
-		{{{ POST GROUP FILTER }}}
+	items := make([]ddb.Keyer, 0, len(users)+len(groups))
+	for i := range users {
+		items = append(items, &users[i])
+	}
+	for i := range groups {
+		items = append(items, &groups[i])
+	}
+	if len(items) > 0 {
+		err = s.db.PutBatch(ctx, items...)
+		if err != nil {
+			return err
+		}
+	}
 
-		groups
-		{name: "admins", id: "1"}
-		{name: "dev_ops", id: "2"}
+	// the cursor is only saved once the changes are written, so that they are listed again if writing them fails.
+	state.Cursor = delta.Cursor
+	state.UpdatedAt = time.Now()
+	return s.db.Put(ctx, state)
+}
 
-		users
-		{name: "bob", id: "1", groups: ["1", "2"]}  // grant access
-		{name: "alice", id: "2", groups: ["3"]}	// deny access
-		{name: "joe", id: "3", groups: ["1", "3"]} // grant access
-		{name: "jane", id: "4", groups: ["2"]} // grant access
+// fullSync lists every user and group from the identity providers and writes them to the database.
+func (s *IdentitySyncer) fullSync(ctx context.Context) error {
+	log := logger.Get(ctx)
 
-		{{{ POST processUsersAndGroups }}}
+	res, err := s.listAndProcess(ctx, s.sources)
+	if err != nil {
+		return err
+	}
 
-		groups
-		{name: "admins", id: "1"}
-		{name: "dev_ops", id: "2"}
+	s.setDeploymentInfo(ctx, log, depid.UserInfo{UserCount: res.idpUserCount, GroupCount: res.idpGroupCount, IDP: s.idpTypes()})
 
-		users
-		{name: "bob", id: "1", groups: ["1", "2"]}  // grant access
-		{name: "joe", id: "3", groups: ["1"]} // grant access
-		{name: "jane", id: "4", groups: ["2"]} // grant access
+	items := make([]ddb.Keyer, 0, len(res.users)+len(res.groups))
+	for _, v := range res.users {
+		vi := v
+		items = append(items, &vi)
+	}
+	for _, v := range res.groups {
+		vi := v
+		items = append(items, &vi)
+	}
 
+	return s.db.PutBatch(ctx, items...)
+}
 
-		SIDE EFFECTS
-		- users with no groups are removed
-		- only filtered groups show in the UI (loss of information; for better or worse)
+// processResult contains the users and groups in the database before and after processing the users and groups from the identity providers.
+type processResult struct {
+	idpUserCount   int
+	idpGroupCount  int
+	internalUsers  []identity.User
+	internalGroups []identity.Group
+	// users is keyed by email and groups is keyed by IdP ID, as returned by processUsersAndGroups.
+	users  map[string]identity.User
+	groups map[string]identity.Group
+}
 
-		CURRENT STATE
-		- users with no groups remain
-		- all groups show in the UI
+// listAndProcess lists every user and group from each source and processes them using the source's group filter, without writing to the database.
+func (s *IdentitySyncer) listAndProcess(ctx context.Context, sources []source) (*processResult, error) {
+	log := logger.Get(ctx)
 
-		APPROACH
-		To maintain current state and introduce new filtering,
-		We pass processUsersAndGroups an optional prop `useIdpGroupsAsFilter`. If true, then only users with groups that exist in the IDP will be returned, this is used conditionally with a regex filter that *prefilters any groups*. Side effects: users with no groups are removed, only filtered groups show in the UI
+	var res processResult
+	var listed []sourceUsersAndGroups
+	for _, src := range sources {
+		//Fetch all users from IDP
+		// The IDP should return the group mappings for users, these group IDs will be internal to the IDP
+		idpUsers, err := src.idp.ListUsers(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// Fetch all groups from IDP
+		idpGroups, err := src.idp.ListGroups(ctx)
+		if err != nil {
+			return nil, err
+		}
 
+		/*
 
-	*/
-	filter := s.groupFilter
-	useIdpGroupsAsFilter := filter != ""
+			example regex filter: "admins|devops"
 
-	if useIdpGroupsAsFilter {
-		// overwrite the existing groups with the filtered groups
-		log.Infow("filtering groups", "filter", filter)
-		idpGroups, err = FilterGroups(idpGroups, filter)
-		if err != nil {
-			return err
+			{{{ ORIGINAL INPUT }}}
+
+			groups
+			{name: "admins", id: "1"}
+			{name: "dev_ops", id: "2"}
+			{name: "accounting", id: "3"}
+
+			users
+			{name: "bob", id: "1", groups: ["1", "2"]}  // grant access
+			{name: "alice", id: "2", groups: ["3"]}	// deny access
+			{name: "joe", id: "3", groups: ["1", "3"]} // grant access
+			{name: "jane", id: "4", groups: ["2"]} // grant access
+
+			{{{ POST GROUP FILTER }}}
+
+			groups
+			{name: "admins", id: "1"}
+			{name: "dev_ops", id: "2"}
+
+			users
+			{name: "bob", id: "1", groups: ["1", "2"]}  // grant access
+			{name: "alice", id: "2", groups: ["3"]}	// deny access
+			{name: "joe", id: "3", groups: ["1", "3"]} // grant access
+			{name: "jane", id: "4", groups: ["2"]} // grant access
+
+			{{{ POST processUsersAndGroups }}}
+
+			groups
+			{name: "admins", id: "1"}
+			{name: "dev_ops", id: "2"}
+
+			users
+			{name: "bob", id: "1", groups: ["1", "2"]}  // grant access
+			{name: "joe", id: "3", groups: ["1"]} // grant access
+			{name: "jane", id: "4", groups: ["2"]} // grant access
+
+
+			SIDE EFFECTS
+			- users with no groups are removed
+			- only filtered groups show in the UI (loss of information; for better or worse)
+
+			CURRENT STATE
+			- users with no groups remain
+			- all groups show in the UI
+
+			APPROACH
+			To maintain current state and introduce new filtering,
+			We pass processUsersAndGroups an optional prop `useIdpGroupsAsFilter`. If true, then only users with groups that exist in the IDP will be returned, this is used conditionally with a regex filter that *prefilters any groups*. Side effects: users with no groups are removed, only filtered groups show in the UI
+
+
+		*/
+		filter := src.groupFilter
+		useIdpGroupsAsFilter := filter != ""
+
+		if useIdpGroupsAsFilter {
+			// overwrite the existing groups with the filtered groups
+			log.Infow("filtering groups", "filter", filter, "idp.type", src.idpType)
+			idpGroups, err = FilterGroups(idpGroups, filter)
+			if err != nil {
+				return nil, err
+			}
 		}
-	}
 
-	log.Infow("fetched users and groups from IDP", "users.count", len(idpUsers), "groups.count", len(idpGroups))
+		log.Infow("fetched users and groups from IDP", "idp.type", src.idpType, "users.count", len(idpUsers), "groups.count", len(idpGroups))
 
-	s.setDeploymentInfo(ctx, log, depid.UserInfo{UserCount: len(idpUsers), GroupCount: len(idpGroups), IDP: s.idpType})
+		res.idpUserCount += len(idpUsers)
+		res.idpGroupCount += len(idpGroups)
+		listed = append(listed, sourceUsersAndGroups{idpType: src.idpType, users: idpUsers, groups: idpGroups, useIdpGroupsAsFilter: useIdpGroupsAsFilter})
+	}
 
-	uq := &storage.ListUsers{}
-	_, err = s.db.Query(ctx, uq)
+	internalUsers, err := s.allUsers(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	gq := &storage.ListGroups{}
-	_, err = s.db.Query(ctx, gq)
+	internalGroups, err := s.allGroups(ctx)
 	if err != nil {
-		return err
-	}
-	usersMap, groupsMap := processUsersAndGroups(s.idpType, idpUsers, idpGroups, uq.Result, gq.Result, useIdpGroupsAsFilter)
-	items := make([]ddb.Keyer, 0, len(usersMap)+len(groupsMap))
-	for _, v := range usersMap {
-		vi := v
-		items = append(items, &vi)
+		return nil, err
 	}
-	for _, v := range groupsMap {
-		vi := v
-		items = append(items, &vi)
+	res.internalUsers = internalUsers
+	res.internalGroups = internalGroups
+	res.users, res.groups = processSources(listed, internalUsers, internalGroups)
+
+	return &res, nil
+}
+
+// allUsers lists every user in the database, following pagination.
+func (s *IdentitySyncer) allUsers(ctx context.Context) ([]identity.User, error) {
+	var users []identity.User
+	var next string
+	for {
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		q := storage.ListUsers{}
+		qr, err := s.db.Query(ctx, &q, opts...)
+		if err == ddb.ErrNoItems {
+			return users, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, q.Result...)
+		next = qr.NextPage
+		if next == "" {
+			return users, nil
+		}
 	}
+}
 
-	return s.db.PutBatch(ctx, items...)
+// allGroups lists every group in the database, following pagination.
+func (s *IdentitySyncer) allGroups(ctx context.Context) ([]identity.Group, error) {
+	var groups []identity.Group
+	var next string
+	for {
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		q := storage.ListGroups{}
+		qr, err := s.db.Query(ctx, &q, opts...)
+		if err == ddb.ErrNoItems {
+			return groups, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, q.Result...)
+		next = qr.NextPage
+		if next == "" {
+			return groups, nil
+		}
+	}
 }
 
 // analytics event
@@ -223,15 +464,69 @@ func (s *IdentitySyncer) setDeploymentInfo(ctx context.Context, log *zap.Sugared
 //
 // useIdpGroupsAsFilter == false: users with no groups remain, all groups show in the UI. If a user/group is removed from the IDP, it will be archived in the DB
 func processUsersAndGroups(idpType string, idpUsers []identity.IDPUser, idpGroups []identity.IDPGroup, internalUsers []identity.User, internalGroups []identity.Group, useIdpGroupsAsFilter bool) (map[string]identity.User, map[string]identity.Group) {
+	return processSources([]sourceUsersAndGroups{{idpType: idpType, users: idpUsers, groups: idpGroups, useIdpGroupsAsFilter: useIdpGroupsAsFilter}}, internalUsers, internalGroups)
+}
+
+// sourceUsersAndGroups are the users and groups listed from an identity provider.
+type sourceUsersAndGroups struct {
+	idpType              string
+	users                []identity.IDPUser
+	groups               []identity.IDPGroup
+	useIdpGroupsAsFilter bool
+}
+
+// processSources merges the users and groups from multiple identity providers, which are in order of precedence.
+//
+// Users are matched by email. If a user exists in more than one identity provider, their name is taken from the first
+// identity provider in the list, and they are added to their groups from every identity provider.
+// If the same group ID is listed by more than one identity provider, the group is owned by the first identity provider in the list.
+//
+// Each group is tagged with the identity provider which owns it, and groups are only archived if they no longer exist in
+// the identity provider which owns them. Groups owned by an identity provider which is no longer configured are archived.
+// Users are archived if they no longer exist in any identity provider.
+func processSources(sources []sourceUsersAndGroups, internalUsers []identity.User, internalGroups []identity.Group) (map[string]identity.User, map[string]identity.Group) {
+	sourceMap := make(map[string]sourceUsersAndGroups)
+	for _, src := range sources {
+		sourceMap[src.idpType] = src
+	}
 
 	idpGroupMap := make(map[string]identity.IDPGroup)
-	for _, g := range idpGroups {
-		idpGroupMap[g.ID] = g
+	// groupSource is the type of the identity provider which owns each group
+	groupSource := make(map[string]string)
+	for _, src := range sources {
+		for _, g := range src.groups {
+			if owner, ok := groupSource[g.ID]; ok && owner != src.idpType {
+				continue
+			}
+			idpGroupMap[g.ID] = g
+			groupSource[g.ID] = src.idpType
+		}
 	}
+
 	idpUserMap := make(map[string]identity.IDPUser)
-	for _, u := range idpUsers {
-		idpUserMap[u.Email] = u
+	// idpUserGroups is the IDs of the groups each user is in, across every identity provider
+	idpUserGroups := make(map[string]map[string]string)
+	// userFiltered is true if every identity provider which lists the user is using its groups as a filter
+	userFiltered := make(map[string]bool)
+	for _, src := range sources {
+		for _, u := range src.users {
+			if _, ok := idpUserMap[u.Email]; !ok {
+				idpUserMap[u.Email] = u
+				idpUserGroups[u.Email] = make(map[string]string)
+				userFiltered[u.Email] = true
+			}
+			userFiltered[u.Email] = userFiltered[u.Email] && src.useIdpGroupsAsFilter
+			for _, gid := range u.Groups {
+				// If we are using the IDP groups as a filter, then we only want to add the groups that exist in the IDP
+				if owner, ok := groupSource[gid]; src.useIdpGroupsAsFilter && (!ok || owner != src.idpType) {
+					// continue i.e. skip adding this group since it doesn't exist in the IDP
+					continue
+				}
+				idpUserGroups[u.Email][gid] = gid
+			}
+		}
 	}
+
 	ddbUserMap := make(map[string]identity.User)
 	for _, u := range internalUsers {
 		ddbUserMap[u.Email] = u
@@ -248,6 +543,7 @@ func processUsersAndGroups(idpType string, idpUsers []identity.IDPUser, idpGroup
 	for _, u := range idpUserMap {
 		//update
 		if existing, ok := ddbUserMap[u.Email]; ok {
+			existing.IdpID = u.ID
 			existing.FirstName = u.FirstName
 			existing.LastName = u.LastName
 			ddbUserMap[u.Email] = existing
@@ -257,16 +553,16 @@ func processUsersAndGroups(idpType string, idpUsers []identity.IDPUser, idpGroup
 		}
 	}
 	// update/create groups
-	for _, idpGroup := range idpGroups {
-		if existingGroup, ok := ddbGroupMap[idpGroup.ID]; ok { //update
+	for id, idpGroup := range idpGroupMap {
+		if existingGroup, ok := ddbGroupMap[id]; ok { //update
 			existingGroup.Description = idpGroup.Description
 			existingGroup.Name = idpGroup.Name
 			existingGroup.Status = types.IdpStatusACTIVE
-			existingGroup.Source = idpType
-			ddbGroupMap[idpGroup.ID] = existingGroup
+			existingGroup.Source = groupSource[id]
+			ddbGroupMap[id] = existingGroup
 		} else { // create
-			newGroup := idpGroup.ToInternalGroup(idpType)
-			ddbGroupMap[idpGroup.ID] = newGroup
+			newGroup := idpGroup.ToInternalGroup(groupSource[id])
+			ddbGroupMap[id] = newGroup
 			internalGroupUsers[newGroup.ID] = make(map[string]string)
 		}
 	}
@@ -274,6 +570,10 @@ func processUsersAndGroups(idpType string, idpUsers []identity.IDPUser, idpGroup
 	// archive deleted users
 	for k, u := range ddbUserMap {
 		if _, ok := idpUserMap[k]; !ok {
+			// users provisioned with SCIM are archived by the identity provider which created them.
+			if u.Source == identity.SCIM {
+				continue
+			}
 			u.Status = types.IdpStatusARCHIVED
 			// Remove all group associations from archived users
 			u.Groups = []string{}
@@ -285,15 +585,30 @@ func processUsersAndGroups(idpType string, idpUsers []identity.IDPUser, idpGroup
 	}
 	// archive deleted groups
 	for k, g := range ddbGroupMap {
+		if g.Source == identity.SCIM {
+			continue
+		}
 
-		if useIdpGroupsAsFilter {
-			if _, ok := idpGroupMap[g.ID]; !ok {
+		owner, ok := sourceMap[g.Source]
+		if !ok {
+			// groups created in Common Fate are kept unless the internal identity provider is configured
+			if g.Source == identity.INTERNAL {
+				continue
+			}
+			// the identity provider which synced this group has been removed from the configuration,
+			// so it will never be listed again
+			g.Status = types.IdpStatusARCHIVED
+			g.Users = []string{}
+			ddbGroupMap[k] = g
+			continue
+		}
 
+		if owner.useIdpGroupsAsFilter {
+			if _, ok := idpGroupMap[g.ID]; !ok {
 				g.Status = types.IdpStatusARCHIVED
 				g.Users = []string{}
 				ddbGroupMap[k] = g
-
-				continue // not covered by tests
+				continue
 			}
 		}
 
@@ -309,22 +624,13 @@ func processUsersAndGroups(idpType string, idpUsers []identity.IDPUser, idpGroup
 		}
 	}
 
-	for _, idpUser := range idpUserMap {
+	for email := range idpUserMap {
 
 		// This map ensures we have a distinct list of ids
 		internalGroupIds := map[string]string{}
-		for _, gid := range idpUser.Groups {
-
-			// If we are using the IDP groups as a filter, then we only want to add the groups that exist in the IDP
-			if useIdpGroupsAsFilter {
-				if _, ok := idpGroupMap[gid]; !ok {
-					// continue i.e. skip adding this group since it doesn't exist in the IDP
-					continue
-				}
-			}
-
+		uid := ddbUserMap[email].ID
+		for gid := range idpUserGroups[email] {
 			internalGroupIds[gid] = gid
-			uid := ddbUserMap[idpUser.Email].ID
 
 			// prevents a panic due to an assignment to a nil map
 			if internalGroupUsers[gid] == nil {
@@ -334,7 +640,7 @@ func processUsersAndGroups(idpType string, idpUsers []identity.IDPUser, idpGroup
 			internalGroupUsers[gid][uid] = uid
 		}
 
-		internalUser := ddbUserMap[idpUser.Email]
+		internalUser := ddbUserMap[email]
 
 		//make sure we are saving the internal groups that the user is apart of
 		// for every internal user group
@@ -345,10 +651,10 @@ func processUsersAndGroups(idpType string, idpUsers []identity.IDPUser, idpGroup
 			// if the group is internal, add it to the list of groups
 
 			source := ddbGroupMap[internalGroupId].Source
-			// if the group is internal, add it to the list of groups
-			if source == identity.INTERNAL {
-				gid := ddbGroupMap[internalGroupId].ID // not covered by tests
-				internalGroupIds[gid] = gid            // not covered by tests
+			// if the group is internal or managed with SCIM, add it to the list of groups
+			if source == identity.INTERNAL || source == identity.SCIM {
+				gid := ddbGroupMap[internalGroupId].ID
+				internalGroupIds[gid] = gid
 			}
 		}
 
@@ -359,15 +665,15 @@ func processUsersAndGroups(idpType string, idpUsers []identity.IDPUser, idpGroup
 
 		internalUser.Groups = groupKeys
 		// if the user is not in any groups, archive them
-		if len(internalUser.Groups) == 0 && useIdpGroupsAsFilter {
+		if len(internalUser.Groups) == 0 && userFiltered[email] {
 			internalUser.Status = types.IdpStatusARCHIVED
 		}
-		ddbUserMap[idpUser.Email] = internalUser
+		ddbUserMap[email] = internalUser
 	}
 
 	// Updates the internal groups with new user mappings
 	for k, v := range ddbGroupMap {
-		if v.Source != identity.INTERNAL {
+		if v.Source != identity.INTERNAL && v.Source != identity.SCIM {
 			um := internalGroupUsers[v.ID]
 			keys := make([]string, 0, len(um))
 			for k2 := range um {
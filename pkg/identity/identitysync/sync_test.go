@@ -1,12 +1,16 @@
 package identitysync
 
 import (
+	"context"
 	"sort"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -51,6 +55,7 @@ func TestIdentitySyncProcessor(t *testing.T) {
 			wantUserMap: map[string]identity.User{
 				"josh@test.go": {
 					ID:        "_",
+					IdpID:     "user1",
 					FirstName: "josh",
 					LastName:  "wilkes",
 					Email:     "josh@test.go",
@@ -190,6 +195,7 @@ func TestIdentitySyncProcessor(t *testing.T) {
 				},
 				"larry@test.go": {
 					ID:        "efgh",
+					IdpID:     "user2",
 					FirstName: "larry",
 					LastName:  "browner",
 					Email:     "larry@test.go",
@@ -300,6 +306,7 @@ func TestIdentitySyncProcessor(t *testing.T) {
 			wantUserMap: map[string]identity.User{
 				"josh@test.go": {
 					ID:        "user1",
+					IdpID:     "user1",
 					FirstName: "josh",
 					LastName:  "wilkes",
 					Email:     "josh@test.go",
@@ -415,6 +422,7 @@ func TestIdentitySyncProcessor(t *testing.T) {
 			wantUserMap: map[string]identity.User{
 				"bob@mail.com": {
 					ID:        "user1",
+					IdpID:     "user1",
 					FirstName: "bob",
 					Email:     "bob@mail.com",
 					Groups: []string{
@@ -425,6 +433,7 @@ func TestIdentitySyncProcessor(t *testing.T) {
 				},
 				"joe@mail.com": {
 					ID:        "user3",
+					IdpID:     "user3",
 					FirstName: "joe",
 					Email:     "joe@mail.com",
 					Groups:    []string{"admins"},
@@ -432,6 +441,7 @@ func TestIdentitySyncProcessor(t *testing.T) {
 				},
 				"alice@mail.com": {
 					ID:        "user3",
+					IdpID:     "user2",
 					FirstName: "alice",
 					Email:     "alice@mail.com",
 					Groups:    []string{},
@@ -502,6 +512,7 @@ func TestIdentitySyncProcessor(t *testing.T) {
 			wantUserMap: map[string]identity.User{
 				"bob@mail.com": {
 					ID:        "user1",
+					IdpID:     "user1",
 					FirstName: "bob",
 					Email:     "bob@mail.com",
 					Groups: []string{
@@ -571,6 +582,7 @@ func TestIdentitySyncProcessor(t *testing.T) {
 			wantUserMap: map[string]identity.User{
 				"bob@mail.com": {
 					ID:        "user1",
+					IdpID:     "user1",
 					FirstName: "bob",
 					Email:     "bob@mail.com",
 					Groups: []string{
@@ -651,6 +663,7 @@ func TestIdentitySyncProcessor(t *testing.T) {
 			wantUserMap: map[string]identity.User{
 				"bob@mail.com": {
 					ID:        "user1",
+					IdpID:     "user1",
 					FirstName: "bob",
 					Email:     "bob@mail.com",
 					Groups:    []string{},
@@ -680,6 +693,81 @@ func TestIdentitySyncProcessor(t *testing.T) {
 			withIdpType:          identity.INTERNAL,
 			useIdpGroupsAsFilter: true,
 		},
+		{
+			name: "users and groups provisioned with SCIM are not modified",
+			giveIdpUsers: []identity.IDPUser{
+				{
+					ID:     "user1",
+					Email:  "bob@mail.com",
+					Groups: []string{"admins"},
+				},
+			},
+			giveIdpGroups: []identity.IDPGroup{
+				{
+					ID:   "admins",
+					Name: "admins",
+				},
+			},
+			giveInternalUsers: []identity.User{
+				{
+					ID:     "user1",
+					Email:  "bob@mail.com",
+					Groups: []string{"grp_scim"},
+					Status: types.IdpStatusACTIVE,
+				},
+				{
+					ID:     "user2",
+					Email:  "alice@mail.com",
+					Groups: []string{"grp_scim"},
+					Status: types.IdpStatusACTIVE,
+					Source: identity.SCIM,
+				},
+			},
+			giveInternalGroups: []identity.Group{
+				{
+					ID:     "grp_scim",
+					IdpID:  "grp_scim",
+					Name:   "engineering",
+					Status: types.IdpStatusACTIVE,
+					Users:  []string{"user1", "user2"},
+					Source: identity.SCIM,
+				},
+			},
+			wantUserMap: map[string]identity.User{
+				"bob@mail.com": {
+					ID:     "user1",
+					IdpID:  "user1",
+					Email:  "bob@mail.com",
+					Groups: []string{"admins", "grp_scim"},
+					Status: types.IdpStatusACTIVE,
+				},
+				"alice@mail.com": {
+					ID:     "user2",
+					Email:  "alice@mail.com",
+					Groups: []string{"grp_scim"},
+					Status: types.IdpStatusACTIVE,
+					Source: identity.SCIM,
+				},
+			},
+			wantGroupMap: map[string]identity.Group{
+				"admins": {
+					IdpID:  "admins",
+					Name:   "admins",
+					Status: types.IdpStatusACTIVE,
+					Users:  []string{"user1"},
+					Source: "okta",
+				},
+				"grp_scim": {
+					ID:     "grp_scim",
+					IdpID:  "grp_scim",
+					Name:   "engineering",
+					Status: types.IdpStatusACTIVE,
+					Users:  []string{"user1", "user2"},
+					Source: identity.SCIM,
+				},
+			},
+			withIdpType: "okta",
+		},
 	}
 	for i := range testcases {
 		tc := testcases[i]
@@ -733,3 +821,196 @@ func TestIdentitySyncProcessor(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessSources(t *testing.T) {
+	type testcase struct {
+		name               string
+		giveSources        []sourceUsersAndGroups
+		giveInternalUsers  []identity.User
+		giveInternalGroups []identity.Group
+		wantUserMap        map[string]identity.User
+		wantGroupMap       map[string]identity.Group
+	}
+
+	testcases := []testcase{
+		{
+			name: "users are merged by email using the first source",
+			giveSources: []sourceUsersAndGroups{
+				{idpType: "okta", users: []identity.IDPUser{{ID: "okta1", FirstName: "Josh", Email: "josh@test.go", Groups: []string{"admins"}}}, groups: []identity.IDPGroup{{ID: "admins", Name: "admins"}}},
+				{idpType: "azure", users: []identity.IDPUser{{ID: "azure1", FirstName: "joshua", Email: "josh@test.go", Groups: []string{"devops"}}}, groups: []identity.IDPGroup{{ID: "devops", Name: "devops"}}},
+			},
+			giveInternalUsers: []identity.User{
+				{ID: "usr_1", IdpID: "azure1", FirstName: "joshua", Email: "josh@test.go", Status: types.IdpStatusACTIVE},
+			},
+			wantUserMap: map[string]identity.User{
+				"josh@test.go": {ID: "usr_1", IdpID: "okta1", FirstName: "Josh", Email: "josh@test.go", Groups: []string{"admins", "devops"}, Status: types.IdpStatusACTIVE},
+			},
+			wantGroupMap: map[string]identity.Group{
+				"admins": {ID: "admins", IdpID: "admins", Name: "admins", Users: []string{"usr_1"}, Status: types.IdpStatusACTIVE, Source: "okta"},
+				"devops": {ID: "devops", IdpID: "devops", Name: "devops", Users: []string{"usr_1"}, Status: types.IdpStatusACTIVE, Source: "azure"},
+			},
+		},
+		{
+			name: "group listed by multiple sources is owned by the first source",
+			giveSources: []sourceUsersAndGroups{
+				{idpType: "okta", groups: []identity.IDPGroup{{ID: "admins", Name: "okta admins"}}},
+				{idpType: "azure", groups: []identity.IDPGroup{{ID: "admins", Name: "azure admins"}}},
+			},
+			giveInternalGroups: []identity.Group{
+				{ID: "admins", IdpID: "admins", Name: "azure admins", Status: types.IdpStatusACTIVE, Source: "azure"},
+			},
+			wantUserMap: map[string]identity.User{},
+			wantGroupMap: map[string]identity.Group{
+				"admins": {ID: "admins", IdpID: "admins", Name: "okta admins", Users: []string{}, Status: types.IdpStatusACTIVE, Source: "okta"},
+			},
+		},
+		{
+			name: "groups are only archived by the source which owns them",
+			giveSources: []sourceUsersAndGroups{
+				{idpType: "okta", users: []identity.IDPUser{{ID: "okta1", Email: "josh@test.go"}}},
+				// the azure group filter doesn't match the okta group, which must not be archived
+				{idpType: "azure", users: []identity.IDPUser{{ID: "azure1", Email: "josh@test.go", Groups: []string{"devops"}}}, groups: []identity.IDPGroup{{ID: "devops", Name: "devops"}}, useIdpGroupsAsFilter: true},
+			},
+			giveInternalUsers: []identity.User{
+				{ID: "usr_1", IdpID: "okta1", Email: "josh@test.go", Groups: []string{"admins", "devops"}, Status: types.IdpStatusACTIVE},
+			},
+			giveInternalGroups: []identity.Group{
+				{ID: "admins", IdpID: "admins", Name: "admins", Users: []string{"usr_1"}, Status: types.IdpStatusACTIVE, Source: "okta"},
+				{ID: "devops", IdpID: "devops", Name: "devops", Users: []string{"usr_1"}, Status: types.IdpStatusACTIVE, Source: "azure"},
+				{ID: "accounting", IdpID: "accounting", Name: "accounting", Users: []string{}, Status: types.IdpStatusACTIVE, Source: "azure"},
+			},
+			wantUserMap: map[string]identity.User{
+				"josh@test.go": {ID: "usr_1", IdpID: "okta1", Email: "josh@test.go", Groups: []string{"devops"}, Status: types.IdpStatusACTIVE},
+			},
+			wantGroupMap: map[string]identity.Group{
+				"admins":     {ID: "admins", IdpID: "admins", Name: "admins", Users: []string{}, Status: types.IdpStatusARCHIVED, Source: "okta"},
+				"devops":     {ID: "devops", IdpID: "devops", Name: "devops", Users: []string{"usr_1"}, Status: types.IdpStatusACTIVE, Source: "azure"},
+				"accounting": {ID: "accounting", IdpID: "accounting", Name: "accounting", Users: []string{}, Status: types.IdpStatusARCHIVED, Source: "azure"},
+			},
+		},
+		{
+			name: "groups from a source which is no longer configured are archived",
+			giveSources: []sourceUsersAndGroups{
+				{idpType: "okta", users: []identity.IDPUser{{ID: "okta1", Email: "josh@test.go", Groups: []string{"admins"}}}, groups: []identity.IDPGroup{{ID: "admins", Name: "admins"}}, useIdpGroupsAsFilter: true},
+			},
+			giveInternalUsers: []identity.User{
+				{ID: "usr_1", IdpID: "okta1", Email: "josh@test.go", Groups: []string{"admins", "devops", "grp_internal"}, Status: types.IdpStatusACTIVE},
+			},
+			giveInternalGroups: []identity.Group{
+				{ID: "admins", IdpID: "admins", Name: "admins", Users: []string{"usr_1"}, Status: types.IdpStatusACTIVE, Source: "okta"},
+				// azure was removed from the identity settings
+				{ID: "devops", IdpID: "devops", Name: "devops", Users: []string{"usr_1"}, Status: types.IdpStatusACTIVE, Source: "azure"},
+				// groups created in Common Fate are not archived by the okta group filter
+				{ID: "grp_internal", IdpID: "grp_internal", Name: "internal", Users: []string{"usr_1"}, Status: types.IdpStatusACTIVE, Source: identity.INTERNAL},
+			},
+			wantUserMap: map[string]identity.User{
+				"josh@test.go": {ID: "usr_1", IdpID: "okta1", Email: "josh@test.go", Groups: []string{"admins", "grp_internal"}, Status: types.IdpStatusACTIVE},
+			},
+			wantGroupMap: map[string]identity.Group{
+				"admins":       {ID: "admins", IdpID: "admins", Name: "admins", Users: []string{"usr_1"}, Status: types.IdpStatusACTIVE, Source: "okta"},
+				"devops":       {ID: "devops", IdpID: "devops", Name: "devops", Users: []string{}, Status: types.IdpStatusARCHIVED, Source: "azure"},
+				"grp_internal": {ID: "grp_internal", IdpID: "grp_internal", Name: "internal", Users: []string{"usr_1"}, Status: types.IdpStatusACTIVE, Source: identity.INTERNAL},
+			},
+		},
+		{
+			name: "user is archived only if they are removed from every source",
+			giveSources: []sourceUsersAndGroups{
+				{idpType: "okta", users: []identity.IDPUser{{ID: "okta2", Email: "chris@test.go"}}},
+				{idpType: "azure", users: []identity.IDPUser{{ID: "azure1", Email: "josh@test.go"}}},
+			},
+			giveInternalUsers: []identity.User{
+				{ID: "usr_1", IdpID: "okta1", Email: "josh@test.go", Status: types.IdpStatusACTIVE},
+				{ID: "usr_2", IdpID: "okta2", Email: "chris@test.go", Status: types.IdpStatusACTIVE},
+				{ID: "usr_3", IdpID: "okta3", Email: "jane@test.go", Status: types.IdpStatusACTIVE},
+			},
+			wantUserMap: map[string]identity.User{
+				"josh@test.go":  {ID: "usr_1", IdpID: "azure1", Email: "josh@test.go", Groups: []string{}, Status: types.IdpStatusACTIVE},
+				"chris@test.go": {ID: "usr_2", IdpID: "okta2", Email: "chris@test.go", Groups: []string{}, Status: types.IdpStatusACTIVE},
+				"jane@test.go":  {ID: "usr_3", IdpID: "okta3", Email: "jane@test.go", Groups: []string{}, Status: types.IdpStatusARCHIVED},
+			},
+			wantGroupMap: map[string]identity.Group{},
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			gotUsers, gotGroups := processSources(tc.giveSources, tc.giveInternalUsers, tc.giveInternalGroups)
+
+			for k, u := range gotUsers {
+				// created and updated times are set when a user is created
+				u.CreatedAt = tc.wantUserMap[k].CreatedAt
+				u.UpdatedAt = tc.wantUserMap[k].UpdatedAt
+				sort.Strings(u.Groups)
+				gotUsers[k] = u
+			}
+			for k, g := range gotGroups {
+				g.CreatedAt = tc.wantGroupMap[k].CreatedAt
+				g.UpdatedAt = tc.wantGroupMap[k].UpdatedAt
+				sort.Strings(g.Users)
+				gotGroups[k] = g
+			}
+
+			assert.Equal(t, tc.wantUserMap, gotUsers)
+			assert.Equal(t, tc.wantGroupMap, gotGroups)
+		})
+	}
+}
+
+// pagedDB returns users and groups from the database one page at a time.
+type pagedDB struct {
+	ddb.Storage
+	users  [][]identity.User
+	groups [][]identity.Group
+}
+
+func (db *pagedDB) Query(ctx context.Context, qb ddb.QueryBuilder, opts ...func(*ddb.QueryOpts)) (*ddb.QueryResult, error) {
+	var o ddb.QueryOpts
+	for _, opt := range opts {
+		opt(&o)
+	}
+	page := 0
+	if o.PageToken != "" {
+		page, _ = strconv.Atoi(o.PageToken)
+	}
+	var pages int
+	switch q := qb.(type) {
+	case *storage.ListUsers:
+		q.Result = db.users[page]
+		pages = len(db.users)
+	case *storage.ListGroups:
+		q.Result = db.groups[page]
+		pages = len(db.groups)
+	default:
+		return db.Storage.Query(ctx, qb, opts...)
+	}
+	var res ddb.QueryResult
+	if page+1 < pages {
+		res.NextPage = strconv.Itoa(page + 1)
+	}
+	return &res, nil
+}
+
+func TestAllUsersAndGroups(t *testing.T) {
+	db := &pagedDB{
+		users: [][]identity.User{
+			{{ID: "1", Email: "a@example.com"}},
+			{{ID: "2", Email: "b@example.com"}, {ID: "3", Email: "c@example.com"}},
+		},
+		groups: [][]identity.Group{
+			{{ID: "a", Name: "a"}},
+			{{ID: "b", Name: "b"}},
+		},
+	}
+	s := IdentitySyncer{db: db}
+	ctx := context.Background()
+
+	users, err := s.allUsers(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []identity.User{{ID: "1", Email: "a@example.com"}, {ID: "2", Email: "b@example.com"}, {ID: "3", Email: "c@example.com"}}, users)
+
+	groups, err := s.allGroups(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []identity.Group{{ID: "a", Name: "a"}, {ID: "b", Name: "b"}}, groups)
+}
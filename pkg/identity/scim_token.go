@@ -0,0 +1,52 @@
+package identity
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/ddb"
+)
+
+// SCIMToken authenticates identity providers which push users and groups to Common Fate using SCIM.
+// Only a hash of the token is stored, so the token can't be viewed after it's created.
+type SCIMToken struct {
+	TokenHash string `json:"tokenHash" dynamodbav:"tokenHash"`
+	// CreatedBy is the ID of the administrator who created the token.
+	CreatedBy string    `json:"createdBy" dynamodbav:"createdBy"`
+	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
+}
+
+// NewSCIMToken generates a random token. The returned token should be shown to the administrator
+// and the SCIMToken saved in place of any existing token.
+func NewSCIMToken(createdBy string, now time.Time) (string, SCIMToken, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", SCIMToken{}, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return token, SCIMToken{TokenHash: hashSCIMToken(token), CreatedBy: createdBy, CreatedAt: now}, nil
+}
+
+// Matches is true if token is the token which was generated for this SCIMToken.
+func (t SCIMToken) Matches(token string) bool {
+	return subtle.ConstantTimeCompare([]byte(t.TokenHash), []byte(hashSCIMToken(token))) == 1
+}
+
+func hashSCIMToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+func (t *SCIMToken) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.SCIMToken.PK1,
+		SK: keys.SCIMToken.SK1,
+	}
+	return keys, nil
+}
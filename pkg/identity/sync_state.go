@@ -0,0 +1,27 @@
+package identity
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/ddb"
+)
+
+// SyncState is saved by the identity sync between runs, so that identity providers
+// which support it only need to list the users and groups which changed since the previous sync.
+type SyncState struct {
+	IdpType string `json:"idpType" dynamodbav:"idpType"`
+	// Cursor is an opaque value returned by the identity provider, which is passed to it on the next sync.
+	Cursor string `json:"cursor" dynamodbav:"cursor"`
+	// LastFullSyncAt is when every user and group was last listed from the identity provider.
+	LastFullSyncAt time.Time `json:"lastFullSyncAt" dynamodbav:"lastFullSyncAt"`
+	UpdatedAt      time.Time `json:"updatedAt" dynamodbav:"updatedAt"`
+}
+
+func (s *SyncState) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.IdentitySyncState.PK1,
+		SK: keys.IdentitySyncState.SK1(s.IdpType),
+	}
+	return keys, nil
+}
@@ -23,6 +23,7 @@ func (u IDPUser) ToInternalUser() User {
 	now := time.Now()
 	return User{
 		ID:        types.NewUserID(),
+		IdpID:     u.ID,
 		FirstName: u.FirstName,
 		LastName:  u.LastName,
 		Email:     u.Email,
@@ -35,6 +36,8 @@ func (u IDPUser) ToInternalUser() User {
 type User struct {
 	// internal id of the user
 	ID string `json:"id" dynamodbav:"id"`
+	// IdpID is the ID of the user in the identity provider they were synced from.
+	IdpID string `json:"idpId,omitempty" dynamodbav:"idpId,omitempty"`
 
 	FirstName string   `json:"firstName" dynamodbav:"firstName"`
 	LastName  string   `json:"lastName" dynamodbav:"lastName"`
@@ -42,6 +45,8 @@ type User struct {
 	Groups    []string `json:"groups" dynamodbav:"groups"`
 
 	Status types.IdpStatus `json:"status" dynamodbav:"status"`
+	// Source is identity.SCIM if the user was provisioned using SCIM, otherwise it is empty.
+	Source string `json:"source,omitempty" dynamodbav:"source,omitempty"`
 
 	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt" dynamodbav:"updatedAt"`
@@ -93,6 +93,14 @@ func BuildRequestReviewMessage(o RequestMessageOpts) (summary string, msg slack.
 		})
 	}
 
+	// Show the progress of each approval stage for rules which require more than a single approval.
+	if len(o.Request.ApprovalStages) > 1 || (len(o.Request.ApprovalStages) == 1 && o.Request.ApprovalStages[0].Quorum > 1) {
+		requestDetails = append(requestDetails, &slack.TextBlockObject{
+			Type: "mrkdwn",
+			Text: fmt.Sprintf("*Approvals:*\n%s", approvalStagesText(o.Request.ApprovalStages)),
+		})
+	}
+
 	//If a tagged user is specified then add it to the message.
 	if o.MentionUser != "" {
 		requestDetails = append(requestDetails, &slack.TextBlockObject{
@@ -123,16 +131,24 @@ func BuildRequestReviewMessage(o RequestMessageOpts) (summary string, msg slack.
 		},
 	)
 
-	if o.WasReviewed || o.Request.Status == access.CANCELLED {
+	if o.WasReviewed || o.Request.Status == access.CANCELLED || o.Request.Status == access.EXPIRED {
 		t := time.Now()
 		when := types.ExpiryString(t)
 
 		text := fmt.Sprintf("*Reviewed by* %s at %s", o.RequestReviewer.Email, when)
 
+		if o.Request.Status == access.PENDING {
+			text = fmt.Sprintf("*Approved by* %s at %s, awaiting further approvals", o.RequestReviewer.Email, when)
+		}
+
 		if o.Request.Status == access.CANCELLED {
 			text = fmt.Sprintf("*Cancelled by* %s at %s", o.RequestorEmail, when)
 		}
 
+		if o.Request.Status == access.EXPIRED {
+			text = fmt.Sprintf("*Expired* at %s without being reviewed", when)
+		}
+
 		reviewContextBlock := slack.NewContextBlock("", slack.TextBlockObject{
 			Type: slack.MarkdownType,
 			Text: text,
@@ -167,6 +183,32 @@ func BuildRequestReviewMessage(o RequestMessageOpts) (summary string, msg slack.
 	return summary, msg
 }
 
+// approvalStagesText renders a line for each approval stage, showing the number of approvals received.
+func approvalStagesText(stages []access.ApprovalStage) string {
+	var lines []string
+	for _, stage := range stages {
+		icon := ":hourglass_flowing_sand:"
+		if stage.Complete() {
+			icon = ":white_check_mark:"
+		}
+		lines = append(lines, fmt.Sprintf("%s %s (%d/%d)", icon, stage.Name, len(stage.ApprovedBy), stage.Quorum))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// slackEscaper escapes the characters which Slack uses for formatting links and mentions.
+// See https://api.slack.com/reference/surfaces/formatting#escaping
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// quoteText escapes user provided text, such as a comment, and renders every line of it as a quote.
+func quoteText(text string) string {
+	lines := strings.Split(slackEscaper.Replace(text), "\n")
+	for i, line := range lines {
+		lines[i] = ">" + line
+	}
+	return strings.Join(lines, "\n")
+}
+
 type RequestDetailMessageOpts struct {
 	Request          access.Request
 	RequestArguments []types.With
@@ -232,3 +274,76 @@ func BuildRequestDetailMessage(o RequestDetailMessageOpts) (summary string, msg
 
 	return summary, msg
 }
+
+type BreakGlassMessageOpts struct {
+	Request          access.Request
+	RequestArguments []types.With
+	Rule             rule.AccessRule
+	ReviewURLs       notifiers.ReviewURLs
+	RequestorEmail   string
+	IsWebhook        bool
+}
+
+// BuildBreakGlassMessage builds a high-priority message to alert approvers that a user has used break-glass access.
+// Access has already been granted, so the message links to the request for the approver to review it after the fact.
+func BuildBreakGlassMessage(o BreakGlassMessageOpts) (summary string, msg slack.Message) {
+	summary = fmt.Sprintf("%s used break-glass access to %s", o.RequestorEmail, o.Rule.Name)
+
+	heading := fmt.Sprintf(":rotating_light: *%s used break-glass access to <%s|%s>*", o.RequestorEmail, o.ReviewURLs.Review, o.Rule.Name)
+	// notify everyone active in webhook channels, as break-glass access requires prompt review.
+	if o.IsWebhook {
+		heading = "<!here> " + heading
+	}
+
+	requestDetails := []*slack.TextBlockObject{
+		{
+			Type: "mrkdwn",
+			Text: fmt.Sprintf("*Duration:*\n%s", o.Request.RequestedTiming.Duration),
+		},
+	}
+
+	for _, v := range o.RequestArguments {
+		requestDetails = append(requestDetails, &slack.TextBlockObject{
+			Type: "mrkdwn",
+			Text: fmt.Sprintf("*%s:*\n%s", v.Title, v.Label),
+		})
+	}
+
+	if o.Request.Data.Reason != nil {
+		requestDetails = append(requestDetails, &slack.TextBlockObject{
+			Type: "mrkdwn",
+			Text: fmt.Sprintf("*Request Reason:*\n%s", *o.Request.Data.Reason),
+		})
+	}
+
+	msg = slack.NewBlockMessage(
+		slack.NewHeaderBlock(slack.NewTextBlockObject(slack.PlainTextType, ":rotating_light: Break-glass access granted", true, false)),
+		slack.SectionBlock{
+			Type: slack.MBTSection,
+			Text: &slack.TextBlockObject{
+				Type: slack.MarkdownType,
+				Text: heading,
+			},
+		},
+		slack.SectionBlock{
+			Type:   slack.MBTSection,
+			Fields: requestDetails,
+		},
+		slack.NewContextBlock("", slack.TextBlockObject{
+			Type: slack.MarkdownType,
+			Text: "Approval was skipped for this request. Please review the access.",
+		}),
+		slack.NewActionBlock("break_glass_actions",
+			slack.ButtonBlockElement{
+				Type:     slack.METButton,
+				Text:     &slack.TextBlockObject{Type: slack.PlainTextType, Text: "Review Access"},
+				Style:    slack.StyleDanger,
+				ActionID: "review",
+				Value:    "review",
+				URL:      o.ReviewURLs.Review,
+			},
+		),
+	)
+
+	return summary, msg
+}
@@ -137,3 +137,17 @@ func TestBuildRequestMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestApprovalStagesText(t *testing.T) {
+	stages := []access.ApprovalStage{
+		{Name: "Team lead", Quorum: 1, ApprovedBy: []string{"usr_1"}},
+		{Name: "Security", Quorum: 2, ApprovedBy: []string{"usr_2"}},
+	}
+	got := approvalStagesText(stages)
+	assert.Equal(t, ":white_check_mark: Team lead (1/1)\n:hourglass_flowing_sand: Security (1/2)", got)
+}
+
+func TestQuoteText(t *testing.T) {
+	got := quoteText("can you check <@U123>?\nfoo & <https://example.com|bar>")
+	assert.Equal(t, ">can you check &lt;@U123&gt;?\n>foo &amp; &lt;https://example.com|bar&gt;", got)
+}
@@ -13,6 +13,11 @@ import (
 )
 
 func (n *SlackNotifier) HandleGrantEvent(ctx context.Context, log *zap.SugaredLogger, event events.CloudWatchEvent) error {
+	if event.DetailType == gevent.GrantDriftType {
+		// drift doesn't always relate to a request, and is recorded in the request audit log instead.
+		log.Infow("ignoring grant drift event")
+		return nil
+	}
 
 	var grantEvent gevent.GrantEventPayload
 	err := json.Unmarshal(event.Detail, &grantEvent)
@@ -51,7 +51,9 @@ func (n *SlackNotifier) HandleRequestEvent(ctx context.Context, log *zap.Sugared
 		// only send slack notification if access request requires approval.
 		// if access request was automatically approved then no slack notification is sent.
 		// this is done to reduce slack notification noise. More here: CF-831
-		if !requestedRule.Approval.IsRequired() {
+		// break-glass requests are notified separately when access is granted.
+		approval := request.ResolveApproval(requestedRule)
+		if !approval.IsRequired() || request.Status != access.PENDING {
 			return nil
 		}
 
@@ -206,8 +208,177 @@ func (n *SlackNotifier) HandleRequestEvent(ctx context.Context, log *zap.Sugared
 		fallback := fmt.Sprintf("Your request to access %s has been approved.", requestedRule.Name)
 		n.sendRequestDetailsMessage(ctx, log, request, requestedRule, *requestingUserQuery.Result, msg, fallback)
 		n.SendUpdatesForRequest(ctx, log, request, requestEvent, requestedRule, requestingUserQuery.Result)
+	case gevent.RequestStageApprovedType:
+		// the request still requires further approvals, so the reviewer messages are updated to show the stage progress.
+		n.SendUpdatesForRequest(ctx, log, request, requestEvent, requestedRule, requestingUserQuery.Result)
+	case gevent.RequestExtensionRequestedType:
+		if request.PendingExtension == nil {
+			return nil
+		}
+		reviewURL, err := notifiers.ReviewURL(n.FrontendURL, request.ID)
+		if err != nil {
+			return errors.Wrap(err, "building review URL")
+		}
+		reviewers := storage.ListRequestReviewers{RequestID: request.ID}
+		_, err = n.DB.Query(ctx, &reviewers)
+		if err != nil && err != ddb.ErrNoItems {
+			return errors.Wrap(err, "getting reviewers")
+		}
+		msg := fmt.Sprintf("%s has asked to extend their access to *%s* by %s. <%s|Review the extension>", requestingUser.Email, requestedRule.Name, request.PendingExtension.Duration, reviewURL.Review)
+		fallback := fmt.Sprintf("%s has asked to extend their access to %s", requestingUser.Email, requestedRule.Name)
+		for _, r := range reviewers.Result {
+			if r.ReviewerID == request.RequestedBy {
+				continue
+			}
+			n.SendDMWithLogOnError(ctx, log, r.ReviewerID, msg, fallback)
+		}
+	case gevent.RequestExtendedType:
+		if request.Grant == nil {
+			return nil
+		}
+		msg := fmt.Sprintf(":white_check_mark: Your access to *%s* has been extended until %s.", requestedRule.Name, types.ExpiryString(request.Grant.End))
+		fallback := fmt.Sprintf("Your access to %s has been extended.", requestedRule.Name)
+		n.SendDMWithLogOnError(ctx, log, request.RequestedBy, msg, fallback)
+	case gevent.RequestBreakGlassType:
+		msg := fmt.Sprintf(":rotating_light: You have been granted break-glass access to *%s*. An approver will review this access.", requestedRule.Name)
+		fallback := fmt.Sprintf("You have been granted break-glass access to %s.", requestedRule.Name)
+		n.SendDMWithLogOnError(ctx, log, request.RequestedBy, msg, fallback)
+
+		reviewURL, err := notifiers.ReviewURL(n.FrontendURL, request.ID)
+		if err != nil {
+			return errors.Wrap(err, "building review URL")
+		}
+		requestArguments, err := n.RenderRequestArguments(ctx, log, request, requestedRule)
+		if err != nil {
+			log.Errorw("failed to generate request arguments, skipping including them in the slack message", "error", err)
+		}
+		opts := BreakGlassMessageOpts{
+			Request:          request,
+			RequestArguments: requestArguments,
+			Rule:             requestedRule,
+			ReviewURLs:       reviewURL,
+			RequestorEmail:   requestingUser.Email,
+			IsWebhook:        true,
+		}
+		summary, webhookMsg := BuildBreakGlassMessage(opts)
+		for _, webhook := range n.webhooks {
+			err = webhook.SendWebhookMessage(ctx, webhookMsg.Blocks, summary)
+			if err != nil {
+				log.Errorw("failed to send break-glass message to incomingWebhook channel", "error", err)
+			}
+		}
+
+		if n.directMessageClient == nil {
+			return nil
+		}
+		reviewers := storage.ListRequestReviewers{RequestID: request.ID}
+		_, err = n.DB.Query(ctx, &reviewers)
+		if err != nil && err != ddb.ErrNoItems {
+			return errors.Wrap(err, "getting reviewers")
+		}
+		opts.IsWebhook = false
+		summary, reviewerMsg := BuildBreakGlassMessage(opts)
+		for _, r := range reviewers.Result {
+			if r.ReviewerID == request.RequestedBy {
+				continue
+			}
+			approver := storage.GetUser{ID: r.ReviewerID}
+			_, err := n.DB.Query(ctx, &approver)
+			if err != nil {
+				log.Errorw("failed to fetch user by id while trying to send message in slack", "user.id", r.ReviewerID, zap.Error(err))
+				continue
+			}
+			_, err = SendMessageBlocks(ctx, n.directMessageClient.client, approver.Result.Email, reviewerMsg, summary)
+			if err != nil {
+				log.Errorw("failed to send break-glass message", "user.id", r.ReviewerID, zap.Error(err))
+			}
+		}
+	case gevent.RequestBreakGlassReviewedType:
+		if request.BreakGlassReview == nil || request.BreakGlassReview.Decision != access.DecisionDECLINED {
+			return nil
+		}
+		msg := fmt.Sprintf(":warning: Your break-glass access to *%s* was flagged by a reviewer.", requestedRule.Name)
+		fallback := fmt.Sprintf("Your break-glass access to %s was flagged by a reviewer.", requestedRule.Name)
+		n.SendDMWithLogOnError(ctx, log, request.RequestedBy, msg, fallback)
+	case gevent.RequestCommentedType:
+		var commented gevent.RequestCommented
+		err = json.Unmarshal(event.Detail, &commented)
+		if err != nil {
+			return err
+		}
+		reviewURL, err := notifiers.ReviewURL(n.FrontendURL, request.ID)
+		if err != nil {
+			return errors.Wrap(err, "building review URL")
+		}
+		msg := fmt.Sprintf(":speech_balloon: %s commented on the request to access *%s*:\n%s\n<%s|View the request>", commented.AuthorEmail, requestedRule.Name, quoteText(commented.Comment.Body), reviewURL.Review)
+		fallback := fmt.Sprintf("%s commented on the request to access %s", commented.AuthorEmail, requestedRule.Name)
+
+		// comments are mirrored into the thread of each reviewer's review message,
+		// so that the discussion happens alongside the request.
+		reviewers := storage.ListRequestReviewers{RequestID: request.ID}
+		_, err = n.DB.Query(ctx, &reviewers)
+		if err != nil && err != ddb.ErrNoItems {
+			return errors.Wrap(err, "getting reviewers")
+		}
+		for _, r := range reviewers.Result {
+			if r.ReviewerID == request.RequestedBy || r.ReviewerID == commented.Comment.AuthorID {
+				continue
+			}
+			if r.Notifications.SlackMessageID == nil {
+				n.SendDMWithLogOnError(ctx, log, r.ReviewerID, msg, fallback)
+				continue
+			}
+			err = n.ReplyInThreadForReviewer(ctx, r, msg, fallback)
+			if err != nil {
+				log.Errorw("failed to reply in slack thread", "user.id", r.ReviewerID, zap.Error(err))
+			}
+		}
+		if commented.Comment.AuthorID != request.RequestedBy {
+			n.SendDMWithLogOnError(ctx, log, request.RequestedBy, msg, fallback)
+		}
 	case gevent.RequestCancelledType:
 		n.SendUpdatesForRequest(ctx, log, request, requestEvent, requestedRule, requestingUserQuery.Result)
+	case gevent.RequestReminderType:
+		var reminder gevent.RequestReminder
+		err = json.Unmarshal(event.Detail, &reminder)
+		if err != nil {
+			return err
+		}
+		reviewURL, err := notifiers.ReviewURL(n.FrontendURL, request.ID)
+		if err != nil {
+			return errors.Wrap(err, "building review URL")
+		}
+		msg := fmt.Sprintf(":alarm_clock: Reminder: %s's request to access *%s* is still awaiting review. <%s|Review the request>", requestingUser.Email, requestedRule.Name, reviewURL.Review)
+		fallback := fmt.Sprintf("Reminder: %s's request to access %s is still awaiting review.", requestingUser.Email, requestedRule.Name)
+
+		reviewers := storage.ListRequestReviewers{RequestID: request.ID}
+		_, err = n.DB.Query(ctx, &reviewers)
+		if err != nil && err != ddb.ErrNoItems {
+			return errors.Wrap(err, "getting reviewers")
+		}
+		outstanding := make(map[string]bool)
+		for _, id := range reminder.ReviewerIDs {
+			outstanding[id] = true
+		}
+		for _, r := range reviewers.Result {
+			if !outstanding[r.ReviewerID] {
+				continue
+			}
+			// reminders are posted in the thread of the original review message so that reviewers have the context of the request.
+			if r.Notifications.SlackMessageID == nil {
+				n.SendDMWithLogOnError(ctx, log, r.ReviewerID, msg, fallback)
+				continue
+			}
+			err = n.ReplyInThreadForReviewer(ctx, r, msg, fallback)
+			if err != nil {
+				log.Errorw("failed to reply in slack thread", "user.id", r.ReviewerID, zap.Error(err))
+			}
+		}
+	case gevent.RequestExpiredType:
+		msg := fmt.Sprintf(":hourglass: Your request to access *%s* has expired because it wasn't reviewed in time.", requestedRule.Name)
+		fallback := fmt.Sprintf("Your request to access %s has expired.", requestedRule.Name)
+		n.SendDMWithLogOnError(ctx, log, request.RequestedBy, msg, fallback)
+		n.SendUpdatesForRequest(ctx, log, request, requestEvent, requestedRule, requestingUserQuery.Result)
 	case gevent.RequestDeclinedType:
 		msg := fmt.Sprintf("Your request to access *%s* has been declined.", requestedRule.Name)
 		fallback := fmt.Sprintf("Your request to access %s has been declined.", requestedRule.Name)
@@ -241,7 +412,8 @@ func (n *SlackNotifier) sendRequestDetailsMessage(ctx context.Context, log *zap.
 		}
 
 		for _, webhook := range n.webhooks {
-			if !requestedRule.Approval.IsRequired() {
+			approval := request.ResolveApproval(requestedRule)
+			if !approval.IsRequired() {
 				headingMsg = fmt.Sprintf(":white_check_mark: %s's request to access *%s* has been automatically approved.\n", requestingUser.Email, requestedRule.Name)
 
 				summary = fmt.Sprintf("%s's request to access %s has been automatically approved.", requestingUser.Email, requestedRule.Name)
@@ -271,8 +443,9 @@ func (n *SlackNotifier) SendUpdatesForRequest(ctx context.Context, log *zap.Suga
 	}
 	reqReviewer := storage.GetUser{ID: requestEvent.ReviewerID}
 	_, err = n.DB.Query(ctx, &reqReviewer)
-	if err != nil && request.Status != access.CANCELLED {
-		log.Errorw("failed to fetch reviewer for request which wasn't cancelled", zap.Error(err))
+	// cancelled and expired requests weren't closed by a reviewer.
+	if err != nil && request.Status != access.CANCELLED && request.Status != access.EXPIRED {
+		log.Errorw("failed to fetch reviewer for request which wasn't cancelled or expired", zap.Error(err))
 		return
 	}
 	reviewURL, err := notifiers.ReviewURL(n.FrontendURL, request.ID)
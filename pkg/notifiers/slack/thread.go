@@ -0,0 +1,45 @@
+package slacknotifier
+
+import (
+	"context"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/pkg/errors"
+	"github.com/slack-go/slack"
+)
+
+// ReplyInThreadForReviewer posts a message as a reply in the thread of the
+// review message which was sent to the reviewer when the request was created.
+func (n *SlackNotifier) ReplyInThreadForReviewer(ctx context.Context, reviewer access.Reviewer, message string, summary string) error {
+	if n.directMessageClient != nil {
+		q := storage.GetUser{ID: reviewer.ReviewerID}
+		_, err := n.DB.Query(ctx, &q)
+		if err != nil {
+			return errors.Wrap(err, "getting user")
+		}
+		if reviewer.Notifications.SlackMessageID == nil {
+			return errors.New("cannot reply in thread because Notifications.SlackMessageID id is nil")
+		}
+
+		u, err := n.directMessageClient.client.GetUserByEmailContext(ctx, q.Result.Email)
+		if err != nil {
+			return err
+		}
+		result, _, _, err := n.directMessageClient.client.OpenConversationContext(ctx, &slack.OpenConversationParameters{
+			Users: []string{u.ID},
+		})
+		if err != nil {
+			return err
+		}
+		_, _, err = n.directMessageClient.client.PostMessageContext(ctx, result.Conversation.ID,
+			slack.MsgOptionTS(*reviewer.Notifications.SlackMessageID),
+			slack.MsgOptionBlocks(slack.NewSectionBlock(&slack.TextBlockObject{Type: slack.MarkdownType, Text: message}, nil, nil)),
+			slack.MsgOptionText(summary, false),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
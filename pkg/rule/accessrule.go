@@ -34,6 +34,53 @@ type AccessRule struct {
 	Name            string                `json:"name" dynamodbav:"name"`
 	Target          Target                `json:"target" dynamodbav:"target"`
 	TimeConstraints types.TimeConstraints `json:"timeConstraints" dynamodbav:"timeConstraints"`
+	// BreakGlass allows users to skip approval during an emergency.
+	// Break-glass requests must include a reason and are reviewed by an approver after access is granted.
+	BreakGlass bool `json:"breakGlass" dynamodbav:"breakGlass"`
+	// SeparationOfDuties constrains who may approve requests for this rule.
+	SeparationOfDuties SeparationOfDuties `json:"separationOfDuties" dynamodbav:"separationOfDuties"`
+}
+
+// SeparationOfDuties constraints are checked when a request is approved.
+// Requestors can never approve their own requests, so this isn't configurable.
+type SeparationOfDuties struct {
+	// ExclusiveGroups are groups whose members cannot approve requests made by other members of the same group.
+	ExclusiveGroups []string `json:"exclusiveGroups,omitempty" dynamodbav:"exclusiveGroups,omitempty"`
+	// MaxApprovalsPerDay is the maximum number of requests for the rule a reviewer may approve in a 24 hour period.
+	// A zero value means there is no limit.
+	MaxApprovalsPerDay int `json:"maxApprovalsPerDay,omitempty" dynamodbav:"maxApprovalsPerDay,omitempty"`
+}
+
+// IsEnabled is true if any separation-of-duties constraints are configured.
+func (s SeparationOfDuties) IsEnabled() bool {
+	return len(s.ExclusiveGroups) > 0 || s.MaxApprovalsPerDay > 0
+}
+
+// SeparationOfDutiesFromAPI converts the API separation-of-duties config, which is optional.
+func SeparationOfDutiesFromAPI(in *types.SeparationOfDuties) SeparationOfDuties {
+	var s SeparationOfDuties
+	if in == nil {
+		return s
+	}
+	if in.ExclusiveGroups != nil {
+		s.ExclusiveGroups = *in.ExclusiveGroups
+	}
+	if in.MaxApprovalsPerDay != nil {
+		s.MaxApprovalsPerDay = *in.MaxApprovalsPerDay
+	}
+	return s
+}
+
+func (s SeparationOfDuties) ToAPI() types.SeparationOfDuties {
+	sod := types.SeparationOfDuties{}
+	if len(s.ExclusiveGroups) > 0 {
+		sod.ExclusiveGroups = &s.ExclusiveGroups
+	}
+	if s.MaxApprovalsPerDay > 0 {
+		m := s.MaxApprovalsPerDay
+		sod.MaxApprovalsPerDay = &m
+	}
+	return sod
 }
 
 // Inherit rule and include `canRequest` field
@@ -49,17 +96,8 @@ func (a AccessRule) ToAPIDetail() types.AccessRuleDetail {
 	if a.Status == ARCHIVED {
 		status = types.AccessRuleStatusARCHIVED
 	}
-	approval := types.ApproverConfig{
-		Groups: &[]string{},
-		Users:  &[]string{},
-	}
-	if a.Approval.Groups != nil {
-		approval.Groups = &a.Approval.Groups
-	}
-	if a.Approval.Users != nil {
-		approval.Users = &a.Approval.Users
-	}
-	return types.AccessRuleDetail{
+	approval := a.Approval.ToAPI()
+	detail := types.AccessRuleDetail{
 		ID:          a.ID,
 		Description: a.Description,
 		Name:        a.Name,
@@ -82,12 +120,20 @@ func (a AccessRule) ToAPIDetail() types.AccessRuleDetail {
 		Version:   a.Version,
 		IsCurrent: a.Current,
 	}
+	if a.BreakGlass {
+		detail.BreakGlass = &a.BreakGlass
+	}
+	if a.SeparationOfDuties.IsEnabled() {
+		sod := a.SeparationOfDuties.ToAPI()
+		detail.SeparationOfDuties = &sod
+	}
+	return detail
 }
 
 // served basic detail of the access rule
 func (a AccessRule) ToAPI() types.AccessRule {
 
-	return types.AccessRule{
+	rule := types.AccessRule{
 		ID:          a.ID,
 		Version:     a.Version,
 		Description: a.Description,
@@ -101,6 +147,10 @@ func (a AccessRule) ToAPI() types.AccessRule {
 		CreatedAt: a.Metadata.CreatedAt,
 		UpdatedAt: a.Metadata.UpdatedAt,
 	}
+	if a.BreakGlass {
+		rule.BreakGlass = &a.BreakGlass
+	}
+	return rule
 }
 
 // This is used to serve a user making a request, it contains all the available arguments and options with title, description and labels
@@ -141,10 +191,261 @@ type Approval struct {
 	//List of users ids represents the individual users who may approve requests for this rule.
 	// This does not represent members of the approval groups
 	Users []string `json:"users" dynamodbav:"users"`
+	// Quorum is the number of distinct approvals required before access is granted.
+	// Rules created before quorums were supported have a zero value, which is treated as a single approval.
+	Quorum int `json:"quorum,omitempty" dynamodbav:"quorum,omitempty"`
+	// Stages are ordered approval stages, such as a team lead followed by the security team.
+	// When Stages are set, the Groups, Users and Quorum fields above are ignored.
+	Stages []ApprovalStage `json:"stages,omitempty" dynamodbav:"stages,omitempty"`
+	// Conditions route requests to different approvers based on the argument values selected in the request.
+	// Conditions are evaluated in order and the first matching condition is used in place of the approvers above.
+	// A condition without any users or groups approves matching requests automatically.
+	Conditions []ApprovalCondition `json:"conditions,omitempty" dynamodbav:"conditions,omitempty"`
+	// ExpiresAfterSeconds is how long a request may remain pending before it is automatically expired.
+	// A zero value means that pending requests never expire.
+	ExpiresAfterSeconds int `json:"expiresAfterSeconds,omitempty" dynamodbav:"expiresAfterSeconds,omitempty"`
+	// RecurringApprovalValiditySeconds is how long an approval of an occurrence of a recurring request
+	// is reused to approve later occurrences. A zero value means that every occurrence must be approved.
+	RecurringApprovalValiditySeconds int `json:"recurringApprovalValiditySeconds,omitempty" dynamodbav:"recurringApprovalValiditySeconds,omitempty"`
+}
+
+// RecurringApprovalValidity is how long an approval of a recurring request is reused for.
+func (a Approval) RecurringApprovalValidity() time.Duration {
+	return time.Duration(a.RecurringApprovalValiditySeconds) * time.Second
+}
+
+// ApprovalCondition routes requests for particular argument values to a set of approvers.
+type ApprovalCondition struct {
+	// ArgumentID is the ID of the target argument to match, such as 'accountId'
+	ArgumentID string `json:"argumentId" dynamodbav:"argumentId"`
+	// Values are the argument values which match the condition
+	Values []string `json:"values" dynamodbav:"values"`
+	Groups []string `json:"groups" dynamodbav:"groups"`
+	Users  []string `json:"users" dynamodbav:"users"`
+	Quorum int      `json:"quorum,omitempty" dynamodbav:"quorum,omitempty"`
+}
+
+// Stage returns the approvers of the condition as a single approval stage.
+func (c ApprovalCondition) Stage() ApprovalStage {
+	return ApprovalStage{
+		Name:   "Approval",
+		Groups: c.Groups,
+		Users:  c.Users,
+		Quorum: c.Quorum,
+	}
+}
+
+// Matches is true if the argument selected in the request is one of the condition's values.
+func (c ApprovalCondition) Matches(arguments map[string]string) bool {
+	selected, ok := arguments[c.ArgumentID]
+	if !ok {
+		return false
+	}
+	for _, v := range c.Values {
+		if v == selected {
+			return true
+		}
+	}
+	return false
+}
+
+// ApprovalStage is a single stage of a multi-stage approval policy.
+type ApprovalStage struct {
+	Name   string   `json:"name" dynamodbav:"name"`
+	Groups []string `json:"groups" dynamodbav:"groups"`
+	Users  []string `json:"users" dynamodbav:"users"`
+	Quorum int      `json:"quorum,omitempty" dynamodbav:"quorum,omitempty"`
+}
+
+// RequiredApprovals returns the number of approvals needed to complete the stage.
+func (s ApprovalStage) RequiredApprovals() int {
+	if s.Quorum < 1 {
+		return 1
+	}
+	return s.Quorum
 }
 
 func (a *Approval) IsRequired() bool {
-	return len(a.Users) > 0 || len(a.Groups) > 0
+	return len(a.Users) > 0 || len(a.Groups) > 0 || len(a.Stages) > 0
+}
+
+// IsConditional is true if the approvers depend on the arguments selected in the request.
+func (a *Approval) IsConditional() bool {
+	return len(a.Conditions) > 0
+}
+
+// ForArguments resolves the approval which applies to a request with the selected arguments.
+// If a condition matches, its approvers are returned, otherwise the approval is returned without its conditions.
+func (a Approval) ForArguments(arguments map[string]string) Approval {
+	for _, c := range a.Conditions {
+		if c.Matches(arguments) {
+			return Approval{
+				Groups:                           c.Groups,
+				Users:                            c.Users,
+				Quorum:                           c.Quorum,
+				ExpiresAfterSeconds:              a.ExpiresAfterSeconds,
+				RecurringApprovalValiditySeconds: a.RecurringApprovalValiditySeconds,
+			}
+		}
+	}
+	a.Conditions = nil
+	return a
+}
+
+// IsMultiStage is true if the rule requires approval in more than one stage.
+func (a *Approval) IsMultiStage() bool {
+	return len(a.Stages) > 1
+}
+
+// GetStages returns the approval stages for the rule.
+// Rules without explicit stages are represented as a single stage
+// built from the Groups, Users and Quorum fields.
+func (a *Approval) GetStages() []ApprovalStage {
+	if len(a.Stages) > 0 {
+		return a.Stages
+	}
+	return []ApprovalStage{{
+		Name:   "Approval",
+		Groups: a.Groups,
+		Users:  a.Users,
+		Quorum: a.Quorum,
+	}}
+}
+
+// ApprovalFromAPI converts the API approver config to an Approval.
+func ApprovalFromAPI(in types.ApproverConfig) Approval {
+	var a Approval
+	if in.Groups != nil {
+		a.Groups = *in.Groups
+	}
+	if in.Users != nil {
+		a.Users = *in.Users
+	}
+	if in.Quorum != nil {
+		a.Quorum = *in.Quorum
+	}
+	if in.Stages != nil {
+		for _, s := range *in.Stages {
+			stage := ApprovalStage{
+				Name:   s.Name,
+				Groups: []string{},
+				Users:  []string{},
+			}
+			if s.Groups != nil {
+				stage.Groups = *s.Groups
+			}
+			if s.Users != nil {
+				stage.Users = *s.Users
+			}
+			if s.Quorum != nil {
+				stage.Quorum = *s.Quorum
+			}
+			a.Stages = append(a.Stages, stage)
+		}
+	}
+	if in.Conditions != nil {
+		for _, c := range *in.Conditions {
+			condition := ApprovalCondition{
+				ArgumentID: c.ArgumentId,
+				Values:     c.Values,
+				Groups:     []string{},
+				Users:      []string{},
+			}
+			if c.Groups != nil {
+				condition.Groups = *c.Groups
+			}
+			if c.Users != nil {
+				condition.Users = *c.Users
+			}
+			if c.Quorum != nil {
+				condition.Quorum = *c.Quorum
+			}
+			a.Conditions = append(a.Conditions, condition)
+		}
+	}
+	if in.ExpiresAfterSeconds != nil {
+		a.ExpiresAfterSeconds = *in.ExpiresAfterSeconds
+	}
+	if in.RecurringApprovalValiditySeconds != nil {
+		a.RecurringApprovalValiditySeconds = *in.RecurringApprovalValiditySeconds
+	}
+	return a
+}
+
+func (a Approval) ToAPI() types.ApproverConfig {
+	approval := types.ApproverConfig{
+		Groups: &[]string{},
+		Users:  &[]string{},
+	}
+	if a.Groups != nil {
+		approval.Groups = &a.Groups
+	}
+	if a.Users != nil {
+		approval.Users = &a.Users
+	}
+	if a.Quorum > 0 {
+		q := a.Quorum
+		approval.Quorum = &q
+	}
+	if len(a.Stages) > 0 {
+		stages := make([]types.ApprovalStage, len(a.Stages))
+		for i := range a.Stages {
+			s := a.Stages[i]
+			stage := types.ApprovalStage{
+				Name:   s.Name,
+				Groups: &[]string{},
+				Users:  &[]string{},
+			}
+			if s.Groups != nil {
+				stage.Groups = &s.Groups
+			}
+			if s.Users != nil {
+				stage.Users = &s.Users
+			}
+			if s.Quorum > 0 {
+				q := s.Quorum
+				stage.Quorum = &q
+			}
+			stages[i] = stage
+		}
+		approval.Stages = &stages
+	}
+	if len(a.Conditions) > 0 {
+		conditions := make([]types.ApprovalCondition, len(a.Conditions))
+		for i := range a.Conditions {
+			c := a.Conditions[i]
+			condition := types.ApprovalCondition{
+				ArgumentId: c.ArgumentID,
+				Values:     c.Values,
+				Groups:     &[]string{},
+				Users:      &[]string{},
+			}
+			if c.Values == nil {
+				condition.Values = []string{}
+			}
+			if c.Groups != nil {
+				condition.Groups = &c.Groups
+			}
+			if c.Users != nil {
+				condition.Users = &c.Users
+			}
+			if c.Quorum > 0 {
+				q := c.Quorum
+				condition.Quorum = &q
+			}
+			conditions[i] = condition
+		}
+		approval.Conditions = &conditions
+	}
+	if a.ExpiresAfterSeconds > 0 {
+		e := a.ExpiresAfterSeconds
+		approval.ExpiresAfterSeconds = &e
+	}
+	if a.RecurringApprovalValiditySeconds > 0 {
+		v := a.RecurringApprovalValiditySeconds
+		approval.RecurringApprovalValiditySeconds = &v
+	}
+	return approval
 }
 
 // Provider defines model for Provider.
@@ -175,6 +476,18 @@ func (t Target) UsesSelectableOptions() bool {
 	return t.WithSelectable != nil && len(t.WithSelectable) > 0
 }
 
+// HasArgument is true if the target has an argument with the given ID.
+func (t Target) HasArgument(argumentID string) bool {
+	if _, ok := t.With[argumentID]; ok {
+		return true
+	}
+	if _, ok := t.WithSelectable[argumentID]; ok {
+		return true
+	}
+	_, ok := t.WithArgumentGroupOptions[argumentID]
+	return ok
+}
+
 // IsForTargetGroup check if this target has a targetgroup ID
 // if so, it means this rule is for a targetgroup not a built-in provider
 func (t Target) IsForTargetGroup() bool {
@@ -0,0 +1,40 @@
+package scim
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+)
+
+// authenticate requires requests to have the deployment's SCIM token as a bearer token.
+// SCIM is disabled until an administrator creates a token.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		header := r.Header.Get("Authorization")
+		token := strings.TrimPrefix(header, "Bearer ")
+		if !strings.HasPrefix(header, "Bearer ") || token == "" {
+			writeError(ctx, w, http.StatusUnauthorized, "", "a bearer token must be provided")
+			return
+		}
+		q := storage.GetSCIMToken{}
+		_, err := s.DB.Query(ctx, &q)
+		if err == ddb.ErrNoItems {
+			logger.Get(ctx).Infow("SCIM request received but no SCIM token has been created")
+			writeError(ctx, w, http.StatusUnauthorized, "", "invalid bearer token")
+			return
+		}
+		if err != nil {
+			writeInternalError(ctx, w, err)
+			return
+		}
+		if !q.Result.Matches(token) {
+			writeError(ctx, w, http.StatusUnauthorized, "", "invalid bearer token")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
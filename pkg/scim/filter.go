@@ -0,0 +1,36 @@
+package scim
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Filter is a SCIM filter expression comparing an attribute to a value, such as 'userName eq "alice@example.com"'.
+//
+// Identity providers only use filters to find a user or group before creating it, so only the 'eq' operator is supported.
+type Filter struct {
+	// Attribute is lowercased, as SCIM attribute names are case insensitive.
+	Attribute string
+	Value     string
+}
+
+// ParseFilter parses a filter from the 'filter' query parameter. An empty filter returns nil.
+func ParseFilter(filter string) (*Filter, error) {
+	filter = strings.TrimSpace(filter)
+	if filter == "" {
+		return nil, nil
+	}
+	parts := strings.SplitN(filter, " ", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("filter %q must be in the form 'attribute eq \"value\"'", filter)
+	}
+	if !strings.EqualFold(parts[1], "eq") {
+		return nil, fmt.Errorf("unsupported filter operator %q, only 'eq' is supported", parts[1])
+	}
+	value, err := strconv.Unquote(strings.TrimSpace(parts[2]))
+	if err != nil {
+		return nil, fmt.Errorf("filter value %s must be a quoted string", parts[2])
+	}
+	return &Filter{Attribute: strings.ToLower(parts[0]), Value: value}, nil
+}
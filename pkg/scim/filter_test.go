@@ -0,0 +1,61 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFilter(t *testing.T) {
+	type testcase struct {
+		name    string
+		give    string
+		want    *Filter
+		wantErr string
+	}
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			give: `userName eq "alice@example.com"`,
+			want: &Filter{Attribute: "username", Value: "alice@example.com"},
+		},
+		{
+			name: "value with spaces",
+			give: `displayName EQ "Platform Engineers"`,
+			want: &Filter{Attribute: "displayname", Value: "Platform Engineers"},
+		},
+		{
+			name: "empty",
+			give: "",
+		},
+		{
+			name:    "unsupported operator",
+			give:    `userName sw "alice"`,
+			wantErr: `unsupported filter operator "sw", only 'eq' is supported`,
+		},
+		{
+			name:    "unquoted value",
+			give:    `userName eq alice`,
+			wantErr: "filter value alice must be a quoted string",
+		},
+		{
+			name:    "missing value",
+			give:    `userName pr`,
+			wantErr: `filter "userName pr" must be in the form 'attribute eq "value"'`,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseFilter(tc.give)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package scim
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/go-chi/chi/v5"
+)
+
+// Only groups which were created using SCIM can be viewed and updated using SCIM,
+// so that an identity provider can't modify internal groups or groups from the identity sync.
+
+func (s *Server) listGroups(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	f, err := ParseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		writeError(ctx, w, http.StatusBadRequest, ErrInvalidFilter, err.Error())
+		return
+	}
+	if f != nil && f.Attribute != "displayname" && f.Attribute != "id" {
+		writeError(ctx, w, http.StatusBadRequest, ErrInvalidFilter, "groups can only be filtered by displayName or id")
+		return
+	}
+	groups, err := s.activeGroups(r)
+	if err != nil {
+		writeInternalError(ctx, w, err)
+		return
+	}
+
+	includeMembers := !strings.Contains(strings.ToLower(r.URL.Query().Get("excludedAttributes")), "members")
+	resources := []Group{}
+	for _, g := range groups {
+		if f != nil && f.Attribute == "displayname" && !strings.EqualFold(g.Name, f.Value) {
+			continue
+		}
+		if f != nil && f.Attribute == "id" && g.ID != f.Value {
+			continue
+		}
+		resources = append(resources, groupResource(g, includeMembers))
+	}
+	writeJSON(ctx, w, paginate(r, resources), http.StatusOK)
+}
+
+func (s *Server) activeGroups(r *http.Request) ([]identity.Group, error) {
+	var groups []identity.Group
+	var next string
+	for {
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		q := storage.ListGroupsForSourceAndStatus{Source: identity.SCIM, Status: types.IdpStatusACTIVE}
+		qr, err := s.DB.Query(r.Context(), &q, opts...)
+		if err == ddb.ErrNoItems {
+			return groups, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, q.Result...)
+		next = qr.NextPage
+		if next == "" {
+			return groups, nil
+		}
+	}
+}
+
+func (s *Server) createGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b Group
+	if !decodeBody(w, r, &b) {
+		return
+	}
+	if b.DisplayName == "" {
+		writeError(ctx, w, http.StatusBadRequest, ErrInvalidValue, "displayName must be provided")
+		return
+	}
+	existing, err := s.activeGroups(r)
+	if err != nil {
+		writeInternalError(ctx, w, err)
+		return
+	}
+	for _, g := range existing {
+		if strings.EqualFold(g.Name, b.DisplayName) {
+			writeError(ctx, w, http.StatusConflict, ErrUniqueness, "a group with this displayName already exists")
+			return
+		}
+	}
+
+	now := s.Clock.Now()
+	id := types.NewGroupID()
+	g := identity.Group{
+		ID: id,
+		// the identity sync looks up groups by their IdpID, so it's the same as the ID for groups created using SCIM.
+		IdpID:     id,
+		Name:      b.DisplayName,
+		Status:    types.IdpStatusACTIVE,
+		Source:    identity.SCIM,
+		Users:     []string{},
+		CreatedAt: now,
+	}
+	s.saveGroup(w, r, &g, referenceValues(b.Members), http.StatusCreated)
+}
+
+// lookupGroup writes a not found error if the group doesn't exist or wasn't created using SCIM.
+func (s *Server) lookupGroup(w http.ResponseWriter, r *http.Request) (*identity.Group, bool) {
+	ctx := r.Context()
+	q := storage.GetGroup{ID: chi.URLParam(r, "id")}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		writeInternalError(ctx, w, err)
+		return nil, false
+	}
+	if err == ddb.ErrNoItems || q.Result.Source != identity.SCIM || q.Result.Status != types.IdpStatusACTIVE {
+		writeError(ctx, w, http.StatusNotFound, "", "group not found")
+		return nil, false
+	}
+	return q.Result, true
+}
+
+func (s *Server) getGroup(w http.ResponseWriter, r *http.Request) {
+	g, ok := s.lookupGroup(w, r)
+	if !ok {
+		return
+	}
+	includeMembers := !strings.Contains(strings.ToLower(r.URL.Query().Get("excludedAttributes")), "members")
+	writeJSON(r.Context(), w, groupResource(*g, includeMembers), http.StatusOK)
+}
+
+func (s *Server) replaceGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	g, ok := s.lookupGroup(w, r)
+	if !ok {
+		return
+	}
+	var b Group
+	if !decodeBody(w, r, &b) {
+		return
+	}
+	if b.DisplayName == "" {
+		writeError(ctx, w, http.StatusBadRequest, ErrInvalidValue, "displayName must be provided")
+		return
+	}
+	g.Name = b.DisplayName
+	s.saveGroup(w, r, g, referenceValues(b.Members), http.StatusOK)
+}
+
+func (s *Server) patchGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	g, ok := s.lookupGroup(w, r)
+	if !ok {
+		return
+	}
+	var b PatchRequest
+	if !decodeBody(w, r, &b) {
+		return
+	}
+	members, err := ApplyGroupPatch(g, b.Operations)
+	if err != nil {
+		writePatchError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+	s.saveGroup(w, r, g, members, http.StatusOK)
+}
+
+func (s *Server) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	g, ok := s.lookupGroup(w, r)
+	if !ok {
+		return
+	}
+	// groups are archived rather than deleted, in the same way as groups which are removed from the identity provider by the identity sync.
+	g.Status = types.IdpStatusARCHIVED
+	items, err := s.setMembers(ctx, g, nil)
+	if err != nil {
+		writeInternalError(ctx, w, err)
+		return
+	}
+	g.UpdatedAt = s.Clock.Now()
+	err = s.DB.PutBatch(ctx, append(items, g)...)
+	if err != nil {
+		writeInternalError(ctx, w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// saveGroup sets the members of the group, saves it, and writes the group to the response.
+func (s *Server) saveGroup(w http.ResponseWriter, r *http.Request, g *identity.Group, members []string, status int) {
+	ctx := r.Context()
+	items, err := s.setMembers(ctx, g, members)
+	if err != nil {
+		writePatchError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+	g.UpdatedAt = s.Clock.Now()
+	err = s.DB.PutBatch(ctx, append(items, g)...)
+	if err != nil {
+		writeInternalError(ctx, w, err)
+		return
+	}
+	writeJSON(ctx, w, groupResource(*g, true), status)
+}
+
+func referenceValues(refs []Reference) []string {
+	values := make([]string, len(refs))
+	for i, r := range refs {
+		values[i] = r.Value
+	}
+	return values
+}
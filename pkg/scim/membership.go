@@ -0,0 +1,77 @@
+package scim
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+)
+
+// setMembers sets the members of the group, and updates the groups of the users which were added or removed.
+// It returns the users which need to be saved along with the group.
+func (s *Server) setMembers(ctx context.Context, g *identity.Group, members []string) ([]ddb.Keyer, error) {
+	before := newMemberSet(g.Users)
+	after := newMemberSet(members)
+
+	var items []ddb.Keyer
+	for _, id := range after.ids {
+		if before.contains(id) {
+			continue
+		}
+		q := storage.GetUser{ID: id}
+		_, err := s.DB.Query(ctx, &q)
+		if err == ddb.ErrNoItems {
+			return nil, PatchError{SCIMType: ErrInvalidValue, Detail: fmt.Sprintf("user %s does not exist", id)}
+		}
+		if err != nil {
+			return nil, err
+		}
+		q.Result.AddGroup(g.ID)
+		q.Result.UpdatedAt = s.Clock.Now()
+		items = append(items, q.Result)
+	}
+	for _, id := range before.ids {
+		if after.contains(id) {
+			continue
+		}
+		q := storage.GetUser{ID: id}
+		_, err := s.DB.Query(ctx, &q)
+		if err == ddb.ErrNoItems {
+			// the user may have been removed from the database, which leaves nothing to update.
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		q.Result.RemoveGroup(g.ID)
+		q.Result.UpdatedAt = s.Clock.Now()
+		items = append(items, q.Result)
+	}
+	g.Users = after.list()
+	return items, nil
+}
+
+// removeFromGroups removes the user from every group they belong to, which is done when a user is deactivated.
+// It returns the groups which need to be saved along with the user.
+func (s *Server) removeFromGroups(ctx context.Context, u *identity.User) ([]ddb.Keyer, error) {
+	var items []ddb.Keyer
+	for _, id := range u.Groups {
+		q := storage.GetGroup{ID: id}
+		_, err := s.DB.Query(ctx, &q)
+		if err == ddb.ErrNoItems {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		members := newMemberSet(q.Result.Users)
+		members.remove(u.ID)
+		q.Result.Users = members.list()
+		q.Result.UpdatedAt = s.Clock.Now()
+		items = append(items, q.Result)
+	}
+	u.Groups = []string{}
+	return items, nil
+}
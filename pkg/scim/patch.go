@@ -0,0 +1,297 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+type PatchOperation struct {
+	// Op is 'add', 'replace' or 'remove'. Azure AD capitalises operations, so they are case insensitive.
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// PatchError is returned when a patch operation can't be applied.
+type PatchError struct {
+	SCIMType string
+	Detail   string
+}
+
+func (e PatchError) Error() string {
+	return e.Detail
+}
+
+func (o PatchOperation) op() (string, error) {
+	op := strings.ToLower(o.Op)
+	if op != "add" && op != "replace" && op != "remove" {
+		return "", PatchError{SCIMType: ErrInvalidSyntax, Detail: fmt.Sprintf("unsupported patch operation %q", o.Op)}
+	}
+	return op, nil
+}
+
+// attributes returns the attributes to update for operations without a path, where the value is an object of attributes.
+func (o PatchOperation) attributes() (map[string]json.RawMessage, error) {
+	var attrs map[string]json.RawMessage
+	err := json.Unmarshal(o.Value, &attrs)
+	if err != nil {
+		return nil, PatchError{SCIMType: ErrInvalidValue, Detail: "the value of a patch operation without a path must be an object"}
+	}
+	return attrs, nil
+}
+
+// ApplyUserPatch applies the operations to the user in memory.
+// Changes to attributes which Common Fate doesn't store are ignored.
+func ApplyUserPatch(u *identity.User, ops []PatchOperation) error {
+	for _, o := range ops {
+		op, err := o.op()
+		if err != nil {
+			return err
+		}
+		if o.Path != "" {
+			err = setUserAttribute(u, op, o.Path, o.Value)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		attrs, err := o.attributes()
+		if err != nil {
+			return err
+		}
+		for k, v := range attrs {
+			err = setUserAttribute(u, op, k, v)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func setUserAttribute(u *identity.User, op string, path string, value json.RawMessage) error {
+	switch strings.ToLower(path) {
+	case "active":
+		if op == "remove" {
+			return PatchError{SCIMType: ErrMutability, Detail: "active can't be removed"}
+		}
+		active, err := parseBool(value)
+		if err != nil {
+			return err
+		}
+		u.Status = types.IdpStatusARCHIVED
+		if active {
+			u.Status = types.IdpStatusACTIVE
+		}
+	case "username":
+		if op == "remove" {
+			return PatchError{SCIMType: ErrMutability, Detail: "userName can't be removed"}
+		}
+		userName, err := parseString(path, value)
+		if err != nil {
+			return err
+		}
+		u.Email = userName
+	case "name.givenname":
+		if op == "remove" {
+			u.FirstName = ""
+			return nil
+		}
+		v, err := parseString(path, value)
+		if err != nil {
+			return err
+		}
+		u.FirstName = v
+	case "name.familyname":
+		if op == "remove" {
+			u.LastName = ""
+			return nil
+		}
+		v, err := parseString(path, value)
+		if err != nil {
+			return err
+		}
+		u.LastName = v
+	case "name":
+		if op == "remove" {
+			u.FirstName, u.LastName = "", ""
+			return nil
+		}
+		var name Name
+		err := json.Unmarshal(value, &name)
+		if err != nil {
+			return PatchError{SCIMType: ErrInvalidValue, Detail: "name must be an object"}
+		}
+		u.FirstName, u.LastName = name.GivenName, name.FamilyName
+	}
+	return nil
+}
+
+// ApplyGroupPatch applies the operations to the group in memory.
+// The members of the group are returned rather than updated, so that the users can be updated along with the group.
+func ApplyGroupPatch(g *identity.Group, ops []PatchOperation) ([]string, error) {
+	members := newMemberSet(g.Users)
+	for _, o := range ops {
+		op, err := o.op()
+		if err != nil {
+			return nil, err
+		}
+		if o.Path != "" {
+			err = setGroupAttribute(g, members, op, o.Path, o.Value)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		attrs, err := o.attributes()
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range attrs {
+			err = setGroupAttribute(g, members, op, k, v)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return members.list(), nil
+}
+
+func setGroupAttribute(g *identity.Group, members *memberSet, op string, path string, value json.RawMessage) error {
+	lower := strings.ToLower(path)
+	switch {
+	case lower == "displayname":
+		if op == "remove" {
+			return PatchError{SCIMType: ErrMutability, Detail: "displayName can't be removed"}
+		}
+		v, err := parseString(path, value)
+		if err != nil {
+			return err
+		}
+		g.Name = v
+	case lower == "members":
+		var refs []Reference
+		if len(value) > 0 {
+			err := json.Unmarshal(value, &refs)
+			if err != nil {
+				return PatchError{SCIMType: ErrInvalidValue, Detail: "members must be a list of members"}
+			}
+		}
+		switch op {
+		case "add":
+			for _, r := range refs {
+				members.add(r.Value)
+			}
+		case "replace":
+			members.clear()
+			for _, r := range refs {
+				members.add(r.Value)
+			}
+		case "remove":
+			// removing members without a value removes every member.
+			if len(refs) == 0 {
+				members.clear()
+			}
+			for _, r := range refs {
+				members.remove(r.Value)
+			}
+		}
+	case strings.HasPrefix(lower, "members[") && strings.HasSuffix(lower, "]"):
+		if op != "remove" {
+			return PatchError{SCIMType: ErrInvalidPath, Detail: "members can only be added using the 'members' path"}
+		}
+		f, err := ParseFilter(path[len("members[") : len(path)-1])
+		if err != nil || f == nil || f.Attribute != "value" {
+			return PatchError{SCIMType: ErrInvalidPath, Detail: fmt.Sprintf("unsupported path %q", path)}
+		}
+		members.remove(f.Value)
+	case lower == "externalid" || lower == "id":
+		// the group ID is assigned by Common Fate, and external IDs aren't stored.
+	default:
+		return PatchError{SCIMType: ErrInvalidPath, Detail: fmt.Sprintf("unsupported path %q", path)}
+	}
+	return nil
+}
+
+func parseString(path string, value json.RawMessage) (string, error) {
+	var s string
+	err := json.Unmarshal(value, &s)
+	if err != nil {
+		return "", PatchError{SCIMType: ErrInvalidValue, Detail: fmt.Sprintf("%s must be a string", path)}
+	}
+	return s, nil
+}
+
+// parseBool parses a boolean value. Azure AD sends booleans as strings, such as "False".
+func parseBool(value json.RawMessage) (bool, error) {
+	var b bool
+	err := json.Unmarshal(value, &b)
+	if err == nil {
+		return b, nil
+	}
+	var s string
+	err = json.Unmarshal(value, &s)
+	if err == nil {
+		b, err = strconv.ParseBool(s)
+		if err == nil {
+			return b, nil
+		}
+	}
+	return false, PatchError{SCIMType: ErrInvalidValue, Detail: "active must be a boolean"}
+}
+
+// memberSet keeps the order members were added in, so that responses are stable.
+type memberSet struct {
+	ids []string
+}
+
+func newMemberSet(ids []string) *memberSet {
+	m := &memberSet{}
+	for _, id := range ids {
+		m.add(id)
+	}
+	return m
+}
+
+func (m *memberSet) add(id string) {
+	if !m.contains(id) {
+		m.ids = append(m.ids, id)
+	}
+}
+
+func (m *memberSet) contains(id string) bool {
+	for _, existing := range m.ids {
+		if existing == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *memberSet) remove(id string) {
+	var ids []string
+	for _, existing := range m.ids {
+		if existing != id {
+			ids = append(ids, existing)
+		}
+	}
+	m.ids = ids
+}
+
+func (m *memberSet) clear() {
+	m.ids = nil
+}
+
+func (m *memberSet) list() []string {
+	return append([]string{}, m.ids...)
+}
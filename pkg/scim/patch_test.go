@@ -0,0 +1,141 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyUserPatch(t *testing.T) {
+	type testcase struct {
+		name    string
+		give    string
+		want    identity.User
+		wantErr error
+	}
+
+	user := identity.User{ID: "usr_1", Email: "alice@example.com", FirstName: "Alice", Status: types.IdpStatusACTIVE}
+
+	testcases := []testcase{
+		{
+			name: "okta deactivates users without a path",
+			give: `[{"op":"replace","value":{"active":false}}]`,
+			want: identity.User{ID: "usr_1", Email: "alice@example.com", FirstName: "Alice", Status: types.IdpStatusARCHIVED},
+		},
+		{
+			name: "azure sends booleans as strings",
+			give: `[{"op":"Replace","path":"active","value":"False"}]`,
+			want: identity.User{ID: "usr_1", Email: "alice@example.com", FirstName: "Alice", Status: types.IdpStatusARCHIVED},
+		},
+		{
+			name: "names and userName",
+			give: `[{"op":"replace","path":"name.familyName","value":"Smith"},{"op":"replace","value":{"userName":"alice.smith@example.com","name.givenName":"Ali"}}]`,
+			want: identity.User{ID: "usr_1", Email: "alice.smith@example.com", FirstName: "Ali", LastName: "Smith", Status: types.IdpStatusACTIVE},
+		},
+		{
+			name: "attributes which aren't stored are ignored",
+			give: `[{"op":"add","path":"title","value":"Engineer"}]`,
+			want: user,
+		},
+		{
+			name:    "invalid active value",
+			give:    `[{"op":"replace","path":"active","value":"maybe"}]`,
+			wantErr: PatchError{SCIMType: ErrInvalidValue, Detail: "active must be a boolean"},
+		},
+		{
+			name:    "unsupported operation",
+			give:    `[{"op":"move","path":"active","value":true}]`,
+			wantErr: PatchError{SCIMType: ErrInvalidSyntax, Detail: `unsupported patch operation "move"`},
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			var ops []PatchOperation
+			err := json.Unmarshal([]byte(tc.give), &ops)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := user
+			err = ApplyUserPatch(&got, ops)
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestApplyGroupPatch(t *testing.T) {
+	type testcase struct {
+		name        string
+		give        string
+		wantName    string
+		wantMembers []string
+		wantErr     error
+	}
+
+	testcases := []testcase{
+		{
+			name:        "add members",
+			give:        `[{"op":"add","path":"members","value":[{"value":"usr_2"},{"value":"usr_3"}]}]`,
+			wantName:    "Engineering",
+			wantMembers: []string{"usr_1", "usr_2", "usr_3"},
+		},
+		{
+			name:        "okta removes members with a filter",
+			give:        `[{"op":"remove","path":"members[value eq \"usr_1\"]"}]`,
+			wantName:    "Engineering",
+			wantMembers: []string{"usr_2"},
+		},
+		{
+			name:        "azure removes members with a value",
+			give:        `[{"op":"Remove","path":"members","value":[{"value":"usr_2"}]}]`,
+			wantName:    "Engineering",
+			wantMembers: []string{"usr_1"},
+		},
+		{
+			name:        "replace members and displayName",
+			give:        `[{"op":"replace","value":{"id":"grp_1","displayName":"Platform","members":[{"value":"usr_3"}]}}]`,
+			wantName:    "Platform",
+			wantMembers: []string{"usr_3"},
+		},
+		{
+			name:        "remove all members",
+			give:        `[{"op":"remove","path":"members"}]`,
+			wantName:    "Engineering",
+			wantMembers: []string{},
+		},
+		{
+			name:    "unsupported path",
+			give:    `[{"op":"replace","path":"owner","value":"usr_1"}]`,
+			wantErr: PatchError{SCIMType: ErrInvalidPath, Detail: `unsupported path "owner"`},
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			var ops []PatchOperation
+			err := json.Unmarshal([]byte(tc.give), &ops)
+			if err != nil {
+				t.Fatal(err)
+			}
+			g := identity.Group{ID: "grp_1", Name: "Engineering", Users: []string{"usr_1", "usr_2"}}
+			got, err := ApplyGroupPatch(&g, ops)
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantName, g.Name)
+			assert.Equal(t, tc.wantMembers, got)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package scim
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+const (
+	UserSchema  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	GroupSchema = "urn:ietf:params:scim:schemas:core:2.0:Group"
+)
+
+// User is a SCIM user resource.
+//
+// Common Fate identifies users by their email address, so the userName must be the user's email address.
+// Attributes which Common Fate doesn't store, such as phone numbers, are accepted and ignored.
+type User struct {
+	Schemas  []string    `json:"schemas"`
+	ID       string      `json:"id,omitempty"`
+	UserName string      `json:"userName"`
+	Name     *Name       `json:"name,omitempty"`
+	Emails   []Email     `json:"emails,omitempty"`
+	Active   *bool       `json:"active,omitempty"`
+	Groups   []Reference `json:"groups,omitempty"`
+	Meta     *Meta       `json:"meta,omitempty"`
+}
+
+type Name struct {
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// Group is a SCIM group resource.
+type Group struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	DisplayName string      `json:"displayName"`
+	Members     []Reference `json:"members,omitempty"`
+	Meta        *Meta       `json:"meta,omitempty"`
+}
+
+// Reference is a group's member, or a group a user belongs to.
+type Reference struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+type Meta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+}
+
+func userResource(u identity.User) User {
+	active := u.Status == types.IdpStatusACTIVE
+	res := User{
+		Schemas:  []string{UserSchema},
+		ID:       u.ID,
+		UserName: u.Email,
+		Name:     &Name{GivenName: u.FirstName, FamilyName: u.LastName},
+		Emails:   []Email{{Value: u.Email, Primary: true}},
+		Active:   &active,
+		Meta:     &Meta{ResourceType: "User", Created: u.CreatedAt, LastModified: u.UpdatedAt},
+	}
+	for _, g := range u.Groups {
+		res.Groups = append(res.Groups, Reference{Value: g})
+	}
+	return res
+}
+
+// groupResource converts a group to a SCIM resource.
+// Identity providers may exclude members when listing groups, as groups can have many members.
+func groupResource(g identity.Group, includeMembers bool) Group {
+	res := Group{
+		Schemas:     []string{GroupSchema},
+		ID:          g.ID,
+		DisplayName: g.Name,
+		Meta:        &Meta{ResourceType: "Group", Created: g.CreatedAt, LastModified: g.UpdatedAt},
+	}
+	if includeMembers {
+		res.Members = []Reference{}
+		for _, u := range g.Users {
+			res.Members = append(res.Members, Reference{Value: u})
+		}
+	}
+	return res
+}
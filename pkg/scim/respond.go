@@ -0,0 +1,109 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/common-fate/apikit/logger"
+)
+
+const (
+	ErrorSchema        = "urn:ietf:params:scim:api:messages:2.0:Error"
+	ListResponseSchema = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	PatchOpSchema      = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+)
+
+// SCIM error types, from RFC 7644 section 3.12.
+const (
+	ErrInvalidFilter = "invalidFilter"
+	ErrInvalidSyntax = "invalidSyntax"
+	ErrInvalidPath   = "invalidPath"
+	ErrInvalidValue  = "invalidValue"
+	ErrUniqueness    = "uniqueness"
+	ErrMutability    = "mutability"
+)
+
+type Error struct {
+	Schemas []string `json:"schemas"`
+	// Status is the HTTP status code as a string, as required by the SCIM specification.
+	Status   string `json:"status"`
+	SCIMType string `json:"scimType,omitempty"`
+	Detail   string `json:"detail,omitempty"`
+}
+
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+func writeJSON(ctx context.Context, w http.ResponseWriter, body any, status int) {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(status)
+	err := json.NewEncoder(w).Encode(body)
+	if err != nil {
+		logger.Get(ctx).Errorw("error writing SCIM response", "error", err)
+	}
+}
+
+func writeError(ctx context.Context, w http.ResponseWriter, status int, scimType string, detail string) {
+	writeJSON(ctx, w, Error{
+		Schemas:  []string{ErrorSchema},
+		Status:   strconv.Itoa(status),
+		SCIMType: scimType,
+		Detail:   detail,
+	}, status)
+}
+
+// writeInternalError logs the error and returns an opaque response, as the identity provider may show the detail to its administrators.
+func writeInternalError(ctx context.Context, w http.ResponseWriter, err error) {
+	logger.Get(ctx).Errorw("SCIM request failed", "error", err)
+	writeError(ctx, w, http.StatusInternalServerError, "", "internal server error")
+}
+
+// writePatchError writes PatchErrors with the provided status, and any other error as an internal error.
+func writePatchError(ctx context.Context, w http.ResponseWriter, status int, err error) {
+	var pe PatchError
+	if errors.As(err, &pe) {
+		writeError(ctx, w, status, pe.SCIMType, pe.Detail)
+		return
+	}
+	writeInternalError(ctx, w, err)
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err != nil {
+		writeError(r.Context(), w, http.StatusBadRequest, ErrInvalidSyntax, err.Error())
+		return false
+	}
+	return true
+}
+
+// paginate applies the 1-based startIndex and count query parameters to the results.
+func paginate[T any](r *http.Request, results []T) ListResponse {
+	start := 1
+	if v, err := strconv.Atoi(r.URL.Query().Get("startIndex")); err == nil && v > 1 {
+		start = v
+	}
+	count := MaxResults
+	if v, err := strconv.Atoi(r.URL.Query().Get("count")); err == nil && v >= 0 && v < MaxResults {
+		count = v
+	}
+	res := ListResponse{
+		Schemas:      []string{ListResponseSchema},
+		TotalResults: len(results),
+		StartIndex:   start,
+		Resources:    []any{},
+	}
+	for i := start - 1; i < len(results) && len(res.Resources) < count; i++ {
+		res.Resources = append(res.Resources, results[i])
+	}
+	res.ItemsPerPage = len(res.Resources)
+	return res
+}
@@ -0,0 +1,45 @@
+// Package scim is a SCIM 2.0 server which identity providers such as Okta, Azure AD and OneLogin
+// use to push changes to users and groups as they happen.
+//
+// It is an alternative to the pull-based identity sync in pkg/identity/identitysync,
+// which fetches every user and group from the identity provider on a schedule.
+// Groups created using SCIM have the identity.SCIM source and aren't modified by the identity sync.
+package scim
+
+import (
+	"net/http"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/ddb"
+	"github.com/go-chi/chi/v5"
+)
+
+// MaxResults is the maximum number of resources returned in a single list response.
+const MaxResults = 100
+
+type Server struct {
+	DB    ddb.Storage
+	Clock clock.Clock
+}
+
+// Routes returns the SCIM endpoints. The handler should be mounted at the SCIM base URL configured in the identity provider.
+func (s *Server) Routes() http.Handler {
+	r := chi.NewRouter()
+	r.Use(s.authenticate)
+	r.Get("/ServiceProviderConfig", s.getServiceProviderConfig)
+
+	r.Get("/Users", s.listUsers)
+	r.Post("/Users", s.createUser)
+	r.Get("/Users/{id}", s.getUser)
+	r.Put("/Users/{id}", s.replaceUser)
+	r.Patch("/Users/{id}", s.patchUser)
+	r.Delete("/Users/{id}", s.deleteUser)
+
+	r.Get("/Groups", s.listGroups)
+	r.Post("/Groups", s.createGroup)
+	r.Get("/Groups/{id}", s.getGroup)
+	r.Put("/Groups/{id}", s.replaceGroup)
+	r.Patch("/Groups/{id}", s.patchGroup)
+	r.Delete("/Groups/{id}", s.deleteGroup)
+	return r
+}
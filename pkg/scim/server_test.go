@@ -0,0 +1,301 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthenticate(t *testing.T) {
+	token, scimToken, err := identity.NewSCIMToken("usr_admin", clock.NewMock().Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type testcase struct {
+		name        string
+		giveHeader  string
+		withToken   *identity.SCIMToken
+		withErr     error
+		wantCode    int
+		wantBodyHas string
+	}
+
+	testcases := []testcase{
+		{
+			name:       "ok",
+			giveHeader: "Bearer " + token,
+			withToken:  &scimToken,
+			wantCode:   http.StatusOK,
+		},
+		{
+			name:        "missing token",
+			withToken:   &scimToken,
+			wantCode:    http.StatusUnauthorized,
+			wantBodyHas: `"detail":"a bearer token must be provided"`,
+		},
+		{
+			name:        "wrong token",
+			giveHeader:  "Bearer wrong",
+			withToken:   &scimToken,
+			wantCode:    http.StatusUnauthorized,
+			wantBodyHas: `"detail":"invalid bearer token"`,
+		},
+		{
+			name:        "SCIM not enabled",
+			giveHeader:  "Bearer " + token,
+			withErr:     ddb.ErrNoItems,
+			wantCode:    http.StatusUnauthorized,
+			wantBodyHas: `"detail":"invalid bearer token"`,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQueryWithErr(&storage.GetSCIMToken{Result: tc.withToken}, tc.withErr)
+			s := Server{DB: db, Clock: clock.NewMock()}
+
+			req := httptest.NewRequest(http.MethodGet, "/ServiceProviderConfig", nil)
+			if tc.giveHeader != "" {
+				req.Header.Set("Authorization", tc.giveHeader)
+			}
+			rr := httptest.NewRecorder()
+			s.Routes().ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			assert.Contains(t, rr.Body.String(), tc.wantBodyHas)
+		})
+	}
+}
+
+func TestCreateUser(t *testing.T) {
+	type testcase struct {
+		name         string
+		give         string
+		withExisting *identity.User
+		wantCode     int
+		want         *User
+		wantErr      *Error
+	}
+	clk := clock.NewMock()
+	active := true
+	inactive := false
+
+	testcases := []testcase{
+		{
+			name:     "ok",
+			give:     `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"userName":"alice@example.com","name":{"givenName":"Alice","familyName":"Smith"},"active":true}`,
+			wantCode: http.StatusCreated,
+			want: &User{
+				Schemas:  []string{UserSchema},
+				UserName: "alice@example.com",
+				Name:     &Name{GivenName: "Alice", FamilyName: "Smith"},
+				Emails:   []Email{{Value: "alice@example.com", Primary: true}},
+				Active:   &active,
+				Meta:     &Meta{ResourceType: "User", Created: clk.Now(), LastModified: clk.Now()},
+			},
+		},
+		{
+			name:         "deactivated users are provisioned again with the same ID",
+			give:         `{"userName":"alice@example.com"}`,
+			withExisting: &identity.User{ID: "usr_1", Email: "alice@example.com", FirstName: "Alice", Status: types.IdpStatusARCHIVED},
+			wantCode:     http.StatusCreated,
+			want: &User{
+				Schemas:  []string{UserSchema},
+				ID:       "usr_1",
+				UserName: "alice@example.com",
+				// the user's name is kept if a new name isn't provided.
+				Name:   &Name{GivenName: "Alice"},
+				Emails: []Email{{Value: "alice@example.com", Primary: true}},
+				Active: &active,
+				Meta:   &Meta{ResourceType: "User", LastModified: clk.Now()},
+			},
+		},
+		{
+			name:         "inactive users can be created",
+			give:         `{"userName":"alice@example.com","active":false}`,
+			withExisting: &identity.User{ID: "usr_1", Email: "alice@example.com", Status: types.IdpStatusARCHIVED},
+			wantCode:     http.StatusCreated,
+			want: &User{
+				Schemas:  []string{UserSchema},
+				ID:       "usr_1",
+				UserName: "alice@example.com",
+				Name:     &Name{},
+				Emails:   []Email{{Value: "alice@example.com", Primary: true}},
+				Active:   &inactive,
+				Meta:     &Meta{ResourceType: "User", LastModified: clk.Now()},
+			},
+		},
+		{
+			name:         "user already exists",
+			give:         `{"userName":"alice@example.com"}`,
+			withExisting: &identity.User{ID: "usr_1", Email: "alice@example.com", Status: types.IdpStatusACTIVE},
+			wantCode:     http.StatusConflict,
+			wantErr:      &Error{Schemas: []string{ErrorSchema}, Status: "409", SCIMType: ErrUniqueness, Detail: "a user with this userName already exists"},
+		},
+		{
+			name:     "userName is required",
+			give:     `{"name":{"givenName":"Alice"}}`,
+			wantCode: http.StatusBadRequest,
+			wantErr:  &Error{Schemas: []string{ErrorSchema}, Status: "400", SCIMType: ErrInvalidValue, Detail: "userName must be provided"},
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			token, scimToken, err := identity.NewSCIMToken("usr_admin", clk.Now())
+			if err != nil {
+				t.Fatal(err)
+			}
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetSCIMToken{Result: &scimToken})
+			if tc.withExisting != nil {
+				db.MockQuery(&storage.GetUserByEmail{Result: tc.withExisting})
+			} else {
+				db.MockQueryWithErr(&storage.GetUserByEmail{}, ddb.ErrNoItems)
+			}
+			s := Server{DB: db, Clock: clk}
+
+			req := httptest.NewRequest(http.MethodPost, "/Users", strings.NewReader(tc.give))
+			req.Header.Set("Authorization", "Bearer "+token)
+			rr := httptest.NewRecorder()
+			s.Routes().ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			assert.Equal(t, "application/scim+json", rr.Header().Get("Content-Type"))
+			if tc.wantErr != nil {
+				var got Error
+				err = json.NewDecoder(rr.Body).Decode(&got)
+				assert.NoError(t, err)
+				assert.Equal(t, *tc.wantErr, got)
+				return
+			}
+			var got User
+			err = json.NewDecoder(rr.Body).Decode(&got)
+			assert.NoError(t, err)
+			if tc.want.ID == "" {
+				// new users are assigned a random ID.
+				assert.NotEmpty(t, got.ID)
+				got.ID = ""
+			}
+			got.Meta.Created = got.Meta.Created.UTC()
+			got.Meta.LastModified = got.Meta.LastModified.UTC()
+			tc.want.Meta.Created = tc.want.Meta.Created.UTC()
+			tc.want.Meta.LastModified = tc.want.Meta.LastModified.UTC()
+			assert.Equal(t, *tc.want, got)
+		})
+	}
+}
+
+func TestGetUser(t *testing.T) {
+	type testcase struct {
+		name     string
+		withUser identity.User
+		wantCode int
+		wantBody string
+	}
+
+	testcases := []testcase{
+		{
+			name:     "ok",
+			withUser: identity.User{ID: "usr_1", Email: "alice@example.com", Source: identity.SCIM, Status: types.IdpStatusACTIVE},
+			wantCode: http.StatusOK,
+			wantBody: `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"id":"usr_1","userName":"alice@example.com","name":{},"emails":[{"value":"alice@example.com","primary":true}],"active":true,"meta":{"resourceType":"User","created":"0001-01-01T00:00:00Z","lastModified":"0001-01-01T00:00:00Z"}}` + "\n",
+		},
+		{
+			name:     "users from the identity sync can't be managed with SCIM",
+			withUser: identity.User{ID: "usr_1", Email: "alice@example.com", Source: "okta", Status: types.IdpStatusACTIVE},
+			wantCode: http.StatusNotFound,
+			wantBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"404","detail":"user not found"}` + "\n",
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			clk := clock.NewMock()
+			token, scimToken, err := identity.NewSCIMToken("usr_admin", clk.Now())
+			if err != nil {
+				t.Fatal(err)
+			}
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetSCIMToken{Result: &scimToken})
+			db.MockQuery(&storage.GetUser{Result: &tc.withUser})
+			s := Server{DB: db, Clock: clk}
+
+			req := httptest.NewRequest(http.MethodGet, "/Users/usr_1", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			rr := httptest.NewRecorder()
+			s.Routes().ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			assert.Equal(t, tc.wantBody, rr.Body.String())
+		})
+	}
+}
+
+func TestGetGroup(t *testing.T) {
+	type testcase struct {
+		name      string
+		withGroup identity.Group
+		wantCode  int
+		wantBody  string
+	}
+
+	testcases := []testcase{
+		{
+			name:      "ok",
+			withGroup: identity.Group{ID: "grp_1", Name: "Engineering", Source: identity.SCIM, Status: types.IdpStatusACTIVE, Users: []string{"usr_1"}},
+			wantCode:  http.StatusOK,
+			wantBody:  `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:Group"],"id":"grp_1","displayName":"Engineering","members":[{"value":"usr_1"}],"meta":{"resourceType":"Group","created":"0001-01-01T00:00:00Z","lastModified":"0001-01-01T00:00:00Z"}}` + "\n",
+		},
+		{
+			name:      "groups from the identity sync can't be managed with SCIM",
+			withGroup: identity.Group{ID: "grp_1", Name: "Engineering", Source: "okta", Status: types.IdpStatusACTIVE},
+			wantCode:  http.StatusNotFound,
+			wantBody:  `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"404","detail":"group not found"}` + "\n",
+		},
+		{
+			name:      "deleted groups are not found",
+			withGroup: identity.Group{ID: "grp_1", Name: "Engineering", Source: identity.SCIM, Status: types.IdpStatusARCHIVED},
+			wantCode:  http.StatusNotFound,
+			wantBody:  `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"404","detail":"group not found"}` + "\n",
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			clk := clock.NewMock()
+			token, scimToken, err := identity.NewSCIMToken("usr_admin", clk.Now())
+			if err != nil {
+				t.Fatal(err)
+			}
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetSCIMToken{Result: &scimToken})
+			db.MockQuery(&storage.GetGroup{Result: &tc.withGroup})
+			s := Server{DB: db, Clock: clk}
+
+			req := httptest.NewRequest(http.MethodGet, "/Groups/grp_1", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			rr := httptest.NewRecorder()
+			s.Routes().ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			assert.Equal(t, tc.wantBody, rr.Body.String())
+		})
+	}
+}
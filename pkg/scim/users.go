@@ -0,0 +1,272 @@
+package scim
+
+import (
+	"net/http"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/go-chi/chi/v5"
+)
+
+// Only users which were provisioned using SCIM can be viewed and updated using SCIM,
+// so that an identity provider can't modify internal users or users from the identity sync.
+// Archived users from other sources are adopted when a user with the same userName is provisioned, see createUser.
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	f, err := ParseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		writeError(ctx, w, http.StatusBadRequest, ErrInvalidFilter, err.Error())
+		return
+	}
+
+	var users []identity.User
+	if f == nil {
+		users, err = s.allUsers(r)
+	} else {
+		switch f.Attribute {
+		case "username", "emails.value", "emails":
+			q := storage.GetUserByEmail{Email: f.Value}
+			_, err = s.DB.Query(ctx, &q)
+			if err == nil {
+				users = append(users, *q.Result)
+			}
+		case "id":
+			q := storage.GetUser{ID: f.Value}
+			_, err = s.DB.Query(ctx, &q)
+			if err == nil {
+				users = append(users, *q.Result)
+			}
+		default:
+			writeError(ctx, w, http.StatusBadRequest, ErrInvalidFilter, "users can only be filtered by userName, emails.value or id")
+			return
+		}
+	}
+	if err != nil && err != ddb.ErrNoItems {
+		writeInternalError(ctx, w, err)
+		return
+	}
+
+	resources := []User{}
+	for _, u := range users {
+		if u.Source != identity.SCIM {
+			continue
+		}
+		resources = append(resources, userResource(u))
+	}
+	writeJSON(ctx, w, paginate(r, resources), http.StatusOK)
+}
+
+func (s *Server) allUsers(r *http.Request) ([]identity.User, error) {
+	var users []identity.User
+	var next string
+	for {
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		q := storage.ListUsers{}
+		qr, err := s.DB.Query(r.Context(), &q, opts...)
+		if err == ddb.ErrNoItems {
+			return users, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, q.Result...)
+		next = qr.NextPage
+		if next == "" {
+			return users, nil
+		}
+	}
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b User
+	if !decodeBody(w, r, &b) {
+		return
+	}
+	if b.UserName == "" {
+		writeError(ctx, w, http.StatusBadRequest, ErrInvalidValue, "userName must be provided")
+		return
+	}
+
+	now := s.Clock.Now()
+	u := identity.User{
+		ID:        types.NewUserID(),
+		Email:     b.UserName,
+		Groups:    []string{},
+		Source:    identity.SCIM,
+		CreatedAt: now,
+	}
+	q := storage.GetUserByEmail{Email: b.UserName}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		writeInternalError(ctx, w, err)
+		return
+	}
+	if err == nil {
+		// users which were deactivated can be provisioned again, which keeps their ID so that their request history is retained.
+		if q.Result.Status == types.IdpStatusACTIVE {
+			writeError(ctx, w, http.StatusConflict, ErrUniqueness, "a user with this userName already exists")
+			return
+		}
+		u = *q.Result
+		u.Source = identity.SCIM
+	}
+
+	u.Status = types.IdpStatusACTIVE
+	if b.Active != nil && !*b.Active {
+		u.Status = types.IdpStatusARCHIVED
+	}
+	if b.Name != nil {
+		u.FirstName, u.LastName = b.Name.GivenName, b.Name.FamilyName
+	}
+	u.UpdatedAt = now
+	err = s.DB.Put(ctx, &u)
+	if err != nil {
+		writeInternalError(ctx, w, err)
+		return
+	}
+	writeJSON(ctx, w, userResource(u), http.StatusCreated)
+}
+
+// lookupUser writes a not found error if the user doesn't exist or wasn't provisioned using SCIM.
+// Deactivated users are still found, so that they can be reactivated.
+func (s *Server) lookupUser(w http.ResponseWriter, r *http.Request) (*identity.User, bool) {
+	ctx := r.Context()
+	q := storage.GetUser{ID: chi.URLParam(r, "id")}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		writeInternalError(ctx, w, err)
+		return nil, false
+	}
+	if err == ddb.ErrNoItems || q.Result.Source != identity.SCIM {
+		writeError(ctx, w, http.StatusNotFound, "", "user not found")
+		return nil, false
+	}
+	return q.Result, true
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	u, ok := s.lookupUser(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(r.Context(), w, userResource(*u), http.StatusOK)
+}
+
+func (s *Server) replaceUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u, ok := s.lookupUser(w, r)
+	if !ok {
+		return
+	}
+	var b User
+	if !decodeBody(w, r, &b) {
+		return
+	}
+	if b.UserName == "" {
+		writeError(ctx, w, http.StatusBadRequest, ErrInvalidValue, "userName must be provided")
+		return
+	}
+	wasActive := u.Status == types.IdpStatusACTIVE
+	oldEmail := u.Email
+	u.Email = b.UserName
+	u.FirstName, u.LastName = "", ""
+	if b.Name != nil {
+		u.FirstName, u.LastName = b.Name.GivenName, b.Name.FamilyName
+	}
+	u.Status = types.IdpStatusACTIVE
+	if b.Active != nil && !*b.Active {
+		u.Status = types.IdpStatusARCHIVED
+	}
+	s.saveUser(w, r, u, wasActive, oldEmail)
+}
+
+func (s *Server) patchUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u, ok := s.lookupUser(w, r)
+	if !ok {
+		return
+	}
+	var b PatchRequest
+	if !decodeBody(w, r, &b) {
+		return
+	}
+	wasActive := u.Status == types.IdpStatusACTIVE
+	oldEmail := u.Email
+	err := ApplyUserPatch(u, b.Operations)
+	if err != nil {
+		writePatchError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+	s.saveUser(w, r, u, wasActive, oldEmail)
+}
+
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u, ok := s.lookupUser(w, r)
+	if !ok {
+		return
+	}
+	// users are archived rather than deleted, so that their requests can still be viewed.
+	wasActive := u.Status == types.IdpStatusACTIVE
+	u.Status = types.IdpStatusARCHIVED
+	items, err := s.userItems(r, u, wasActive, u.Email)
+	if err != nil {
+		writeInternalError(ctx, w, err)
+		return
+	}
+	err = s.DB.PutBatch(ctx, items...)
+	if err != nil {
+		writeInternalError(ctx, w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// saveUser saves a user which has been updated and writes the user to the response.
+func (s *Server) saveUser(w http.ResponseWriter, r *http.Request, u *identity.User, wasActive bool, oldEmail string) {
+	ctx := r.Context()
+	items, err := s.userItems(r, u, wasActive, oldEmail)
+	if err != nil {
+		writePatchError(ctx, w, http.StatusConflict, err)
+		return
+	}
+	err = s.DB.PutBatch(ctx, items...)
+	if err != nil {
+		writeInternalError(ctx, w, err)
+		return
+	}
+	writeJSON(ctx, w, userResource(*u), http.StatusOK)
+}
+
+// userItems returns the items to save when a user is updated.
+// Users who are deactivated are removed from their groups, and a user's email can't be changed to the email of another user.
+func (s *Server) userItems(r *http.Request, u *identity.User, wasActive bool, oldEmail string) ([]ddb.Keyer, error) {
+	ctx := r.Context()
+	if u.Email != oldEmail {
+		q := storage.GetUserByEmail{Email: u.Email}
+		_, err := s.DB.Query(ctx, &q)
+		if err == nil && q.Result.ID != u.ID {
+			return nil, PatchError{SCIMType: ErrUniqueness, Detail: "a user with this userName already exists"}
+		}
+		if err != nil && err != ddb.ErrNoItems {
+			return nil, err
+		}
+	}
+	var items []ddb.Keyer
+	if wasActive && u.Status == types.IdpStatusARCHIVED {
+		groups, err := s.removeFromGroups(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, groups...)
+	}
+	u.UpdatedAt = s.Clock.Now()
+	return append(items, u), nil
+}
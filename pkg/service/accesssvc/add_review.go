@@ -2,13 +2,18 @@ package accesssvc
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/common-fate/analytics-go"
+	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/common-fate/pkg/access"
 	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/service/rulesvc"
 	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
 	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/hashicorp/go-multierror"
 )
 
 type AddReviewOpts struct {
@@ -32,15 +37,24 @@ type AddReviewResult struct {
 
 // AddReviewAndGrantAccess reviews a Request. It updates the status of the Request depending on the review decision.
 // If the review approves access, access is granted.
+//
+// Requests approved using break-glass access are reviewed after access has been granted,
+// in which case the review is recorded without changing the status of the request.
 func (s *Service) AddReviewAndGrantAccess(ctx context.Context, opts AddReviewOpts) (*AddReviewResult, error) {
 	request := opts.Request
+	if opts.ReviewerID == request.RequestedBy {
+		return nil, ErrCannotReviewOwnRequest
+	}
+	if request.IsBreakGlass() {
+		return s.reviewBreakGlass(ctx, opts)
+	}
 	if request.Status != access.PENDING {
 		return nil, InvalidStatusError{Status: request.Status}
 	}
 
 	originalStatus := request.Status
 
-	isAllowed := canReview(opts)
+	isAllowed := canReview(opts, s.Clock.Now())
 	if !isAllowed {
 		return nil, ErrUserNotAuthorized
 	}
@@ -48,17 +62,46 @@ func (s *Service) AddReviewAndGrantAccess(ctx context.Context, opts AddReviewOpt
 	r := access.Review{
 		ID:              types.NewRequestReviewID(),
 		RequestID:       request.ID,
+		RuleID:          request.Rule,
 		ReviewerID:      opts.ReviewerID,
 		Decision:        opts.Decision,
 		Comment:         opts.Comment,
 		OverrideTimings: opts.OverrideTiming,
+		ReviewedAt:      s.Clock.Now(),
 	}
+	// reviews made by a delegate are marked as delegated in the audit trail.
+	if d := reviewerDelegation(opts.ReviewerID, opts.Reviewers); d != nil && !opts.ReviewerIsAdmin {
+		r.DelegatedFrom = &d.UserID
+	}
+
+	// the approval stage which was approved by this review, if any.
+	var approvedStage *access.ApprovalStage
+	// claimed is true once the request has been saved with its new status, before access was granted.
+	claimed := false
+	request.UpdatedAt = s.Clock.Now()
 
 	// update the request status, based on the review decision
 	switch r.Decision {
 	case access.DecisionApproved:
+		err := s.checkSeparationOfDuties(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		if opts.OverrideTiming != nil {
+			request.OverrideTiming = opts.OverrideTiming
+		}
+		stage, err := s.approveStage(ctx, &request, opts)
+		if err != nil {
+			return nil, err
+		}
+		approvedStage = &stage
+
+		// the request remains pending until every approval stage has reached its quorum.
+		if request.CurrentApprovalStage() != -1 {
+			break
+		}
+
 		request.Status = access.APPROVED
-		request.OverrideTiming = opts.OverrideTiming
 		// This will check against the requests which do have grants already
 		overlaps, err := s.overlapsExistingGrant(ctx, request)
 		if err != nil {
@@ -67,8 +110,20 @@ func (s *Service) AddReviewAndGrantAccess(ctx context.Context, opts AddReviewOpt
 		if overlaps {
 			return nil, ErrRequestOverlapsExistingGrant
 		}
+		// the approved request is saved before access is granted,
+		// so that concurrent approvals can't both grant access.
+		err = s.putRequestIfUnchanged(ctx, request, opts.Request.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		claimed = true
 		grant, err := s.Workflow.Grant(ctx, request, opts.AccessRule)
 		if err != nil {
+			// return the request to pending so that it can be reviewed again.
+			releaseErr := s.putRequestIfUnchanged(ctx, opts.Request, request.UpdatedAt)
+			if releaseErr != nil {
+				return nil, multierror.Append(err, releaseErr)
+			}
 			return nil, err
 		}
 		request.Grant = grant
@@ -78,34 +133,63 @@ func (s *Service) AddReviewAndGrantAccess(ctx context.Context, opts AddReviewOpt
 	case access.DecisionDECLINED:
 		request.Status = access.DECLINED
 	}
-	request.UpdatedAt = s.Clock.Now()
 
 	// we need to save the Review, the updated Request in the database.
-	items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, request, dbupdate.WithReviewers(opts.Reviewers))
+	items, err := dbupdate.GetUpdateReviewerItems(ctx, s.DB, request, dbupdate.WithReviewers(opts.Reviewers))
 	if err != nil {
 		return nil, err
 	}
 	items = append(items, &r)
 
-	if request.OverrideTiming != nil {
+	if r.Decision == access.DecisionApproved && opts.OverrideTiming != nil {
 		// audit log event
 		reqEvent := access.NewTimingChangeEvent(request.ID, request.UpdatedAt, &opts.ReviewerID, request.RequestedTiming, *request.OverrideTiming)
+		reqEvent.DelegatedFrom = r.DelegatedFrom
 		items = append(items, &reqEvent)
 	}
-	// audit log event
-	reqEvent := access.NewStatusChangeEvent(request.ID, request.UpdatedAt, &opts.ReviewerID, originalStatus, request.Status)
-
-	items = append(items, &reqEvent)
+	if approvedStage != nil {
+		// audit log event
+		stageEvent := access.NewApprovalStageEvent(request.ID, request.UpdatedAt, &opts.ReviewerID, *approvedStage)
+		stageEvent.DelegatedFrom = r.DelegatedFrom
+		items = append(items, &stageEvent)
+	}
+	if request.Status != originalStatus {
+		// audit log event
+		reqEvent := access.NewStatusChangeEvent(request.ID, request.UpdatedAt, &opts.ReviewerID, originalStatus, request.Status)
+		reqEvent.DelegatedFrom = r.DelegatedFrom
+		items = append(items, &reqEvent)
+	}
+	if claimed {
+		// the request was locked before access was granted, and is saved again with its grant.
+		items = append(items, &request)
+	} else {
+		err = s.putRequestIfUnchanged(ctx, request, opts.Request.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
 	// store the updated items in the database
 	err = s.DB.PutBatch(ctx, items...)
 	if err != nil {
 		return nil, err
 	}
 
-	switch r.Decision {
-	case access.DecisionApproved:
+	// later occurrences of a recurring request can reuse this approval.
+	if claimed && request.RecurringRequestID != nil {
+		err = s.recordRecurringApproval(ctx, request, s.Clock.Now())
+		if err != nil {
+			// the request has already been approved, so the occurrence is left approved
+			// and later occurrences are reviewed as usual.
+			logger.Get(ctx).Warnw("failed to record approval of recurring request", "request.id", request.ID, "recurringRequest.id", *request.RecurringRequestID, "error", err)
+		}
+	}
+
+	switch {
+	case r.Decision == access.DecisionApproved && request.Status == access.PENDING:
+		err = s.EventPutter.Put(ctx, gevent.RequestStageApproved{Request: request, ReviewerEmail: opts.ReviewerEmail, ReviewerID: r.ReviewerID, Stage: *approvedStage})
+	case r.Decision == access.DecisionApproved:
 		err = s.EventPutter.Put(ctx, gevent.RequestApproved{Request: request, ReviewerEmail: opts.ReviewerEmail, ReviewerID: r.ReviewerID})
-	case access.DecisionDECLINED:
+	case r.Decision == access.DecisionDECLINED:
 		err = s.EventPutter.Put(ctx, gevent.RequestDeclined{Request: request, ReviewerEmail: opts.ReviewerEmail, ReviewerID: r.ReviewerID})
 	}
 
@@ -145,9 +229,93 @@ func (s *Service) AddReviewAndGrantAccess(ctx context.Context, opts AddReviewOpt
 	return &res, nil
 }
 
+// approveStage records the reviewer's approval against the current approval stage of the request,
+// returning the updated stage.
+//
+// For rules with multiple stages, only the approvers of the current stage (or administrators) may approve.
+// Administrators count as a single approval towards the current stage's quorum.
+func (s *Service) approveStage(ctx context.Context, request *access.Request, opts AddReviewOpts) (access.ApprovalStage, error) {
+	approval := request.ResolveApproval(opts.AccessRule)
+	if len(request.ApprovalStages) == 0 {
+		// requests created before approval stages were introduced don't have any progress recorded,
+		// so it is initialised from the access rule.
+		request.ApprovalStages = access.NewApprovalStages(approval)
+	}
+	stages, stage, err := s.recordStageApproval(ctx, request.ApprovalStages, approval, opts)
+	if err != nil {
+		return access.ApprovalStage{}, err
+	}
+	request.ApprovalStages = stages
+	return stage, nil
+}
+
+// recordStageApproval records the reviewer's approval against the first incomplete stage,
+// returning a copy of the stages with the approval recorded along with the updated stage.
+// The stages passed in are not modified.
+//
+// Approvals made by a delegate are recorded against the approver who delegated their reviews,
+// so that a delegate and their delegator can't both count towards a stage's quorum.
+func (s *Service) recordStageApproval(ctx context.Context, stages []access.ApprovalStage, approval rule.Approval, opts AddReviewOpts) ([]access.ApprovalStage, access.ApprovalStage, error) {
+	delegation := reviewerDelegation(opts.ReviewerID, opts.Reviewers)
+	approverID := opts.ReviewerID
+	if delegation != nil && !opts.ReviewerIsAdmin {
+		approverID = delegation.UserID
+	}
+	if access.HasApproved(stages, opts.ReviewerID) || access.HasApproved(stages, approverID) {
+		return nil, access.ApprovalStage{}, ErrReviewerAlreadyApproved
+	}
+
+	updated := make([]access.ApprovalStage, len(stages))
+	for i, stage := range stages {
+		stage.ApprovedBy = append([]string{}, stage.ApprovedBy...)
+		updated[i] = stage
+	}
+
+	current := access.CurrentApprovalStage(updated)
+	if current == -1 {
+		return nil, access.ApprovalStage{}, errors.New("no approval stages remaining")
+	}
+
+	ruleStages := approval.GetStages()
+	if approval.IsMultiStage() && !opts.ReviewerIsAdmin && current < len(ruleStages) {
+		approvers, err := rulesvc.GetStageApprovers(ctx, s.DB, ruleStages[current])
+		if err != nil {
+			return nil, access.ApprovalStage{}, err
+		}
+		// delegates can approve the stage on behalf of the approver who delegated their reviews.
+		isStageApprover := false
+		for _, a := range approvers {
+			if a == opts.ReviewerID || (delegation != nil && a == delegation.UserID) {
+				isStageApprover = true
+				break
+			}
+		}
+		if !isStageApprover {
+			return nil, access.ApprovalStage{}, ErrUserNotAuthorized
+		}
+	}
+
+	updated[current].ApprovedBy = append(updated[current].ApprovedBy, approverID)
+	return updated, updated[current], nil
+}
+
+// putRequestIfUnchanged saves a reviewed request, conditional on the request not having been updated since it was read.
+// This prevents concurrent reviews from overwriting each other's approval stage progress.
+func (s *Service) putRequestIfUnchanged(ctx context.Context, request access.Request, readUpdatedAt time.Time) error {
+	err := dbupdate.PutIf(ctx, s.DB, &request, dbupdate.Condition{
+		Expression: "updatedAt = :updatedAt",
+		Values:     map[string]any{":updatedAt": readUpdatedAt},
+	})
+	if err == dbupdate.ErrConditionFailed {
+		return ErrRequestModified
+	}
+	return err
+}
+
 // users can review requests if they are a Common Fate administrator,
 // or if they are a Reviewer on the request.
-func canReview(opts AddReviewOpts) bool {
+// Reviewers who were added as a delegate can only review while the delegation is active.
+func canReview(opts AddReviewOpts, now time.Time) bool {
 	if opts.ReviewerID == opts.Request.RequestedBy {
 		return false
 	}
@@ -156,7 +324,7 @@ func canReview(opts AddReviewOpts) bool {
 	}
 	for _, r := range opts.Reviewers {
 		if opts.ReviewerID == r.ReviewerID {
-			return true
+			return r.Delegation == nil || r.Delegation.IsActive(now)
 		}
 	}
 	// the user isn't allowed to review the request.
@@ -2,15 +2,24 @@ package accesssvc
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+
 	"github.com/benbjohnson/clock"
 	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/rule"
 	"github.com/common-fate/common-fate/pkg/service/accesssvc/mocks"
 	accessMocks "github.com/common-fate/common-fate/pkg/service/accesssvc/mocks"
 	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
 	"github.com/common-fate/ddb/ddbmock"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -37,12 +46,22 @@ func TestAddReview(t *testing.T) {
 		StartTime: &now,
 	}
 	reviewed := types.REVIEWED
+	approvedByA := []access.ApprovalStage{{Name: "Approval", Quorum: 1, ApprovedBy: []string{"a"}}}
 	requestWithOverride := access.Request{
 		Status:         access.APPROVED,
 		Grant:          &access.Grant{},
 		OverrideTiming: overrideTiming,
 		UpdatedAt:      clk.Now(),
 		ApprovalMethod: &reviewed,
+		ApprovalStages: approvedByA,
+	}
+	multiStageRule := rule.AccessRule{
+		Approval: rule.Approval{
+			Stages: []rule.ApprovalStage{
+				{Name: "Team lead", Users: []string{"a"}},
+				{Name: "Security", Users: []string{"b", "c"}, Quorum: 2},
+			},
+		},
 	}
 
 	testcases := []testcase{
@@ -83,6 +102,7 @@ func TestAddReview(t *testing.T) {
 					UpdatedAt:      clk.Now(),
 					Grant:          &access.Grant{},
 					ApprovalMethod: &reviewed,
+					ApprovalStages: approvedByA,
 				},
 			},
 		},
@@ -138,7 +158,7 @@ func TestAddReview(t *testing.T) {
 					RequestedBy: "a",
 				},
 			},
-			wantErr: ErrUserNotAuthorized,
+			wantErr: ErrCannotReviewOwnRequest,
 		},
 		{
 			name: "admin cannot review own request",
@@ -151,7 +171,7 @@ func TestAddReview(t *testing.T) {
 					RequestedBy: "a",
 				},
 			},
-			wantErr: ErrUserNotAuthorized,
+			wantErr: ErrCannotReviewOwnRequest,
 		},
 		{
 			name: "admin can review not own request",
@@ -180,9 +200,162 @@ func TestAddReview(t *testing.T) {
 					UpdatedAt:      clk.Now(),
 					Grant:          &access.Grant{},
 					ApprovalMethod: &reviewed,
+					ApprovalStages: approvedByA,
+				},
+			},
+		},
+		{
+			name: "quorum not reached keeps request pending",
+			give: AddReviewOpts{
+				ReviewerID: "a",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{ReviewerID: "a"},
+					{ReviewerID: "b"},
+				},
+				Request: access.Request{
+					Status:         access.PENDING,
+					ApprovalStages: []access.ApprovalStage{{Name: "Approval", Quorum: 2, ApprovedBy: []string{}}},
+				},
+			},
+			want: &AddReviewResult{
+				Request: access.Request{
+					Status:         access.PENDING,
+					UpdatedAt:      clk.Now(),
+					ApprovalStages: []access.ApprovalStage{{Name: "Approval", Quorum: 2, ApprovedBy: []string{"a"}}},
+				},
+			},
+		},
+		{
+			name: "quorum reached grants access",
+			give: AddReviewOpts{
+				ReviewerID: "b",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{ReviewerID: "a"},
+					{ReviewerID: "b"},
+				},
+				Request: access.Request{
+					Status:         access.PENDING,
+					ApprovalStages: []access.ApprovalStage{{Name: "Approval", Quorum: 2, ApprovedBy: []string{"a"}}},
+				},
+			},
+			withCreateGrantResponse: createGrantResponse{
+				request: &access.Request{
+					Grant: &access.Grant{},
+				},
+			},
+			want: &AddReviewResult{
+				Request: access.Request{
+					Status:         access.APPROVED,
+					UpdatedAt:      clk.Now(),
+					Grant:          &access.Grant{},
+					ApprovalMethod: &reviewed,
+					ApprovalStages: []access.ApprovalStage{{Name: "Approval", Quorum: 2, ApprovedBy: []string{"a", "b"}}},
+				},
+			},
+		},
+		{
+			name: "reviewer cannot approve twice",
+			give: AddReviewOpts{
+				ReviewerID: "a",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{ReviewerID: "a"},
+					{ReviewerID: "b"},
+				},
+				Request: access.Request{
+					Status:         access.PENDING,
+					ApprovalStages: []access.ApprovalStage{{Name: "Approval", Quorum: 2, ApprovedBy: []string{"a"}}},
+				},
+			},
+			wantErr: ErrReviewerAlreadyApproved,
+		},
+		{
+			name: "delegate approval counts as the delegator's",
+			give: AddReviewOpts{
+				ReviewerID: "c",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{ReviewerID: "a"},
+					{ReviewerID: "b"},
+					{ReviewerID: "c", Delegation: &access.Delegation{UserID: "a", DelegateID: "c", StartsAt: clk.Now().Add(-time.Hour), EndsAt: clk.Now().Add(time.Hour)}},
+				},
+				Request: access.Request{
+					Status:         access.PENDING,
+					ApprovalStages: []access.ApprovalStage{{Name: "Approval", Quorum: 2, ApprovedBy: []string{}}},
+				},
+			},
+			want: &AddReviewResult{
+				Request: access.Request{
+					Status:         access.PENDING,
+					UpdatedAt:      clk.Now(),
+					ApprovalStages: []access.ApprovalStage{{Name: "Approval", Quorum: 2, ApprovedBy: []string{"a"}}},
 				},
 			},
 		},
+		{
+			name: "delegate cannot approve after their delegator",
+			give: AddReviewOpts{
+				ReviewerID: "c",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{ReviewerID: "a"},
+					{ReviewerID: "b"},
+					{ReviewerID: "c", Delegation: &access.Delegation{UserID: "a", DelegateID: "c", StartsAt: clk.Now().Add(-time.Hour), EndsAt: clk.Now().Add(time.Hour)}},
+				},
+				Request: access.Request{
+					Status:         access.PENDING,
+					ApprovalStages: []access.ApprovalStage{{Name: "Approval", Quorum: 2, ApprovedBy: []string{"a"}}},
+				},
+			},
+			wantErr: ErrReviewerAlreadyApproved,
+		},
+		{
+			name: "first stage approved",
+			give: AddReviewOpts{
+				ReviewerID: "a",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{ReviewerID: "a"},
+					{ReviewerID: "b"},
+					{ReviewerID: "c"},
+				},
+				AccessRule: multiStageRule,
+				Request: access.Request{
+					Status:         access.PENDING,
+					ApprovalStages: access.NewApprovalStages(multiStageRule.Approval),
+				},
+			},
+			want: &AddReviewResult{
+				Request: access.Request{
+					Status:    access.PENDING,
+					UpdatedAt: clk.Now(),
+					ApprovalStages: []access.ApprovalStage{
+						{Name: "Team lead", Quorum: 1, ApprovedBy: []string{"a"}},
+						{Name: "Security", Quorum: 2, ApprovedBy: []string{}},
+					},
+				},
+			},
+		},
+		{
+			name: "later stage approver cannot approve before earlier stage is complete",
+			give: AddReviewOpts{
+				ReviewerID: "b",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{ReviewerID: "a"},
+					{ReviewerID: "b"},
+					{ReviewerID: "c"},
+				},
+				AccessRule: multiStageRule,
+				Request: access.Request{
+					Status:         access.PENDING,
+					ApprovalStages: access.NewApprovalStages(multiStageRule.Approval),
+				},
+			},
+			wantErr: ErrUserNotAuthorized,
+		},
 	}
 
 	for i := range testcases {
@@ -191,7 +364,7 @@ func TestAddReview(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			workflowMock := accessMocks.NewMockWorkflow(ctrl)
-			if tc.wantErr == nil {
+			if tc.withCreateGrantResponse.request != nil {
 				workflowMock.EXPECT().Grant(gomock.Any(), gomock.Any(), gomock.Any()).Return(tc.withCreateGrantResponse.request.Grant, tc.withCreateGrantResponse.err).AnyTimes()
 			}
 
@@ -223,3 +396,108 @@ func TestAddReview(t *testing.T) {
 	}
 
 }
+
+// conditionalDB is mock storage which sends conditional writes to a fake DynamoDB endpoint,
+// as the mock storage doesn't support condition expressions.
+type conditionalDB struct {
+	ddb.Storage
+	client *dynamodb.Client
+}
+
+func (c *conditionalDB) Client() *dynamodb.Client { return c.client }
+
+// newConditionalDB returns mock storage which sends conditional writes to a fake DynamoDB endpoint.
+// The endpoint records the condition expression of each write, and fails every write if conditionFails is true.
+func newConditionalDB(t *testing.T, db ddb.Storage, conditionFails bool) (*conditionalDB, *[]string) {
+	var conditions []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var in struct {
+			ConditionExpression string
+		}
+		err := json.NewDecoder(r.Body).Decode(&in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		conditions = append(conditions, in.ConditionExpression)
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		if conditionFails {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"com.amazonaws.dynamodb.v20120810#ConditionalCheckFailedException","message":"The conditional request failed"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return &conditionalDB{
+		Storage: db,
+		client: dynamodb.New(dynamodb.Options{
+			Region:       "us-east-1",
+			BaseEndpoint: aws.String(server.URL),
+			Credentials:  credentials.NewStaticCredentialsProvider("test", "test", ""),
+		}),
+	}, &conditions
+}
+
+func TestAddReviewLocksRequestBeforeGrant(t *testing.T) {
+	type testcase struct {
+		name           string
+		conditionFails bool
+		wantGrant      bool
+		wantErr        error
+	}
+
+	testcases := []testcase{
+		{
+			name:      "ok",
+			wantGrant: true,
+		},
+		{
+			name:           "request reviewed concurrently",
+			conditionFails: true,
+			wantErr:        ErrRequestModified,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			c := ddbmock.New(t)
+			c.MockQuery(&storage.ListRequestsForUserAndRequestend{})
+			db, conditions := newConditionalDB(t, c, tc.conditionFails)
+
+			clk := clock.NewMock()
+			ctrl := gomock.NewController(t)
+			workflowMock := accessMocks.NewMockWorkflow(ctrl)
+			if tc.wantGrant {
+				workflowMock.EXPECT().Grant(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, request access.Request, rule rule.AccessRule) (*access.Grant, error) {
+					// the request must have been locked before access is granted.
+					assert.Equal(t, []string{"updatedAt = :updatedAt"}, *conditions)
+					return &access.Grant{}, nil
+				})
+			}
+			ep := mocks.NewMockEventPutter(ctrl)
+			ep.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			s := Service{
+				Clock:       clk,
+				DB:          db,
+				EventPutter: ep,
+				Workflow:    workflowMock,
+			}
+			_, err := s.AddReviewAndGrantAccess(context.Background(), AddReviewOpts{
+				ReviewerID: "a",
+				Decision:   access.DecisionApproved,
+				Reviewers:  []access.Reviewer{{ReviewerID: "a"}},
+				Request:    access.Request{Status: access.PENDING, UpdatedAt: clk.Now()},
+			})
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tc.wantErr)
+			}
+			assert.Equal(t, "updatedAt = :updatedAt", (*conditions)[0])
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package accesssvc
+
+import (
+	"context"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// reviewBreakGlass records an approver's review of a request which was approved using break-glass access.
+// Access has already been granted, so the review doesn't change the status of the request.
+// The decision is stored as a Review and on the request so that it appears in the audit trail.
+func (s *Service) reviewBreakGlass(ctx context.Context, opts AddReviewOpts) (*AddReviewResult, error) {
+	request := opts.Request
+	if !canReview(opts, s.Clock.Now()) {
+		return nil, ErrUserNotAuthorized
+	}
+	if !request.RequiresBreakGlassReview() {
+		return nil, ErrBreakGlassAlreadyReviewed
+	}
+
+	now := s.Clock.Now()
+	r := access.Review{
+		ID:         types.NewRequestReviewID(),
+		RequestID:  request.ID,
+		RuleID:     request.Rule,
+		ReviewerID: opts.ReviewerID,
+		Decision:   opts.Decision,
+		Comment:    opts.Comment,
+		ReviewedAt: now,
+	}
+
+	request.BreakGlassReview = &access.BreakGlassReview{
+		ReviewID:   r.ID,
+		ReviewerID: r.ReviewerID,
+		Decision:   r.Decision,
+		Comment:    r.Comment,
+		ReviewedAt: now,
+	}
+	request.UpdatedAt = now
+
+	items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, request, dbupdate.WithReviewers(opts.Reviewers))
+	if err != nil {
+		return nil, err
+	}
+	items = append(items, &r)
+	err = s.DB.PutBatch(ctx, items...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.EventPutter.Put(ctx, gevent.RequestBreakGlassReviewed{
+		Request:       request,
+		ReviewerID:    r.ReviewerID,
+		ReviewerEmail: opts.ReviewerEmail,
+		Decision:      r.Decision,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AddReviewResult{Request: request}, nil
+}
@@ -0,0 +1,122 @@
+package accesssvc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc/mocks"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReviewBreakGlass(t *testing.T) {
+	type testcase struct {
+		name    string
+		give    AddReviewOpts
+		want    *AddReviewResult
+		wantErr error
+	}
+
+	clk := clock.NewMock()
+	breakGlass := types.BREAKGLASS
+	comment := "access was not required for the incident"
+	reviewers := []access.Reviewer{{ReviewerID: "a"}}
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			give: AddReviewOpts{
+				ReviewerID: "a",
+				Decision:   access.DecisionDECLINED,
+				Comment:    &comment,
+				Reviewers:  reviewers,
+				Request: access.Request{
+					RequestedBy:    "b",
+					Status:         access.APPROVED,
+					Grant:          &access.Grant{},
+					ApprovalMethod: &breakGlass,
+				},
+			},
+			want: &AddReviewResult{
+				Request: access.Request{
+					RequestedBy:    "b",
+					Status:         access.APPROVED, // the status is not changed by the review
+					Grant:          &access.Grant{},
+					ApprovalMethod: &breakGlass,
+					UpdatedAt:      clk.Now(),
+					BreakGlassReview: &access.BreakGlassReview{
+						ReviewerID: "a",
+						Decision:   access.DecisionDECLINED,
+						Comment:    &comment,
+						ReviewedAt: clk.Now(),
+					},
+				},
+			},
+		},
+		{
+			name: "already reviewed",
+			give: AddReviewOpts{
+				ReviewerID: "a",
+				Decision:   access.DecisionApproved,
+				Reviewers:  reviewers,
+				Request: access.Request{
+					RequestedBy:      "b",
+					Status:           access.APPROVED,
+					ApprovalMethod:   &breakGlass,
+					BreakGlassReview: &access.BreakGlassReview{ReviewerID: "c", Decision: access.DecisionApproved},
+				},
+			},
+			wantErr: ErrBreakGlassAlreadyReviewed,
+		},
+		{
+			name: "requestor cannot review their own break-glass access",
+			give: AddReviewOpts{
+				ReviewerID:      "b",
+				ReviewerIsAdmin: true,
+				Decision:        access.DecisionApproved,
+				Request: access.Request{
+					RequestedBy:    "b",
+					Status:         access.APPROVED,
+					ApprovalMethod: &breakGlass,
+				},
+			},
+			wantErr: ErrCannotReviewOwnRequest,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ep := mocks.NewMockEventPutter(ctrl)
+			ep.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			c := ddbmock.New(t)
+			// called by dbupdate.GetUpdateRequestItems
+			c.MockQuery(&storage.ListRequestReviewers{})
+
+			s := Service{
+				Clock:       clk,
+				DB:          c,
+				EventPutter: ep,
+			}
+			got, err := s.AddReviewAndGrantAccess(context.Background(), tc.give)
+			if tc.wantErr != nil {
+				assert.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			// ignore the autogenerated review ID for testing.
+			if got != nil && got.Request.BreakGlassReview != nil {
+				got.Request.BreakGlassReview.ReviewID = ""
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -62,5 +62,5 @@ func canCancel(opts CancelRequestOpts, request access.Request) bool {
 
 // A request can be cancelled if
 func isCancellable(request access.Request) bool {
-	return request.Status == access.PENDING || request.Grant == nil && request.Status != access.CANCELLED
+	return request.Status == access.PENDING || request.Grant == nil && request.Status != access.CANCELLED && request.Status != access.EXPIRED
 }
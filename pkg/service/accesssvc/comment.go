@@ -0,0 +1,71 @@
+package accesssvc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+type AddCommentOpts struct {
+	AuthorID      string
+	AuthorEmail   string
+	AuthorIsAdmin bool
+	Request       access.Request
+	Reviewers     []access.Reviewer
+	Body          string
+}
+
+// AddComment adds a comment to the discussion on a request.
+// The requestor, reviewers of the request and administrators can comment.
+// The comment is recorded in the request's audit trail and an event is emitted so that it can be mirrored to Slack.
+func (s *Service) AddComment(ctx context.Context, opts AddCommentOpts) (*access.Comment, error) {
+	if !canComment(opts) {
+		return nil, ErrUserNotAuthorized
+	}
+	body := strings.TrimSpace(opts.Body)
+	if body == "" {
+		return nil, ErrCommentEmpty
+	}
+
+	c := access.Comment{
+		ID:        types.NewRequestCommentID(),
+		RequestID: opts.Request.ID,
+		AuthorID:  opts.AuthorID,
+		Body:      body,
+		CreatedAt: s.Clock.Now(),
+	}
+	// audit log event
+	commentEvent := access.NewCommentEvent(c)
+
+	err := s.DB.PutBatch(ctx, &c, &commentEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.EventPutter.Put(ctx, gevent.RequestCommented{
+		Request:     opts.Request,
+		Comment:     c,
+		AuthorEmail: opts.AuthorEmail,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// users can comment on requests if they are a Common Fate administrator,
+// the requestor, or a Reviewer on the request.
+func canComment(opts AddCommentOpts) bool {
+	if opts.AuthorIsAdmin || opts.AuthorID == opts.Request.RequestedBy {
+		return true
+	}
+	for _, r := range opts.Reviewers {
+		if opts.AuthorID == r.ReviewerID {
+			return true
+		}
+	}
+	return false
+}
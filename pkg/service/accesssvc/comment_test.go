@@ -0,0 +1,81 @@
+package accesssvc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc/mocks"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddComment(t *testing.T) {
+	type testcase struct {
+		name    string
+		give    AddCommentOpts
+		want    *access.Comment
+		wantErr error
+	}
+
+	clk := clock.NewMock()
+	request := access.Request{ID: "req_1", RequestedBy: "a"}
+	reviewers := []access.Reviewer{{ReviewerID: "b"}}
+
+	testcases := []testcase{
+		{
+			name: "requestor can comment",
+			give: AddCommentOpts{AuthorID: "a", Request: request, Reviewers: reviewers, Body: "I need access to fix the incident"},
+			want: &access.Comment{RequestID: "req_1", AuthorID: "a", Body: "I need access to fix the incident", CreatedAt: clk.Now()},
+		},
+		{
+			name: "reviewer can comment",
+			give: AddCommentOpts{AuthorID: "b", Request: request, Reviewers: reviewers, Body: "  which incident?  "},
+			want: &access.Comment{RequestID: "req_1", AuthorID: "b", Body: "which incident?", CreatedAt: clk.Now()},
+		},
+		{
+			name: "admin can comment",
+			give: AddCommentOpts{AuthorID: "c", AuthorIsAdmin: true, Request: request, Reviewers: reviewers, Body: "ok"},
+			want: &access.Comment{RequestID: "req_1", AuthorID: "c", Body: "ok", CreatedAt: clk.Now()},
+		},
+		{
+			name:    "other users cannot comment",
+			give:    AddCommentOpts{AuthorID: "c", Request: request, Reviewers: reviewers, Body: "hello"},
+			wantErr: ErrUserNotAuthorized,
+		},
+		{
+			name:    "empty comment",
+			give:    AddCommentOpts{AuthorID: "a", Request: request, Reviewers: reviewers, Body: " \n"},
+			wantErr: ErrCommentEmpty,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ep := mocks.NewMockEventPutter(ctrl)
+			ep.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			s := Service{
+				Clock:       clk,
+				DB:          ddbmock.New(t),
+				EventPutter: ep,
+			}
+			got, err := s.AddComment(context.Background(), tc.give)
+			if tc.wantErr != nil {
+				assert.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			// ignore the autogenerated comment ID for testing.
+			if got != nil {
+				got.ID = ""
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
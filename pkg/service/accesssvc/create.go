@@ -3,19 +3,20 @@ package accesssvc
 import (
 	"context"
 	"errors"
-	"github.com/common-fate/common-fate/pkg/autoapproval"
 	"net/http"
-	"os"
 	"sync"
+	"time"
 
 	"github.com/common-fate/analytics-go"
 	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/autoapproval"
 	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/common-fate/common-fate/pkg/identity"
 	"github.com/common-fate/common-fate/pkg/rule"
 	"github.com/common-fate/common-fate/pkg/service/rulesvc"
+	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
 	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb"
@@ -32,6 +33,8 @@ type CreateRequests struct {
 	Reason       *string
 	Timing       types.RequestTiming
 	With         *types.CreateRequestWithSubRequest
+	// BreakGlass skips approval for rules which allow break-glass access.
+	BreakGlass bool
 }
 
 type CreateRequestsOpts struct {
@@ -65,6 +68,7 @@ func (s *Service) CreateRequests(ctx context.Context, in CreateRequestsOpts) ([]
 				},
 				Rule:             validated.rule,
 				RequestArguments: validated.requestArguments,
+				BreakGlass:       in.Create.BreakGlass,
 			})
 			mu.Lock()
 			if err != nil {
@@ -93,13 +97,17 @@ type createRequestOpts struct {
 	Request          CreateRequest
 	Rule             rule.AccessRule
 	RequestArguments map[string]types.RequestArgument
+	BreakGlass       bool
+	// RecurringRequest is set if the request is an occurrence of a recurring request.
+	// The options selected for the recurring request are used in place of the request arguments.
+	RecurringRequest *access.RecurringRequest
+	// ReuseApproval approves the request automatically, because an earlier occurrence
+	// of the recurring request was approved by a reviewer.
+	ReuseApproval bool
 }
 
-// createRequest creates a new request and saves it in the database.
-func (s *Service) createRequest(ctx context.Context, in createRequestOpts) (CreateRequestResult, error) {
-	now := s.Clock.Now()
-	log := logger.Get(ctx).With("user.id", in.User.ID)
-	// the request is valid, so create it.
+// newRequest builds a pending request from the options. The request isn't saved.
+func newRequest(in createRequestOpts, now time.Time) (access.Request, error) {
 	req := access.Request{
 		ID:          types.NewRequestID(),
 		RequestedBy: in.User.ID,
@@ -112,67 +120,80 @@ func (s *Service) createRequest(ctx context.Context, in createRequestOpts) (Crea
 		RequestedTiming: access.TimingFromRequestTiming(in.Request.Timing),
 		Rule:            in.Rule.ID,
 		RuleVersion:     in.Rule.Version,
-		SelectedWith:    make(map[string]access.Option),
 	}
-	if in.Request.With != nil {
-		for k, v := range in.Request.With {
-			argument := in.RequestArguments[k]
-			found := false
-			for _, option := range argument.Options {
-				// because validation has passed, we can have certainty that the matching value will be found here
-				// as a fallback, return an error if it is not found because something has gone seriously wrong with validation
-				if option.Value == v {
-					req.SelectedWith[k] = access.Option{
-						Value:       option.Value,
-						Label:       option.Label,
-						Description: option.Description,
-					}
-					found = true
-					break
-				}
-			}
-			if !found {
-				// this should never happen but here just in case
-				return CreateRequestResult{}, errors.New("unexpected error, failed to find a matching option for a with argument when creating a new access request")
-			}
+	if in.RecurringRequest != nil {
+		req.RecurringRequestID = &in.RecurringRequest.ID
+		req.SelectedWith = in.RecurringRequest.SelectedWith
+	} else {
+		selected, err := selectedOptions(in.Request.With, in.RequestArguments)
+		if err != nil {
+			return access.Request{}, err
 		}
+		req.SelectedWith = selected
+	}
+	return req, nil
+}
+
+// createRequest creates a new request and saves it in the database.
+func (s *Service) createRequest(ctx context.Context, in createRequestOpts) (CreateRequestResult, error) {
+	log := logger.Get(ctx).With("user.id", in.User.ID)
+	// the request is valid, so create it.
+	req, err := newRequest(in, s.Clock.Now())
+	if err != nil {
+		return CreateRequestResult{}, err
 	}
 
 	// If the approval is not required, auto-approve the request
 	auto := types.AUTOMATIC
 	revd := types.REVIEWED
+	breakGlass := types.BREAKGLASS
+
+	// the approvers for the request may depend on the arguments which were selected.
+	approval := req.ResolveApproval(in.Rule)
+
+	// break-glass requests are approved immediately and are reviewed by an approver after access is granted.
+	skipApproval := !approval.IsRequired() || in.BreakGlass || in.ReuseApproval
+
+	// requests which would otherwise require approval are evaluated against the auto-approval policies.
+	var autoApproval *autoapproval.ResponseBody
+	if !skipApproval && s.AutoApproval != nil {
+		res, err := s.evaluateAutoApproval(ctx, in, req, false)
+		if err != nil {
+			// fall back to requiring approval if the policies can't be evaluated.
+			log.Errorw("error evaluating auto-approval policies", "err", err)
+		} else {
+			autoApproval = &res
+		}
+	}
+	if autoApproval != nil && autoApproval.Decision == autoapproval.DENIED {
+		return CreateRequestResult{}, apio.NewRequestError(AutoApprovalDeniedError{Justification: autoApproval.Justification}, http.StatusBadRequest)
+	}
+	autoapproved := autoApproval != nil && autoApproval.Decision == autoapproval.AUTO_APPROVED
 
-	if !in.Rule.Approval.IsRequired() {
+	if in.BreakGlass {
+		req.Status = access.APPROVED
+		req.ApprovalMethod = &breakGlass
+	} else if in.ReuseApproval && approval.IsRequired() {
+		// the request was approved by the reviewer of an earlier occurrence.
+		req.Status = access.APPROVED
+		req.ApprovalMethod = &revd
+	} else if !approval.IsRequired() || autoapproved {
 		req.Status = access.APPROVED
 		req.ApprovalMethod = &auto
 	} else {
 		req.ApprovalMethod = &revd
+		req.ApprovalStages = access.NewApprovalStages(approval)
 	}
 
-	approvers, err := rulesvc.GetApprovers(ctx, s.DB, in.Rule)
+	reviewers, err := s.requestReviewers(ctx, req, in.Rule)
 	if err != nil {
 		return CreateRequestResult{}, err
 	}
 
 	// track items to insert in the database.
 	items := []ddb.Keyer{&req}
-
-	// create Reviewers for each approver in the Access Rule. Reviewers will see the request in the End User portal.
-	var reviewers []access.Reviewer
-	for _, u := range approvers {
-		// users cannot approve their own requests.
-		// We don't create a Reviewer for them, even if they are an approver on the Access Rule.
-		if u == req.RequestedBy {
-			continue
-		}
-
-		r := access.Reviewer{
-			ReviewerID: u,
-			Request:    req,
-		}
-
-		reviewers = append(reviewers, r)
-		items = append(items, &r)
+	for i := range reviewers {
+		items = append(items, &reviewers[i])
 	}
 
 	log.Debugw("saving request", "request", req, "reviewers", reviewers)
@@ -181,7 +202,7 @@ func (s *Service) createRequest(ctx context.Context, in createRequestOpts) (Crea
 	reqEvent := access.NewRequestCreatedEvent(req.ID, req.CreatedAt, &req.RequestedBy)
 
 	//before saving the request check to see if there already is a active approved rule
-	if !in.Rule.Approval.IsRequired() {
+	if skipApproval || autoapproved {
 
 		// This will check against the requests which do have grants already
 		overlaps, err := s.overlapsExistingGrant(ctx, req)
@@ -195,6 +216,27 @@ func (s *Service) createRequest(ctx context.Context, in createRequestOpts) (Crea
 	}
 
 	items = append(items, &reqEvent)
+	if in.BreakGlass {
+		// audit log event
+		breakGlassEvent := access.NewBreakGlassEvent(req.ID, req.CreatedAt, &req.RequestedBy)
+		items = append(items, &breakGlassEvent)
+	}
+	if in.ReuseApproval && approval.IsRequired() {
+		// audit log event
+		reuseEvent := access.NewRecordedEvent(req.ID, nil, req.CreatedAt, map[string]string{
+			"recurringRequestId": in.RecurringRequest.ID,
+			"approvalReusedFrom": in.RecurringRequest.ApprovedAt.Format(time.RFC3339),
+		})
+		items = append(items, &reuseEvent)
+	}
+	if autoApproval != nil && (autoApproval.Decision != autoapproval.REQUIRES_APPROVAL || autoApproval.Justification != "") {
+		// audit log event
+		autoApprovalEvent := access.NewRecordedEvent(req.ID, nil, req.CreatedAt, map[string]string{
+			"autoApprovalDecision":      string(autoApproval.Decision),
+			"autoApprovalJustification": autoApproval.Justification,
+		})
+		items = append(items, &autoApprovalEvent)
+	}
 	// save the request.
 	err = s.DB.PutBatch(ctx, items...)
 	if err != nil {
@@ -207,18 +249,8 @@ func (s *Service) createRequest(ctx context.Context, in createRequestOpts) (Crea
 	if err != nil {
 		return CreateRequestResult{}, err
 	}
-	// TODO(IvanVan) Get it from config
-	arn := os.Getenv("COMMONFATE_AUTO_APPROVAL_LAMBDA_ARN")
-	var autoapproved bool
-	if arn != "" {
-		autoapproved, err = autoapproval.Service{}.Autoapprove(in.User, in.Rule, arn)
-		if err != nil {
-			log.Errorw("error happened when calling auto-approval lambda", "err", err)
-		}
-	}
-
 	// check to see if it valid for instant approval
-	if !in.Rule.Approval.IsRequired() || autoapproved {
+	if skipApproval || autoapproved {
 		log.Debugw("auto-approving", "request", req, "reviewers", reviewers)
 		grant, err := s.Workflow.Grant(ctx, req, in.Rule)
 		if err != nil {
@@ -235,6 +267,13 @@ func (s *Service) createRequest(ctx context.Context, in createRequestOpts) (Crea
 		}
 	}
 
+	if in.BreakGlass {
+		err = s.EventPutter.Put(ctx, gevent.RequestBreakGlass{Request: req, RequestorEmail: in.User.Email})
+		if err != nil {
+			return CreateRequestResult{}, err
+		}
+	}
+
 	// analytics event
 	analytics.FromContext(ctx).Track(&analytics.RequestCreated{
 		RequestedBy:      req.RequestedBy,
@@ -244,7 +283,7 @@ func (s *Service) createRequest(ctx context.Context, in createRequestOpts) (Crea
 		RuleID:           req.Rule,
 		Timing:           req.RequestedTiming.ToAnalytics(),
 		HasReason:        req.HasReason(),
-		RequiresApproval: in.Rule.Approval.IsRequired() && !autoapproved,
+		RequiresApproval: approval.IsRequired() && !autoapproved && !in.BreakGlass && !in.ReuseApproval,
 	})
 
 	return CreateRequestResult{
@@ -253,6 +292,100 @@ func (s *Service) createRequest(ctx context.Context, in createRequestOpts) (Crea
 	}, nil
 }
 
+// requestReviewers returns the Reviewers for a request, who are the approvers in the Access Rule and the delegates of approvers who are away.
+func (s *Service) requestReviewers(ctx context.Context, req access.Request, accessRule rule.AccessRule) ([]access.Reviewer, error) {
+	approvers, err := rulesvc.GetRequestApprovers(ctx, s.DB, accessRule, req.Arguments(accessRule))
+	if err != nil {
+		return nil, err
+	}
+
+	// approvers who are away may have delegated their reviews to another user.
+	delegations, err := s.activeDelegations(ctx, req.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// create Reviewers for each approver in the Access Rule. Reviewers will see the request in the End User portal.
+	var reviewers []access.Reviewer
+	isReviewer := make(map[string]bool)
+	for _, u := range approvers {
+		// users cannot approve their own requests.
+		// We don't create a Reviewer for them, even if they are an approver on the Access Rule.
+		if u == req.RequestedBy {
+			continue
+		}
+
+		r := access.Reviewer{
+			ReviewerID: u,
+			Request:    req,
+		}
+
+		reviewers = append(reviewers, r)
+		isReviewer[u] = true
+	}
+
+	// the delegates of approvers are added as Reviewers too, so that requests aren't stuck while the approver is away.
+	for _, u := range approvers {
+		d, ok := delegations[u]
+		if !ok || u == req.RequestedBy || d.DelegateID == req.RequestedBy || isReviewer[d.DelegateID] {
+			continue
+		}
+		r := access.Reviewer{
+			ReviewerID: d.DelegateID,
+			Request:    req,
+			Delegation: &d,
+		}
+		reviewers = append(reviewers, r)
+		isReviewer[d.DelegateID] = true
+	}
+	return reviewers, nil
+}
+
+// selectedOptions looks up the options for the argument values selected in a request,
+// so that their labels can be displayed alongside the request.
+func selectedOptions(with map[string]string, requestArguments map[string]types.RequestArgument) (map[string]access.Option, error) {
+	selected := make(map[string]access.Option)
+	for k, v := range with {
+		argument := requestArguments[k]
+		found := false
+		for _, option := range argument.Options {
+			// because validation has passed, we can have certainty that the matching value will be found here
+			// as a fallback, return an error if it is not found because something has gone seriously wrong with validation
+			if option.Value == v {
+				selected[k] = access.Option{
+					Value:       option.Value,
+					Label:       option.Label,
+					Description: option.Description,
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			// this should never happen but here just in case
+			return nil, errors.New("unexpected error, failed to find a matching option for a with argument when creating a new access request")
+		}
+	}
+	return selected, nil
+}
+
+// evaluateAutoApproval builds the input for the auto-approval policies, including the user's recent requests.
+func (s *Service) evaluateAutoApproval(ctx context.Context, in createRequestOpts, req access.Request, dryRun bool) (autoapproval.ResponseBody, error) {
+	history := storage.ListRequestsForUser{UserId: in.User.ID}
+	_, err := s.DB.Query(ctx, &history, ddb.Limit(50))
+	if err != nil && err != ddb.ErrNoItems {
+		return autoapproval.ResponseBody{}, err
+	}
+	return s.AutoApproval.Evaluate(ctx, autoapproval.RequestBody{
+		User:      in.User,
+		Rule:      in.Rule,
+		Arguments: req.Arguments(in.Rule),
+		Timing:    req.RequestedTiming.ToAPI(),
+		History:   history.Result,
+		DryRun:    dryRun,
+	})
+}
+
 func groupMatches(ruleGroups []string, userGroups []string) error {
 	for _, rg := range ruleGroups {
 		for _, ug := range userGroups {
@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/benbjohnson/clock"
 	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/autoapproval"
 	"github.com/common-fate/common-fate/pkg/identity"
 	"github.com/common-fate/common-fate/pkg/rule"
 	accessMocks "github.com/common-fate/common-fate/pkg/service/accesssvc/mocks"
@@ -18,6 +20,7 @@ import (
 	"github.com/common-fate/ddb"
 	"github.com/common-fate/ddb/ddbmock"
 	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-multierror"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -37,11 +40,16 @@ func TestNewRequest(t *testing.T) {
 		withGetGroupResponse         *storage.GetGroup
 		withRequestArgumentsResponse map[string]types.RequestArgument
 		currentRequestsForGrant      []access.Request
+		withAutoApproval             *autoapproval.ResponseBody
+		withDelegations              []access.Delegation
 	}
 
 	clk := clock.NewMock()
 	autoApproval := types.AUTOMATIC
 	reviewed := types.REVIEWED
+	singleStage := []access.ApprovalStage{{Name: "Approval", Quorum: 1, ApprovedBy: []string{}}}
+	breakGlass := types.BREAKGLASS
+	reason := "production incident"
 	testcases := []testcase{
 		{
 			name: "ok, no approvers so should auto approve",
@@ -101,6 +109,130 @@ func TestNewRequest(t *testing.T) {
 			withRequestArgumentsResponse: map[string]types.RequestArgument{},
 			currentRequestsForGrant:      []access.Request{},
 		},
+		{
+			name: "break-glass skips approval",
+			in: CreateRequestsOpts{
+				User:   identity.User{Groups: []string{"a"}},
+				Create: CreateRequests{Reason: &reason, BreakGlass: true},
+			},
+			rule: &rule.AccessRule{
+				Groups:     []string{"a"},
+				BreakGlass: true,
+				Approval: rule.Approval{
+					Users: []string{"b"},
+				},
+			},
+			want: []CreateRequestResult{
+				{Request: access.Request{
+					ID:             "-",
+					Status:         access.APPROVED,
+					Data:           access.RequestData{Reason: &reason},
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					Grant:          &access.Grant{},
+					ApprovalMethod: &breakGlass,
+					SelectedWith:   make(map[string]access.Option),
+				},
+					Reviewers: []access.Reviewer{
+						{
+							ReviewerID: "b",
+							Request: access.Request{
+								ID:             "-",
+								Status:         access.APPROVED,
+								Data:           access.RequestData{Reason: &reason},
+								CreatedAt:      clk.Now(),
+								UpdatedAt:      clk.Now(),
+								ApprovalMethod: &breakGlass,
+								SelectedWith:   make(map[string]access.Option),
+							},
+						},
+					}},
+			},
+			withCreateGrantResponse: createGrantResponse{
+				request: &access.Request{Grant: &access.Grant{}},
+			},
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+			currentRequestsForGrant:      []access.Request{},
+		},
+		{
+			name: "break-glass not enabled for rule",
+			in: CreateRequestsOpts{
+				User:   identity.User{Groups: []string{"a"}},
+				Create: CreateRequests{Reason: &reason, BreakGlass: true},
+			},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+			},
+			wantErr:                 ErrBreakGlassNotEnabled,
+			currentRequestsForGrant: []access.Request{},
+		},
+		{
+			name: "break-glass requires a reason",
+			in: CreateRequestsOpts{
+				User:   identity.User{Groups: []string{"a"}},
+				Create: CreateRequests{BreakGlass: true},
+			},
+			rule: &rule.AccessRule{
+				Groups:     []string{"a"},
+				BreakGlass: true,
+			},
+			wantErr:                 ErrBreakGlassReasonRequired,
+			currentRequestsForGrant: []access.Request{},
+		},
+		{
+			name: "auto-approval policy approves request",
+			in:   CreateRequestsOpts{User: identity.User{Groups: []string{"a"}}},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+				Approval: rule.Approval{
+					Users: []string{"b"},
+				},
+			},
+			withAutoApproval: &autoapproval.ResponseBody{Decision: autoapproval.AUTO_APPROVED, Justification: "short duration"},
+			want: []CreateRequestResult{
+				{Request: access.Request{
+					ID:             "-",
+					Status:         access.APPROVED,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					Grant:          &access.Grant{},
+					ApprovalMethod: &autoApproval,
+					SelectedWith:   make(map[string]access.Option),
+				},
+					Reviewers: []access.Reviewer{
+						{
+							ReviewerID: "b",
+							Request: access.Request{
+								ID:             "-",
+								Status:         access.APPROVED,
+								CreatedAt:      clk.Now(),
+								UpdatedAt:      clk.Now(),
+								ApprovalMethod: &autoApproval,
+								SelectedWith:   make(map[string]access.Option),
+							},
+						},
+					}},
+			},
+			withCreateGrantResponse: createGrantResponse{
+				request: &access.Request{Grant: &access.Grant{}},
+			},
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+			currentRequestsForGrant:      []access.Request{},
+		},
+		{
+			name: "auto-approval policy denies request",
+			in:   CreateRequestsOpts{User: identity.User{Groups: []string{"a"}}},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+				Approval: rule.Approval{
+					Users: []string{"b"},
+				},
+			},
+			withAutoApproval:             &autoapproval.ResponseBody{Decision: autoapproval.DENIED, Justification: "outside business hours"},
+			wantErr:                      &multierror.Error{Errors: []error{apio.NewRequestError(AutoApprovalDeniedError{Justification: "outside business hours"}, http.StatusBadRequest)}},
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+			currentRequestsForGrant:      []access.Request{},
+		},
 		{
 			name: "user not in correct group",
 			in:   CreateRequestsOpts{User: identity.User{Groups: []string{"a"}}},
@@ -133,6 +265,7 @@ func TestNewRequest(t *testing.T) {
 					CreatedAt:      clk.Now(),
 					UpdatedAt:      clk.Now(),
 					ApprovalMethod: &reviewed,
+					ApprovalStages: singleStage,
 					SelectedWith:   make(map[string]access.Option),
 				},
 					Reviewers: []access.Reviewer{
@@ -144,6 +277,7 @@ func TestNewRequest(t *testing.T) {
 								CreatedAt:      clk.Now(),
 								UpdatedAt:      clk.Now(),
 								ApprovalMethod: &reviewed,
+								ApprovalStages: singleStage,
 								SelectedWith:   make(map[string]access.Option),
 							},
 						},
@@ -152,6 +286,131 @@ func TestNewRequest(t *testing.T) {
 			withRequestArgumentsResponse: map[string]types.RequestArgument{},
 			currentRequestsForGrant:      []access.Request{},
 		},
+		{
+			name: "approval condition routes request to condition approvers",
+			in:   CreateRequestsOpts{User: identity.User{Groups: []string{"a"}}},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+				Target: rule.Target{With: map[string]string{"accountId": "123456789012"}},
+				Approval: rule.Approval{
+					Conditions: []rule.ApprovalCondition{
+						{ArgumentID: "accountId", Values: []string{"123456789012"}, Users: []string{"c"}},
+					},
+				},
+			},
+			want: []CreateRequestResult{
+				{Request: access.Request{
+					ID:             "-",
+					Status:         access.PENDING,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					ApprovalMethod: &reviewed,
+					ApprovalStages: singleStage,
+					SelectedWith:   make(map[string]access.Option),
+				},
+					Reviewers: []access.Reviewer{
+						{
+							ReviewerID: "c",
+							Request: access.Request{
+								ID:             "-",
+								Status:         access.PENDING,
+								CreatedAt:      clk.Now(),
+								UpdatedAt:      clk.Now(),
+								ApprovalMethod: &reviewed,
+								ApprovalStages: singleStage,
+								SelectedWith:   make(map[string]access.Option),
+							},
+						},
+					}},
+			},
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+			currentRequestsForGrant:      []access.Request{},
+		},
+		{
+			name: "approval condition without approvers auto approves",
+			in:   CreateRequestsOpts{User: identity.User{Groups: []string{"a"}}},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+				Target: rule.Target{With: map[string]string{"accountId": "123456789012"}},
+				Approval: rule.Approval{
+					Users: []string{"b"},
+					Conditions: []rule.ApprovalCondition{
+						{ArgumentID: "accountId", Values: []string{"123456789012"}},
+					},
+				},
+			},
+			want: []CreateRequestResult{
+				{Request: access.Request{
+					ID:             "-",
+					Status:         access.APPROVED,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					Grant:          &access.Grant{},
+					ApprovalMethod: &autoApproval,
+					SelectedWith:   make(map[string]access.Option),
+				}},
+			},
+			withCreateGrantResponse: createGrantResponse{
+				request: &access.Request{Grant: &access.Grant{}},
+			},
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+			currentRequestsForGrant:      []access.Request{},
+		},
+		{
+			name: "delegate of approver is added as a reviewer",
+			in:   CreateRequestsOpts{User: identity.User{Groups: []string{"a"}}},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+				Approval: rule.Approval{
+					Users: []string{"b"},
+				},
+			},
+			withDelegations: []access.Delegation{
+				{UserID: "b", DelegateID: "d", StartsAt: clk.Now().Add(-time.Hour), EndsAt: clk.Now().Add(time.Hour)},
+				// delegations which have ended are ignored
+				{UserID: "c", DelegateID: "e", StartsAt: clk.Now().Add(-time.Hour * 2), EndsAt: clk.Now().Add(-time.Hour)},
+			},
+			want: []CreateRequestResult{
+				{Request: access.Request{
+					ID:             "-",
+					Status:         access.PENDING,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					ApprovalMethod: &reviewed,
+					ApprovalStages: singleStage,
+					SelectedWith:   make(map[string]access.Option),
+				},
+					Reviewers: []access.Reviewer{
+						{
+							ReviewerID: "b",
+							Request: access.Request{
+								ID:             "-",
+								Status:         access.PENDING,
+								CreatedAt:      clk.Now(),
+								UpdatedAt:      clk.Now(),
+								ApprovalMethod: &reviewed,
+								ApprovalStages: singleStage,
+								SelectedWith:   make(map[string]access.Option),
+							},
+						},
+						{
+							ReviewerID: "d",
+							Request: access.Request{
+								ID:             "-",
+								Status:         access.PENDING,
+								CreatedAt:      clk.Now(),
+								UpdatedAt:      clk.Now(),
+								ApprovalMethod: &reviewed,
+								ApprovalStages: singleStage,
+								SelectedWith:   make(map[string]access.Option),
+							},
+							Delegation: &access.Delegation{UserID: "b", DelegateID: "d", StartsAt: clk.Now().Add(-time.Hour), EndsAt: clk.Now().Add(time.Hour)},
+						},
+					}},
+			},
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+			currentRequestsForGrant:      []access.Request{},
+		},
 		{
 			name: "requestor is approver on access rule",
 			in:   CreateRequestsOpts{User: identity.User{ID: "a", Groups: []string{"a"}}},
@@ -171,6 +430,7 @@ func TestNewRequest(t *testing.T) {
 					CreatedAt:      clk.Now(),
 					UpdatedAt:      clk.Now(),
 					ApprovalMethod: &reviewed,
+					ApprovalStages: singleStage,
 					SelectedWith:   make(map[string]access.Option),
 				},
 					Reviewers: []access.Reviewer{
@@ -183,6 +443,7 @@ func TestNewRequest(t *testing.T) {
 								CreatedAt:      clk.Now(),
 								UpdatedAt:      clk.Now(),
 								ApprovalMethod: &reviewed,
+								ApprovalStages: singleStage,
 								SelectedWith:   make(map[string]access.Option),
 							},
 						},
@@ -216,6 +477,7 @@ func TestNewRequest(t *testing.T) {
 					CreatedAt:      clk.Now(),
 					UpdatedAt:      clk.Now(),
 					ApprovalMethod: &reviewed,
+					ApprovalStages: singleStage,
 					SelectedWith:   make(map[string]access.Option),
 				},
 					Reviewers: []access.Reviewer{
@@ -228,6 +490,7 @@ func TestNewRequest(t *testing.T) {
 								CreatedAt:      clk.Now(),
 								UpdatedAt:      clk.Now(),
 								ApprovalMethod: &reviewed,
+								ApprovalStages: singleStage,
 								SelectedWith:   make(map[string]access.Option),
 							},
 						},
@@ -246,6 +509,8 @@ func TestNewRequest(t *testing.T) {
 			db.MockQuery(tc.withGetGroupResponse)
 			db.MockQuery(&storage.ListRequestReviewers{})
 			db.MockQuery(&storage.ListRequestsForUserAndRequestend{Result: tc.currentRequestsForGrant})
+			db.MockQuery(&storage.ListRequestsForUser{})
+			db.MockQuery(&storage.ListDelegations{Result: tc.withDelegations})
 			ctrl := gomock.NewController(t)
 
 			defer ctrl.Finish()
@@ -273,6 +538,11 @@ func TestNewRequest(t *testing.T) {
 				Rules:       rs,
 				Workflow:    workflowMock,
 			}
+			if tc.withAutoApproval != nil {
+				aa := accessMocks.NewMockAutoApprover(ctrl)
+				aa.EXPECT().Evaluate(gomock.Any(), gomock.Any()).Return(*tc.withAutoApproval, nil)
+				s.AutoApproval = aa
+			}
 			got, err := s.CreateRequests(context.Background(), tc.in)
 			var gotWithoutIDs []CreateRequestResult
 			// ignore the autogenerated ID for testing.
@@ -0,0 +1,281 @@
+package accesssvc
+
+import (
+	"context"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/hashicorp/go-multierror"
+)
+
+type SetDelegateOpts struct {
+	UserID     string
+	DelegateID string
+	// StartsAt is optional and defaults to the current time.
+	StartsAt *time.Time
+	EndsAt   time.Time
+	Reason   *string
+}
+
+// SetDelegate sets a delegate to review requests on behalf of a user, replacing any existing delegate.
+//
+// While the delegation is active, the delegate is added as a reviewer to new requests which the user would review.
+// If the delegation is active immediately, the delegate is also added as a reviewer to the user's pending requests,
+// otherwise they are added by ApplyDelegations once the delegation starts.
+// Any existing delegate is removed as a reviewer from the user's pending requests.
+func (s *Service) SetDelegate(ctx context.Context, opts SetDelegateOpts) (*access.Delegation, error) {
+	now := s.Clock.Now()
+	d := access.Delegation{
+		UserID:     opts.UserID,
+		DelegateID: opts.DelegateID,
+		StartsAt:   now,
+		EndsAt:     opts.EndsAt,
+		Reason:     opts.Reason,
+		CreatedAt:  now,
+	}
+	if opts.StartsAt != nil {
+		d.StartsAt = *opts.StartsAt
+	}
+	if d.DelegateID == d.UserID {
+		return nil, ErrCannotDelegateToSelf
+	}
+	if !d.EndsAt.After(d.StartsAt) || !d.EndsAt.After(now) {
+		return nil, ErrInvalidDelegationPeriod
+	}
+
+	_, err := s.DB.Query(ctx, &storage.GetUser{ID: d.DelegateID})
+	if err == ddb.ErrNoItems {
+		return nil, ErrDelegateNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.removeDelegatedReviewers(ctx, d.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []ddb.Keyer{&d}
+	if d.IsActive(now) {
+		reviewers, err := s.delegateReviewersForPendingRequests(ctx, d)
+		if err != nil {
+			return nil, err
+		}
+		for i := range reviewers {
+			items = append(items, &reviewers[i])
+		}
+	}
+
+	err = s.DB.PutBatch(ctx, items...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// RemoveDelegate removes a user's delegate.
+// The delegate is also removed as a reviewer from the user's pending requests, so that they can no longer review them.
+func (s *Service) RemoveDelegate(ctx context.Context, userID string) error {
+	q := storage.GetDelegation{UserID: userID}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil {
+		return err
+	}
+	err = s.removeDelegatedReviewers(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return s.DB.Delete(ctx, q.Result)
+}
+
+type ApplyDelegationsResult struct {
+	// Reviewers are the delegates which were added as reviewers to pending requests.
+	Reviewers []access.Reviewer
+}
+
+// ApplyDelegations adds the delegate of each active delegation as a reviewer to the delegating user's pending requests.
+//
+// It is intended to be run on a schedule, so that delegations which start in the future
+// are applied to requests which were already pending when the delegation started.
+func (s *Service) ApplyDelegations(ctx context.Context) (*ApplyDelegationsResult, error) {
+	active, err := s.activeDelegations(ctx, s.Clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	var res ApplyDelegationsResult
+	var result *multierror.Error
+	for _, d := range active {
+		reviewers, err := s.delegateReviewersForPendingRequests(ctx, d)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+		if len(reviewers) == 0 {
+			continue
+		}
+		items := make([]ddb.Keyer, len(reviewers))
+		for i := range reviewers {
+			items[i] = &reviewers[i]
+		}
+		err = s.DB.PutBatch(ctx, items...)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+		res.Reviewers = append(res.Reviewers, reviewers...)
+	}
+	return &res, result.ErrorOrNil()
+}
+
+// removeDelegatedReviewers removes the Reviewers which were added by a user's delegation from their pending requests.
+// It does nothing if the user hasn't delegated their reviews.
+func (s *Service) removeDelegatedReviewers(ctx context.Context, userID string) error {
+	q := storage.GetDelegation{UserID: userID}
+	_, err := s.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	reviewers, err := s.delegatedReviewersForPendingRequests(ctx, *q.Result)
+	if err != nil {
+		return err
+	}
+	if len(reviewers) == 0 {
+		return nil
+	}
+	items := make([]ddb.Keyer, len(reviewers))
+	for i := range reviewers {
+		items[i] = &reviewers[i]
+	}
+	return s.DB.DeleteBatch(ctx, items...)
+}
+
+// delegatedReviewersForPendingRequests returns the delegate's Reviewers which were added by the delegation
+// to pending requests which the delegating user is a reviewer of.
+func (s *Service) delegatedReviewersForPendingRequests(ctx context.Context, d access.Delegation) ([]access.Reviewer, error) {
+	requests, err := s.pendingRequestsForReviewer(ctx, d.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var reviewers []access.Reviewer
+	for _, req := range requests {
+		rq := storage.GetRequestReviewer{RequestID: req.ID, ReviewerID: d.DelegateID}
+		_, err := s.DB.Query(ctx, &rq)
+		if err == ddb.ErrNoItems {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		// the delegate remains a reviewer of requests which they can review in their own right.
+		if rq.Result.Delegation == nil || rq.Result.Delegation.UserID != d.UserID {
+			continue
+		}
+		reviewers = append(reviewers, *rq.Result)
+	}
+	return reviewers, nil
+}
+
+// delegateReviewersForPendingRequests builds Reviewers for the delegate on each pending request
+// which the delegating user is a reviewer of.
+func (s *Service) delegateReviewersForPendingRequests(ctx context.Context, d access.Delegation) ([]access.Reviewer, error) {
+	requests, err := s.pendingRequestsForReviewer(ctx, d.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var reviewers []access.Reviewer
+	for _, req := range requests {
+		// users cannot review their own requests.
+		if req.RequestedBy == d.DelegateID {
+			continue
+		}
+		// don't overwrite the delegate's Reviewer if they can already review the request.
+		_, err := s.DB.Query(ctx, &storage.GetRequestReviewer{RequestID: req.ID, ReviewerID: d.DelegateID})
+		if err == nil {
+			continue
+		}
+		if err != ddb.ErrNoItems {
+			return nil, err
+		}
+		delegation := d
+		reviewers = append(reviewers, access.Reviewer{
+			ReviewerID: d.DelegateID,
+			Request:    req,
+			Delegation: &delegation,
+		})
+	}
+	return reviewers, nil
+}
+
+// pendingRequestsForReviewer returns every pending request which the user is a reviewer of.
+func (s *Service) pendingRequestsForReviewer(ctx context.Context, reviewerID string) ([]access.Request, error) {
+	var requests []access.Request
+	hasMore := true
+	var next string
+	for hasMore {
+		q := storage.ListRequestsForReviewerAndStatus{ReviewerID: reviewerID, Status: access.PENDING}
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		qr, err := s.DB.Query(ctx, &q, opts...)
+		if err == ddb.ErrNoItems {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, q.Result...)
+		next = qr.NextPage
+		hasMore = next != ""
+	}
+	return requests, nil
+}
+
+// activeDelegations returns the delegations which are active at the given time, keyed by the ID of the delegating user.
+func (s *Service) activeDelegations(ctx context.Context, now time.Time) (map[string]access.Delegation, error) {
+	res := make(map[string]access.Delegation)
+	hasMore := true
+	var next string
+	for hasMore {
+		q := storage.ListDelegations{}
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		qr, err := s.DB.Query(ctx, &q, opts...)
+		if err == ddb.ErrNoItems {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range q.Result {
+			if d.IsActive(now) {
+				res[d.UserID] = d
+			}
+		}
+		next = qr.NextPage
+		hasMore = next != ""
+	}
+	return res, nil
+}
+
+// reviewerDelegation returns the delegation which allowed the user to review the request,
+// or nil if the user is a reviewer in their own right.
+func reviewerDelegation(reviewerID string, reviewers []access.Reviewer) *access.Delegation {
+	for _, r := range reviewers {
+		if r.ReviewerID == reviewerID {
+			return r.Delegation
+		}
+	}
+	return nil
+}
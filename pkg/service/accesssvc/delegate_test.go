@@ -0,0 +1,172 @@
+package accesssvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetDelegate(t *testing.T) {
+	type testcase struct {
+		name                string
+		give                SetDelegateOpts
+		withDelegateUserErr error
+		withPendingRequests []access.Request
+		want                *access.Delegation
+		wantErr             error
+	}
+
+	clk := clock.NewMock()
+	future := clk.Now().Add(time.Hour * 24)
+	past := clk.Now().Add(-time.Hour)
+
+	testcases := []testcase{
+		{
+			name:                "ok",
+			give:                SetDelegateOpts{UserID: "a", DelegateID: "b", EndsAt: future},
+			withPendingRequests: []access.Request{{ID: "req_1", RequestedBy: "c"}},
+			want:                &access.Delegation{UserID: "a", DelegateID: "b", StartsAt: clk.Now(), EndsAt: future, CreatedAt: clk.Now()},
+		},
+		{
+			name: "scheduled delegation",
+			give: SetDelegateOpts{UserID: "a", DelegateID: "b", StartsAt: &past, EndsAt: future},
+			want: &access.Delegation{UserID: "a", DelegateID: "b", StartsAt: past, EndsAt: future, CreatedAt: clk.Now()},
+		},
+		{
+			name:    "cannot delegate to self",
+			give:    SetDelegateOpts{UserID: "a", DelegateID: "a", EndsAt: future},
+			wantErr: ErrCannotDelegateToSelf,
+		},
+		{
+			name:    "delegation already ended",
+			give:    SetDelegateOpts{UserID: "a", DelegateID: "b", EndsAt: past},
+			wantErr: ErrInvalidDelegationPeriod,
+		},
+		{
+			name:    "ends before it starts",
+			give:    SetDelegateOpts{UserID: "a", DelegateID: "b", StartsAt: &future, EndsAt: future.Add(-time.Minute)},
+			wantErr: ErrInvalidDelegationPeriod,
+		},
+		{
+			name:                "delegate not found",
+			give:                SetDelegateOpts{UserID: "a", DelegateID: "b", EndsAt: future},
+			withDelegateUserErr: ddb.ErrNoItems,
+			wantErr:             ErrDelegateNotFound,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQueryWithErr(&storage.GetUser{Result: &identity.User{ID: tc.give.DelegateID}}, tc.withDelegateUserErr)
+			db.MockQueryWithErr(&storage.GetDelegation{}, ddb.ErrNoItems)
+			db.MockQuery(&storage.ListRequestsForReviewerAndStatus{Result: tc.withPendingRequests})
+			db.MockQueryWithErr(&storage.GetRequestReviewer{}, ddb.ErrNoItems)
+
+			s := Service{
+				Clock: clk,
+				DB:    db,
+			}
+			got, err := s.SetDelegate(context.Background(), tc.give)
+			if tc.wantErr != nil {
+				assert.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestDelegateReviewersForPendingRequests(t *testing.T) {
+	d := access.Delegation{UserID: "a", DelegateID: "b"}
+	requests := []access.Request{
+		{ID: "req_1", RequestedBy: "c"},
+		// the delegate can't review their own request
+		{ID: "req_2", RequestedBy: "b"},
+	}
+
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.ListRequestsForReviewerAndStatus{Result: requests})
+	db.MockQueryWithErr(&storage.GetRequestReviewer{}, ddb.ErrNoItems)
+
+	s := Service{DB: db}
+	got, err := s.delegateReviewersForPendingRequests(context.Background(), d)
+	assert.NoError(t, err)
+	assert.Equal(t, []access.Reviewer{{ReviewerID: "b", Request: requests[0], Delegation: &d}}, got)
+}
+
+func TestDelegatedReviewersForPendingRequests(t *testing.T) {
+	type testcase struct {
+		name         string
+		withReviewer *access.Reviewer
+		withErr      error
+		want         []access.Reviewer
+	}
+
+	d := access.Delegation{UserID: "a", DelegateID: "b"}
+	other := access.Delegation{UserID: "c", DelegateID: "b"}
+	request := access.Request{ID: "req_1", RequestedBy: "d"}
+
+	testcases := []testcase{
+		{
+			name:         "delegated reviewer",
+			withReviewer: &access.Reviewer{ReviewerID: "b", Request: request, Delegation: &d},
+			want:         []access.Reviewer{{ReviewerID: "b", Request: request, Delegation: &d}},
+		},
+		{
+			name:         "reviewer in their own right",
+			withReviewer: &access.Reviewer{ReviewerID: "b", Request: request},
+		},
+		{
+			name:         "reviewer through another delegation",
+			withReviewer: &access.Reviewer{ReviewerID: "b", Request: request, Delegation: &other},
+		},
+		{
+			name:    "not a reviewer",
+			withErr: ddb.ErrNoItems,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListRequestsForReviewerAndStatus{Result: []access.Request{request}})
+			db.MockQueryWithErr(&storage.GetRequestReviewer{Result: tc.withReviewer}, tc.withErr)
+
+			s := Service{DB: db}
+			got, err := s.delegatedReviewersForPendingRequests(context.Background(), d)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestApplyDelegations(t *testing.T) {
+	clk := clock.NewMock()
+	started := access.Delegation{UserID: "a", DelegateID: "b", StartsAt: clk.Now().Add(-time.Minute), EndsAt: clk.Now().Add(time.Hour)}
+	scheduled := access.Delegation{UserID: "c", DelegateID: "d", StartsAt: clk.Now().Add(time.Hour), EndsAt: clk.Now().Add(time.Hour * 2)}
+	request := access.Request{ID: "req_1", RequestedBy: "e"}
+
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.ListDelegations{Result: []access.Delegation{started, scheduled}})
+	db.MockQuery(&storage.ListRequestsForReviewerAndStatus{Result: []access.Request{request}})
+	db.MockQueryWithErr(&storage.GetRequestReviewer{}, ddb.ErrNoItems)
+
+	s := Service{Clock: clk, DB: db}
+	got, err := s.ApplyDelegations(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &ApplyDelegationsResult{Reviewers: []access.Reviewer{{ReviewerID: "b", Request: request, Delegation: &started}}}, got)
+}
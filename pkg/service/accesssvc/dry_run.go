@@ -0,0 +1,158 @@
+package accesssvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/apikit/logger"
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/autoapproval"
+	"github.com/common-fate/iso8601"
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+// DryRunResult describes what would happen if a request was created for a single combination of arguments.
+type DryRunResult struct {
+	With map[string]string
+	// Start and End are the times the grant would be active for.
+	Start time.Time
+	End   time.Time
+	// RequiresApproval is false if the request would be approved automatically.
+	RequiresApproval bool
+	// Approvers are the users who would be asked to review the request, including the delegates of approvers who are away.
+	Approvers []string
+	// OverlappingRequests have grants for the same access during the same time.
+	// Requests which don't require approval can't be created while they overlap an existing grant.
+	OverlappingRequests []access.Request
+	// ValidationSupported is false if the provider can't validate grants, which is the case for target groups.
+	ValidationSupported bool
+	Validations         []ahTypes.GrantValidation
+}
+
+// DryRunRequests runs the same checks as CreateRequests, without saving anything or granting access.
+// Validation errors which would prevent the request from being created are returned in the same way as CreateRequests.
+func (s *Service) DryRunRequests(ctx context.Context, in CreateRequestsOpts) ([]DryRunResult, error) {
+	validated, err := s.validateCreateRequests(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	var results []DryRunResult
+	for _, c := range validated.argumentCombinations {
+		res, err := s.dryRunRequest(ctx, createRequestOpts{
+			User: in.User,
+			Request: CreateRequest{
+				AccessRuleId: in.Create.AccessRuleId,
+				Reason:       in.Create.Reason,
+				Timing:       in.Create.Timing,
+				With:         c,
+			},
+			Rule:             validated.rule,
+			RequestArguments: validated.requestArguments,
+			BreakGlass:       in.Create.BreakGlass,
+		})
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+	}
+	return results, nil
+}
+
+func (s *Service) dryRunRequest(ctx context.Context, in createRequestOpts) (DryRunResult, error) {
+	log := logger.Get(ctx).With("user.id", in.User.ID)
+	now := s.Clock.Now()
+	// the request is built the same way as when creating it, so that the approvers and grant window match.
+	req, err := newRequest(in, now)
+	if err != nil {
+		return DryRunResult{}, err
+	}
+
+	approval := req.ResolveApproval(in.Rule)
+	requiresApproval := approval.IsRequired() && !in.BreakGlass
+	if requiresApproval && s.AutoApproval != nil {
+		// auto-approval backends are told that this is a dry run, so that they don't act on the decision.
+		res, err := s.evaluateAutoApproval(ctx, in, req, true)
+		if err != nil {
+			log.Errorw("error evaluating auto-approval policies", "err", err)
+		} else if res.Decision == autoapproval.DENIED {
+			return DryRunResult{}, apio.NewRequestError(AutoApprovalDeniedError{Justification: res.Justification}, http.StatusBadRequest)
+		} else if res.Decision == autoapproval.AUTO_APPROVED {
+			requiresApproval = false
+		}
+	}
+
+	start, end := req.GetInterval(access.WithNow(now))
+	result := DryRunResult{
+		With:             in.Request.With,
+		Start:            start,
+		End:              end,
+		RequiresApproval: requiresApproval,
+		Approvers:        []string{},
+		Validations:      []ahTypes.GrantValidation{},
+	}
+
+	if requiresApproval {
+		reviewers, err := s.requestReviewers(ctx, req, in.Rule)
+		if err != nil {
+			return DryRunResult{}, err
+		}
+		for _, r := range reviewers {
+			result.Approvers = append(result.Approvers, r.ReviewerID)
+		}
+	}
+
+	result.OverlappingRequests, err = s.overlappingGrants(ctx, req)
+	if err != nil {
+		return DryRunResult{}, err
+	}
+
+	// grant validation is run by the Access Handler, which doesn't provision target group grants.
+	if !in.Rule.Target.IsForTargetGroup() {
+		validation, err := s.validateGrant(ctx, in, req, start, end)
+		if err != nil {
+			return DryRunResult{}, err
+		}
+		result.ValidationSupported = validation.Supported
+		result.Validations = validation.Validations
+	}
+	return result, nil
+}
+
+// validateGrant runs the provider's grant validation steps for the request without granting access.
+func (s *Service) validateGrant(ctx context.Context, in createRequestOpts, req access.Request, start time.Time, end time.Time) (*ahTypes.GrantValidationResponse, error) {
+	with := make(map[string]string)
+	for k, v := range in.Rule.Target.With {
+		with[k] = v
+	}
+	for k, v := range req.SelectedWith {
+		with[k] = v.Value
+	}
+	res, err := s.AHClient.ValidateGrantWithResponse(ctx, ahTypes.ValidateGrantJSONRequestBody{
+		Id:       req.ID,
+		Provider: in.Rule.Target.ProviderID,
+		Subject:  openapi_types.Email(in.User.Email),
+		Start:    iso8601.New(start),
+		End:      iso8601.New(end),
+		With:     ahTypes.CreateGrant_With{AdditionalProperties: with},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON200 != nil {
+		return &ahTypes.GrantValidationResponse{
+			Supported:   res.JSON200.Supported,
+			Validations: res.JSON200.Validations,
+		}, nil
+	}
+	// the grant is rejected by the Access Handler if the provider doesn't exist or the grant window is invalid.
+	if res.JSON400 != nil && res.JSON400.Error != nil {
+		return nil, apio.NewRequestError(errors.New(*res.JSON400.Error), http.StatusBadRequest)
+	}
+	logger.Get(ctx).Errorw("unhandled Access Handler response", "body", string(res.Body))
+	return nil, fmt.Errorf("unhandled response code %d from access provider service when validating grant", res.StatusCode())
+}
@@ -0,0 +1,174 @@
+package accesssvc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/apio"
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/accesshandler/pkg/types/ahmocks"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/rule"
+	accessMocks "github.com/common-fate/common-fate/pkg/service/accesssvc/mocks"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDryRunRequests(t *testing.T) {
+	type validateResponse struct {
+		supported   bool
+		validations []ahTypes.GrantValidation
+		err         *string
+	}
+	type testcase struct {
+		name           string
+		rule           rule.AccessRule
+		timing         types.RequestTiming
+		withRequests   []access.Request
+		withValidation *validateResponse
+		want           []DryRunResult
+		wantErr        string
+	}
+
+	clk := clock.NewMock()
+	now := clk.Now()
+	user := identity.User{ID: "usr_1", Email: "alice@example.com", Groups: []string{"a"}}
+	hour := types.RequestTiming{DurationSeconds: 3600}
+
+	targetGroupRule := rule.AccessRule{
+		ID:              "rul_1",
+		Groups:          []string{"a"},
+		Target:          rule.Target{ProviderID: "tg_1", TargetGroupID: "tg_1"},
+		Approval:        rule.Approval{Users: []string{"usr_1", "usr_2"}},
+		TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 3600},
+	}
+	providerRule := rule.AccessRule{
+		ID:              "rul_2",
+		Groups:          []string{"a"},
+		Target:          rule.Target{ProviderID: "okta", With: map[string]string{"group": "Admins"}},
+		TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 3600},
+	}
+	failed := ahTypes.GrantValidation{
+		Id:     "user-exists-in-okta",
+		Name:   "We couldn't find a matching user account for you in Okta",
+		Status: ahTypes.FAILED,
+		Logs:   []ahTypes.Log{{Level: ahTypes.LogLevelERROR, Msg: "could not find user alice@example.com in Okta"}},
+	}
+	invalidWindow := "grant start time is in the past"
+
+	testcases := []testcase{
+		{
+			name:   "target group rule requiring approval",
+			rule:   targetGroupRule,
+			timing: hour,
+			withRequests: []access.Request{
+				{ID: "req_1", Rule: "rul_1", RequestedTiming: access.TimingFromRequestTiming(hour), Grant: &access.Grant{Status: ahTypes.GrantStatusACTIVE}},
+				{ID: "req_2", Rule: "rul_1", RequestedTiming: access.TimingFromRequestTiming(hour), Grant: &access.Grant{Status: ahTypes.GrantStatusEXPIRED}},
+			},
+			want: []DryRunResult{
+				{
+					With:             map[string]string{},
+					Start:            now,
+					End:              now.Add(time.Hour),
+					RequiresApproval: true,
+					// users can't approve their own requests.
+					Approvers:           []string{"usr_2"},
+					OverlappingRequests: []access.Request{{ID: "req_1", Rule: "rul_1", RequestedTiming: access.TimingFromRequestTiming(hour), Grant: &access.Grant{Status: ahTypes.GrantStatusACTIVE}}},
+					Validations:         []ahTypes.GrantValidation{},
+				},
+			},
+		},
+		{
+			name:           "provider validation results are returned",
+			rule:           providerRule,
+			timing:         hour,
+			withValidation: &validateResponse{supported: true, validations: []ahTypes.GrantValidation{failed}},
+			want: []DryRunResult{
+				{
+					With:                map[string]string{},
+					Start:               now,
+					End:                 now.Add(time.Hour),
+					Approvers:           []string{},
+					ValidationSupported: true,
+					Validations:         []ahTypes.GrantValidation{failed},
+				},
+			},
+		},
+		{
+			name:           "grant rejected by the access handler",
+			rule:           providerRule,
+			timing:         hour,
+			withValidation: &validateResponse{err: &invalidWindow},
+			wantErr:        invalidWindow,
+		},
+		{
+			name:    "requests which fail validation return the same error as creating them",
+			rule:    providerRule,
+			timing:  types.RequestTiming{DurationSeconds: 7200},
+			wantErr: "request validation failed",
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetAccessRuleCurrent{Result: &tc.rule})
+			db.MockQuery(&storage.ListCurrentAccessRules{Result: []rule.AccessRule{tc.rule}})
+			db.MockQuery(&storage.ListRequestsForUserAndRequestend{Result: tc.withRequests})
+			db.MockQuery(&storage.ListDelegations{})
+
+			rs := accessMocks.NewMockAccessRuleService(ctrl)
+			rs.EXPECT().RequestArguments(gomock.Any(), tc.rule.Target).Return(map[string]types.RequestArgument{}, nil)
+
+			ah := ahmocks.NewMockClientWithResponsesInterface(ctrl)
+			if tc.withValidation != nil {
+				res := ahTypes.ValidateGrantResponse{HTTPResponse: &http.Response{StatusCode: http.StatusOK}}
+				if tc.withValidation.err != nil {
+					res.HTTPResponse.StatusCode = http.StatusBadRequest
+					res.JSON400 = &struct {
+						Error *string `json:"error,omitempty"`
+					}{Error: tc.withValidation.err}
+				} else {
+					res.JSON200 = &struct {
+						Supported   bool                      `json:"supported"`
+						Validations []ahTypes.GrantValidation `json:"validations"`
+					}{Supported: tc.withValidation.supported, Validations: tc.withValidation.validations}
+				}
+				ah.EXPECT().ValidateGrantWithResponse(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, body ahTypes.ValidateGrantJSONRequestBody, reqEditors ...ahTypes.RequestEditorFn) (*ahTypes.ValidateGrantResponse, error) {
+					assert.Equal(t, "okta", body.Provider)
+					assert.Equal(t, "alice@example.com", string(body.Subject))
+					assert.Equal(t, map[string]string{"group": "Admins"}, body.With.AdditionalProperties)
+					return &res, nil
+				})
+			}
+
+			s := Service{
+				Clock:    clk,
+				DB:       db,
+				Rules:    rs,
+				AHClient: ah,
+			}
+			got, err := s.DryRunRequests(context.Background(), CreateRequestsOpts{
+				User:   user,
+				Create: CreateRequests{AccessRuleId: tc.rule.ID, Timing: tc.timing},
+			})
+			if tc.wantErr != "" {
+				var apiErr *apio.APIError
+				assert.ErrorAs(t, err, &apiErr)
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -24,8 +24,83 @@ var (
 
 	// ErrRequestOverlapsExistingGrant is returned if the request overlaps an existing grant
 	ErrRequestOverlapsExistingGrant = errors.New("this request overlaps an existing grant")
+
+	// ErrReviewerAlreadyApproved is returned if a reviewer tries to approve a request more than once.
+	// Each approval counts towards a stage's quorum, so a single reviewer may only approve once.
+	ErrReviewerAlreadyApproved = errors.New("you have already approved this request")
+
+	// ErrRequestModified is returned if a request was updated by another review while it was being reviewed.
+	ErrRequestModified = errors.New("this request was updated by another reviewer, please refresh and try again")
+
+	// ErrRequestCannotBeExtended is returned if the request does not have an active grant
+	ErrRequestCannotBeExtended = errors.New("only requests with an active grant can be extended")
+
+	// ErrExtensionAlreadyPending is returned if the requestor tries to extend a request which already has an extension awaiting approval
+	ErrExtensionAlreadyPending = errors.New("this request already has an extension awaiting approval")
+
+	// ErrExtensionNotSupported is returned when extending a request for a built-in provider, as only grants for target groups can be extended
+	ErrExtensionNotSupported = errors.New("only requests for target groups can be extended")
+
+	// ErrNoPendingExtension is returned when reviewing an extension for a request which doesn't have one
+	ErrNoPendingExtension = errors.New("this request does not have an extension awaiting approval")
+
+	// ErrBreakGlassNotEnabled is returned if a user requests break-glass access for an Access Rule which doesn't allow it
+	ErrBreakGlassNotEnabled = errors.New("break-glass access is not enabled for this access rule")
+
+	// ErrBreakGlassReasonRequired is returned if a user requests break-glass access without providing a reason
+	ErrBreakGlassReasonRequired = errors.New("a reason is required when requesting break-glass access")
+
+	// ErrBreakGlassAlreadyReviewed is returned if a reviewer tries to review a break-glass request which has already been reviewed
+	ErrBreakGlassAlreadyReviewed = errors.New("this break-glass request has already been reviewed")
+
+	// ErrCommentEmpty is returned if a user tries to post a comment without any text
+	ErrCommentEmpty = errors.New("comment must not be empty")
+
+	// ErrCannotDelegateToSelf is returned if a user tries to set themselves as their own delegate
+	ErrCannotDelegateToSelf = errors.New("you cannot set yourself as your delegate")
+
+	// ErrInvalidDelegationPeriod is returned if a delegation ends before it starts, or has already ended
+	ErrInvalidDelegationPeriod = errors.New("delegation must end after it starts and must not have already ended")
+
+	// ErrDelegateNotFound is returned if the delegate doesn't exist
+	ErrDelegateNotFound = errors.New("delegate user not found")
+
+	// ErrCannotReviewOwnRequest is returned if a user tries to review their own request.
+	// This applies to administrators too.
+	ErrCannotReviewOwnRequest = errors.New("you cannot review your own request")
+
+	// ErrApprovalLimitReached is returned if a reviewer has already approved the maximum number of requests
+	// allowed by the Access Rule's separation-of-duties constraints in the past 24 hours.
+	ErrApprovalLimitReached = errors.New("you have reached the maximum number of approvals per day for this access rule")
+
+	// ErrRecurringRequestMultipleValues is returned if a recurring request selects more than one value for an argument
+	ErrRecurringRequestMultipleValues = errors.New("recurring requests must select a single value for each argument")
+
+	// ErrRecurringRequestAlreadyCancelled is returned if a user tries to cancel a recurring request which has already been cancelled
+	ErrRecurringRequestAlreadyCancelled = errors.New("this recurring request has already been cancelled")
+
+	// ErrRecurringRequestModified is returned when spawning an occurrence of a recurring request which was cancelled or updated
+	// since it was read
+	ErrRecurringRequestModified = errors.New("this recurring request was updated while its next occurrence was being requested")
+
+	// ErrRecurringRequestNoLongerAllowed is returned when spawning an occurrence of a recurring request
+	// if the Access Rule has been archived, the user is no longer allowed to request it,
+	// or the occurrence no longer meets the rule's constraints.
+	ErrRecurringRequestNoLongerAllowed = errors.New("the user is no longer allowed to request access to the access rule")
 )
 
+// AutoApprovalDeniedError is returned if the auto-approval policies deny a request.
+type AutoApprovalDeniedError struct {
+	Justification string
+}
+
+func (e AutoApprovalDeniedError) Error() string {
+	if e.Justification == "" {
+		return "request was denied by the auto-approval policy"
+	}
+	return fmt.Sprintf("request was denied by the auto-approval policy: %s", e.Justification)
+}
+
 // InvalidStatusError is returned if a user tries to review a request which wasn't PENDING.
 type InvalidStatusError struct {
 	Status access.Status
@@ -34,3 +109,13 @@ type InvalidStatusError struct {
 func (e InvalidStatusError) Error() string {
 	return fmt.Sprintf("request has invalid status: %s", e.Status)
 }
+
+// SharedExclusiveGroupError is returned if a reviewer tries to approve a request made by a member of
+// an exclusive group which the reviewer also belongs to.
+type SharedExclusiveGroupError struct {
+	GroupID string
+}
+
+func (e SharedExclusiveGroupError) Error() string {
+	return fmt.Sprintf("requests made by members of the %s group must be approved by a user outside of the group", e.GroupID)
+}
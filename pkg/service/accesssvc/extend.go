@@ -0,0 +1,314 @@
+package accesssvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/common-fate/apikit/apio"
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
+	"github.com/hashicorp/go-multierror"
+)
+
+type ExtendRequestOpts struct {
+	UserID     string
+	UserEmail  string
+	Request    access.Request
+	AccessRule rule.AccessRule
+	// Extension is the amount of time to extend the grant by
+	Extension time.Duration
+	Reason    *string
+}
+
+type ExtendRequestResult struct {
+	// The updated request
+	Request access.Request
+	// ExtensionPending is true if the extension requires approval before it takes effect
+	ExtensionPending bool
+}
+
+// ExtendRequest extends the grant of a request which is currently active.
+// If the access rule requires approval, the extension is saved on the request and must be approved by a reviewer,
+// otherwise the grant is extended immediately.
+func (s *Service) ExtendRequest(ctx context.Context, opts ExtendRequestOpts) (*ExtendRequestResult, error) {
+	request := opts.Request
+	if opts.UserID != request.RequestedBy {
+		return nil, ErrUserNotAuthorized
+	}
+	if !s.isExtendable(request) {
+		return nil, ErrRequestCannotBeExtended
+	}
+	if request.PendingExtension != nil {
+		return nil, ErrExtensionAlreadyPending
+	}
+	if !opts.AccessRule.Target.IsForTargetGroup() {
+		return nil, ErrExtensionNotSupported
+	}
+	err := validateExtension(request, opts.AccessRule, opts.Extension)
+	if err != nil {
+		return nil, err
+	}
+	err = s.checkExtensionOverlap(ctx, request, opts.Extension)
+	if err != nil {
+		return nil, err
+	}
+
+	approval := request.ResolveApproval(opts.AccessRule)
+	if !approval.IsRequired() {
+		request, err = s.applyExtension(ctx, opts.Request, request, opts.AccessRule, opts.Extension, opts.UserID)
+		if err != nil {
+			return nil, err
+		}
+		err = s.EventPutter.Put(ctx, gevent.RequestExtended{Request: request})
+		if err != nil {
+			return nil, err
+		}
+		return &ExtendRequestResult{Request: request}, nil
+	}
+
+	request.PendingExtension = &access.Extension{
+		Duration:       opts.Extension,
+		Reason:         opts.Reason,
+		RequestedAt:    s.Clock.Now(),
+		ApprovalStages: access.NewApprovalStages(approval),
+	}
+	request.UpdatedAt = s.Clock.Now()
+	err = s.putExtensionReview(ctx, request, opts.Request.UpdatedAt, nil)
+	if err != nil {
+		return nil, err
+	}
+	err = s.EventPutter.Put(ctx, gevent.RequestExtensionRequested{Request: request, RequestorEmail: opts.UserEmail})
+	if err != nil {
+		return nil, err
+	}
+	return &ExtendRequestResult{Request: request, ExtensionPending: true}, nil
+}
+
+type ReviewExtensionOpts struct {
+	ReviewerID      string
+	ReviewerEmail   string
+	ReviewerIsAdmin bool
+	Reviewers       []access.Reviewer
+	Decision        access.Decision
+	Request         access.Request
+	AccessRule      rule.AccessRule
+}
+
+// ReviewExtension approves or declines the pending extension for a request.
+// Extensions are approved in the same stages as the request, and the grant is only extended once every stage has reached its quorum.
+// A single decline from any reviewer of the request declines the extension.
+func (s *Service) ReviewExtension(ctx context.Context, opts ReviewExtensionOpts) (*access.Request, error) {
+	request := opts.Request
+	if request.PendingExtension == nil {
+		return nil, ErrNoPendingExtension
+	}
+	if opts.ReviewerID == request.RequestedBy {
+		return nil, ErrCannotReviewOwnRequest
+	}
+	reviewOpts := AddReviewOpts{
+		ReviewerID:      opts.ReviewerID,
+		ReviewerIsAdmin: opts.ReviewerIsAdmin,
+		Reviewers:       opts.Reviewers,
+		Request:         request,
+		AccessRule:      opts.AccessRule,
+	}
+	if !canReview(reviewOpts, s.Clock.Now()) {
+		return nil, ErrUserNotAuthorized
+	}
+
+	if opts.Decision == access.DecisionDECLINED {
+		request.PendingExtension = nil
+		request.UpdatedAt = s.Clock.Now()
+		err := s.putExtensionReview(ctx, request, opts.Request.UpdatedAt, opts.Reviewers)
+		if err != nil {
+			return nil, err
+		}
+		return &request, nil
+	}
+
+	if !s.isExtendable(request) {
+		return nil, ErrRequestCannotBeExtended
+	}
+	if !opts.AccessRule.Target.IsForTargetGroup() {
+		return nil, ErrExtensionNotSupported
+	}
+	err := s.checkSeparationOfDuties(ctx, reviewOpts)
+	if err != nil {
+		return nil, err
+	}
+	err = s.approveExtensionStage(ctx, &request, reviewOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	// the extension remains pending until every approval stage has reached its quorum.
+	if access.CurrentApprovalStage(request.PendingExtension.ApprovalStages) != -1 {
+		request.UpdatedAt = s.Clock.Now()
+		err = s.putExtensionReview(ctx, request, opts.Request.UpdatedAt, opts.Reviewers)
+		if err != nil {
+			return nil, err
+		}
+		return &request, nil
+	}
+
+	extension := request.PendingExtension.Duration
+	err = s.checkExtensionOverlap(ctx, request, extension)
+	if err != nil {
+		return nil, err
+	}
+	request, err = s.applyExtension(ctx, opts.Request, request, opts.AccessRule, extension, opts.ReviewerID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.EventPutter.Put(ctx, gevent.RequestExtended{Request: request, ReviewerID: opts.ReviewerID, ReviewerEmail: opts.ReviewerEmail})
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+// approveExtensionStage records the reviewer's approval against the current approval stage of the request's pending extension.
+// The same reviewers may approve each stage as for the request itself.
+func (s *Service) approveExtensionStage(ctx context.Context, request *access.Request, opts AddReviewOpts) error {
+	approval := request.ResolveApproval(opts.AccessRule)
+	// copy the extension so that we don't modify the request passed in the opts
+	extension := *request.PendingExtension
+	if len(extension.ApprovalStages) == 0 {
+		// extensions requested before approval stages were introduced don't have any progress recorded,
+		// so it is initialised from the access rule.
+		extension.ApprovalStages = access.NewApprovalStages(approval)
+	}
+	stages, _, err := s.recordStageApproval(ctx, extension.ApprovalStages, approval, opts)
+	if err != nil {
+		return err
+	}
+	extension.ApprovalStages = stages
+	request.PendingExtension = &extension
+	return nil
+}
+
+// putExtensionReview saves a request with changes to its pending extension,
+// conditional on the request not having been updated since it was read.
+// The reviewers of the request are queried if they aren't provided.
+func (s *Service) putExtensionReview(ctx context.Context, request access.Request, readUpdatedAt time.Time, reviewers []access.Reviewer) error {
+	items, err := dbupdate.GetUpdateReviewerItems(ctx, s.DB, request, dbupdate.WithReviewers(reviewers))
+	if err != nil {
+		return err
+	}
+	err = s.putRequestIfUnchanged(ctx, request, readUpdatedAt)
+	if err != nil {
+		return err
+	}
+	return s.DB.PutBatch(ctx, items...)
+}
+
+// isExtendable is true if the request has a grant which is currently active.
+func (s *Service) isExtendable(request access.Request) bool {
+	return request.Status == access.APPROVED &&
+		request.Grant != nil &&
+		request.Grant.Status == ahTypes.GrantStatusACTIVE &&
+		request.Grant.End.After(s.Clock.Now())
+}
+
+// validateExtension checks that the extended grant would not be longer than the maximum duration allowed by the access rule.
+func validateExtension(request access.Request, accessRule rule.AccessRule, extension time.Duration) error {
+	if extension <= 0 {
+		return &apio.APIError{
+			Err:    errors.New("request validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: []apio.FieldError{
+				{
+					Field: "extensionDurationSeconds",
+					Error: "extensionDurationSeconds must be greater than 0",
+				},
+			},
+		}
+	}
+	total := request.Grant.End.Sub(request.Grant.Start) + extension
+	maxDuration := time.Second * time.Duration(accessRule.TimeConstraints.MaxDurationSeconds)
+	if total > maxDuration {
+		return &apio.APIError{
+			Err:    errors.New("request validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: []apio.FieldError{
+				{
+					Field: "extensionDurationSeconds",
+					Error: fmt.Sprintf("the extended duration of %d seconds exceeds the maximum duration seconds: %d", int(total.Seconds()), accessRule.TimeConstraints.MaxDurationSeconds),
+				},
+			},
+		}
+	}
+	return nil
+}
+
+// checkExtensionOverlap returns ErrRequestOverlapsExistingGrant if the extended grant would overlap another grant.
+func (s *Service) checkExtensionOverlap(ctx context.Context, request access.Request, extension time.Duration) error {
+	extended := request
+	extendedTiming := extendedTiming(request, extension)
+	extended.OverrideTiming = &extendedTiming
+	overlaps, err := s.overlapsExistingGrant(ctx, extended)
+	if err != nil {
+		return err
+	}
+	if overlaps {
+		return ErrRequestOverlapsExistingGrant
+	}
+	return nil
+}
+
+// applyExtension extends the grant, and saves the updated request along with an audit log event.
+//
+// The request is saved before the grant is extended, conditional on it not having been updated since it was read,
+// so that concurrent reviews can't both extend the grant.
+// If the grant can't be extended the request is returned to how it was read, so that the extension can be retried.
+func (s *Service) applyExtension(ctx context.Context, read access.Request, request access.Request, accessRule rule.AccessRule, extension time.Duration, actorID string) (access.Request, error) {
+	from := request.EffectiveTiming()
+	to := extendedTiming(request, extension)
+
+	request.PendingExtension = nil
+	request.UpdatedAt = s.Clock.Now()
+	err := s.putRequestIfUnchanged(ctx, request, read.UpdatedAt)
+	if err != nil {
+		return access.Request{}, err
+	}
+
+	grant, err := s.Workflow.Extend(ctx, request, accessRule, request.Grant.End.Add(extension))
+	if err != nil {
+		releaseErr := s.putRequestIfUnchanged(ctx, read, request.UpdatedAt)
+		if releaseErr != nil {
+			return access.Request{}, multierror.Append(err, releaseErr)
+		}
+		return access.Request{}, err
+	}
+	request.Grant = grant
+	request.OverrideTiming = &to
+
+	items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, request)
+	if err != nil {
+		return access.Request{}, err
+	}
+	// audit log event
+	reqEvent := access.NewTimingChangeEvent(request.ID, request.UpdatedAt, &actorID, from, to)
+	items = append(items, &reqEvent)
+
+	err = s.DB.PutBatch(ctx, items...)
+	if err != nil {
+		return access.Request{}, err
+	}
+	return request, nil
+}
+
+// extendedTiming returns the timing of the request's grant after it has been extended.
+func extendedTiming(request access.Request, extension time.Duration) access.Timing {
+	start := request.Grant.Start
+	return access.Timing{
+		Duration:  request.Grant.End.Add(extension).Sub(start),
+		StartTime: &start,
+	}
+}
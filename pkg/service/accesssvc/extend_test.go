@@ -0,0 +1,362 @@
+package accesssvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc/mocks"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtendRequest(t *testing.T) {
+	type testcase struct {
+		name          string
+		give          ExtendRequestOpts
+		wantExtendErr bool
+		want          *ExtendRequestResult
+		wantErr       error
+	}
+
+	clk := clock.NewMock()
+	start := clk.Now().Add(-time.Minute)
+	end := clk.Now().Add(time.Hour)
+	newEnd := end.Add(time.Hour)
+	newTiming := access.Timing{Duration: newEnd.Sub(start), StartTime: &start}
+	reason := "need more time"
+
+	activeRequest := access.Request{
+		ID:          "req_1",
+		RequestedBy: "usr_1",
+		Status:      access.APPROVED,
+		Grant: &access.Grant{
+			Start:  start,
+			End:    end,
+			Status: ahTypes.GrantStatusACTIVE,
+		},
+	}
+	noApprovalRule := rule.AccessRule{
+		Target:          rule.Target{TargetGroupID: "tg_1"},
+		TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 4 * 3600},
+	}
+	approvalRule := rule.AccessRule{
+		Target:          rule.Target{TargetGroupID: "tg_1"},
+		Approval:        rule.Approval{Users: []string{"usr_2"}},
+		TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 4 * 3600},
+	}
+	providerRule := rule.AccessRule{
+		Target:          rule.Target{BuiltInProviderType: "okta"},
+		TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 4 * 3600},
+	}
+
+	testcases := []testcase{
+		{
+			name: "extended immediately when approval is not required",
+			give: ExtendRequestOpts{
+				UserID:     "usr_1",
+				Request:    activeRequest,
+				AccessRule: noApprovalRule,
+				Extension:  time.Hour,
+			},
+			wantExtendErr: false,
+			want: &ExtendRequestResult{
+				Request: access.Request{
+					ID:          "req_1",
+					RequestedBy: "usr_1",
+					Status:      access.APPROVED,
+					Grant: &access.Grant{
+						Start:     start,
+						End:       newEnd,
+						Status:    ahTypes.GrantStatusACTIVE,
+						UpdatedAt: clk.Now(),
+					},
+					OverrideTiming: &newTiming,
+					UpdatedAt:      clk.Now(),
+				},
+			},
+		},
+		{
+			name: "pending when approval is required",
+			give: ExtendRequestOpts{
+				UserID:     "usr_1",
+				Request:    activeRequest,
+				AccessRule: approvalRule,
+				Extension:  time.Hour,
+				Reason:     &reason,
+			},
+			want: &ExtendRequestResult{
+				Request: access.Request{
+					ID:          "req_1",
+					RequestedBy: "usr_1",
+					Status:      access.APPROVED,
+					Grant:       activeRequest.Grant,
+					PendingExtension: &access.Extension{
+						Duration:    time.Hour,
+						Reason:      &reason,
+						RequestedAt: clk.Now(),
+						ApprovalStages: []access.ApprovalStage{
+							{Name: "Approval", Quorum: 1, ApprovedBy: []string{}},
+						},
+					},
+					UpdatedAt: clk.Now(),
+				},
+				ExtensionPending: true,
+			},
+		},
+		{
+			name: "only the requestor can extend",
+			give: ExtendRequestOpts{
+				UserID:     "usr_2",
+				Request:    activeRequest,
+				AccessRule: noApprovalRule,
+				Extension:  time.Hour,
+			},
+			wantErr: ErrUserNotAuthorized,
+		},
+		{
+			name: "grant must be active",
+			give: ExtendRequestOpts{
+				UserID: "usr_1",
+				Request: access.Request{
+					RequestedBy: "usr_1",
+					Status:      access.PENDING,
+				},
+				AccessRule: noApprovalRule,
+				Extension:  time.Hour,
+			},
+			wantErr: ErrRequestCannotBeExtended,
+		},
+		{
+			name: "extension already pending",
+			give: ExtendRequestOpts{
+				UserID: "usr_1",
+				Request: access.Request{
+					RequestedBy:      "usr_1",
+					Status:           access.APPROVED,
+					Grant:            activeRequest.Grant,
+					PendingExtension: &access.Extension{Duration: time.Hour},
+				},
+				AccessRule: approvalRule,
+				Extension:  time.Hour,
+			},
+			wantErr: ErrExtensionAlreadyPending,
+		},
+		{
+			name: "built-in provider grants can't be extended",
+			give: ExtendRequestOpts{
+				UserID:     "usr_1",
+				Request:    activeRequest,
+				AccessRule: providerRule,
+				Extension:  time.Hour,
+			},
+			wantErr: ErrExtensionNotSupported,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			workflowMock := mocks.NewMockWorkflow(ctrl)
+			workflowMock.EXPECT().Extend(gomock.Any(), gomock.Any(), gomock.Any(), newEnd).Return(&access.Grant{
+				Start:     start,
+				End:       newEnd,
+				Status:    ahTypes.GrantStatusACTIVE,
+				UpdatedAt: clk.Now(),
+			}, nil).AnyTimes()
+
+			ep := mocks.NewMockEventPutter(ctrl)
+			ep.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListRequestsForUserAndRequestend{})
+			db.MockQuery(&storage.ListRequestReviewers{})
+
+			s := Service{
+				Clock:       clk,
+				DB:          db,
+				EventPutter: ep,
+				Workflow:    workflowMock,
+			}
+			got, err := s.ExtendRequest(context.Background(), tc.give)
+			if tc.wantErr != nil {
+				assert.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestValidateExtension(t *testing.T) {
+	start := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	request := access.Request{
+		Grant: &access.Grant{
+			Start: start,
+			End:   start.Add(time.Hour),
+		},
+	}
+	accessRule := rule.AccessRule{
+		TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 2 * 3600},
+	}
+
+	assert.NoError(t, validateExtension(request, accessRule, time.Hour))
+	assert.Error(t, validateExtension(request, accessRule, time.Hour+time.Second))
+	assert.Error(t, validateExtension(request, accessRule, 0))
+}
+
+func TestReviewExtension(t *testing.T) {
+	type testcase struct {
+		name         string
+		give         ReviewExtensionOpts
+		wantExtended bool
+		want         *access.Request
+		wantErr      error
+	}
+
+	clk := clock.NewMock()
+	start := clk.Now().Add(-time.Minute)
+	end := clk.Now().Add(time.Hour)
+	newEnd := end.Add(time.Hour)
+	newTiming := access.Timing{Duration: newEnd.Sub(start), StartTime: &start}
+	grant := &access.Grant{
+		Start:  start,
+		End:    end,
+		Status: ahTypes.GrantStatusACTIVE,
+	}
+	extendedGrant := &access.Grant{
+		Start:     start,
+		End:       newEnd,
+		Status:    ahTypes.GrantStatusACTIVE,
+		UpdatedAt: clk.Now(),
+	}
+
+	quorumRule := rule.AccessRule{
+		Target:          rule.Target{TargetGroupID: "tg_1"},
+		Approval:        rule.Approval{Users: []string{"usr_2", "usr_3"}, Quorum: 2},
+		TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 4 * 3600},
+	}
+	reviewers := []access.Reviewer{{ReviewerID: "usr_2"}, {ReviewerID: "usr_3"}}
+
+	// pendingRequest returns a request with an extension which has been approved by the given reviewers.
+	pendingRequest := func(approvedBy ...string) access.Request {
+		return access.Request{
+			ID:          "req_1",
+			RequestedBy: "usr_1",
+			Status:      access.APPROVED,
+			Grant:       grant,
+			PendingExtension: &access.Extension{
+				Duration: time.Hour,
+				ApprovalStages: []access.ApprovalStage{
+					{Quorum: 2, ApprovedBy: append([]string{}, approvedBy...)},
+				},
+			},
+		}
+	}
+
+	testcases := []testcase{
+		{
+			name: "first approval of a quorum leaves the extension pending",
+			give: ReviewExtensionOpts{
+				ReviewerID: "usr_2",
+				Reviewers:  reviewers,
+				Decision:   access.DecisionApproved,
+				Request:    pendingRequest(),
+				AccessRule: quorumRule,
+			},
+			want: func() *access.Request {
+				r := pendingRequest("usr_2")
+				r.UpdatedAt = clk.Now()
+				return &r
+			}(),
+		},
+		{
+			name: "final approval of a quorum extends the grant",
+			give: ReviewExtensionOpts{
+				ReviewerID: "usr_3",
+				Reviewers:  reviewers,
+				Decision:   access.DecisionApproved,
+				Request:    pendingRequest("usr_2"),
+				AccessRule: quorumRule,
+			},
+			wantExtended: true,
+			want: &access.Request{
+				ID:             "req_1",
+				RequestedBy:    "usr_1",
+				Status:         access.APPROVED,
+				Grant:          extendedGrant,
+				OverrideTiming: &newTiming,
+				UpdatedAt:      clk.Now(),
+			},
+		},
+		{
+			name: "reviewer can't approve twice",
+			give: ReviewExtensionOpts{
+				ReviewerID: "usr_2",
+				Reviewers:  reviewers,
+				Decision:   access.DecisionApproved,
+				Request:    pendingRequest("usr_2"),
+				AccessRule: quorumRule,
+			},
+			wantErr: ErrReviewerAlreadyApproved,
+		},
+		{
+			name: "decline removes the pending extension",
+			give: ReviewExtensionOpts{
+				ReviewerID: "usr_2",
+				Reviewers:  reviewers,
+				Decision:   access.DecisionDECLINED,
+				Request:    pendingRequest(),
+				AccessRule: quorumRule,
+			},
+			want: &access.Request{
+				ID:          "req_1",
+				RequestedBy: "usr_1",
+				Status:      access.APPROVED,
+				Grant:       grant,
+				UpdatedAt:   clk.Now(),
+			},
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			workflowMock := mocks.NewMockWorkflow(ctrl)
+			if tc.wantExtended {
+				workflowMock.EXPECT().Extend(gomock.Any(), gomock.Any(), gomock.Any(), newEnd).Return(extendedGrant, nil)
+			}
+
+			ep := mocks.NewMockEventPutter(ctrl)
+			ep.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListRequestsForUserAndRequestend{})
+			db.MockQuery(&storage.ListRequestReviewers{})
+
+			s := Service{
+				Clock:       clk,
+				DB:          db,
+				EventPutter: ep,
+				Workflow:    workflowMock,
+			}
+			got, err := s.ReviewExtension(context.Background(), tc.give)
+			if tc.wantErr != nil {
+				assert.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	access "github.com/common-fate/common-fate/pkg/access"
 	rule "github.com/common-fate/common-fate/pkg/rule"
@@ -36,6 +37,21 @@ func (m *MockWorkflow) EXPECT() *MockWorkflowMockRecorder {
 	return m.recorder
 }
 
+// Extend mocks base method.
+func (m *MockWorkflow) Extend(arg0 context.Context, arg1 access.Request, arg2 rule.AccessRule, arg3 time.Time) (*access.Grant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Extend", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*access.Grant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Extend indicates an expected call of Extend.
+func (mr *MockWorkflowMockRecorder) Extend(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Extend", reflect.TypeOf((*MockWorkflow)(nil).Extend), arg0, arg1, arg2, arg3)
+}
+
 // Grant mocks base method.
 func (m *MockWorkflow) Grant(arg0 context.Context, arg1 access.Request, arg2 rule.AccessRule) (*access.Grant, error) {
 	m.ctrl.T.Helper()
@@ -18,8 +18,18 @@ type requestAndRule struct {
 }
 
 func overlapsExistingGrantCheck(req access.Request, upcomingRequests []access.Request, currentRequestRule rule.AccessRule, allRules []rule.AccessRule, clock clock.Clock) (bool, error) {
+	overlapping, err := findOverlappingGrants(req, upcomingRequests, currentRequestRule, allRules, clock)
+	if err != nil {
+		return false, err
+	}
+	return len(overlapping) > 0, nil
+}
+
+// findOverlappingGrants returns the requests with an active or pending grant for the same access as the request, during the same time.
+func findOverlappingGrants(req access.Request, upcomingRequests []access.Request, currentRequestRule rule.AccessRule, allRules []rule.AccessRule, clock clock.Clock) ([]access.Request, error) {
 	start, end := req.GetInterval(access.WithNow(clock.Now()))
 	var upcomingRequestAndRules []requestAndRule
+	var overlapping []access.Request
 
 	ruleMap := make(map[string]rule.AccessRule)
 
@@ -29,12 +39,16 @@ func overlapsExistingGrantCheck(req access.Request, upcomingRequests []access.Re
 
 	//make a map of requests mapped to their relative access rules
 	for _, upcomingRequest := range upcomingRequests {
+		// a request can't overlap its own grant, this is the case when extending a request with an active grant
+		if req.Grant != nil && upcomingRequest.ID == req.ID {
+			continue
+		}
 
 		if accessRule, ok := ruleMap[upcomingRequest.Rule]; ok {
 			upcomingRequestAndRules = append(upcomingRequestAndRules, requestAndRule{request: upcomingRequest, rule: accessRule})
 
 		} else {
-			return false, fmt.Errorf("request contains access rule that does not exist")
+			return nil, fmt.Errorf("request contains access rule that does not exist")
 		}
 	}
 
@@ -68,7 +82,7 @@ func overlapsExistingGrantCheck(req access.Request, upcomingRequests []access.Re
 			if r.request.Grant != nil {
 				if r.request.Grant.Status == "ACTIVE" || r.request.Grant.Status == "PENDING" {
 					if reflect.DeepEqual(currentRequestArguments, upcomingRequestArguments) {
-						return true, nil
+						overlapping = append(overlapping, r.request)
 					}
 				}
 
@@ -77,10 +91,19 @@ func overlapsExistingGrantCheck(req access.Request, upcomingRequests []access.Re
 		}
 
 	}
-	return false, nil
+	return overlapping, nil
 }
 
 func (s *Service) overlapsExistingGrant(ctx context.Context, req access.Request) (bool, error) {
+	overlapping, err := s.overlappingGrants(ctx, req)
+	if err != nil {
+		return false, err
+	}
+	return len(overlapping) > 0, nil
+}
+
+// overlappingGrants returns the user's requests with grants which overlap the request.
+func (s *Service) overlappingGrants(ctx context.Context, req access.Request) ([]access.Request, error) {
 	start, _ := req.GetInterval(access.WithNow(s.Clock.Now()))
 
 	rq := storage.ListRequestsForUserAndRequestend{
@@ -90,28 +113,24 @@ func (s *Service) overlapsExistingGrant(ctx context.Context, req access.Request)
 	}
 	_, err := s.DB.Query(ctx, &rq)
 	if err != nil && err != ddb.ErrNoItems {
-		return false, err
+		return nil, err
 	}
 	upcomingRequests := rq.Result
 	if len(upcomingRequests) == 0 {
-		return false, nil
+		return nil, nil
 	}
 
 	ruleq := storage.GetAccessRuleCurrent{ID: req.Rule}
 	_, err = s.DB.Query(ctx, &ruleq)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
 	allRules := storage.ListCurrentAccessRules{}
 	_, err = s.DB.Query(ctx, &allRules)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	isOverlapping, err := overlapsExistingGrantCheck(req, upcomingRequests, *ruleq.Result, allRules.Result, s.Clock)
-	if err != nil {
-		return false, err
-	}
-	return isOverlapping, nil
+	return findOverlappingGrants(req, upcomingRequests, *ruleq.Result, allRules.Result, s.Clock)
 }
@@ -67,6 +67,15 @@ func TestOverlapsExistingGrant(t *testing.T) {
 			clock:              clk,
 			want:               true,
 		},
+		{
+			name:               "extending an active request does not overlap itself",
+			accessRequest:      access.Request{ID: "req_active", Rule: "rule_a", Grant: &access.Grant{Status: "ACTIVE"}, RequestedTiming: access.Timing{StartTime: &now, Duration: time.Minute * 5}},
+			upcomingRequests:   []access.Request{{ID: "req_active", Status: activeRequest.Status, Grant: activeRequest.Grant, Rule: "rule_a", RequestedTiming: activeRequest.RequestedTiming}},
+			currentRequestRule: rule.AccessRule{ID: "rule_a", Target: rule.Target{ProviderID: "prov_a"}},
+			allRules:           []rule.AccessRule{{ID: "rule_a", Target: rule.Target{ProviderID: "prov_a"}}},
+			clock:              clk,
+			want:               false,
+		},
 		{
 			name:               "scheduled request overlaps current active request fails",
 			accessRequest:      access.Request{Rule: "rule_a", RequestedTiming: access.Timing{StartTime: &inOneMinute, Duration: time.Minute * 5}},
@@ -0,0 +1,452 @@
+package accesssvc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/service/rulesvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/hashicorp/go-multierror"
+)
+
+type CreateRecurringRequestOpts struct {
+	User         identity.User
+	AccessRuleID string
+	Reason       *string
+	// With must have a single value for each argument, as every occurrence is requested with the same arguments.
+	With       *types.CreateRequestWith
+	Recurrence access.Recurrence
+}
+
+type CreateRecurringRequestResult struct {
+	RecurringRequest access.RecurringRequest
+	// Request is the first occurrence of the recurring request.
+	// It is nil if the user already has access for the first occurrence.
+	Request *access.Request
+}
+
+// CreateRecurringRequest creates a recurring request and requests its first occurrence.
+// Later occurrences are requested by SpawnRecurringRequests.
+func (s *Service) CreateRecurringRequest(ctx context.Context, in CreateRecurringRequestOpts) (*CreateRecurringRequestResult, error) {
+	err := in.Recurrence.Validate()
+	if err != nil {
+		return nil, apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	now := s.Clock.Now()
+	first, err := in.Recurrence.Next(now)
+	if err != nil {
+		return nil, err
+	}
+
+	create := CreateRequests{
+		AccessRuleId: in.AccessRuleID,
+		Reason:       in.Reason,
+		Timing: types.RequestTiming{
+			DurationSeconds: int(in.Recurrence.Duration.Seconds()),
+			StartTime:       &first,
+		},
+	}
+	if in.With != nil {
+		create.With = &types.CreateRequestWithSubRequest{*in.With}
+	}
+	validated, err := s.validateCreateRequests(ctx, CreateRequestsOpts{User: in.User, Create: create})
+	if err != nil {
+		return nil, err
+	}
+	if len(validated.argumentCombinations) != 1 {
+		return nil, apio.NewRequestError(ErrRecurringRequestMultipleValues, http.StatusBadRequest)
+	}
+	selected, err := selectedOptions(validated.argumentCombinations[0], validated.requestArguments)
+	if err != nil {
+		return nil, err
+	}
+
+	rr := access.RecurringRequest{
+		ID:          types.NewRecurringRequestID(),
+		RequestedBy: in.User.ID,
+		Rule:        validated.rule.ID,
+		Data: access.RequestData{
+			Reason: in.Reason,
+		},
+		SelectedWith:   selected,
+		Recurrence:     in.Recurrence,
+		Status:         access.RecurringRequestActive,
+		NextOccurrence: first,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	req, err := s.requestOccurrence(ctx, rr, in.User, validated.rule)
+	if err != nil {
+		return nil, err
+	}
+	err = scheduleNextOccurrence(&rr, now)
+	if err != nil {
+		return nil, err
+	}
+	err = dbupdate.PutIf(ctx, s.DB, &rr, dbupdate.ItemNotExists)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateRecurringRequestResult{
+		RecurringRequest: rr,
+		Request:          req,
+	}, nil
+}
+
+type SpawnRecurringRequestsOpts struct {
+	// LeadTime is how far ahead of its start time each occurrence is requested,
+	// giving reviewers time to approve it.
+	LeadTime time.Duration
+}
+
+type SpawnRecurringRequestsResult struct {
+	// Requests are the occurrences which were requested.
+	Requests []access.Request
+	// Cancelled are recurring requests which were cancelled because the user can no longer request access to the rule.
+	Cancelled []access.RecurringRequest
+}
+
+// SpawnRecurringRequests requests the next occurrence of each active recurring request which starts within the lead time.
+//
+// It is intended to be run on a schedule.
+// Occurrences which were missed entirely, such as while the schedule wasn't running, are skipped.
+// Each occurrence is validated against the current version of the Access Rule, and the recurring request is cancelled
+// if the occurrence is no longer allowed.
+func (s *Service) SpawnRecurringRequests(ctx context.Context, opts SpawnRecurringRequestsOpts) (*SpawnRecurringRequestsResult, error) {
+	active, err := s.listRecurringRequestsForStatus(ctx, access.RecurringRequestActive)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.Clock.Now()
+	var res SpawnRecurringRequestsResult
+	var result *multierror.Error
+
+	for _, rr := range active {
+		if !rr.NextOccurrence.Add(rr.Recurrence.Duration).After(now) {
+			next, err := rr.Recurrence.Next(now)
+			if err != nil {
+				result = multierror.Append(result, err)
+				continue
+			}
+			skipped := rr
+			skipped.NextOccurrence = next
+			skipped.UpdatedAt = now
+			err = s.putRecurringRequestIfActive(ctx, skipped, rr.UpdatedAt)
+			if err == ErrRecurringRequestModified {
+				// the recurring request was cancelled while it was being spawned.
+				continue
+			}
+			if err != nil {
+				result = multierror.Append(result, err)
+				continue
+			}
+			rr = skipped
+		}
+		if rr.NextOccurrence.After(now.Add(opts.LeadTime)) {
+			continue
+		}
+
+		user, rul, err := s.recurringRequestUserAndRule(ctx, rr)
+		if err == ErrRecurringRequestNoLongerAllowed {
+			cancelled := rr
+			cancelled.Status = access.RecurringRequestCancelled
+			cancelled.UpdatedAt = now
+			err = s.putRecurringRequestIfActive(ctx, cancelled, rr.UpdatedAt)
+			if err == ErrRecurringRequestModified {
+				continue
+			}
+			if err != nil {
+				result = multierror.Append(result, err)
+				continue
+			}
+			res.Cancelled = append(res.Cancelled, cancelled)
+			continue
+		}
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		// the recurring request is saved with its following occurrence before this occurrence is requested,
+		// so that an occurrence is never requested after the recurring request has been cancelled.
+		scheduled := rr
+		err = scheduleNextOccurrence(&scheduled, now)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+		err = s.putRecurringRequestIfActive(ctx, scheduled, rr.UpdatedAt)
+		if err == ErrRecurringRequestModified {
+			continue
+		}
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		req, err := s.requestOccurrence(ctx, rr, *user, *rul)
+		if err != nil {
+			// the occurrence is requested again on the next run.
+			releaseErr := s.putRecurringRequestIfActive(ctx, rr, scheduled.UpdatedAt)
+			result = multierror.Append(result, err)
+			if releaseErr != nil {
+				result = multierror.Append(result, releaseErr)
+			}
+			continue
+		}
+		if req != nil {
+			res.Requests = append(res.Requests, *req)
+		}
+	}
+	return &res, result.ErrorOrNil()
+}
+
+// listRecurringRequestsForStatus lists every page of recurring requests with the given status.
+func (s *Service) listRecurringRequestsForStatus(ctx context.Context, status access.RecurringRequestStatus) ([]access.RecurringRequest, error) {
+	var recurring []access.RecurringRequest
+	hasMore := true
+	var next string
+	for hasMore {
+		q := storage.ListRecurringRequestsForStatus{Status: status}
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		qr, err := s.DB.Query(ctx, &q, opts...)
+		if err == ddb.ErrNoItems {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		recurring = append(recurring, q.Result...)
+		next = qr.NextPage
+		hasMore = next != ""
+	}
+	return recurring, nil
+}
+
+// putRecurringRequestIfActive saves a recurring request, conditional on it still being active and not having been updated
+// since it was read, so that spawning occurrences doesn't overwrite a concurrent cancellation.
+func (s *Service) putRecurringRequestIfActive(ctx context.Context, rr access.RecurringRequest, readUpdatedAt time.Time) error {
+	err := dbupdate.PutIf(ctx, s.DB, &rr, dbupdate.Condition{
+		Expression: "#status = :active AND updatedAt = :updatedAt",
+		Names:      map[string]string{"#status": "status"},
+		Values:     map[string]any{":active": access.RecurringRequestActive, ":updatedAt": readUpdatedAt},
+	})
+	if err == dbupdate.ErrConditionFailed {
+		return ErrRecurringRequestModified
+	}
+	return err
+}
+
+// recurringRequestUserAndRule looks up the user who made a recurring request and the current version of its Access Rule.
+//
+// ErrRecurringRequestNoLongerAllowed is returned if the rule has been archived, the user is no longer in one of its groups,
+// or the next occurrence doesn't meet the constraints of the current version of the rule, such as its maximum duration
+// or the options which can be selected for its arguments.
+func (s *Service) recurringRequestUserAndRule(ctx context.Context, rr access.RecurringRequest) (*identity.User, *rule.AccessRule, error) {
+	uq := storage.GetUser{ID: rr.RequestedBy}
+	_, err := s.DB.Query(ctx, &uq)
+	if err != nil {
+		return nil, nil, err
+	}
+	rq := storage.GetAccessRuleCurrent{ID: rr.Rule}
+	_, err = s.DB.Query(ctx, &rq)
+	if err == ddb.ErrNoItems {
+		return nil, nil, ErrRecurringRequestNoLongerAllowed
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if rq.Result.Status == rule.ARCHIVED || groupMatches(rq.Result.Groups, uq.Result.Groups) != nil {
+		return nil, nil, ErrRecurringRequestNoLongerAllowed
+	}
+	requestArguments, err := s.Rules.RequestArguments(ctx, rq.Result.Target)
+	if err != nil {
+		return nil, nil, err
+	}
+	// validateCreateRequest only returns errors for requests which don't meet the rule's constraints.
+	err = validateCreateRequest(ctx, occurrenceCreateRequest(rr), *rq.Result, requestArguments)
+	if err != nil {
+		return nil, nil, ErrRecurringRequestNoLongerAllowed
+	}
+	return uq.Result, rq.Result, nil
+}
+
+// requestOccurrence requests the next occurrence of a recurring request.
+// The recurring request isn't saved, and callers must schedule its following occurrence.
+//
+// The occurrence is approved automatically if a reviewer approved an earlier occurrence
+// within the recurring approval validity of the Access Rule.
+// If the user already has access for the occurrence, it is skipped and the returned request is nil.
+func (s *Service) requestOccurrence(ctx context.Context, rr access.RecurringRequest, user identity.User, rul rule.AccessRule) (*access.Request, error) {
+	reuse, err := s.canReuseApproval(ctx, rr, user, rul)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.createRequest(ctx, createRequestOpts{
+		User:             user,
+		Request:          occurrenceCreateRequest(rr),
+		Rule:             rul,
+		RecurringRequest: &rr,
+		ReuseApproval:    reuse,
+	})
+	switch err {
+	case nil:
+		return &res.Request, nil
+	case ErrRequestOverlapsExistingGrant:
+		// the user already has access for the occurrence, so it doesn't need to be requested.
+		return nil, nil
+	default:
+		return nil, err
+	}
+}
+
+// occurrenceCreateRequest builds the request for the next occurrence of a recurring request.
+func occurrenceCreateRequest(rr access.RecurringRequest) CreateRequest {
+	timing := rr.OccurrenceTiming(rr.NextOccurrence)
+	with := make(map[string]string)
+	for k, v := range rr.SelectedWith {
+		with[k] = v.Value
+	}
+	return CreateRequest{
+		AccessRuleId: rr.Rule,
+		Reason:       rr.Data.Reason,
+		Timing:       timing.ToAPI(),
+		With:         with,
+	}
+}
+
+// scheduleNextOccurrence moves a recurring request on to the occurrence following its next occurrence.
+func scheduleNextOccurrence(rr *access.RecurringRequest, now time.Time) error {
+	// the following occurrence starts strictly after this one.
+	next, err := rr.Recurrence.Next(rr.NextOccurrence.Add(time.Minute))
+	if err != nil {
+		return err
+	}
+	rr.NextOccurrence = next
+	rr.UpdatedAt = now
+	return nil
+}
+
+type CancelRecurringRequestOpts struct {
+	UserID             string
+	RecurringRequestID string
+}
+
+// CancelRecurringRequest stops a recurring request from requesting any further occurrences.
+// Occurrences which have already been requested are not affected.
+func (s *Service) CancelRecurringRequest(ctx context.Context, opts CancelRecurringRequestOpts) (*access.RecurringRequest, error) {
+	q := storage.GetRecurringRequest{ID: opts.RecurringRequestID}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil {
+		return nil, err
+	}
+	rr := q.Result
+	// users can only cancel their own recurring requests.
+	if rr.RequestedBy != opts.UserID {
+		return nil, ddb.ErrNoItems
+	}
+	if rr.Status == access.RecurringRequestCancelled {
+		return nil, ErrRecurringRequestAlreadyCancelled
+	}
+	rr.Status = access.RecurringRequestCancelled
+	rr.UpdatedAt = s.Clock.Now()
+	err = s.DB.Put(ctx, rr)
+	if err != nil {
+		return nil, err
+	}
+	return rr, nil
+}
+
+// recordRecurringApprovalAttempts is how many times recording an approval is attempted
+// when the recurring request is updated concurrently, such as when its next occurrence is requested.
+const recordRecurringApprovalAttempts = 3
+
+// recordRecurringApproval records that an occurrence of a recurring request was approved by its reviewers,
+// so that the approval can be reused for later occurrences.
+//
+// The approval is saved conditionally on the recurring request being active and unchanged since it was read,
+// so that a cancellation made at the same time isn't overwritten. The approval isn't recorded for cancelled recurring requests.
+func (s *Service) recordRecurringApproval(ctx context.Context, occurrence access.Request, approvedAt time.Time) error {
+	for attempt := 1; ; attempt++ {
+		q := storage.GetRecurringRequest{ID: *occurrence.RecurringRequestID}
+		_, err := s.DB.Query(ctx, &q)
+		if err != nil {
+			return err
+		}
+		rr := *q.Result
+		if rr.Status != access.RecurringRequestActive {
+			return nil
+		}
+		readUpdatedAt := rr.UpdatedAt
+		rr.ApprovedAt = &approvedAt
+		rr.ApprovedRuleVersion = occurrence.RuleVersion
+		rr.ApprovalStages = occurrence.ApprovalStages
+		rr.UpdatedAt = approvedAt
+		err = s.putRecurringRequestIfActive(ctx, rr, readUpdatedAt)
+		if err != ErrRecurringRequestModified || attempt == recordRecurringApprovalAttempts {
+			return err
+		}
+	}
+}
+
+// canReuseApproval is true if the last approval of a recurring request can be reused for its next occurrence.
+//
+// The approval must be within the rule's recurring approval validity and made against the current version of the rule.
+// Each approval stage must still reach its quorum with the reviewers who approved it, and those reviewers
+// must still meet the rule's separation-of-duties constraints, as they would need to for a new approval.
+// Approvals made by administrators who aren't approvers of the rule are never reused.
+func (s *Service) canReuseApproval(ctx context.Context, rr access.RecurringRequest, user identity.User, rul rule.AccessRule) (bool, error) {
+	if !rr.ApprovalIsValid(rul.Version, rul.Approval.RecurringApprovalValidity(), s.Clock.Now()) {
+		return false, nil
+	}
+	occurrence := access.Request{RequestedBy: user.ID, Rule: rul.ID, SelectedWith: rr.SelectedWith}
+	approval := occurrence.ResolveApproval(rul)
+	stages := approval.GetStages()
+	required := access.NewApprovalStages(approval)
+	if len(rr.ApprovalStages) != len(stages) {
+		return false, nil
+	}
+	for i, stage := range stages {
+		approvers, err := rulesvc.GetStageApprovers(ctx, s.DB, stage)
+		if err != nil {
+			return false, err
+		}
+		isApprover := make(map[string]bool)
+		for _, a := range approvers {
+			isApprover[a] = true
+		}
+		approvals := 0
+		for _, reviewerID := range rr.ApprovalStages[i].ApprovedBy {
+			if !isApprover[reviewerID] {
+				continue
+			}
+			err = s.checkSeparationOfDuties(ctx, AddReviewOpts{ReviewerID: reviewerID, Request: occurrence, AccessRule: rul})
+			var sharedGroup SharedExclusiveGroupError
+			if err == ErrApprovalLimitReached || errors.As(err, &sharedGroup) {
+				continue
+			}
+			if err != nil {
+				return false, err
+			}
+			approvals++
+		}
+		if approvals < required[i].Quorum {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,218 @@
+package accesssvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc/mocks"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpawnRecurringRequests(t *testing.T) {
+	type testcase struct {
+		name          string
+		withRecurring access.RecurringRequest
+		withRule      rule.AccessRule
+		wantGrant     bool
+		wantStatuses  []access.Status
+		withArguments map[string]types.RequestArgument
+		wantCancelled []string
+	}
+
+	clk := clock.NewMock()
+	// 2022-01-03 is a Monday.
+	clk.Set(time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC))
+	now := clk.Now()
+	dayAgo := now.Add(-time.Hour * 24)
+
+	mondays := access.Recurrence{Weekdays: []time.Weekday{time.Monday}, StartTime: "09:00", Duration: time.Hour * 8}
+	thisMonday := now.Add(time.Hour * 9)
+	nextMonday := thisMonday.AddDate(0, 0, 7)
+
+	timeConstraints := types.TimeConstraints{MaxDurationSeconds: 3600 * 8}
+	approvalRule := rule.AccessRule{ID: "rul_1", Version: "1", Status: rule.ACTIVE, Groups: []string{"everyone"}, TimeConstraints: timeConstraints,
+		Approval: rule.Approval{Users: []string{"b"}, RecurringApprovalValiditySeconds: 3600 * 24 * 30},
+	}
+
+	approved := access.RecurringRequest{ID: "rrq_1", RequestedBy: "a", Rule: "rul_1", Recurrence: mondays, Status: access.RecurringRequestActive, NextOccurrence: thisMonday,
+		ApprovedAt: &dayAgo, ApprovedRuleVersion: "1", ApprovalStages: []access.ApprovalStage{{Name: "Approval", Quorum: 1, ApprovedBy: []string{"b"}}},
+	}
+
+	testcases := []testcase{
+		{
+			name:          "occurrence is requested",
+			withRecurring: access.RecurringRequest{ID: "rrq_1", RequestedBy: "a", Rule: "rul_1", Recurrence: mondays, Status: access.RecurringRequestActive, NextOccurrence: thisMonday},
+			withRule:      approvalRule,
+			wantStatuses:  []access.Status{access.PENDING},
+		},
+		{
+			name:          "earlier approval is reused",
+			withRecurring: approved,
+			withRule:      approvalRule,
+			wantGrant:     true,
+			wantStatuses:  []access.Status{access.APPROVED},
+		},
+		{
+			name:          "rule updated since the approval",
+			withRecurring: approved,
+			withRule: rule.AccessRule{ID: "rul_1", Version: "2", Status: rule.ACTIVE, Groups: []string{"everyone"}, TimeConstraints: timeConstraints,
+				Approval: rule.Approval{Users: []string{"b", "c"}, RecurringApprovalValiditySeconds: 3600 * 24 * 30},
+			},
+			wantStatuses: []access.Status{access.PENDING},
+		},
+		{
+			name: "reviewer is no longer an approver",
+			withRecurring: access.RecurringRequest{ID: "rrq_1", RequestedBy: "a", Rule: "rul_1", Recurrence: mondays, Status: access.RecurringRequestActive, NextOccurrence: thisMonday,
+				ApprovedAt: &dayAgo, ApprovedRuleVersion: "1", ApprovalStages: []access.ApprovalStage{{Name: "Approval", Quorum: 1, ApprovedBy: []string{"c"}}},
+			},
+			withRule:     approvalRule,
+			wantStatuses: []access.Status{access.PENDING},
+		},
+		{
+			name:          "reviewer shares an exclusive group with the requestor",
+			withRecurring: approved,
+			withRule: rule.AccessRule{ID: "rul_1", Version: "1", Status: rule.ACTIVE, Groups: []string{"everyone"}, TimeConstraints: timeConstraints,
+				Approval:           rule.Approval{Users: []string{"b"}, RecurringApprovalValiditySeconds: 3600 * 24 * 30},
+				SeparationOfDuties: rule.SeparationOfDuties{ExclusiveGroups: []string{"everyone"}},
+			},
+			wantStatuses: []access.Status{access.PENDING},
+		},
+		{
+			name:          "occurrence is not yet due",
+			withRecurring: access.RecurringRequest{ID: "rrq_1", RequestedBy: "a", Rule: "rul_1", Recurrence: mondays, Status: access.RecurringRequestActive, NextOccurrence: nextMonday},
+			withRule:      approvalRule,
+		},
+		{
+			name:          "archived rule cancels the recurring request",
+			withRecurring: access.RecurringRequest{ID: "rrq_1", RequestedBy: "a", Rule: "rul_1", Recurrence: mondays, Status: access.RecurringRequestActive, NextOccurrence: thisMonday},
+			withRule:      rule.AccessRule{ID: "rul_1", Version: "1", Status: rule.ARCHIVED, Groups: []string{"everyone"}},
+			wantCancelled: []string{"rrq_1"},
+		},
+		{
+			name:          "occurrence longer than the rule now allows cancels the recurring request",
+			withRecurring: access.RecurringRequest{ID: "rrq_1", RequestedBy: "a", Rule: "rul_1", Recurrence: mondays, Status: access.RecurringRequestActive, NextOccurrence: thisMonday},
+			withRule: rule.AccessRule{ID: "rul_1", Version: "2", Status: rule.ACTIVE, Groups: []string{"everyone"},
+				TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 3600},
+			},
+			wantCancelled: []string{"rrq_1"},
+		},
+		{
+			name: "selected option no longer allowed cancels the recurring request",
+			withRecurring: access.RecurringRequest{ID: "rrq_1", RequestedBy: "a", Rule: "rul_1", Recurrence: mondays, Status: access.RecurringRequestActive, NextOccurrence: thisMonday,
+				SelectedWith: map[string]access.Option{"accountId": {Value: "123"}},
+			},
+			withRule: approvalRule,
+			withArguments: map[string]types.RequestArgument{
+				"accountId": {RequiresSelection: true, Options: []types.WithOption{{Value: "456", Valid: true}}},
+			},
+			wantCancelled: []string{"rrq_1"},
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ep := mocks.NewMockEventPutter(ctrl)
+			ep.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			rules := mocks.NewMockAccessRuleService(ctrl)
+			rules.EXPECT().RequestArguments(gomock.Any(), gomock.Any()).Return(tc.withArguments, nil).AnyTimes()
+			wf := mocks.NewMockWorkflow(ctrl)
+			if tc.wantGrant {
+				wf.EXPECT().Grant(gomock.Any(), gomock.Any(), gomock.Any()).Return(&access.Grant{}, nil).Times(1)
+			}
+
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListRecurringRequestsForStatus{Result: []access.RecurringRequest{tc.withRecurring}})
+			db.MockQuery(&storage.GetUser{Result: &identity.User{ID: "a", Groups: []string{"everyone"}}})
+			db.MockQuery(&storage.GetAccessRuleCurrent{Result: &tc.withRule})
+			db.MockQuery(&storage.ListDelegations{})
+			db.MockQuery(&storage.ListRequestReviewers{})
+			db.MockQueryWithErr(&storage.ListRequestsForUserAndRequestend{}, ddb.ErrNoItems)
+
+			s := Service{
+				Clock:       clk,
+				DB:          db,
+				EventPutter: ep,
+				Workflow:    wf,
+				Rules:       rules,
+			}
+			got, err := s.SpawnRecurringRequests(context.Background(), SpawnRecurringRequestsOpts{LeadTime: time.Hour * 24})
+			assert.NoError(t, err)
+
+			var statuses []access.Status
+			for _, req := range got.Requests {
+				assert.Equal(t, tc.withRecurring.ID, *req.RecurringRequestID)
+				assert.Equal(t, thisMonday, *req.RequestedTiming.StartTime)
+				statuses = append(statuses, req.Status)
+			}
+			assert.Equal(t, tc.wantStatuses, statuses)
+
+			var cancelled []string
+			for _, rr := range got.Cancelled {
+				assert.Equal(t, access.RecurringRequestCancelled, rr.Status)
+				cancelled = append(cancelled, rr.ID)
+			}
+			assert.Equal(t, tc.wantCancelled, cancelled)
+		})
+	}
+}
+
+func TestCancelRecurringRequest(t *testing.T) {
+	type testcase struct {
+		name          string
+		give          CancelRecurringRequestOpts
+		withRecurring access.RecurringRequest
+		wantErr       error
+	}
+
+	testcases := []testcase{
+		{
+			name:          "ok",
+			give:          CancelRecurringRequestOpts{UserID: "a", RecurringRequestID: "rrq_1"},
+			withRecurring: access.RecurringRequest{ID: "rrq_1", RequestedBy: "a", Status: access.RecurringRequestActive},
+		},
+		{
+			name:          "other users can't cancel",
+			give:          CancelRecurringRequestOpts{UserID: "b", RecurringRequestID: "rrq_1"},
+			withRecurring: access.RecurringRequest{ID: "rrq_1", RequestedBy: "a", Status: access.RecurringRequestActive},
+			wantErr:       ddb.ErrNoItems,
+		},
+		{
+			name:          "already cancelled",
+			give:          CancelRecurringRequestOpts{UserID: "a", RecurringRequestID: "rrq_1"},
+			withRecurring: access.RecurringRequest{ID: "rrq_1", RequestedBy: "a", Status: access.RecurringRequestCancelled},
+			wantErr:       ErrRecurringRequestAlreadyCancelled,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetRecurringRequest{Result: &tc.withRecurring})
+
+			s := Service{
+				Clock: clock.NewMock(),
+				DB:    db,
+			}
+			got, err := s.CancelRecurringRequest(context.Background(), tc.give)
+			assert.Equal(t, tc.wantErr, err)
+			if tc.wantErr == nil {
+				assert.Equal(t, access.RecurringRequestCancelled, got.Status)
+			}
+		})
+	}
+}
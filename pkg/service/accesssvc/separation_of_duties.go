@@ -0,0 +1,65 @@
+package accesssvc
+
+import (
+	"context"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+)
+
+// checkSeparationOfDuties checks that approving the request doesn't violate the separation-of-duties
+// constraints of the Access Rule. The constraints apply to administrators as well as reviewers.
+func (s *Service) checkSeparationOfDuties(ctx context.Context, opts AddReviewOpts) error {
+	sod := opts.AccessRule.SeparationOfDuties
+	if len(sod.ExclusiveGroups) > 0 {
+		requestor := storage.GetUser{ID: opts.Request.RequestedBy}
+		_, err := s.DB.Query(ctx, &requestor)
+		if err != nil {
+			return err
+		}
+		reviewer := storage.GetUser{ID: opts.ReviewerID}
+		_, err = s.DB.Query(ctx, &reviewer)
+		if err != nil {
+			return err
+		}
+		for _, g := range sod.ExclusiveGroups {
+			if requestor.Result.BelongsToGroup(g) && reviewer.Result.BelongsToGroup(g) {
+				return SharedExclusiveGroupError{GroupID: g}
+			}
+		}
+	}
+
+	if sod.MaxApprovalsPerDay > 0 {
+		since := s.Clock.Now().Add(-24 * time.Hour)
+		approvals := 0
+		hasMore := true
+		var next string
+		for hasMore {
+			q := storage.ListReviewsForReviewerAndRule{ReviewerID: opts.ReviewerID, RuleID: opts.AccessRule.ID, Since: since}
+			var queryOpts []func(*ddb.QueryOpts)
+			if next != "" {
+				queryOpts = append(queryOpts, ddb.Page(next))
+			}
+			qr, err := s.DB.Query(ctx, &q, queryOpts...)
+			if err == ddb.ErrNoItems {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			for _, r := range q.Result {
+				if r.Decision == access.DecisionApproved {
+					approvals++
+				}
+			}
+			next = qr.NextPage
+			hasMore = next != ""
+		}
+		if approvals >= sod.MaxApprovalsPerDay {
+			return ErrApprovalLimitReached
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package accesssvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckSeparationOfDuties(t *testing.T) {
+	type testcase struct {
+		name        string
+		give        rule.SeparationOfDuties
+		withUser    identity.User
+		withReviews []access.Review
+		wantErr     error
+	}
+
+	clk := clock.NewMock()
+	clk.Add(time.Hour * 48)
+	hourAgo := clk.Now().Add(-time.Hour)
+
+	testcases := []testcase{
+		{
+			name: "no constraints",
+		},
+		{
+			name:     "requestor and reviewer share an exclusive group",
+			give:     rule.SeparationOfDuties{ExclusiveGroups: []string{"finance"}},
+			withUser: identity.User{Groups: []string{"finance"}},
+			wantErr:  SharedExclusiveGroupError{GroupID: "finance"},
+		},
+		{
+			name:     "requestor and reviewer share a group which isn't exclusive",
+			give:     rule.SeparationOfDuties{ExclusiveGroups: []string{"finance"}},
+			withUser: identity.User{Groups: []string{"engineering"}},
+		},
+		{
+			name: "approval limit reached",
+			give: rule.SeparationOfDuties{MaxApprovalsPerDay: 2},
+			withReviews: []access.Review{
+				{RuleID: "rul_1", Decision: access.DecisionApproved, ReviewedAt: hourAgo},
+				{RuleID: "rul_1", Decision: access.DecisionApproved, ReviewedAt: hourAgo},
+			},
+			wantErr: ErrApprovalLimitReached,
+		},
+		{
+			name: "declines don't count towards the limit",
+			give: rule.SeparationOfDuties{MaxApprovalsPerDay: 2},
+			withReviews: []access.Review{
+				{RuleID: "rul_1", Decision: access.DecisionApproved, ReviewedAt: hourAgo},
+				{RuleID: "rul_1", Decision: access.DecisionDECLINED, ReviewedAt: hourAgo},
+			},
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetUser{Result: &tc.withUser})
+			db.MockQuery(&storage.ListReviewsForReviewerAndRule{Result: tc.withReviews})
+
+			s := Service{
+				Clock: clk,
+				DB:    db,
+			}
+			err := s.checkSeparationOfDuties(context.Background(), AddReviewOpts{
+				ReviewerID: "a",
+				Request:    access.Request{ID: "req_1", Rule: "rul_1", RequestedBy: "b"},
+				AccessRule: rule.AccessRule{ID: "rul_1", SeparationOfDuties: tc.give},
+			})
+			if tc.wantErr != nil {
+				assert.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,11 +2,13 @@ package accesssvc
 
 import (
 	"context"
+	"time"
 
 	"github.com/benbjohnson/clock"
 
 	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
 	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/autoapproval"
 	"github.com/common-fate/common-fate/pkg/cache"
 	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/common-fate/common-fate/pkg/rule"
@@ -23,11 +25,19 @@ type Service struct {
 	AHClient    AHClient
 	Rules       AccessRuleService
 	Workflow    Workflow
+	// AutoApproval is optional, if it is nil requests which require approval are always reviewed.
+	AutoApproval AutoApprover
 }
 
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/workflow.go -package=mocks . Workflow
 type Workflow interface {
 	Grant(ctx context.Context, request access.Request, accessRule rule.AccessRule) (*access.Grant, error)
+	Extend(ctx context.Context, request access.Request, accessRule rule.AccessRule, end time.Time) (*access.Grant, error)
+}
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/autoapprover.go -package=mocks . AutoApprover
+type AutoApprover interface {
+	Evaluate(ctx context.Context, in autoapproval.RequestBody) (autoapproval.ResponseBody, error)
 }
 
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/eventputter.go -package=mocks . EventPutter
@@ -0,0 +1,225 @@
+package accesssvc
+
+import (
+	"context"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
+	"github.com/common-fate/ddb"
+	"github.com/hashicorp/go-multierror"
+)
+
+type SweepPendingRequestsOpts struct {
+	// ReminderInterval is how often the reviewers of a pending request are reminded to review it.
+	// If it is zero, no reminders are sent.
+	ReminderInterval time.Duration
+}
+
+type SweepPendingRequestsResult struct {
+	Reminded []access.Request
+	Expired  []access.Request
+}
+
+// SweepPendingRequests reminds reviewers about requests which have been pending for longer than the reminder interval,
+// and expires requests which have been pending for longer than their Access Rule allows.
+//
+// It is intended to be run on a schedule.
+// An error sweeping one request doesn't prevent the remaining requests from being swept.
+func (s *Service) SweepPendingRequests(ctx context.Context, opts SweepPendingRequestsOpts) (*SweepPendingRequestsResult, error) {
+	pending, err := s.listRequestsForStatus(ctx, access.PENDING)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.Clock.Now()
+	var res SweepPendingRequestsResult
+	var result *multierror.Error
+	// requests are swept against the version of the rule they were made for.
+	rules := make(map[string]rule.AccessRule)
+
+	for _, req := range pending {
+		key := req.Rule + "#" + req.RuleVersion
+		rul, ok := rules[key]
+		if !ok {
+			rq := storage.GetAccessRuleVersion{ID: req.Rule, VersionID: req.RuleVersion}
+			_, err := s.DB.Query(ctx, &rq)
+			if err != nil {
+				result = multierror.Append(result, err)
+				continue
+			}
+			rul = *rq.Result
+			rules[key] = rul
+		}
+
+		if isExpired(req, rul, now) {
+			expired, err := s.expireRequest(ctx, req, now)
+			if err == ErrRequestModified {
+				// the request was reviewed while it was being swept.
+				continue
+			}
+			if err != nil {
+				result = multierror.Append(result, err)
+				continue
+			}
+			res.Expired = append(res.Expired, *expired)
+			continue
+		}
+
+		if requiresReminder(req, opts.ReminderInterval, now) {
+			reminded, err := s.remindReviewers(ctx, req, now)
+			if err == ErrRequestModified {
+				continue
+			}
+			if err != nil {
+				result = multierror.Append(result, err)
+				continue
+			}
+			res.Reminded = append(res.Reminded, *reminded)
+		}
+	}
+	return &res, result.ErrorOrNil()
+}
+
+// isExpired is true if the request has been pending for longer than the rule allows.
+func isExpired(req access.Request, rul rule.AccessRule, now time.Time) bool {
+	if rul.Approval.ExpiresAfterSeconds <= 0 {
+		return false
+	}
+	expiry := req.CreatedAt.Add(time.Duration(rul.Approval.ExpiresAfterSeconds) * time.Second)
+	return !now.Before(expiry)
+}
+
+// requiresReminder is true if the reviewers haven't been reminded about the request within the reminder interval.
+func requiresReminder(req access.Request, interval time.Duration, now time.Time) bool {
+	if interval <= 0 {
+		return false
+	}
+	last := req.CreatedAt
+	if req.RemindedAt != nil {
+		last = *req.RemindedAt
+	}
+	return !now.Before(last.Add(interval))
+}
+
+func (s *Service) expireRequest(ctx context.Context, req access.Request, now time.Time) (*access.Request, error) {
+	originalStatus := req.Status
+	listedUpdatedAt := req.UpdatedAt
+	req.Status = access.EXPIRED
+	req.UpdatedAt = now
+
+	items, err := dbupdate.GetUpdateReviewerItems(ctx, s.DB, req)
+	if err != nil {
+		return nil, err
+	}
+	// the request is expired automatically, so the audit log event has no actor.
+	reqEvent := access.NewStatusChangeEvent(req.ID, now, nil, originalStatus, req.Status)
+	items = append(items, &reqEvent)
+
+	err = s.putPendingRequestIfUnchanged(ctx, req, listedUpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	err = s.DB.PutBatch(ctx, items...)
+	if err != nil {
+		return nil, err
+	}
+	err = s.EventPutter.Put(ctx, gevent.RequestExpired{Request: req})
+	if err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+func (s *Service) remindReviewers(ctx context.Context, req access.Request, now time.Time) (*access.Request, error) {
+	rq := storage.ListRequestReviewers{RequestID: req.ID}
+	_, err := s.DB.Query(ctx, &rq)
+	if err != nil && err != ddb.ErrNoItems {
+		return nil, err
+	}
+
+	// the reminder doesn't change updatedAt, so that it doesn't conflict with reviews of the request.
+	req.RemindedAt = &now
+	items, err := dbupdate.GetUpdateReviewerItems(ctx, s.DB, req, dbupdate.WithReviewers(rq.Result))
+	if err != nil {
+		return nil, err
+	}
+	reqEvent := access.NewReminderSentEvent(req.ID, now)
+	items = append(items, &reqEvent)
+
+	err = s.putPendingRequestIfUnchanged(ctx, req, req.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	err = s.DB.PutBatch(ctx, items...)
+	if err != nil {
+		return nil, err
+	}
+	err = s.EventPutter.Put(ctx, gevent.RequestReminder{Request: req, ReviewerIDs: outstandingReviewers(req, rq.Result)})
+	if err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+// listRequestsForStatus lists every page of requests with the given status.
+// The requests are listed before any are swept, as sweeping a request changes its status.
+func (s *Service) listRequestsForStatus(ctx context.Context, status access.Status) ([]access.Request, error) {
+	var requests []access.Request
+	hasMore := true
+	var next string
+	for hasMore {
+		q := storage.ListRequestsForStatus{Status: status}
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		qr, err := s.DB.Query(ctx, &q, opts...)
+		if err == ddb.ErrNoItems {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, q.Result...)
+		next = qr.NextPage
+		hasMore = next != ""
+	}
+	return requests, nil
+}
+
+// putPendingRequestIfUnchanged saves a swept request, conditional on the request still being pending
+// and not having been updated since it was listed, so that the sweeper doesn't overwrite a concurrent review.
+func (s *Service) putPendingRequestIfUnchanged(ctx context.Context, req access.Request, listedUpdatedAt time.Time) error {
+	err := dbupdate.PutIf(ctx, s.DB, &req, dbupdate.Condition{
+		Expression: "#status = :pending AND updatedAt = :updatedAt",
+		Names:      map[string]string{"#status": "status"},
+		Values:     map[string]any{":pending": access.PENDING, ":updatedAt": listedUpdatedAt},
+	})
+	if err == dbupdate.ErrConditionFailed {
+		return ErrRequestModified
+	}
+	return err
+}
+
+// outstandingReviewers returns the IDs of the reviewers of a request who haven't yet approved it.
+// Delegates are not reminded once they, or the approver who delegated their reviews, have approved the request.
+func outstandingReviewers(req access.Request, reviewers []access.Reviewer) []string {
+	approved := make(map[string]bool)
+	for _, stage := range req.ApprovalStages {
+		for _, id := range stage.ApprovedBy {
+			approved[id] = true
+		}
+	}
+	ids := []string{}
+	for _, r := range reviewers {
+		if r.ReviewerID == req.RequestedBy || approved[r.ReviewerID] || (r.Delegation != nil && approved[r.Delegation.UserID]) {
+			continue
+		}
+		ids = append(ids, r.ReviewerID)
+	}
+	return ids
+}
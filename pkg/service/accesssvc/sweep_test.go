@@ -0,0 +1,133 @@
+package accesssvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc/mocks"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSweepPendingRequests(t *testing.T) {
+	type testcase struct {
+		name             string
+		reminderInterval time.Duration
+		withRequest      access.Request
+		withRule         rule.AccessRule
+		withReviewers    []access.Reviewer
+		// withConditionFailed simulates the request being reviewed while it is swept.
+		withConditionFailed bool
+		wantEvent           gevent.EventTyper
+		wantReminded        []access.Request
+		wantExpired         []access.Request
+	}
+
+	clk := clock.NewMock()
+	clk.Add(time.Hour * 48)
+	now := clk.Now()
+	dayAgo := now.Add(-time.Hour * 24)
+	hourAgo := now.Add(-time.Hour)
+
+	expiringRule := rule.AccessRule{ID: "rul_1", Version: "1", Approval: rule.Approval{ExpiresAfterSeconds: 3600 * 12}}
+	reviewers := []access.Reviewer{{ReviewerID: "a"}, {ReviewerID: "b"}, {ReviewerID: "c"}}
+
+	testcases := []testcase{
+		{
+			name:             "pending request is expired",
+			reminderInterval: time.Hour,
+			withRequest:      access.Request{ID: "req_1", Rule: "rul_1", RuleVersion: "1", Status: access.PENDING, CreatedAt: dayAgo},
+			withRule:         expiringRule,
+			wantEvent:        gevent.RequestExpired{Request: access.Request{ID: "req_1", Rule: "rul_1", RuleVersion: "1", Status: access.EXPIRED, CreatedAt: dayAgo, UpdatedAt: now}},
+			wantExpired:      []access.Request{{ID: "req_1", Rule: "rul_1", RuleVersion: "1", Status: access.EXPIRED, CreatedAt: dayAgo, UpdatedAt: now}},
+		},
+		{
+			name:             "reviewers who haven't approved are reminded",
+			reminderInterval: time.Hour * 4,
+			withRequest: access.Request{ID: "req_1", Rule: "rul_1", RuleVersion: "1", Status: access.PENDING, CreatedAt: dayAgo, RequestedBy: "a",
+				ApprovalStages: []access.ApprovalStage{{Name: "Approval", Quorum: 2, ApprovedBy: []string{"b"}}},
+			},
+			withRule:      rule.AccessRule{ID: "rul_1", Version: "1"},
+			withReviewers: reviewers,
+			wantEvent: gevent.RequestReminder{
+				Request: access.Request{ID: "req_1", Rule: "rul_1", RuleVersion: "1", Status: access.PENDING, CreatedAt: dayAgo, RequestedBy: "a", RemindedAt: &now,
+					ApprovalStages: []access.ApprovalStage{{Name: "Approval", Quorum: 2, ApprovedBy: []string{"b"}}},
+				},
+				ReviewerIDs: []string{"c"},
+			},
+			wantReminded: []access.Request{{ID: "req_1", Rule: "rul_1", RuleVersion: "1", Status: access.PENDING, CreatedAt: dayAgo, RequestedBy: "a", RemindedAt: &now,
+				ApprovalStages: []access.ApprovalStage{{Name: "Approval", Quorum: 2, ApprovedBy: []string{"b"}}},
+			}},
+		},
+		{
+			name:                "request reviewed while it is expired",
+			reminderInterval:    time.Hour,
+			withRequest:         access.Request{ID: "req_1", Rule: "rul_1", RuleVersion: "1", Status: access.PENDING, CreatedAt: dayAgo},
+			withRule:            expiringRule,
+			withConditionFailed: true,
+		},
+		{
+			name:                "request reviewed while reviewers are reminded",
+			reminderInterval:    time.Hour * 4,
+			withRequest:         access.Request{ID: "req_1", Rule: "rul_1", RuleVersion: "1", Status: access.PENDING, CreatedAt: dayAgo},
+			withRule:            rule.AccessRule{ID: "rul_1", Version: "1"},
+			withReviewers:       reviewers,
+			withConditionFailed: true,
+		},
+		{
+			name:             "recently reminded",
+			reminderInterval: time.Hour * 4,
+			withRequest:      access.Request{ID: "req_1", Rule: "rul_1", RuleVersion: "1", Status: access.PENDING, CreatedAt: dayAgo, RemindedAt: &hourAgo},
+			withRule:         rule.AccessRule{ID: "rul_1", Version: "1"},
+		},
+		{
+			name:        "reminders disabled",
+			withRequest: access.Request{ID: "req_1", Rule: "rul_1", RuleVersion: "1", Status: access.PENDING, CreatedAt: dayAgo},
+			withRule:    rule.AccessRule{ID: "rul_1", Version: "1"},
+		},
+		{
+			name:             "request is not yet expired",
+			reminderInterval: time.Hour * 48,
+			withRequest:      access.Request{ID: "req_1", Rule: "rul_1", RuleVersion: "1", Status: access.PENDING, CreatedAt: hourAgo},
+			withRule:         expiringRule,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ep := mocks.NewMockEventPutter(ctrl)
+			if tc.wantEvent != nil {
+				ep.EXPECT().Put(gomock.Any(), tc.wantEvent).Return(nil).Times(1)
+			}
+
+			c := ddbmock.New(t)
+			c.MockQuery(&storage.ListRequestsForStatus{Result: []access.Request{tc.withRequest}})
+			c.MockQuery(&storage.GetAccessRuleVersion{Result: &tc.withRule})
+			c.MockQuery(&storage.ListRequestReviewers{Result: tc.withReviewers})
+			var db ddb.Storage = c
+			if tc.withConditionFailed {
+				db, _ = newConditionalDB(t, c, true)
+			}
+
+			s := Service{
+				Clock:       clk,
+				DB:          db,
+				EventPutter: ep,
+			}
+			got, err := s.SweepPendingRequests(context.Background(), SweepPendingRequestsOpts{ReminderInterval: tc.reminderInterval})
+			assert.NoError(t, err)
+			assert.Equal(t, &SweepPendingRequestsResult{Reminded: tc.wantReminded, Expired: tc.wantExpired}, got)
+		})
+	}
+}
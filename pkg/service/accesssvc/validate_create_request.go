@@ -45,6 +45,16 @@ func (s *Service) validateCreateRequests(ctx context.Context, in CreateRequestsO
 		return nil, err
 	}
 
+	if in.Create.BreakGlass {
+		if !rule.BreakGlass {
+			return nil, apio.NewRequestError(ErrBreakGlassNotEnabled, http.StatusBadRequest)
+		}
+		// break-glass requests skip approval, so a reason is required for the audit trail.
+		if in.Create.Reason == nil || *in.Create.Reason == "" {
+			return nil, apio.NewRequestError(ErrBreakGlassReasonRequired, http.StatusBadRequest)
+		}
+	}
+
 	requestArguments, err := s.Rules.RequestArguments(ctx, rule.Target)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,11 @@
+package bulkrevokesvc
+
+import "errors"
+
+var (
+	// ErrNoFilter is returned when a bulk revocation doesn't filter the grants to revoke,
+	// so that every grant in the deployment can't be revoked by mistake.
+	ErrNoFilter = errors.New("at least one of user, access rule, target group or provider must be provided")
+	// ErrBulkRevocationNotFound is returned when a bulk revocation doesn't exist.
+	ErrBulkRevocationNotFound = errors.New("bulk revocation not found")
+)
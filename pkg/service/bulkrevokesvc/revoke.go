@@ -0,0 +1,477 @@
+package bulkrevokesvc
+
+import (
+	"context"
+	"time"
+
+	"github.com/common-fate/apikit/logger"
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"golang.org/x/sync/errgroup"
+)
+
+// Filter selects the grants to revoke. Grants must match every field which is set.
+type Filter struct {
+	UserID       string
+	AccessRuleID string
+	// TargetGroupID matches grants for access rules which use the target group.
+	TargetGroupID string
+	// ProviderID matches grants for access rules which use the Access Handler provider.
+	ProviderID string
+}
+
+// IsEmpty is true if the filter would match every grant.
+func (f Filter) IsEmpty() bool {
+	return f.UserID == "" && f.AccessRuleID == "" && f.TargetGroupID == "" && f.ProviderID == ""
+}
+
+// matchesTarget is true if the filter needs the target of the access rule which a request was made for.
+func (f Filter) matchesTarget() bool {
+	return f.TargetGroupID != "" || f.ProviderID != ""
+}
+
+// Matches is true if the request has a grant which matches the filter.
+// target is the target of the access rule version which the request was made for.
+func (f Filter) Matches(req access.Request, target rule.Target) bool {
+	if req.Grant == nil {
+		return false
+	}
+	if f.UserID != "" && req.RequestedBy != f.UserID {
+		return false
+	}
+	if f.AccessRuleID != "" && req.Rule != f.AccessRuleID {
+		return false
+	}
+	if f.TargetGroupID != "" && target.TargetGroupID != f.TargetGroupID {
+		return false
+	}
+	// access rules for target groups also use the target group ID as their provider ID, so they are excluded.
+	if f.ProviderID != "" && (target.IsForTargetGroup() || target.ProviderID != f.ProviderID) {
+		return false
+	}
+	return true
+}
+
+func (f Filter) toMap() map[string]string {
+	m := make(map[string]string)
+	if f.UserID != "" {
+		m["userId"] = f.UserID
+	}
+	if f.AccessRuleID != "" {
+		m["accessRuleId"] = f.AccessRuleID
+	}
+	if f.TargetGroupID != "" {
+		m["targetGroupId"] = f.TargetGroupID
+	}
+	if f.ProviderID != "" {
+		m["providerId"] = f.ProviderID
+	}
+	return m
+}
+
+type RevokeOpts struct {
+	Filter Filter
+	// DryRun lists the grants which would be revoked without revoking them.
+	DryRun       bool
+	Reason       string
+	RevokerID    string
+	RevokerEmail string
+}
+
+type RevocationStatus string
+
+const (
+	RevocationPending   RevocationStatus = "PENDING"
+	RevocationSucceeded RevocationStatus = "REVOKED"
+	RevocationFailed    RevocationStatus = "FAILED"
+	// RevocationDryRun is the status of grants which would be revoked, when the bulk revocation is a dry run.
+	RevocationDryRun RevocationStatus = "DRY_RUN"
+)
+
+// Revocation is the outcome of revoking the grant for a single request.
+type Revocation struct {
+	RequestID    string
+	UserID       string
+	AccessRuleID string
+	Provider     string
+	Status       RevocationStatus
+	// Error is set if the grant couldn't be revoked.
+	// Failed revocations are retried by the grant retry queue.
+	Error string
+}
+
+func revocationFromGrant(g access.BulkRevocationGrant) Revocation {
+	return Revocation{
+		RequestID:    g.RequestID,
+		UserID:       g.UserID,
+		AccessRuleID: g.AccessRuleID,
+		Provider:     g.Provider,
+		Status:       RevocationStatus(g.Status),
+		Error:        g.Error,
+	}
+}
+
+type RevokeResult struct {
+	ID     string
+	DryRun bool
+	// Status is empty for dry runs, as they aren't saved.
+	Status      access.BulkRevocationStatus
+	CompletedAt *time.Time
+	Revocations []Revocation
+}
+
+// Revoke queues every active or pending grant which matches the filter to be revoked.
+//
+// The bulk revocation is saved along with a pending revocation for each grant, and a BulkRevokeRequested event
+// is emitted for the event handler to revoke the grants with Process. The result can be polled with Get.
+func (s *Service) Revoke(ctx context.Context, opts RevokeOpts) (*RevokeResult, error) {
+	if opts.Filter.IsEmpty() {
+		return nil, ErrNoFilter
+	}
+	requests, err := s.listRevocableRequests(ctx, opts.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.Clock.Now()
+	res := RevokeResult{
+		ID:          types.NewBulkRevocationID(),
+		DryRun:      opts.DryRun,
+		Revocations: make([]Revocation, len(requests)),
+	}
+	if opts.DryRun {
+		for i, req := range requests {
+			res.Revocations[i] = Revocation{
+				RequestID:    req.ID,
+				UserID:       req.RequestedBy,
+				AccessRuleID: req.Rule,
+				Provider:     req.Grant.Provider,
+				Status:       RevocationDryRun,
+			}
+		}
+		return &res, nil
+	}
+
+	bulk := access.BulkRevocation{
+		ID:         res.ID,
+		Actor:      opts.RevokerID,
+		ActorEmail: opts.RevokerEmail,
+		Reason:     opts.Reason,
+		Filter:     opts.Filter.toMap(),
+		Status:     access.BulkRevocationPending,
+		Count:      len(requests),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	// there is nothing to queue if no grants match.
+	if len(requests) == 0 {
+		bulk.Status = access.BulkRevocationCompleted
+		bulk.CompletedAt = &now
+	}
+	items := []ddb.Keyer{&bulk}
+	for i, req := range requests {
+		g := access.BulkRevocationGrant{
+			BulkRevocationID: bulk.ID,
+			RequestID:        req.ID,
+			UserID:           req.RequestedBy,
+			AccessRuleID:     req.Rule,
+			Provider:         req.Grant.Provider,
+			Status:           access.BulkRevocationGrantPending,
+			UpdatedAt:        now,
+		}
+		items = append(items, &g)
+		res.Revocations[i] = revocationFromGrant(g)
+	}
+	res.Status = bulk.Status
+	res.CompletedAt = bulk.CompletedAt
+	err = s.DB.PutBatch(ctx, items...)
+	if err != nil {
+		return nil, err
+	}
+	if len(requests) == 0 {
+		return &res, nil
+	}
+
+	logger.Get(ctx).Infow("queued grants to be revoked", "bulkRevocation.id", bulk.ID, "count", len(requests), "filter", opts.Filter)
+	err = s.EventPutter.Put(ctx, gevent.BulkRevokeRequested{ID: bulk.ID})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// Process revokes the grants which were queued by a bulk revocation. It is called by the event handler.
+//
+// Grants are revoked concurrently, and a failure to revoke one grant doesn't stop the others from being revoked.
+// The outcome for each grant is saved once it has been attempted, so if processing is interrupted and retried,
+// only the grants which haven't been attempted are revoked. Once every grant has been attempted, the bulk revocation
+// is completed and a BulkRevokeCompleted event is emitted listing each revocation.
+//
+// The bulk revocation is only saved while it isn't completed, so if processing is run more than once concurrently,
+// the BulkRevokeCompleted event is only emitted by the run which completed it.
+func (s *Service) Process(ctx context.Context, bulkRevocationID string) error {
+	bulk, err := s.getBulkRevocation(ctx, bulkRevocationID)
+	if err != nil {
+		return err
+	}
+	if bulk.Status == access.BulkRevocationCompleted {
+		return nil
+	}
+	grants, err := s.listGrants(ctx, bulk.ID)
+	if err != nil {
+		return err
+	}
+	bulk.Status = access.BulkRevocationInProgress
+	bulk.UpdatedAt = s.Clock.Now()
+	err = s.putIfNotCompleted(ctx, bulk)
+	if err == dbupdate.ErrConditionFailed {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	log := logger.Get(ctx).With("bulkRevocation.id", bulk.ID)
+	log.Infow("revoking grants", "count", len(grants))
+
+	concurrency := s.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	var g errgroup.Group
+	g.SetLimit(concurrency)
+	for i := range grants {
+		if grants[i].Status != access.BulkRevocationGrantPending {
+			continue
+		}
+		// each goroutine only writes to its own grant, so no lock is needed.
+		grant := &grants[i]
+		g.Go(func() error {
+			return s.revokeGrant(ctx, *bulk, grant)
+		})
+	}
+	err = g.Wait()
+	if err != nil {
+		return err
+	}
+
+	now := s.Clock.Now()
+	bulk.Status = access.BulkRevocationCompleted
+	bulk.UpdatedAt = now
+	bulk.CompletedAt = &now
+	err = s.putIfNotCompleted(ctx, bulk)
+	if err == dbupdate.ErrConditionFailed {
+		log.Infow("bulk revocation was already completed by another attempt")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	evt := gevent.BulkRevokeCompleted{
+		ID:          bulk.ID,
+		Actor:       bulk.Actor,
+		ActorEmail:  bulk.ActorEmail,
+		Reason:      bulk.Reason,
+		Filter:      bulk.Filter,
+		Revoked:     []string{},
+		Failed:      []string{},
+		CompletedAt: now,
+	}
+	for _, r := range grants {
+		if r.Status == access.BulkRevocationGrantRevoked {
+			evt.Revoked = append(evt.Revoked, r.RequestID)
+		} else {
+			evt.Failed = append(evt.Failed, r.RequestID)
+		}
+	}
+	return s.EventPutter.Put(ctx, &evt)
+}
+
+// putIfNotCompleted saves the bulk revocation, returning dbupdate.ErrConditionFailed if it has already been completed.
+func (s *Service) putIfNotCompleted(ctx context.Context, bulk *access.BulkRevocation) error {
+	return dbupdate.PutIf(ctx, s.DB, bulk, dbupdate.Condition{
+		Expression: "#status <> :completed",
+		Names:      map[string]string{"#status": "status"},
+		Values:     map[string]any{":completed": access.BulkRevocationCompleted},
+	})
+}
+
+// revokeGrant revokes the grant for a single request, saving the outcome.
+// Only errors saving the outcome are returned, so that the grant is attempted again when processing is retried.
+//
+// Grants which are no longer active are treated as already revoked, as they may have been revoked by an earlier attempt.
+// The outcome is only saved if the grant is still pending, as processing may be run more than once concurrently,
+// in which case the outcome and audit log event are saved by whichever run attempted the grant first.
+func (s *Service) revokeGrant(ctx context.Context, bulk access.BulkRevocation, grant *access.BulkRevocationGrant) error {
+	log := logger.Get(ctx).With("bulkRevocation.id", bulk.ID, "request.id", grant.RequestID)
+	q := storage.GetRequest{ID: grant.RequestID}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil {
+		return err
+	}
+	_, err = s.Workflow.Revoke(ctx, *q.Result, bulk.Actor, bulk.ActorEmail)
+	now := s.Clock.Now()
+	grant.UpdatedAt = now
+	var requestEvent *access.RequestEvent
+	switch err {
+	case nil:
+		grant.Status = access.BulkRevocationGrantRevoked
+		// links the revocation in the audit log of the request to the bulk revocation.
+		e := access.NewBulkRevokeEvent(grant.RequestID, now, bulk.Actor, bulk.ID)
+		requestEvent = &e
+	case workflowsvc.ErrGrantInactive, workflowsvc.ErrNoGrant:
+		log.Infow("grant is no longer active, treating it as already revoked", "error", err)
+		grant.Status = access.BulkRevocationGrantRevoked
+	default:
+		log.Errorw("failed to revoke grant", "error", err)
+		grant.Status = access.BulkRevocationGrantFailed
+		grant.Error = err.Error()
+	}
+
+	err = dbupdate.PutIf(ctx, s.DB, grant, dbupdate.Condition{
+		Expression: "#status = :pending",
+		Names:      map[string]string{"#status": "status"},
+		Values:     map[string]any{":pending": access.BulkRevocationGrantPending},
+	})
+	if err == dbupdate.ErrConditionFailed {
+		log.Infow("outcome of revoking grant was already saved by another attempt")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if requestEvent == nil {
+		return nil
+	}
+	return s.DB.Put(ctx, requestEvent)
+}
+
+// Get returns a bulk revocation along with the outcome of revoking each of its grants.
+func (s *Service) Get(ctx context.Context, bulkRevocationID string) (*RevokeResult, error) {
+	bulk, err := s.getBulkRevocation(ctx, bulkRevocationID)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := s.listGrants(ctx, bulk.ID)
+	if err != nil {
+		return nil, err
+	}
+	res := RevokeResult{
+		ID:          bulk.ID,
+		Status:      bulk.Status,
+		CompletedAt: bulk.CompletedAt,
+		Revocations: make([]Revocation, len(grants)),
+	}
+	for i, g := range grants {
+		res.Revocations[i] = revocationFromGrant(g)
+	}
+	return &res, nil
+}
+
+func (s *Service) getBulkRevocation(ctx context.Context, id string) (*access.BulkRevocation, error) {
+	q := storage.GetBulkRevocation{ID: id}
+	_, err := s.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		return nil, ErrBulkRevocationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return q.Result, nil
+}
+
+func (s *Service) listGrants(ctx context.Context, bulkRevocationID string) ([]access.BulkRevocationGrant, error) {
+	var grants []access.BulkRevocationGrant
+	hasMore := true
+	var next string
+	for hasMore {
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		q := storage.ListBulkRevocationGrants{BulkRevocationID: bulkRevocationID}
+		qr, err := s.DB.Query(ctx, &q, opts...)
+		if err == ddb.ErrNoItems {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		grants = append(grants, q.Result...)
+		next = qr.NextPage
+		hasMore = next != ""
+	}
+	return grants, nil
+}
+
+// listRevocableRequests lists the approved requests with an active or pending grant which matches the filter.
+func (s *Service) listRevocableRequests(ctx context.Context, filter Filter) ([]access.Request, error) {
+	now := s.Clock.Now()
+	// the targets of the access rule versions which requests were made for, which are only looked up if the filter needs them.
+	targets := make(map[string]rule.Target)
+	var requests []access.Request
+	hasMore := true
+	var next string
+	for hasMore {
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		var result []access.Request
+		var qr *ddb.QueryResult
+		var err error
+		if filter.UserID != "" {
+			q := storage.ListRequestsForUserAndStatus{UserId: filter.UserID, Status: access.APPROVED}
+			qr, err = s.DB.Query(ctx, &q, opts...)
+			result = q.Result
+		} else {
+			q := storage.ListRequestsForStatus{Status: access.APPROVED}
+			qr, err = s.DB.Query(ctx, &q, opts...)
+			result = q.Result
+		}
+		if err == ddb.ErrNoItems {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, req := range result {
+			if req.Grant == nil {
+				continue
+			}
+			revocable := req.Grant.Status == ahTypes.GrantStatusACTIVE || req.Grant.Status == ahTypes.GrantStatusPENDING
+			if !revocable || !req.Grant.End.After(now) {
+				continue
+			}
+			var target rule.Target
+			if filter.matchesTarget() {
+				key := req.Rule + "#" + req.RuleVersion
+				var ok bool
+				target, ok = targets[key]
+				if !ok {
+					rq := storage.GetAccessRuleVersion{ID: req.Rule, VersionID: req.RuleVersion}
+					_, err = s.DB.Query(ctx, &rq)
+					if err != nil {
+						return nil, err
+					}
+					target = rq.Result.Target
+					targets[key] = target
+				}
+			}
+			if filter.Matches(req, target) {
+				requests = append(requests, req)
+			}
+		}
+		next = qr.NextPage
+		hasMore = next != ""
+	}
+	return requests, nil
+}
@@ -0,0 +1,327 @@
+package bulkrevokesvc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/service/bulkrevokesvc/mocks"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// testDB looks up requests and access rules by their ID, and records the items which are saved.
+type testDB struct {
+	ddb.Storage
+	requests map[string]access.Request
+	rules    map[string]rule.AccessRule
+	saved    []ddb.Keyer
+}
+
+func (d *testDB) Query(ctx context.Context, qb ddb.QueryBuilder, opts ...func(*ddb.QueryOpts)) (*ddb.QueryResult, error) {
+	switch q := qb.(type) {
+	case *storage.GetRequest:
+		req, ok := d.requests[q.ID]
+		if !ok {
+			return nil, ddb.ErrNoItems
+		}
+		q.Result = &req
+		return &ddb.QueryResult{}, nil
+	case *storage.GetAccessRuleVersion:
+		r, ok := d.rules[q.ID]
+		if !ok {
+			return nil, ddb.ErrNoItems
+		}
+		q.Result = &r
+		return &ddb.QueryResult{}, nil
+	}
+	return d.Storage.Query(ctx, qb, opts...)
+}
+
+func (d *testDB) Put(ctx context.Context, item ddb.Keyer) error {
+	return d.PutBatch(ctx, item)
+}
+
+func (d *testDB) PutBatch(ctx context.Context, items ...ddb.Keyer) error {
+	d.saved = append(d.saved, items...)
+	return nil
+}
+
+func TestRevoke(t *testing.T) {
+	type testcase struct {
+		name         string
+		give         RevokeOpts
+		withRequests []access.Request
+		wantStatuses map[string]RevocationStatus
+		wantStatus   access.BulkRevocationStatus
+		wantQueued   bool
+		wantErr      error
+	}
+
+	clk := clock.NewMock()
+	now := clk.Now()
+	grant := func(provider string, status ahTypes.GrantStatus, end time.Time) *access.Grant {
+		return &access.Grant{Provider: provider, Status: status, End: end}
+	}
+	requests := []access.Request{
+		{ID: "req_1", RequestedBy: "usr_1", Rule: "rul_1", Grant: grant("tg_1", ahTypes.GrantStatusACTIVE, now.Add(time.Hour))},
+		{ID: "req_2", RequestedBy: "usr_1", Rule: "rul_2", Grant: grant("okta", ahTypes.GrantStatusPENDING, now.Add(time.Hour))},
+		{ID: "req_3", RequestedBy: "usr_2", Rule: "rul_1", Grant: grant("tg_1", ahTypes.GrantStatusACTIVE, now.Add(time.Hour))},
+		{ID: "req_4", RequestedBy: "usr_1", Rule: "rul_1", Grant: grant("tg_1", ahTypes.GrantStatusEXPIRED, now.Add(-time.Hour))},
+		{ID: "req_5", RequestedBy: "usr_1", Rule: "rul_1"},
+	}
+	rules := map[string]rule.AccessRule{
+		"rul_1": {ID: "rul_1", Target: rule.Target{ProviderID: "tg_1", TargetGroupID: "tg_1"}},
+		"rul_2": {ID: "rul_2", Target: rule.Target{ProviderID: "okta"}},
+	}
+
+	testcases := []testcase{
+		{
+			name:         "queues grants for a user",
+			give:         RevokeOpts{Filter: Filter{UserID: "usr_1"}, RevokerID: "usr_admin", Reason: "incident"},
+			withRequests: requests,
+			wantStatuses: map[string]RevocationStatus{"req_1": RevocationPending, "req_2": RevocationPending},
+			wantStatus:   access.BulkRevocationPending,
+			wantQueued:   true,
+		},
+		{
+			name:         "target group",
+			give:         RevokeOpts{Filter: Filter{TargetGroupID: "tg_1"}, RevokerID: "usr_admin"},
+			withRequests: requests,
+			wantStatuses: map[string]RevocationStatus{"req_1": RevocationPending, "req_3": RevocationPending},
+			wantStatus:   access.BulkRevocationPending,
+			wantQueued:   true,
+		},
+		{
+			name:         "provider doesn't match target groups",
+			give:         RevokeOpts{Filter: Filter{ProviderID: "tg_1"}, RevokerID: "usr_admin"},
+			withRequests: requests,
+			wantStatuses: map[string]RevocationStatus{},
+			wantStatus:   access.BulkRevocationCompleted,
+		},
+		{
+			name:         "provider",
+			give:         RevokeOpts{Filter: Filter{ProviderID: "okta"}, RevokerID: "usr_admin"},
+			withRequests: requests,
+			wantStatuses: map[string]RevocationStatus{"req_2": RevocationPending},
+			wantStatus:   access.BulkRevocationPending,
+			wantQueued:   true,
+		},
+		{
+			name:         "dry run doesn't queue grants",
+			give:         RevokeOpts{Filter: Filter{AccessRuleID: "rul_1"}, DryRun: true},
+			withRequests: requests,
+			wantStatuses: map[string]RevocationStatus{"req_1": RevocationDryRun, "req_3": RevocationDryRun},
+		},
+		{
+			name:    "a filter is required",
+			give:    RevokeOpts{},
+			wantErr: ErrNoFilter,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			c := ddbmock.New(t)
+			c.MockQuery(&storage.ListRequestsForStatus{Result: tc.withRequests})
+			c.MockQuery(&storage.ListRequestsForUserAndStatus{Result: tc.withRequests})
+			db := &testDB{Storage: c, rules: rules}
+
+			// grants are only revoked when the bulk revocation is processed.
+			wf := mocks.NewMockWorkflow(ctrl)
+			var gotEvent gevent.EventTyper
+			ep := mocks.NewMockEventPutter(ctrl)
+			ep.EXPECT().Put(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, detail gevent.EventTyper) error {
+				gotEvent = detail
+				return nil
+			}).AnyTimes()
+
+			s := Service{
+				Clock:       clk,
+				DB:          db,
+				Workflow:    wf,
+				EventPutter: ep,
+			}
+			got, err := s.Revoke(context.Background(), tc.give)
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			gotStatuses := make(map[string]RevocationStatus)
+			for _, r := range got.Revocations {
+				gotStatuses[r.RequestID] = r.Status
+			}
+			assert.Equal(t, tc.wantStatuses, gotStatuses)
+			assert.Equal(t, tc.wantStatus, got.Status)
+
+			if tc.give.DryRun {
+				assert.Empty(t, db.saved)
+			} else {
+				// the bulk revocation and each of its grants are saved.
+				assert.Len(t, db.saved, len(tc.wantStatuses)+1)
+				assert.Equal(t, got.ID, db.saved[0].(*access.BulkRevocation).ID)
+				assert.Equal(t, tc.give.RevokerID, db.saved[0].(*access.BulkRevocation).Actor)
+			}
+			if tc.wantQueued {
+				assert.Equal(t, gevent.BulkRevokeRequested{ID: got.ID}, gotEvent)
+			} else {
+				assert.Nil(t, gotEvent)
+			}
+		})
+	}
+}
+
+func TestProcess(t *testing.T) {
+	type testcase struct {
+		name       string
+		withBulk   access.BulkRevocation
+		withGrants []access.BulkRevocationGrant
+		// withFailures are the errors returned when revoking requests, by request ID.
+		withFailures map[string]error
+		wantRevoked  []string
+		// wantRequestEvents are the requests which have the revocation linked to the bulk revocation in their audit log.
+		wantRequestEvents []string
+		wantEvent         *gevent.BulkRevokeCompleted
+	}
+
+	clk := clock.NewMock()
+	now := clk.Now()
+	requests := map[string]access.Request{
+		"req_1": {ID: "req_1", Grant: &access.Grant{Status: ahTypes.GrantStatusACTIVE, End: now.Add(time.Hour)}},
+		"req_2": {ID: "req_2", Grant: &access.Grant{Status: ahTypes.GrantStatusACTIVE, End: now.Add(time.Hour)}},
+		"req_3": {ID: "req_3", Grant: &access.Grant{Status: ahTypes.GrantStatusREVOKED, End: now.Add(time.Hour)}},
+	}
+	bulk := access.BulkRevocation{ID: "brv_1", Actor: "usr_admin", Reason: "incident", Filter: map[string]string{"userId": "usr_1"}, Status: access.BulkRevocationPending}
+	pending := func(requestID string) access.BulkRevocationGrant {
+		return access.BulkRevocationGrant{BulkRevocationID: "brv_1", RequestID: requestID, Status: access.BulkRevocationGrantPending}
+	}
+
+	testcases := []testcase{
+		{
+			name:              "revokes pending grants",
+			withBulk:          bulk,
+			withGrants:        []access.BulkRevocationGrant{pending("req_1"), pending("req_2")},
+			withFailures:      map[string]error{"req_2": errors.New("provider unavailable")},
+			wantRevoked:       []string{"req_1", "req_2"},
+			wantRequestEvents: []string{"req_1"},
+			wantEvent: &gevent.BulkRevokeCompleted{
+				ID:          "brv_1",
+				Actor:       "usr_admin",
+				Reason:      "incident",
+				Filter:      map[string]string{"userId": "usr_1"},
+				Revoked:     []string{"req_1"},
+				Failed:      []string{"req_2"},
+				CompletedAt: now,
+			},
+		},
+		{
+			name:     "grants which were already attempted are skipped when processing is retried",
+			withBulk: access.BulkRevocation{ID: "brv_1", Actor: "usr_admin", Reason: "incident", Filter: map[string]string{"userId": "usr_1"}, Status: access.BulkRevocationInProgress},
+			withGrants: []access.BulkRevocationGrant{
+				{BulkRevocationID: "brv_1", RequestID: "req_3", Status: access.BulkRevocationGrantRevoked},
+				pending("req_1"),
+			},
+			wantRevoked:       []string{"req_1"},
+			wantRequestEvents: []string{"req_1"},
+			wantEvent: &gevent.BulkRevokeCompleted{
+				ID:          "brv_1",
+				Actor:       "usr_admin",
+				Reason:      "incident",
+				Filter:      map[string]string{"userId": "usr_1"},
+				Revoked:     []string{"req_3", "req_1"},
+				Failed:      []string{},
+				CompletedAt: now,
+			},
+		},
+		{
+			name:         "grants which are no longer active are treated as already revoked",
+			withBulk:     bulk,
+			withGrants:   []access.BulkRevocationGrant{pending("req_1"), pending("req_3")},
+			withFailures: map[string]error{"req_3": workflowsvc.ErrGrantInactive},
+			wantRevoked:  []string{"req_1", "req_3"},
+			// the grant which was already revoked isn't linked to the bulk revocation in its audit log.
+			wantRequestEvents: []string{"req_1"},
+			wantEvent: &gevent.BulkRevokeCompleted{
+				ID:          "brv_1",
+				Actor:       "usr_admin",
+				Reason:      "incident",
+				Filter:      map[string]string{"userId": "usr_1"},
+				Revoked:     []string{"req_1", "req_3"},
+				Failed:      []string{},
+				CompletedAt: now,
+			},
+		},
+		{
+			name:       "completed bulk revocations aren't processed again",
+			withBulk:   access.BulkRevocation{ID: "brv_1", Status: access.BulkRevocationCompleted},
+			withGrants: []access.BulkRevocationGrant{pending("req_1")},
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			c := ddbmock.New(t)
+			c.MockQuery(&storage.GetBulkRevocation{Result: &tc.withBulk})
+			c.MockQuery(&storage.ListBulkRevocationGrants{Result: tc.withGrants})
+			db := &testDB{Storage: c, requests: requests}
+
+			var gotRevoked []string
+			wf := mocks.NewMockWorkflow(ctrl)
+			wf.EXPECT().Revoke(gomock.Any(), gomock.Any(), "usr_admin", "").DoAndReturn(func(ctx context.Context, request access.Request, revokerID string, revokerEmail string) (*access.Request, error) {
+				gotRevoked = append(gotRevoked, request.ID)
+				return nil, tc.withFailures[request.ID]
+			}).Times(len(tc.wantRevoked))
+
+			var gotEvent *gevent.BulkRevokeCompleted
+			ep := mocks.NewMockEventPutter(ctrl)
+			ep.EXPECT().Put(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, detail gevent.EventTyper) error {
+				gotEvent = detail.(*gevent.BulkRevokeCompleted)
+				return nil
+			}).AnyTimes()
+
+			s := Service{
+				Clock:       clk,
+				DB:          db,
+				Workflow:    wf,
+				EventPutter: ep,
+				// revoke one grant at a time so that the order of the event is stable.
+				Concurrency: 1,
+			}
+			err := s.Process(context.Background(), "brv_1")
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantRevoked, gotRevoked)
+			assert.Equal(t, tc.wantEvent, gotEvent)
+
+			if tc.wantEvent == nil {
+				assert.Empty(t, db.saved)
+				return
+			}
+			var gotRequestEvents []string
+			for _, item := range db.saved {
+				if e, ok := item.(*access.RequestEvent); ok {
+					assert.Equal(t, "brv_1", *e.BulkRevocationID)
+					gotRequestEvents = append(gotRequestEvents, e.RequestID)
+				}
+			}
+			assert.Equal(t, tc.wantRequestEvents, gotRequestEvents)
+			last := db.saved[len(db.saved)-1].(*access.BulkRevocation)
+			assert.Equal(t, access.BulkRevocationCompleted, last.Status)
+		})
+	}
+}
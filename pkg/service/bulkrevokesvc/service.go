@@ -0,0 +1,37 @@
+package bulkrevokesvc
+
+import (
+	"context"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/ddb"
+)
+
+// DefaultConcurrency is the number of grants which are revoked at once if Concurrency isn't set.
+const DefaultConcurrency = 10
+
+// Service revokes every grant matching a filter at once, such as all of the grants for a user during a security incident.
+// Bulk revocations are queued by the API and processed by the event handler.
+type Service struct {
+	Clock       clock.Clock
+	DB          ddb.Storage
+	Workflow    Workflow
+	EventPutter EventPutter
+	// Concurrency is the maximum number of grants which are revoked at once.
+	// Providers may rate limit requests, so this is kept low by default.
+	Concurrency int
+}
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/workflow.go -package=mocks . Workflow
+
+// Workflow revokes grants. Failed revocations are recorded as grant retries by the workflow.
+type Workflow interface {
+	Revoke(ctx context.Context, request access.Request, revokerID string, revokerEmail string) (*access.Request, error)
+}
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/eventputter.go -package=mocks . EventPutter
+type EventPutter interface {
+	Put(ctx context.Context, detail gevent.EventTyper) error
+}
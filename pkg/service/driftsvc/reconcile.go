@@ -0,0 +1,287 @@
+package driftsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/common-fate/apikit/logger"
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/target"
+	"github.com/common-fate/ddb"
+	"github.com/hashicorp/go-multierror"
+)
+
+type ReconcileResult struct {
+	// Detected is drift which was found for the first time.
+	Detected []access.Drift
+	// Resolved is drift which was found previously and no longer exists.
+	Resolved []access.Drift
+	// Unchecked is the number of active grants which couldn't be checked,
+	// because they were made through a target group, their provider doesn't support it or the check failed.
+	Unchecked int
+}
+
+// Reconcile checks that the access for each active grant still exists in its provider,
+// and looks for access to the targets of active grants which no active grant explains.
+//
+// Drift is reported with a GrantDrift event the first time it's detected.
+// It is intended to be run on a schedule.
+//
+// Grants made through target groups aren't checked, as the handler protocol has no way to check
+// whether a grant's access exists or to list who has access to a target. They are counted as unchecked.
+func (s *Service) Reconcile(ctx context.Context) (*ReconcileResult, error) {
+	log := logger.Get(ctx)
+	requests, err := s.listApprovedRequests(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.listDrifts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.Clock.Now()
+	var res ReconcileResult
+	var found []access.Drift
+	targetGroups := make(map[string]*target.Group)
+	// providerTargets are the targets of active access handler provider grants,
+	// which are checked for access that wasn't granted by Common Fate.
+	providerTargets := make(map[string]access.Grant)
+	activeRequests := make(map[string]bool)
+	checkedRequests := make(map[string]bool)
+	activeSubjects := make(map[string]bool)
+	// latestRequests are the most recent request for each target and subject,
+	// so that unexpected access can be related to the request which last granted it.
+	latestRequests := make(map[string]string)
+
+	for _, req := range requests {
+		if req.Grant == nil {
+			continue
+		}
+		grant := *req.Grant
+		key := access.TargetKey(grant.Provider, grant.With.AdditionalProperties)
+		subjectKey := key + "#" + strings.ToLower(grant.Subject)
+		// requests are listed newest first.
+		if _, ok := latestRequests[subjectKey]; !ok {
+			latestRequests[subjectKey] = req.ID
+		}
+		// only grants which are currently active are checked, so that providers aren't called for every grant ever made.
+		if grant.Status != ahTypes.GrantStatusACTIVE || !grant.End.After(now) {
+			continue
+		}
+		activeRequests[req.ID] = true
+		activeSubjects[subjectKey] = true
+
+		tg, err := s.getTargetGroup(ctx, targetGroups, grant.Provider)
+		if err != nil {
+			return nil, err
+		}
+		if tg != nil {
+			res.Unchecked++
+			continue
+		}
+		providerTargets[key] = grant
+
+		active, supported, err := s.checkGrant(ctx, req.ID, grant)
+		if err != nil {
+			log.Warnw("failed to check whether grant is active", "request.id", req.ID, "error", err)
+			res.Unchecked++
+			continue
+		}
+		if !supported {
+			res.Unchecked++
+			continue
+		}
+		checkedRequests[req.ID] = true
+		if !active {
+			requestID := req.ID
+			found = append(found, access.NewDrift(access.DriftMissing, grant.Provider, grant.With.AdditionalProperties, grant.Subject, &requestID, now))
+		}
+	}
+
+	listedTargets := make(map[string]bool)
+	for key, grant := range providerTargets {
+		subjects, supported, err := s.listAssignments(ctx, grant)
+		if err != nil {
+			log.Warnw("failed to list provider assignments", "provider", grant.Provider, "with", grant.With.AdditionalProperties, "error", err)
+			continue
+		}
+		if !supported {
+			continue
+		}
+		listedTargets[key] = true
+		for _, subject := range subjects {
+			subjectKey := key + "#" + strings.ToLower(subject)
+			if activeSubjects[subjectKey] {
+				continue
+			}
+			var requestID *string
+			if id, ok := latestRequests[subjectKey]; ok {
+				requestID = &id
+			}
+			found = append(found, access.NewDrift(access.DriftUnexpected, grant.Provider, grant.With.AdditionalProperties, subject, requestID, now))
+		}
+	}
+
+	var result *multierror.Error
+	previous := make(map[string]bool)
+	for _, d := range existing {
+		previous[d.ID] = true
+	}
+	current := make(map[string]bool)
+	for i := range found {
+		d := found[i]
+		current[d.ID] = true
+		if previous[d.ID] {
+			continue
+		}
+		// the drift is saved after the event is sent, so that it's reported again next time if sending fails.
+		err = s.EventPutter.Put(ctx, gevent.GrantDrift{Drift: d})
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+		err = s.DB.Put(ctx, &d)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+		res.Detected = append(res.Detected, d)
+	}
+
+	var resolved []ddb.Keyer
+	for i := range existing {
+		d := existing[i]
+		if current[d.ID] {
+			continue
+		}
+		// drift is only resolved if the access it relates to was checked successfully.
+		switch d.Kind {
+		case access.DriftMissing:
+			if d.RequestID != nil && activeRequests[*d.RequestID] && !checkedRequests[*d.RequestID] {
+				continue
+			}
+		case access.DriftUnexpected:
+			if !listedTargets[access.TargetKey(d.Provider, d.With)] {
+				continue
+			}
+		}
+		res.Resolved = append(res.Resolved, d)
+		resolved = append(resolved, &d)
+	}
+	if len(resolved) > 0 {
+		err = s.DB.DeleteBatch(ctx, resolved...)
+		if err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+	return &res, result.ErrorOrNil()
+}
+
+// listApprovedRequests lists all approved requests, newest first.
+func (s *Service) listApprovedRequests(ctx context.Context) ([]access.Request, error) {
+	var requests []access.Request
+	hasMore := true
+	var next string
+	for hasMore {
+		q := storage.ListRequestsForStatus{Status: access.APPROVED}
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		qr, err := s.DB.Query(ctx, &q, opts...)
+		if err == ddb.ErrNoItems {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, q.Result...)
+		next = qr.NextPage
+		hasMore = next != ""
+	}
+	return requests, nil
+}
+
+// listDrifts lists every page of drift which has previously been detected.
+func (s *Service) listDrifts(ctx context.Context) ([]access.Drift, error) {
+	var drifts []access.Drift
+	hasMore := true
+	var next string
+	for hasMore {
+		q := storage.ListDrifts{}
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		qr, err := s.DB.Query(ctx, &q, opts...)
+		if err == ddb.ErrNoItems {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		drifts = append(drifts, q.Result...)
+		next = qr.NextPage
+		hasMore = next != ""
+	}
+	return drifts, nil
+}
+
+// getTargetGroup returns the target group with the given ID, or nil if the provider is an access handler provider.
+func (s *Service) getTargetGroup(ctx context.Context, cache map[string]*target.Group, id string) (*target.Group, error) {
+	if tg, ok := cache[id]; ok {
+		return tg, nil
+	}
+	q := storage.GetTargetGroup{ID: id}
+	_, err := s.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		cache[id] = nil
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	cache[id] = q.Result
+	return q.Result, nil
+}
+
+// checkGrant checks whether the access for a grant exists using its access handler provider.
+// supported is false if the grant can't be checked.
+func (s *Service) checkGrant(ctx context.Context, requestID string, grant access.Grant) (active bool, supported bool, err error) {
+	res, err := s.AHClient.CheckGrantWithResponse(ctx, ahTypes.CheckGrantJSONRequestBody(grant.ToAHGrant(requestID)))
+	if err != nil {
+		return false, false, err
+	}
+	if res.JSON200 != nil {
+		return res.JSON200.Active, res.JSON200.Supported, nil
+	}
+	if res.JSON400 != nil && res.JSON400.Error != nil {
+		return false, false, errors.New(*res.JSON400.Error)
+	}
+	return false, false, fmt.Errorf("unhandled response code %d from access handler when checking grant", res.StatusCode())
+}
+
+// listAssignments lists the subjects with access to the target of an access handler provider grant.
+// supported is false if the provider can't list assignments.
+func (s *Service) listAssignments(ctx context.Context, grant access.Grant) (subjects []string, supported bool, err error) {
+	body := ahTypes.ListProviderAssignmentsJSONRequestBody{
+		With: ahTypes.ListProviderAssignmentsJSONBody_With{AdditionalProperties: grant.With.AdditionalProperties},
+	}
+	res, err := s.AHClient.ListProviderAssignmentsWithResponse(ctx, grant.Provider, body)
+	if err != nil {
+		return nil, false, err
+	}
+	if res.JSON200 != nil {
+		return res.JSON200.Subjects, res.JSON200.Supported, nil
+	}
+	if res.JSON404 != nil && res.JSON404.Error != nil {
+		return nil, false, errors.New(*res.JSON404.Error)
+	}
+	return nil, false, fmt.Errorf("unhandled response code %d from access handler when listing assignments", res.StatusCode())
+}
@@ -0,0 +1,175 @@
+package driftsvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/accesshandler/pkg/types/ahmocks"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/service/driftsvc/mocks"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/target"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconcile(t *testing.T) {
+	type check struct {
+		active    bool
+		supported bool
+	}
+	type testcase struct {
+		name            string
+		withRequests    []access.Request
+		withDrifts      []access.Drift
+		withTargetGroup *target.Group
+		withCheck       *check
+		// withAssignments are the subjects with access to the target. Assignments aren't listed if it is nil.
+		withAssignments []string
+		wantDetected    []access.Drift
+		wantResolved    []access.Drift
+		wantUnchecked   int
+	}
+
+	clk := clock.NewMock()
+	now := clk.Now()
+	requestID := "req_1"
+	with := map[string]string{"groupId": "admins"}
+
+	grant := func(subject string, status ahTypes.GrantStatus) *access.Grant {
+		return &access.Grant{Provider: "okta", Subject: subject, With: ahTypes.Grant_With{AdditionalProperties: with}, Status: status, End: now.Add(time.Hour)}
+	}
+	active := access.Request{ID: requestID, Status: access.APPROVED, Grant: grant("alice@example.com", ahTypes.GrantStatusACTIVE)}
+	expired := access.Request{ID: requestID, Status: access.APPROVED, Grant: grant("alice@example.com", ahTypes.GrantStatusEXPIRED)}
+	// bob's grant is active, so that the target is checked for unexpected access.
+	activeBob := access.Request{ID: "req_2", Status: access.APPROVED, Grant: grant("bob@example.com", ahTypes.GrantStatusACTIVE)}
+	ended := access.Request{ID: requestID, Status: access.APPROVED, Grant: grant("alice@example.com", ahTypes.GrantStatusACTIVE)}
+	ended.Grant.End = now.Add(-time.Minute)
+
+	missing := access.NewDrift(access.DriftMissing, "okta", with, "alice@example.com", &requestID, now)
+	unexpected := access.NewDrift(access.DriftUnexpected, "okta", with, "alice@example.com", &requestID, now)
+	untracked := access.NewDrift(access.DriftUnexpected, "okta", with, "bob@example.com", nil, now)
+
+	testcases := []testcase{
+		{
+			name:            "grant is active",
+			withRequests:    []access.Request{active},
+			withCheck:       &check{active: true, supported: true},
+			withAssignments: []string{"alice@example.com"},
+		},
+		{
+			name:            "access was removed from the provider",
+			withRequests:    []access.Request{active},
+			withCheck:       &check{active: false, supported: true},
+			withAssignments: []string{},
+			wantDetected:    []access.Drift{missing},
+		},
+		{
+			name:            "provider doesn't support checking grants",
+			withRequests:    []access.Request{active},
+			withCheck:       &check{supported: false},
+			withAssignments: []string{"alice@example.com"},
+			wantUnchecked:   1,
+		},
+		{
+			name:            "access remains after the grant expired",
+			withRequests:    []access.Request{activeBob, expired},
+			withCheck:       &check{active: true, supported: true},
+			withAssignments: []string{"ALICE@example.com", "bob@example.com"},
+			wantDetected:    []access.Drift{access.NewDrift(access.DriftUnexpected, "okta", with, "ALICE@example.com", &requestID, now)},
+		},
+		{
+			name:            "access which was never requested",
+			withRequests:    []access.Request{active},
+			withCheck:       &check{active: true, supported: true},
+			withAssignments: []string{"alice@example.com", "bob@example.com"},
+			wantDetected:    []access.Drift{untracked},
+		},
+		{
+			name:            "drift is only reported once",
+			withRequests:    []access.Request{active},
+			withDrifts:      []access.Drift{missing},
+			withCheck:       &check{active: false, supported: true},
+			withAssignments: []string{},
+		},
+		{
+			name:            "drift is resolved",
+			withRequests:    []access.Request{active},
+			withDrifts:      []access.Drift{unexpected},
+			withCheck:       &check{active: true, supported: true},
+			withAssignments: []string{"alice@example.com"},
+			wantResolved:    []access.Drift{unexpected},
+		},
+		{
+			name:         "drift isn't resolved if the grant couldn't be checked",
+			withRequests: []access.Request{active},
+			withDrifts:   []access.Drift{missing},
+			withCheck:    &check{supported: false},
+			// the provider can't list assignments either.
+			wantUnchecked: 1,
+		},
+		{
+			name:         "grants which aren't active aren't checked",
+			withRequests: []access.Request{expired},
+		},
+		{
+			name:         "grants which have passed their end time aren't checked",
+			withRequests: []access.Request{ended},
+		},
+		{
+			name:            "target group grants aren't checked",
+			withRequests:    []access.Request{active},
+			withTargetGroup: &target.Group{ID: "okta"},
+			wantUnchecked:   1,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListRequestsForStatus{Result: tc.withRequests})
+			db.MockQuery(&storage.ListDrifts{Result: tc.withDrifts})
+			if tc.withTargetGroup != nil {
+				db.MockQuery(&storage.GetTargetGroup{Result: tc.withTargetGroup})
+			} else {
+				db.MockQueryWithErr(&storage.GetTargetGroup{}, ddb.ErrNoItems)
+			}
+
+			// the access handler isn't called unless expected, so the test fails if grants which aren't active are checked.
+			ah := ahmocks.NewMockClientWithResponsesInterface(ctrl)
+			if tc.withCheck != nil {
+				ah.EXPECT().CheckGrantWithResponse(gomock.Any(), gomock.Any()).Return(&ahTypes.CheckGrantResponse{JSON200: &struct {
+					Active    bool `json:"active"`
+					Supported bool `json:"supported"`
+				}{Active: tc.withCheck.active, Supported: tc.withCheck.supported}}, nil)
+				ah.EXPECT().ListProviderAssignmentsWithResponse(gomock.Any(), "okta", gomock.Any()).Return(&ahTypes.ListProviderAssignmentsResponse{JSON200: &struct {
+					Subjects  []string `json:"subjects"`
+					Supported bool     `json:"supported"`
+				}{Subjects: tc.withAssignments, Supported: tc.withAssignments != nil}}, nil)
+			}
+
+			ep := mocks.NewMockEventPutter(ctrl)
+			for _, d := range tc.wantDetected {
+				ep.EXPECT().Put(gomock.Any(), gevent.GrantDrift{Drift: d}).Return(nil)
+			}
+
+			s := Service{
+				Clock:       clk,
+				DB:          db,
+				AHClient:    ah,
+				EventPutter: ep,
+			}
+			got, err := s.Reconcile(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, &ReconcileResult{Detected: tc.wantDetected, Resolved: tc.wantResolved, Unchecked: tc.wantUnchecked}, got)
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package driftsvc
+
+import (
+	"context"
+
+	"github.com/benbjohnson/clock"
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/ddb"
+)
+
+// Service reconciles the grants made by Common Fate with the access which exists in providers,
+// so that access which is changed outside of Common Fate is detected.
+type Service struct {
+	Clock       clock.Clock
+	DB          ddb.Storage
+	AHClient    AHClient
+	EventPutter EventPutter
+}
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/eventputter.go -package=mocks . EventPutter
+type EventPutter interface {
+	Put(ctx context.Context, detail gevent.EventTyper) error
+}
+
+type AHClient interface {
+	ahTypes.ClientWithResponsesInterface
+}
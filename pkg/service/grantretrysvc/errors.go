@@ -0,0 +1,8 @@
+package grantretrysvc
+
+import "errors"
+
+var (
+	// ErrGrantRetryClosed is returned when attempting to retry or abandon a grant retry which has already succeeded or been abandoned
+	ErrGrantRetryClosed = errors.New("this grant retry has already succeeded or been abandoned")
+)
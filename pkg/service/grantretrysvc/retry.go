@@ -0,0 +1,243 @@
+package grantretrysvc
+
+import (
+	"context"
+
+	"github.com/common-fate/apikit/logger"
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
+	"github.com/common-fate/common-fate/pkg/targetgroupgranter"
+	"github.com/common-fate/ddb"
+	"github.com/hashicorp/go-multierror"
+)
+
+type ProcessDueRetriesResult struct {
+	// Succeeded are retries which were completed.
+	Succeeded []access.GrantRetry
+	// Failed are retries which failed again, and have been rescheduled or marked as exhausted.
+	Failed []access.GrantRetry
+	// Abandoned are retries which no longer need to be attempted, such as because the grant has ended.
+	Abandoned []access.GrantRetry
+}
+
+// ProcessDueRetries attempts each pending grant retry which is due.
+//
+// It is intended to be run on a schedule.
+func (s *Service) ProcessDueRetries(ctx context.Context) (*ProcessDueRetriesResult, error) {
+	due, err := s.listDueRetries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ProcessDueRetriesResult
+	var result *multierror.Error
+	for _, retry := range due {
+		updated, err := s.attempt(ctx, retry)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+		switch updated.Status {
+		case access.GrantRetrySucceeded:
+			res.Succeeded = append(res.Succeeded, *updated)
+		case access.GrantRetryAbandoned:
+			res.Abandoned = append(res.Abandoned, *updated)
+		default:
+			if updated.Attempts > retry.Attempts {
+				res.Failed = append(res.Failed, *updated)
+			}
+		}
+	}
+	return &res, result.ErrorOrNil()
+}
+
+// Retry attempts a grant retry immediately.
+// Retries which have exhausted their automatic attempts can be retried this way.
+func (s *Service) Retry(ctx context.Context, retryID string) (*access.GrantRetry, error) {
+	q := storage.GetGrantRetry{ID: retryID}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil {
+		return nil, err
+	}
+	if !q.Result.IsOpen() {
+		return nil, ErrGrantRetryClosed
+	}
+	return s.attempt(ctx, *q.Result)
+}
+
+// Abandon stops a grant retry from being attempted again.
+// This is used when the change has been made manually, or is no longer needed.
+func (s *Service) Abandon(ctx context.Context, retryID string) (*access.GrantRetry, error) {
+	q := storage.GetGrantRetry{ID: retryID}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil {
+		return nil, err
+	}
+	if !q.Result.IsOpen() {
+		return nil, ErrGrantRetryClosed
+	}
+	return s.close(ctx, *q.Result, access.GrantRetryAbandoned)
+}
+
+// attempt makes the change to the grant again and returns the updated retry.
+// An error is only returned if the retry couldn't be updated, failed attempts are recorded on the returned retry.
+func (s *Service) attempt(ctx context.Context, retry access.GrantRetry) (*access.GrantRetry, error) {
+	log := logger.Get(ctx).With("retry.id", retry.ID, "request.id", retry.RequestID, "action", retry.Action)
+	log.Infow("retrying grant change", "attempts", retry.Attempts)
+
+	q := storage.GetRequest{ID: retry.RequestID}
+	_, err := s.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		log.Infow("abandoning retry because the request no longer exists")
+		return s.close(ctx, retry, access.GrantRetryAbandoned)
+	}
+	if err != nil {
+		return nil, err
+	}
+	req := *q.Result
+
+	switch retry.Action {
+	case access.GrantRetryRevoke:
+		return s.attemptRevoke(ctx, retry, req)
+	case access.GrantRetryActivate, access.GrantRetryDeactivate:
+		return s.attemptTargetGroupGrant(ctx, retry, req)
+	default:
+		log.Warnw("abandoning retry with unknown action")
+		return s.close(ctx, retry, access.GrantRetryAbandoned)
+	}
+}
+
+func (s *Service) attemptRevoke(ctx context.Context, retry access.GrantRetry, req access.Request) (*access.GrantRetry, error) {
+	if req.Grant != nil && req.Grant.Status == ahTypes.GrantStatusREVOKED {
+		// the grant was revoked by another attempt, such as the user revoking it again.
+		return s.close(ctx, retry, access.GrantRetrySucceeded)
+	}
+	var revokerID, revokerEmail string
+	if retry.Actor != nil {
+		revokerID = *retry.Actor
+		uq := storage.GetUser{ID: revokerID}
+		_, err := s.DB.Query(ctx, &uq)
+		if err != nil && err != ddb.ErrNoItems {
+			return nil, err
+		}
+		if err == nil {
+			revokerEmail = uq.Result.Email
+		}
+	}
+	_, err := s.Workflow.Revoke(ctx, req, revokerID, revokerEmail)
+	switch err {
+	case nil:
+		return s.close(ctx, retry, access.GrantRetrySucceeded)
+	case workflowsvc.ErrGrantInactive, workflowsvc.ErrNoGrant:
+		// the grant has ended, so there is nothing left to revoke.
+		return s.close(ctx, retry, access.GrantRetryAbandoned)
+	default:
+		return s.failed(ctx, retry, err)
+	}
+}
+
+func (s *Service) attemptTargetGroupGrant(ctx context.Context, retry access.GrantRetry, req access.Request) (*access.GrantRetry, error) {
+	if retry.Grant == nil {
+		return s.close(ctx, retry, access.GrantRetryAbandoned)
+	}
+	now := s.Clock.Now()
+	if req.Grant != nil && req.Grant.Status == ahTypes.GrantStatusREVOKED {
+		// revoking the grant removes access, so there is nothing left to activate or deactivate.
+		return s.close(ctx, retry, access.GrantRetryAbandoned)
+	}
+	if retry.Action == access.GrantRetryActivate && !retry.Grant.End.After(now) {
+		// the grant would be deactivated straight away, so there is no need to activate it.
+		return s.close(ctx, retry, access.GrantRetryAbandoned)
+	}
+
+	out, err := s.Granter.HandleRequest(ctx, targetgroupgranter.InputEvent{
+		Action: targetgroupgranter.EventType(retry.Action),
+		Grant:  *retry.Grant,
+		State:  retry.State,
+	})
+	if err != nil {
+		return s.failed(ctx, retry, err)
+	}
+
+	if retry.Action == access.GrantRetryDeactivate && out.Extended {
+		// the grant was extended while deactivation was being retried, so it is deactivated at its new end time instead.
+		grant := out.Grant
+		retry.Grant = &grant
+		retry.NextAttemptAt = grant.End.Time
+		retry.UpdatedAt = now
+		err = s.DB.Put(ctx, &retry)
+		if err != nil {
+			return nil, err
+		}
+		return &retry, nil
+	}
+
+	if retry.Action == access.GrantRetryActivate {
+		// the workflow stopped when activation failed, so the grant is deactivated by a retry scheduled for when it ends.
+		deactivate := access.NewGrantRetry(retry.RequestID, access.GrantRetryDeactivate, now)
+		grant := out.Grant
+		deactivate.Grant = &grant
+		deactivate.State = out.State
+		deactivate.NextAttemptAt = grant.End.Time
+		err = s.DB.Put(ctx, &deactivate)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s.close(ctx, retry, access.GrantRetrySucceeded)
+}
+
+// failed returns the retry after a failed attempt.
+// Failures are normally recorded by the workflow or granter making the change,
+// so the failure is only recorded here if the attempt failed before the change was made.
+func (s *Service) failed(ctx context.Context, retry access.GrantRetry, cause error) (*access.GrantRetry, error) {
+	logger.Get(ctx).Warnw("grant retry failed", "retry.id", retry.ID, "error", cause)
+	q := storage.GetGrantRetry{ID: retry.ID}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		return nil, err
+	}
+	if err == nil && q.Result.Attempts > retry.Attempts {
+		return q.Result, nil
+	}
+	return dbupdate.RecordGrantRetryFailure(ctx, s.DB, retry, cause, s.Clock.Now())
+}
+
+func (s *Service) close(ctx context.Context, retry access.GrantRetry, status access.GrantRetryStatus) (*access.GrantRetry, error) {
+	retry.Status = status
+	retry.UpdatedAt = s.Clock.Now()
+	err := s.DB.Put(ctx, &retry)
+	if err != nil {
+		return nil, err
+	}
+	return &retry, nil
+}
+
+// listDueRetries lists all of the pending retries which are due.
+// They are listed before any are attempted, as attempting a retry moves it within the index being paged through.
+func (s *Service) listDueRetries(ctx context.Context) ([]access.GrantRetry, error) {
+	var retries []access.GrantRetry
+	hasMore := true
+	var next string
+	for hasMore {
+		q := storage.ListDueGrantRetries{Now: s.Clock.Now()}
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		qr, err := s.DB.Query(ctx, &q, opts...)
+		if err == ddb.ErrNoItems {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		retries = append(retries, q.Result...)
+		next = qr.NextPage
+		hasMore = next != ""
+	}
+	return retries, nil
+}
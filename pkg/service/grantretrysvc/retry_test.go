@@ -0,0 +1,209 @@
+package grantretrysvc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/service/grantretrysvc/mocks"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/targetgroupgranter"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/iso8601"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetry(t *testing.T) {
+	type testcase struct {
+		name           string
+		give           access.GrantRetry
+		withRequest    *access.Request
+		withRevokeErr  error
+		withGranterOut *targetgroupgranter.GrantState
+		// withRecorded is the retry saved by the workflow after a failed attempt.
+		withRecorded *access.GrantRetry
+		wantStatus   access.GrantRetryStatus
+		wantAttempts int
+		wantErr      error
+	}
+
+	clk := clock.NewMock()
+	now := clk.Now()
+	actor := "usr_1"
+	activeRequest := &access.Request{ID: "req_1", Grant: &access.Grant{Status: ahTypes.GrantStatusACTIVE, End: now.Add(time.Hour)}}
+	revokedRequest := &access.Request{ID: "req_1", Grant: &access.Grant{Status: ahTypes.GrantStatusREVOKED, End: now.Add(time.Hour)}}
+
+	revoke := access.NewGrantRetry("req_1", access.GrantRetryRevoke, now)
+	revoke.Actor = &actor
+	revoke.RecordFailure(errors.New("provider unavailable"), now)
+
+	grant := ahTypes.Grant{ID: "req_1", Provider: "test", End: iso8601.New(now.Add(time.Hour))}
+	activate := access.NewGrantRetry("req_1", access.GrantRetryActivate, now)
+	activate.Grant = &grant
+	activate.RecordFailure(errors.New("handler unavailable"), now)
+
+	deactivate := access.NewGrantRetry("req_1", access.GrantRetryDeactivate, now)
+	deactivate.Grant = &grant
+
+	ended := ahTypes.Grant{ID: "req_1", Provider: "test", End: iso8601.New(now.Add(-time.Minute))}
+	activateEnded := activate
+	activateEnded.Grant = &ended
+
+	recorded := revoke
+	recorded.RecordFailure(errors.New("provider unavailable"), now)
+
+	closed := revoke
+	closed.Status = access.GrantRetrySucceeded
+
+	testcases := []testcase{
+		{
+			name:         "revoke succeeds",
+			give:         revoke,
+			withRequest:  activeRequest,
+			wantStatus:   access.GrantRetrySucceeded,
+			wantAttempts: 1,
+		},
+		{
+			name:          "revoke fails again",
+			give:          revoke,
+			withRequest:   activeRequest,
+			withRevokeErr: errors.New("provider unavailable"),
+			withRecorded:  &recorded,
+			wantStatus:    access.GrantRetryPending,
+			wantAttempts:  2,
+		},
+		{
+			name:          "revoke fails before the change is made",
+			give:          revoke,
+			withRequest:   activeRequest,
+			withRevokeErr: errors.New("database unavailable"),
+			withRecorded:  &revoke,
+			wantStatus:    access.GrantRetryPending,
+			wantAttempts:  2,
+		},
+		{
+			name:          "grant ended before it could be revoked",
+			give:          revoke,
+			withRequest:   activeRequest,
+			withRevokeErr: workflowsvc.ErrGrantInactive,
+			wantStatus:    access.GrantRetryAbandoned,
+			wantAttempts:  1,
+		},
+		{
+			name:         "grant was already revoked",
+			give:         revoke,
+			withRequest:  revokedRequest,
+			wantStatus:   access.GrantRetrySucceeded,
+			wantAttempts: 1,
+		},
+		{
+			name:         "request no longer exists",
+			give:         revoke,
+			wantStatus:   access.GrantRetryAbandoned,
+			wantAttempts: 1,
+		},
+		{
+			name:           "activate succeeds",
+			give:           activate,
+			withRequest:    activeRequest,
+			withGranterOut: &targetgroupgranter.GrantState{Grant: grant},
+			wantStatus:     access.GrantRetrySucceeded,
+			wantAttempts:   1,
+		},
+		{
+			name:         "activate is abandoned after the grant ended",
+			give:         activateEnded,
+			withRequest:  activeRequest,
+			wantStatus:   access.GrantRetryAbandoned,
+			wantAttempts: 1,
+		},
+		{
+			name:         "activate is abandoned after the grant was revoked",
+			give:         activate,
+			withRequest:  revokedRequest,
+			wantStatus:   access.GrantRetryAbandoned,
+			wantAttempts: 1,
+		},
+		{
+			name:         "deactivate is abandoned after the grant was revoked",
+			give:         deactivate,
+			withRequest:  revokedRequest,
+			wantStatus:   access.GrantRetryAbandoned,
+			wantAttempts: 0,
+		},
+		{
+			name:    "closed retries can't be retried",
+			give:    closed,
+			wantErr: ErrGrantRetryClosed,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetGrantRetry{Result: &tc.give})
+			if tc.withRequest != nil {
+				db.MockQuery(&storage.GetRequest{Result: tc.withRequest})
+			} else {
+				db.MockQueryWithErr(&storage.GetRequest{}, ddb.ErrNoItems)
+			}
+			db.MockQuery(&storage.GetUser{Result: &identity.User{ID: actor, Email: "admin@example.com"}})
+
+			wf := mocks.NewMockWorkflow(ctrl)
+			wf.EXPECT().Revoke(gomock.Any(), gomock.Any(), actor, "admin@example.com").DoAndReturn(func(ctx context.Context, request access.Request, revokerID string, revokerEmail string) (*access.Request, error) {
+				if tc.withRecorded != nil {
+					db.MockQuery(&storage.GetGrantRetry{Result: tc.withRecorded})
+				}
+				return nil, tc.withRevokeErr
+			}).AnyTimes()
+			granter := mocks.NewMockGranter(ctrl)
+			if tc.withGranterOut != nil {
+				granter.EXPECT().HandleRequest(gomock.Any(), targetgroupgranter.InputEvent{Action: targetgroupgranter.ACTIVATE, Grant: grant}).Return(*tc.withGranterOut, nil)
+			}
+
+			s := Service{
+				Clock:    clk,
+				DB:       db,
+				Workflow: wf,
+				Granter:  granter,
+			}
+			got, err := s.Retry(context.Background(), tc.give.ID)
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, got.Status)
+			assert.Equal(t, tc.wantAttempts, got.Attempts)
+		})
+	}
+}
+
+func TestAbandon(t *testing.T) {
+	clk := clock.NewMock()
+	retry := access.NewGrantRetry("req_1", access.GrantRetryRevoke, clk.Now())
+	retry.RecordFailure(errors.New("provider unavailable"), clk.Now())
+
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.GetGrantRetry{Result: &retry})
+	s := Service{Clock: clk, DB: db}
+
+	got, err := s.Abandon(context.Background(), retry.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, access.GrantRetryAbandoned, got.Status)
+
+	abandoned := *got
+	db.MockQuery(&storage.GetGrantRetry{Result: &abandoned})
+	_, err = s.Abandon(context.Background(), retry.ID)
+	assert.Equal(t, ErrGrantRetryClosed, err)
+}
@@ -0,0 +1,33 @@
+package grantretrysvc
+
+import (
+	"context"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/targetgroupgranter"
+	"github.com/common-fate/ddb"
+)
+
+// Service retries changes to grants which failed, such as revocations
+// which couldn't be completed because a provider was unavailable.
+type Service struct {
+	Clock    clock.Clock
+	DB       ddb.Storage
+	Workflow Workflow
+	Granter  Granter
+}
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/workflow.go -package=mocks . Workflow
+
+// Workflow revokes grants. Failed revocations are recorded as grant retries by the workflow.
+type Workflow interface {
+	Revoke(ctx context.Context, request access.Request, revokerID string, revokerEmail string) (*access.Request, error)
+}
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/granter.go -package=mocks . Granter
+
+// Granter activates and deactivates target group grants. Failures are recorded as grant retries by the granter.
+type Granter interface {
+	HandleRequest(ctx context.Context, in targetgroupgranter.InputEvent) (targetgroupgranter.GrantState, error)
+}
@@ -2,11 +2,14 @@ package requestroutersvc
 
 import (
 	"context"
+	"math/rand"
 	"testing"
 
+	"github.com/common-fate/common-fate/pkg/access"
 	"github.com/common-fate/common-fate/pkg/handler"
 	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/target"
+	"github.com/common-fate/ddb"
 	"github.com/common-fate/ddb/ddbmock"
 	"github.com/common-fate/provider-registry-sdk-go/pkg/providerregistrysdk"
 	"github.com/stretchr/testify/assert"
@@ -85,3 +88,121 @@ func TestRoute(t *testing.T) {
 	}
 
 }
+
+func TestRoutes(t *testing.T) {
+	type testcase struct {
+		name        string
+		give        target.Group
+		withRoutes  []target.Route
+		withHandler *handler.Handler
+		handlerErr  error
+		wantHandler []string
+		wantErr     error
+	}
+
+	zero := 0
+	three := 3
+
+	testcases := []testcase{
+		{
+			name:        "priority routes fall back in priority order",
+			withRoutes:  []target.Route{{Group: "tg", Handler: "primary", Priority: 999, Valid: true}, {Group: "tg", Handler: "secondary", Priority: 100, Valid: true}},
+			withHandler: &handler.Handler{Healthy: true},
+			wantHandler: []string{"primary", "secondary"},
+		},
+		{
+			name:        "weighted routes with no weight are tried last",
+			give:        target.Group{RoutingStrategy: target.RoutingWeighted},
+			withRoutes:  []target.Route{{Group: "tg", Handler: "primary", Priority: 999, Valid: true, Weight: &zero}, {Group: "tg", Handler: "secondary", Priority: 100, Valid: true, Weight: &three}},
+			withHandler: &handler.Handler{Healthy: true},
+			wantHandler: []string{"secondary", "primary"},
+		},
+		{
+			name:        "unhealthy handlers are used as a last resort",
+			withRoutes:  []target.Route{{Group: "tg", Handler: "primary", Priority: 999, Valid: true}},
+			withHandler: &handler.Handler{Healthy: false},
+			wantHandler: []string{"primary"},
+		},
+		{
+			name:        "deleted handlers are skipped",
+			withRoutes:  []target.Route{{Group: "tg", Handler: "primary", Priority: 999, Valid: true}},
+			withHandler: &handler.Handler{},
+			handlerErr:  ddb.ErrNoItems,
+			wantErr:     ErrCannotRoute,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListTargetRoutesForGroup{Result: tc.withRoutes})
+			db.MockQuery(&storage.ListValidTargetRoutesForGroupByPriority{Result: tc.withRoutes})
+			db.MockQueryWithErr(&storage.GetHandler{Result: tc.withHandler}, tc.handlerErr)
+
+			s := Service{
+				DB:   db,
+				Rand: rand.New(rand.NewSource(1)),
+			}
+
+			got, err := s.Routes(context.Background(), tc.give)
+			assert.Equal(t, tc.wantErr, err)
+
+			var handlers []string
+			for _, r := range got {
+				handlers = append(handlers, r.Route.Handler)
+			}
+			assert.Equal(t, tc.wantHandler, handlers)
+		})
+	}
+}
+
+func TestGrantRoute(t *testing.T) {
+	type testcase struct {
+		name       string
+		withState  *access.GrantState
+		withRoutes []target.Route
+		wantErr    error
+		want       *RouteResult
+	}
+
+	testcases := []testcase{
+		{
+			name:       "saved handler is used",
+			withState:  &access.GrantState{RequestID: "req_1", HandlerID: "handler_1", Kind: "Account"},
+			withRoutes: []target.Route{{Group: "tg_1", Handler: "handler_2", Kind: "Role", Priority: 999, Valid: true}},
+			want:       &RouteResult{Route: target.Route{Group: "tg_1", Handler: "handler_1", Kind: "Account"}, Handler: handler.Handler{ID: "handler_1"}},
+		},
+		{
+			name:       "grants without saved state use the first route",
+			withRoutes: []target.Route{{Group: "tg_1", Handler: "handler_1", Kind: "Role", Priority: 999, Valid: true}},
+			want:       &RouteResult{Route: target.Route{Group: "tg_1", Handler: "handler_1", Kind: "Role", Priority: 999, Valid: true}, Handler: handler.Handler{ID: "handler_1"}},
+		},
+		{
+			name:      "saved handler has been deleted",
+			withState: &access.GrantState{RequestID: "req_1", HandlerID: "handler_1", Kind: "Account"},
+			wantErr:   ddb.ErrNoItems,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListTargetRoutesForGroup{Result: tc.withRoutes})
+			db.MockQuery(&storage.ListValidTargetRoutesForGroupByPriority{Result: tc.withRoutes})
+			if tc.wantErr != nil {
+				db.MockQueryWithErr(&storage.GetHandler{}, tc.wantErr)
+			} else {
+				db.MockQuery(&storage.GetHandler{Result: &tc.want.Handler})
+			}
+
+			s := Service{
+				DB: db,
+			}
+			got, err := s.GrantRoute(context.Background(), target.Group{ID: "tg_1"}, tc.withState)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -2,7 +2,11 @@ package requestroutersvc
 
 import (
 	"context"
+	"math"
+	"math/rand"
+	"sort"
 
+	"github.com/common-fate/common-fate/pkg/access"
 	"github.com/common-fate/common-fate/pkg/handler"
 	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/target"
@@ -11,6 +15,9 @@ import (
 
 type Service struct {
 	DB ddb.Storage
+	// Rand is used to choose between routes when a target group uses weighted routing.
+	// If it is nil, a shared random source is used.
+	Rand *rand.Rand
 }
 
 type RouteResult struct {
@@ -18,11 +25,40 @@ type RouteResult struct {
 	Handler handler.Handler
 }
 
-// Route is a very basic router that just chooses the highest priority valid and healthy deployment
-// returns an error if none is found
-// has no way of falling back to lower priority
+// Route chooses the route which should be tried first for the target group.
+// returns an error if no valid route is found
 func (s *Service) Route(ctx context.Context, tg target.Group) (*RouteResult, error) {
+	routes, err := s.Routes(ctx, tg)
+	if err != nil {
+		return nil, err
+	}
+	return &routes[0], nil
+}
+
+// GrantRoute returns the route through the handler which provisioned a grant, using the state saved when it was activated.
+// Access must be removed by the handler which provisioned it, so there is no fallback to other routes.
+// Grants which were activated before the state was saved don't have one, and use the first route for the target group.
+func (s *Service) GrantRoute(ctx context.Context, tg target.Group, state *access.GrantState) (*RouteResult, error) {
+	if state == nil {
+		return s.Route(ctx, tg)
+	}
+	q := storage.GetHandler{ID: state.HandlerID}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil {
+		return nil, err
+	}
+	return &RouteResult{
+		Route:   target.Route{Group: tg.ID, Handler: state.HandlerID, Kind: state.Kind},
+		Handler: *q.Result,
+	}, nil
+}
 
+// Routes returns the valid routes for the target group in the order they should be tried.
+// Callers should fall back to the next route if invoking a handler fails.
+//
+// Routes are ordered by the routing strategy of the target group.
+// Routes with unhealthy handlers are ordered after all healthy routes, so that they are only used as a last resort.
+func (s *Service) Routes(ctx context.Context, tg target.Group) ([]RouteResult, error) {
 	groupRoutes := storage.ListTargetRoutesForGroup{
 		Group: tg.ID,
 	}
@@ -35,31 +71,80 @@ func (s *Service) Route(ctx context.Context, tg target.Group) (*RouteResult, err
 		return nil, ErrNoRoutes
 	}
 
-	// Next get the highest priority valid route
-	validRoute := storage.ListValidTargetRoutesForGroupByPriority{
+	// Next get the valid routes, highest priority first
+	validRoutes := storage.ListValidTargetRoutesForGroupByPriority{
 		Group: tg.ID,
 	}
-	_, err = s.DB.Query(ctx, &validRoute, ddb.Limit(1))
+	_, err = s.DB.Query(ctx, &validRoutes)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(validRoute.Result) != 1 {
+	var healthy, unhealthy []RouteResult
+	for _, route := range validRoutes.Result {
+		handlerQuery := storage.GetHandler{
+			ID: route.Handler,
+		}
+		_, err = s.DB.Query(ctx, &handlerQuery)
+		if err == ddb.ErrNoItems {
+			// the handler has been deleted, so the route can't be used
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		res := RouteResult{
+			Route:   route,
+			Handler: *handlerQuery.Result,
+		}
+		if res.Handler.Healthy {
+			healthy = append(healthy, res)
+		} else {
+			unhealthy = append(unhealthy, res)
+		}
+	}
+	if tg.RoutingStrategy == target.RoutingWeighted {
+		healthy = s.shuffleByWeight(healthy)
+	}
+	routes := append(healthy, unhealthy...)
+	if len(routes) == 0 {
 		return nil, ErrCannotRoute
 	}
+	return routes, nil
+}
 
-	handlerQuery := storage.GetHandler{
-		ID: validRoute.Result[0].Handler,
+// shuffleByWeight orders routes randomly, with each route's chance of coming first being proportional to its weight.
+// Routes with a weight of zero are ordered last, by priority, so that they are only used if the others fail.
+func (s *Service) shuffleByWeight(routes []RouteResult) []RouteResult {
+	type weighted struct {
+		route RouteResult
+		key   float64
 	}
-	_, err = s.DB.Query(ctx, &handlerQuery)
-	if err == ddb.ErrNoItems {
-		return nil, ErrCannotRoute
+	var candidates []weighted
+	var drained []RouteResult
+	for _, r := range routes {
+		w := r.Route.GetWeight()
+		if w <= 0 {
+			drained = append(drained, r)
+			continue
+		}
+		// weighted random sampling without replacement (Efraimidis-Spirakis):
+		// ordering by u^(1/w) descending gives each route a chance of coming first proportional to its weight.
+		candidates = append(candidates, weighted{route: r, key: math.Pow(s.float64(), 1/float64(w))})
 	}
-	if err != nil {
-		return nil, err
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].key > candidates[j].key
+	})
+	res := make([]RouteResult, 0, len(routes))
+	for _, c := range candidates {
+		res = append(res, c.route)
 	}
-	return &RouteResult{
-		Route:   validRoute.Result[0],
-		Handler: *handlerQuery.Result,
-	}, nil
+	return append(res, drained...)
+}
+
+func (s *Service) float64() float64 {
+	if s.Rand != nil {
+		return s.Rand.Float64()
+	}
+	return rand.Float64()
 }
@@ -47,15 +47,66 @@ func (u *userMap) All() []string {
 // GetApprovers gets all the approvers for a rule, both those assigned as individuals and those
 // assigned via a group. It de-duplicates users, so if a user is assigned as an approver through
 // multiple groups they'll only be returned once.
+//
+// For rules with multiple approval stages, the approvers of every stage are returned.
+// For rules with approval conditions, the approvers of every condition are returned.
+// Use GetRequestApprovers to get the approvers for a particular request.
 func GetApprovers(ctx context.Context, db ddb.Storage, rule rule.AccessRule) ([]string, error) {
 	users := newUserMap()
+	err := addApprovalApprovers(ctx, db, rule.Approval, users)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range rule.Approval.Conditions {
+		err := addStageApprovers(ctx, db, c.Stage(), users)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res := users.All()
+	return res, nil
+}
+
+// GetRequestApprovers gets the approvers for a request made with the selected arguments.
+// If the rule has approval conditions, only the approvers of the matching condition are returned.
+func GetRequestApprovers(ctx context.Context, db ddb.Storage, rule rule.AccessRule, arguments map[string]string) ([]string, error) {
+	users := newUserMap()
+	err := addApprovalApprovers(ctx, db, rule.Approval.ForArguments(arguments), users)
+	if err != nil {
+		return nil, err
+	}
+	res := users.All()
+	return res, nil
+}
+
+// GetStageApprovers gets the approvers for a single approval stage.
+func GetStageApprovers(ctx context.Context, db ddb.Storage, stage rule.ApprovalStage) ([]string, error) {
+	users := newUserMap()
+	err := addStageApprovers(ctx, db, stage, users)
+	if err != nil {
+		return nil, err
+	}
+	res := users.All()
+	return res, nil
+}
+
+func addApprovalApprovers(ctx context.Context, db ddb.Storage, approval rule.Approval, users *userMap) error {
+	for _, stage := range approval.GetStages() {
+		err := addStageApprovers(ctx, db, stage, users)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	for _, u := range rule.Approval.Users {
+func addStageApprovers(ctx context.Context, db ddb.Storage, stage rule.ApprovalStage, users *userMap) error {
+	for _, u := range stage.Users {
 		users.Add(u)
 	}
 
 	wg, gctx := errgroup.WithContext(ctx)
-	for _, g := range rule.Approval.Groups {
+	for _, g := range stage.Groups {
 		id := g
 		wg.Go(func() error {
 			q := &storage.GetGroup{ID: id}
@@ -69,11 +120,5 @@ func GetApprovers(ctx context.Context, db ddb.Storage, rule rule.AccessRule) ([]
 			return nil
 		})
 	}
-	err := wg.Wait()
-	if err != nil {
-		return nil, err
-	}
-
-	res := users.All()
-	return res, nil
+	return wg.Wait()
 }
@@ -67,6 +67,38 @@ func TestGetApprovers(t *testing.T) {
 			},
 			want: []string{"usr_2"},
 		},
+		{
+			name: "multiple stages",
+			giveRule: rule.AccessRule{
+				Approval: rule.Approval{
+					// top level approvers are ignored when stages are set
+					Users: []string{"usr_ignored"},
+					Stages: []rule.ApprovalStage{
+						{Name: "Team lead", Users: []string{"usr_1"}},
+						{Name: "Security", Users: []string{"usr_3"}, Groups: []string{"grp_1"}},
+					},
+				},
+			},
+			mockGetGroup: &identity.Group{
+				Users: []string{"usr_2"},
+			},
+			want: []string{"usr_1", "usr_2", "usr_3"},
+		},
+		{
+			name: "conditions",
+			giveRule: rule.AccessRule{
+				Approval: rule.Approval{
+					Users: []string{"usr_1"},
+					Conditions: []rule.ApprovalCondition{
+						{ArgumentID: "accountId", Values: []string{"123456789012"}, Groups: []string{"grp_1"}},
+					},
+				},
+			},
+			mockGetGroup: &identity.Group{
+				Users: []string{"usr_2"},
+			},
+			want: []string{"usr_1", "usr_2"},
+		},
 		// returning an empty array rather than nil ensures that our API endpoints
 		// that use this method don't return null when the frontend is expecting an array.
 		{
@@ -92,3 +124,54 @@ func TestGetApprovers(t *testing.T) {
 	}
 
 }
+
+func TestGetRequestApprovers(t *testing.T) {
+	type testcase struct {
+		name          string
+		giveArguments map[string]string
+		want          []string
+	}
+
+	giveRule := rule.AccessRule{
+		Approval: rule.Approval{
+			Users: []string{"usr_1"},
+			Conditions: []rule.ApprovalCondition{
+				{ArgumentID: "accountId", Values: []string{"123456789012", "210987654321"}, Groups: []string{"grp_prod_owners"}},
+				{ArgumentID: "accountId", Values: []string{"111111111111"}},
+			},
+		},
+	}
+
+	testcases := []testcase{
+		{
+			name:          "matches condition",
+			giveArguments: map[string]string{"accountId": "210987654321"},
+			want:          []string{"usr_2"},
+		},
+		{
+			name:          "matches condition without approvers",
+			giveArguments: map[string]string{"accountId": "111111111111"},
+			want:          []string{},
+		},
+		{
+			name:          "no matching condition",
+			giveArguments: map[string]string{"accountId": "999999999999"},
+			want:          []string{"usr_1"},
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetGroup{Result: &identity.Group{Users: []string{"usr_2"}}})
+
+			ctx := context.Background()
+			got, err := GetRequestApprovers(ctx, db, giveRule, tc.giveArguments)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.ElementsMatch(t, tc.want, got)
+		})
+	}
+}
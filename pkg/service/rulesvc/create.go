@@ -203,6 +203,113 @@ func (s *Service) ProcessTarget(ctx context.Context, in types.CreateAccessRuleTa
 	return target, nil
 }
 
+// validateApproval checks that the approval quorum, any approval stages and any approval conditions are valid,
+// and that the approvers assigned to each stage or condition exist.
+// returns apio.APIError so it will bubble up as a 400 error from api usage
+func (s *Service) validateApproval(ctx context.Context, in rule.Approval, target rule.Target) error {
+	if in.Quorum < 0 {
+		return apio.NewRequestError(errors.New("approval quorum must not be negative"), http.StatusBadRequest)
+	}
+	if in.ExpiresAfterSeconds < 0 {
+		return apio.NewRequestError(errors.New("approval expiry must not be negative"), http.StatusBadRequest)
+	}
+	if in.RecurringApprovalValiditySeconds < 0 {
+		return apio.NewRequestError(errors.New("recurring approval validity must not be negative"), http.StatusBadRequest)
+	}
+	for i, stage := range in.Stages {
+		if stage.Name == "" {
+			return apio.NewRequestError(fmt.Errorf("approval stage %d must have a name", i+1), http.StatusBadRequest)
+		}
+		if stage.Quorum < 0 {
+			return apio.NewRequestError(fmt.Errorf("approval stage '%s' quorum must not be negative", stage.Name), http.StatusBadRequest)
+		}
+		if len(stage.Users) == 0 && len(stage.Groups) == 0 {
+			return apio.NewRequestError(fmt.Errorf("approval stage '%s' must have at least one approving user or group", stage.Name), http.StatusBadRequest)
+		}
+		err := s.validateStageApprovers(ctx, fmt.Sprintf("approval stage '%s'", stage.Name), stage)
+		if err != nil {
+			return err
+		}
+	}
+	if len(in.Stages) == 0 && in.Quorum > 1 {
+		err := s.validateStageApprovers(ctx, "approval", in.GetStages()[0])
+		if err != nil {
+			return err
+		}
+	}
+	for i, c := range in.Conditions {
+		if c.ArgumentID == "" {
+			return apio.NewRequestError(fmt.Errorf("approval condition %d must have an argument", i+1), http.StatusBadRequest)
+		}
+		if !target.HasArgument(c.ArgumentID) {
+			return apio.NewRequestError(fmt.Errorf("approval condition %d refers to argument '%s' which is not an argument of the access rule target", i+1, c.ArgumentID), http.StatusBadRequest)
+		}
+		if len(c.Values) == 0 {
+			return apio.NewRequestError(fmt.Errorf("approval condition %d must have at least one value", i+1), http.StatusBadRequest)
+		}
+		if c.Quorum < 0 {
+			return apio.NewRequestError(fmt.Errorf("approval condition %d quorum must not be negative", i+1), http.StatusBadRequest)
+		}
+		err := s.validateStageApprovers(ctx, fmt.Sprintf("approval condition %d", i+1), c.Stage())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateStageApprovers checks that the users and groups assigned to an approval stage exist,
+// and that there are enough approvers for the stage's quorum to be met.
+// name describes the stage in error messages, such as "approval stage 'security'".
+func (s *Service) validateStageApprovers(ctx context.Context, name string, stage rule.ApprovalStage) error {
+	for _, u := range stage.Users {
+		_, err := s.DB.Query(ctx, &storage.GetUser{ID: u})
+		if err == ddb.ErrNoItems {
+			return apio.NewRequestError(fmt.Errorf("%s approving user '%s' does not exist", name, u), http.StatusBadRequest)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	for _, g := range stage.Groups {
+		_, err := s.DB.Query(ctx, &storage.GetGroup{ID: g})
+		if err == ddb.ErrNoItems {
+			return apio.NewRequestError(fmt.Errorf("%s approving group '%s' does not exist", name, g), http.StatusBadRequest)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if stage.Quorum <= 1 {
+		return nil
+	}
+	approvers, err := GetStageApprovers(ctx, s.DB, stage)
+	if err != nil {
+		return err
+	}
+	if len(approvers) < stage.Quorum {
+		return apio.NewRequestError(fmt.Errorf("%s quorum of %d is larger than the %d users who can approve it", name, stage.Quorum, len(approvers)), http.StatusBadRequest)
+	}
+	return nil
+}
+
+// validateSeparationOfDuties checks that the exclusive groups exist and the approval limit isn't negative.
+func (s *Service) validateSeparationOfDuties(ctx context.Context, in rule.SeparationOfDuties) error {
+	if in.MaxApprovalsPerDay < 0 {
+		return apio.NewRequestError(errors.New("maximum approvals per day must not be negative"), http.StatusBadRequest)
+	}
+	for _, g := range in.ExclusiveGroups {
+		_, err := s.DB.Query(ctx, &storage.GetGroup{ID: g})
+		if err == ddb.ErrNoItems {
+			return apio.NewRequestError(fmt.Errorf("exclusive group '%s' does not exist", g), http.StatusBadRequest)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *Service) CreateAccessRule(ctx context.Context, userID string, in types.CreateAccessRuleRequest) (*rule.AccessRule, error) {
 	id := types.NewAccessRuleID()
 
@@ -256,14 +363,16 @@ func (s *Service) CreateAccessRule(ctx context.Context, userID string, in types.
 		return nil, errors.New("access rule cannot be longer than 6 months")
 	}
 
-	approvals := rule.Approval{}
-
-	if in.Approval.Groups != nil {
-		approvals.Groups = *in.Approval.Groups
+	approvals := rule.ApprovalFromAPI(in.Approval)
+	err = s.validateApproval(ctx, approvals, target)
+	if err != nil {
+		return nil, err
 	}
 
-	if in.Approval.Users != nil {
-		approvals.Users = *in.Approval.Users
+	sod := rule.SeparationOfDutiesFromAPI(in.SeparationOfDuties)
+	err = s.validateSeparationOfDuties(ctx, sod)
+	if err != nil {
+		return nil, err
 	}
 
 	rul := rule.AccessRule{
@@ -279,10 +388,14 @@ func (s *Service) CreateAccessRule(ctx context.Context, userID string, in types.
 			UpdatedAt: now,
 			UpdatedBy: userID,
 		},
-		Target:          target,
-		TimeConstraints: in.TimeConstraints,
-		Version:         types.NewVersionID(),
-		Current:         true,
+		Target:             target,
+		TimeConstraints:    in.TimeConstraints,
+		Version:            types.NewVersionID(),
+		Current:            true,
+		SeparationOfDuties: sod,
+	}
+	if in.BreakGlass != nil {
+		rul.BreakGlass = *in.BreakGlass
 	}
 
 	log.Debugw("saving access rule", "rule", rul)
@@ -12,6 +12,7 @@ import (
 	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
 	"github.com/common-fate/common-fate/accesshandler/pkg/types/ahmocks"
 	"github.com/common-fate/common-fate/pkg/cache"
+	"github.com/common-fate/common-fate/pkg/identity"
 	"github.com/common-fate/common-fate/pkg/rule"
 	"github.com/common-fate/common-fate/pkg/service/rulesvc/mocks"
 	"github.com/common-fate/common-fate/pkg/storage"
@@ -369,3 +370,118 @@ func TestProcessTarget(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateApproval(t *testing.T) {
+	type testcase struct {
+		name        string
+		give        rule.Approval
+		userErr     error
+		groupErr    error
+		wantErr     error
+		wantErrCode int
+	}
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			give: rule.Approval{Users: []string{"u1", "u2"}, Groups: []string{"g1"}, Quorum: 3},
+		},
+		{
+			name:        "quorum larger than approvers",
+			give:        rule.Approval{Users: []string{"u1", "u2"}, Groups: []string{"g1"}, Quorum: 4},
+			wantErr:     errors.New("approval quorum of 4 is larger than the 3 users who can approve it"),
+			wantErrCode: http.StatusBadRequest,
+		},
+		{
+			name:        "stage quorum larger than approvers",
+			give:        rule.Approval{Stages: []rule.ApprovalStage{{Name: "security", Users: []string{"u1"}, Quorum: 2}}},
+			wantErr:     errors.New("approval stage 'security' quorum of 2 is larger than the 1 users who can approve it"),
+			wantErrCode: http.StatusBadRequest,
+		},
+		{
+			name:        "approving user not found",
+			give:        rule.Approval{Stages: []rule.ApprovalStage{{Name: "security", Users: []string{"u1"}}}},
+			userErr:     ddb.ErrNoItems,
+			wantErr:     errors.New("approval stage 'security' approving user 'u1' does not exist"),
+			wantErrCode: http.StatusBadRequest,
+		},
+		{
+			name:        "approving group not found",
+			give:        rule.Approval{Stages: []rule.ApprovalStage{{Name: "security", Groups: []string{"g1"}}}},
+			groupErr:    ddb.ErrNoItems,
+			wantErr:     errors.New("approval stage 'security' approving group 'g1' does not exist"),
+			wantErrCode: http.StatusBadRequest,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQueryWithErr(&storage.GetUser{Result: &identity.User{ID: "u1"}}, tc.userErr)
+			db.MockQueryWithErr(&storage.GetGroup{Result: &identity.Group{ID: "g1", Users: []string{"u2", "u3"}}}, tc.groupErr)
+			s := Service{DB: db}
+
+			err := s.validateApproval(context.Background(), tc.give, rule.Target{})
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.wantErr.Error())
+			var apiErr *apio.APIError
+			if assert.ErrorAs(t, err, &apiErr) {
+				assert.Equal(t, tc.wantErrCode, apiErr.Status)
+			}
+		})
+	}
+}
+
+func TestValidateSeparationOfDuties(t *testing.T) {
+	type testcase struct {
+		name        string
+		give        rule.SeparationOfDuties
+		groupErr    error
+		wantErr     error
+		wantErrCode int
+	}
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			give: rule.SeparationOfDuties{ExclusiveGroups: []string{"g1"}, MaxApprovalsPerDay: 5},
+		},
+		{
+			name:        "exclusive group not found",
+			give:        rule.SeparationOfDuties{ExclusiveGroups: []string{"g1"}},
+			groupErr:    ddb.ErrNoItems,
+			wantErr:     errors.New("exclusive group 'g1' does not exist"),
+			wantErrCode: http.StatusBadRequest,
+		},
+		{
+			name:        "negative approval limit",
+			give:        rule.SeparationOfDuties{MaxApprovalsPerDay: -1},
+			wantErr:     errors.New("maximum approvals per day must not be negative"),
+			wantErrCode: http.StatusBadRequest,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQueryWithErr(&storage.GetGroup{Result: &identity.Group{ID: "g1"}}, tc.groupErr)
+			s := Service{DB: db}
+
+			err := s.validateSeparationOfDuties(context.Background(), tc.give)
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.wantErr.Error())
+			var apiErr *apio.APIError
+			if assert.ErrorAs(t, err, &apiErr) {
+				assert.Equal(t, tc.wantErrCode, apiErr.Status)
+			}
+		})
+	}
+}
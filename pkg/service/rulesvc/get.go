@@ -60,6 +60,36 @@ func canGet(user *identity.User, rule *rule.AccessRule, isAdmin bool) bool {
 			}
 		}
 	}
+	// DE = User can see a rule they're an approver of in one of its approval stages
+	for _, stage := range rule.Approval.Stages {
+		for _, au := range stage.Users {
+			if au == user.ID {
+				return true
+			}
+		}
+		for _, group := range user.Groups {
+			for _, g := range stage.Groups {
+				if g == group {
+					return true
+				}
+			}
+		}
+	}
+	// DE = User can see a rule they're an approver of via an approval condition
+	for _, c := range rule.Approval.Conditions {
+		for _, au := range c.Users {
+			if au == user.ID {
+				return true
+			}
+		}
+		for _, group := range user.Groups {
+			for _, g := range c.Groups {
+				if g == group {
+					return true
+				}
+			}
+		}
+	}
 	// DE = User can see a rule they're assigned to (via the groups)
 	for _, group := range user.Groups {
 		for _, g := range rule.Groups {
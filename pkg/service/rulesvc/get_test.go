@@ -31,6 +31,17 @@ func TestGetRule(t *testing.T) {
 		},
 	}
 
+	var stagedReq = &rule.AccessRule{
+		ID:     "rule2",
+		Groups: []string{"group1"},
+		Approval: rule.Approval{
+			Stages: []rule.ApprovalStage{
+				{Name: "Team lead", Users: []string{"lead1"}},
+				{Name: "Security", Groups: []string{"security"}},
+			},
+		},
+	}
+
 	testcases := []testcase{
 		{
 			name:            "User can see a rule they're an approver for",
@@ -65,6 +76,30 @@ func TestGetRule(t *testing.T) {
 			getRuleResponse: mockReq,
 			wantErr:         ErrUserNotAuthorized,
 		},
+		{
+			name:            "User can see a rule they're an approver for in an approval stage",
+			givenUser:       identity.User{ID: "lead1"},
+			getRuleResponse: stagedReq,
+			want: &rule.GetAccessRuleResponse{
+				Rule:       stagedReq,
+				CanRequest: false,
+			},
+		},
+		{
+			name:            "User can see a rule they're an approver of in an approval stage (via groups)",
+			givenUser:       identity.User{ID: "b", Groups: []string{"security"}},
+			getRuleResponse: stagedReq,
+			want: &rule.GetAccessRuleResponse{
+				Rule:       stagedReq,
+				CanRequest: false,
+			},
+		},
+		{
+			name:            "User *cannot* see a staged rule if they aren't an approver in any stage",
+			givenUser:       identity.User{ID: "c", Groups: []string{"engineering"}},
+			getRuleResponse: stagedReq,
+			wantErr:         ErrUserNotAuthorized,
+		},
 		{
 			name:            "Admins can always access rules",
 			givenUser:       identity.User{ID: "a"},
@@ -67,21 +67,27 @@ func (s *Service) UpdateRule(ctx context.Context, in *UpdateOpts) (*rule.AccessR
 	// fields to be updated
 	newVersion.Description = in.UpdateRequest.Description
 	newVersion.Name = in.UpdateRequest.Name
-	if in.UpdateRequest.Approval.Users != nil {
-		newVersion.Approval.Users = *in.UpdateRequest.Approval.Users
-
-	} else {
-		newVersion.Approval.Groups = []string{}
+	newVersion.Approval = rule.ApprovalFromAPI(in.UpdateRequest.Approval)
+	if newVersion.Approval.Users == nil {
+		newVersion.Approval.Users = []string{}
 	}
-	if in.UpdateRequest.Approval.Groups != nil {
-		newVersion.Approval.Groups = *in.UpdateRequest.Approval.Groups
-	} else {
+	if newVersion.Approval.Groups == nil {
 		newVersion.Approval.Groups = []string{}
 	}
+	err = s.validateApproval(ctx, newVersion.Approval, target)
+	if err != nil {
+		return nil, err
+	}
+	newVersion.SeparationOfDuties = rule.SeparationOfDutiesFromAPI(in.UpdateRequest.SeparationOfDuties)
+	err = s.validateSeparationOfDuties(ctx, newVersion.SeparationOfDuties)
+	if err != nil {
+		return nil, err
+	}
 	newVersion.Groups = in.UpdateRequest.Groups
 	newVersion.Metadata.UpdatedBy = in.UpdaterID
 	newVersion.Metadata.UpdatedAt = clk.Now()
 	newVersion.TimeConstraints = in.UpdateRequest.TimeConstraints
+	newVersion.BreakGlass = in.UpdateRequest.BreakGlass != nil && *in.UpdateRequest.BreakGlass
 	newVersion.Version = types.NewVersionID()
 	newVersion.Target = target
 
@@ -0,0 +1,22 @@
+package sessionactivitysvc
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrNoActivity      = errors.New("at least one activity record must be provided")
+	ErrTooManyActivity = fmt.Errorf("at most %d activity records can be recorded at once", MaxActivityPerCall)
+	ErrGrantNotFound   = errors.New("grant not found")
+)
+
+// InvalidActivityError is returned when an activity record is missing a required field.
+type InvalidActivityError struct {
+	Index int
+	Err   error
+}
+
+func (e InvalidActivityError) Error() string {
+	return fmt.Sprintf("activity record %d is invalid: %s", e.Index, e.Err)
+}
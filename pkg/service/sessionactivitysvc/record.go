@@ -0,0 +1,63 @@
+package sessionactivitysvc
+
+import (
+	"context"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+)
+
+// Record saves session activity as recorded events on the requests which the grants belong to.
+//
+// Activity is attributed to the user who requested the grant. Nothing is saved if any
+// of the activity is invalid or refers to a request which doesn't have a grant.
+func (s *Service) Record(ctx context.Context, activity []access.SessionActivity) ([]access.RequestEvent, error) {
+	if len(activity) == 0 {
+		return nil, ErrNoActivity
+	}
+	if len(activity) > MaxActivityPerCall {
+		return nil, ErrTooManyActivity
+	}
+	for i, a := range activity {
+		err := a.Validate()
+		if err != nil {
+			return nil, InvalidActivityError{Index: i, Err: err}
+		}
+	}
+
+	// activity is usually streamed for a single session, so each request only needs to be looked up once.
+	requests := make(map[string]*access.Request)
+	for _, a := range activity {
+		if _, ok := requests[a.GrantID]; ok {
+			continue
+		}
+		q := storage.GetRequest{ID: a.GrantID}
+		_, err := s.DB.Query(ctx, &q)
+		if err == ddb.ErrNoItems {
+			return nil, ErrGrantNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+		if q.Result.Grant == nil {
+			return nil, ErrGrantNotFound
+		}
+		requests[a.GrantID] = q.Result
+	}
+
+	now := s.Clock.Now()
+	events := make([]access.RequestEvent, len(activity))
+	items := make([]ddb.Keyer, len(activity))
+	for i, a := range activity {
+		events[i] = access.NewSessionActivityEvent(a, &requests[a.GrantID].RequestedBy, now)
+		items[i] = &events[i]
+	}
+	err := s.DB.PutBatch(ctx, items...)
+	if err != nil {
+		return nil, err
+	}
+	logger.Get(ctx).Infow("recorded session activity", "count", len(events))
+	return events, nil
+}
@@ -0,0 +1,108 @@
+package sessionactivitysvc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecord(t *testing.T) {
+	type testcase struct {
+		name           string
+		give           []access.SessionActivity
+		withRequest    *access.Request
+		withRequestErr error
+		withPutErr     error
+		// wantEvents are compared without their IDs, which are random.
+		wantEvents []access.RequestEvent
+		wantErr    error
+	}
+
+	clk := clock.NewMock()
+	now := clk.Now()
+	occurredAt := now.Add(-time.Minute)
+	user := "usr_1"
+	source := "ecs-shell"
+	session := "ses_1"
+	command := map[string]string{"command": "ls"}
+	request := &access.Request{ID: "req_1", RequestedBy: user, Grant: &access.Grant{}}
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			give: []access.SessionActivity{
+				{GrantID: "req_1", Source: source, SessionID: session, OccurredAt: occurredAt, Data: command},
+				{GrantID: "req_1", Source: source, Data: command},
+			},
+			withRequest: request,
+			wantEvents: []access.RequestEvent{
+				{RequestID: "req_1", Actor: &user, CreatedAt: occurredAt, RecordedEvent: &command, RecordedEventSource: &source, SessionID: &session},
+				// activity without a time is recorded at the current time.
+				{RequestID: "req_1", Actor: &user, CreatedAt: now, RecordedEvent: &command, RecordedEventSource: &source},
+			},
+		},
+		{
+			name:    "no activity",
+			wantErr: ErrNoActivity,
+		},
+		{
+			name:    "too much activity",
+			give:    make([]access.SessionActivity, MaxActivityPerCall+1),
+			wantErr: ErrTooManyActivity,
+		},
+		{
+			name:    "invalid activity",
+			give:    []access.SessionActivity{{GrantID: "req_1", Data: command}},
+			wantErr: InvalidActivityError{Index: 0, Err: errors.New("source must be provided")},
+		},
+		{
+			name:           "request not found",
+			give:           []access.SessionActivity{{GrantID: "req_1", Source: source, Data: command}},
+			withRequestErr: ddb.ErrNoItems,
+			wantErr:        ErrGrantNotFound,
+		},
+		{
+			name:        "request has no grant",
+			give:        []access.SessionActivity{{GrantID: "req_1", Source: source, Data: command}},
+			withRequest: &access.Request{ID: "req_1", RequestedBy: user},
+			wantErr:     ErrGrantNotFound,
+		},
+		{
+			name:        "database error",
+			give:        []access.SessionActivity{{GrantID: "req_1", Source: source, Data: command}},
+			withRequest: request,
+			withPutErr:  errors.New("database unavailable"),
+			wantErr:     errors.New("database unavailable"),
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQueryWithErr(&storage.GetRequest{Result: tc.withRequest}, tc.withRequestErr)
+			db.PutBatchErr = tc.withPutErr
+
+			s := Service{Clock: clk, DB: db}
+			got, err := s.Record(context.Background(), tc.give)
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			for i := range got {
+				assert.NotEmpty(t, got[i].ID)
+				got[i].ID = ""
+			}
+			assert.Equal(t, tc.wantEvents, got)
+		})
+	}
+}
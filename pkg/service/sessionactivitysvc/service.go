@@ -0,0 +1,17 @@
+package sessionactivitysvc
+
+import (
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/ddb"
+)
+
+// MaxActivityPerCall is the most session activity which can be recorded at once.
+// Sources which produce more activity than this should send it in batches.
+const MaxActivityPerCall = 100
+
+// Service records the activity users perform while using their grants, such as the commands run in a shell session.
+// Activity is stored as recorded events on the request which the grant belongs to, so it appears on the request timeline.
+type Service struct {
+	Clock clock.Clock
+	DB    ddb.Storage
+}
@@ -111,6 +111,9 @@ func (s *Service) CreateGroup(ctx context.Context, req types.CreateTargetGroupRe
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
+	if req.RoutingStrategy != nil {
+		group.RoutingStrategy = target.RoutingStrategy(*req.RoutingStrategy)
+	}
 	//based on the target schema provider type set the Icon
 
 	log.Debugw("saving target group", "group", group)
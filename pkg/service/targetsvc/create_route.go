@@ -30,6 +30,7 @@ func (s *Service) CreateRoute(ctx context.Context, group string, req types.Creat
 		// hardcoded mode until multi mode is supported
 		Kind:     req.Kind,
 		Priority: req.Priority,
+		Weight:   req.Weight,
 		// invalid initially, healthcheck service will update this async
 		Valid: false,
 	}
@@ -10,6 +10,11 @@ var (
 	// ErrNoGrant is returned when attempting to revoke a request which has no grant yet
 	ErrNoGrant = errors.New("request has no grant")
 	// ErrNoGrant is returned when attempting to revoke a request which has no grant yet
+
+	// ErrGrantCannotBeExtended is returned when attempting to extend a grant which is not active
+	ErrGrantCannotBeExtended = errors.New("only active grants can be extended")
+	// ErrExtensionNotSupported is returned by runtimes which cannot extend grants for built-in providers
+	ErrExtensionNotSupported = errors.New("extending grants is only supported for target groups")
 )
 
 // GrantValidationError is returned if grantValidation fails
@@ -0,0 +1,82 @@
+package workflowsvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtendGrant(t *testing.T) {
+	type testcase struct {
+		name        string
+		giveRequest access.Request
+		giveEnd     time.Time
+		want        *access.Grant
+		wantErr     error
+	}
+	clk := clock.NewMock()
+	end := clk.Now().Add(time.Hour)
+	newEnd := end.Add(time.Hour)
+	targetGroupRule := rule.AccessRule{Target: rule.Target{TargetGroupID: "123"}}
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			giveRequest: access.Request{
+				ID:    "req_1",
+				Grant: &access.Grant{Status: ahTypes.GrantStatusACTIVE, End: end},
+			},
+			giveEnd: newEnd,
+			want:    &access.Grant{Status: ahTypes.GrantStatusACTIVE, End: newEnd, UpdatedAt: clk.Now()},
+		},
+		{
+			name:        "no grant",
+			giveRequest: access.Request{ID: "req_1"},
+			giveEnd:     newEnd,
+			wantErr:     ErrNoGrant,
+		},
+		{
+			name: "grant is not active",
+			giveRequest: access.Request{
+				ID:    "req_1",
+				Grant: &access.Grant{Status: ahTypes.GrantStatusPENDING, End: end},
+			},
+			giveEnd: newEnd,
+			wantErr: ErrGrantCannotBeExtended,
+		},
+		{
+			name: "end must be after the current end",
+			giveRequest: access.Request{
+				ID:    "req_1",
+				Grant: &access.Grant{Status: ahTypes.GrantStatusACTIVE, End: end},
+			},
+			giveEnd: end,
+			wantErr: GrantValidationError{ValidationFailureMsg: "the extended end time must be after the current end time of the grant"},
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			runtime := mocks.NewMockRuntime(ctrl)
+			runtime.EXPECT().Extend(gomock.Any(), "req_1", tc.giveEnd, true).Return(nil).AnyTimes()
+
+			s := Service{
+				Runtime: runtime,
+				Clk:     clk,
+			}
+			got, err := s.Extend(context.Background(), tc.giveRequest, targetGroupRule, tc.giveEnd)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
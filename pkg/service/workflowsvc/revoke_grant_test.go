@@ -157,6 +157,7 @@ func TestRevokeGrant(t *testing.T) {
 			c.MockQueryWithErr(&storage.GetUser{Result: tc.withUser}, tc.wantUserErr)
 			c.MockQueryWithErr(&storage.GetAccessRuleVersion{Result: &tc.getRule}, tc.withGetRuleVersionResponseErr)
 			c.MockQueryWithErr(&storage.ListRequestReviewers{Result: tc.requestReviewers}, tc.wantUserErr)
+			c.MockQueryWithErr(&storage.GetGrantRetry{}, ddb.ErrNoItems)
 
 			s := Service{
 				Runtime:  runtime,
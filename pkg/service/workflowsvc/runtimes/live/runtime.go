@@ -7,17 +7,21 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	aws_config "github.com/aws/aws-sdk-go-v2/config"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	sfntypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
 	"github.com/common-fate/apikit/logger"
 	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
 	"github.com/common-fate/common-fate/pkg/cfaws"
 	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/common-fate/common-fate/pkg/handler"
 	"github.com/common-fate/common-fate/pkg/service/requestroutersvc"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
 	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/targetgroupgranter"
 	"github.com/common-fate/ddb"
@@ -87,6 +91,21 @@ func (r *Runtime) Revoke(ctx context.Context, grantID string, isForTargetGroup b
 	return r.revokeProvider(ctx, grantID)
 }
 
+// Extend extends an active grant.
+//
+// For target groups, the step function reaches the original end time and invokes the granter to deactivate access.
+// The granter reads the end time of the grant from the database, and if it has been extended, the workflow
+// waits for the new end time rather than deactivating access.
+// So there is nothing to update here other than the grant in the database, which is saved by the caller.
+//
+// Grants for built-in providers are managed by the access handler, which does not support extending grants.
+func (r *Runtime) Extend(ctx context.Context, grantID string, end time.Time, isForTargetGroup bool) error {
+	if !isForTargetGroup {
+		return workflowsvc.ErrExtensionNotSupported
+	}
+	return nil
+}
+
 func BuildExecutionARN(stateMachineARN string, grantID string) string {
 
 	splitARN := strings.Split(stateMachineARN, ":")
@@ -111,9 +130,19 @@ func (r *Runtime) revokeTargetGroup(ctx context.Context, grantID string) error {
 	exeARN := BuildExecutionARN(r.StateMachineARN, grantID)
 
 	out, err := sfnClient.DescribeExecution(ctx, &sfn.DescribeExecutionInput{ExecutionArn: aws.String(exeARN)})
+	var notFound *sfntypes.ExecutionDoesNotExist
+	if errors.As(err, &notFound) {
+		// the execution may have been deleted, in which case access is removed using the saved grant state.
+		return r.revokeFromState(ctx, grantID, err)
+	}
 	if err != nil {
 		return err
 	}
+	if out.Status != sfntypes.ExecutionStatusRunning {
+		// the execution stops if activating the grant fails, and activation is then retried outside of it,
+		// so access is removed using the saved grant state rather than by stopping the execution.
+		return r.revokeFromState(ctx, grantID, nil)
+	}
 
 	//build the previous grant from the execution input
 	var input targetgroupgranter.WorkflowInput
@@ -136,7 +165,17 @@ func (r *Runtime) revokeTargetGroup(ctx context.Context, grantID string) error {
 	if err != nil {
 		return err
 	}
-	routeResult, err := r.RequestRouter.Route(ctx, *tgq.Result)
+	// access is removed through the handler which provisioned it, which is recorded in the saved grant state.
+	sq := storage.GetRequestGrantState{RequestID: grantID}
+	_, err = r.DB.Query(ctx, &sq)
+	if err != nil && err != ddb.ErrNoItems {
+		return err
+	}
+	var savedState *access.GrantState
+	if err == nil {
+		savedState = sq.Result
+	}
+	routeResult, err := r.RequestRouter.GrantRoute(ctx, *tgq.Result, savedState)
 	if err != nil {
 		return err
 	}
@@ -149,14 +188,7 @@ func (r *Runtime) revokeTargetGroup(ctx context.Context, grantID string) error {
 	//if the state of the grant is in the active state
 	if lastState.Type == "WaitStateEntered" && *lastState.StateEnteredEventDetails.Name == "Wait for Window End" {
 
-		// Pull the state from the output of the activate step so it can be used when revoking access
-		exitActivateStepEvent := statefn.Events[len(statefn.Events)-2]
-		if exitActivateStepEvent.Type != "TaskStateExited" || exitActivateStepEvent.StateExitedEventDetails == nil {
-			return errors.New("unexpected workflow state")
-		}
-
-		var gs targetgroupgranter.GrantState
-		err = json.Unmarshal([]byte(aws.ToString(exitActivateStepEvent.StateExitedEventDetails.Output)), &gs)
+		state, err := grantState(savedState, statefn.Events)
 		if err != nil {
 			return err
 		}
@@ -170,7 +202,7 @@ func (r *Runtime) revokeTargetGroup(ctx context.Context, grantID string) error {
 			Request: msg.AccessRequest{
 				ID: grantID,
 			},
-			State: gs.State,
+			State: state,
 		}
 
 		err = runtime.Revoke(ctx, req)
@@ -187,6 +219,79 @@ func (r *Runtime) revokeTargetGroup(ctx context.Context, grantID string) error {
 	return nil
 }
 
+// revokeFromState removes access for a grant using the state and handler which were saved when it was activated,
+// for grants whose workflow execution no longer exists or has stopped.
+// If the grant was never activated, executionErr is returned, which is nil if there is no access to remove.
+func (r *Runtime) revokeFromState(ctx context.Context, grantID string, executionErr error) error {
+	sq := storage.GetRequestGrantState{RequestID: grantID}
+	_, err := r.DB.Query(ctx, &sq)
+	if err == ddb.ErrNoItems {
+		// there is no record of the grant being activated, so there is no access to remove.
+		return executionErr
+	}
+	if err != nil {
+		return err
+	}
+	rq := storage.GetRequest{ID: grantID}
+	_, err = r.DB.Query(ctx, &rq)
+	if err != nil {
+		return err
+	}
+	if rq.Result.Grant == nil {
+		return workflowsvc.ErrNoGrant
+	}
+	hq := storage.GetHandler{ID: sq.Result.HandlerID}
+	_, err = r.DB.Query(ctx, &hq)
+	if err != nil {
+		return err
+	}
+	runtime, err := handler.GetRuntime(ctx, *hq.Result)
+	if err != nil {
+		return err
+	}
+	logger.Get(ctx).Infow("revoking grant using saved grant state", "grant.id", grantID, "handler", sq.Result.HandlerID)
+	return runtime.Revoke(ctx, msg.Revoke{
+		Subject: rq.Result.Grant.Subject,
+		Target: msg.Target{
+			Kind:      sq.Result.Kind,
+			Arguments: rq.Result.Grant.With.AdditionalProperties,
+		},
+		Request: msg.AccessRequest{
+			ID: grantID,
+		},
+		State: sq.Result.State,
+	})
+}
+
+// grantState returns the state which the handler returned when it provisioned access for the grant.
+// The state is saved when the grant is activated. Grants which were activated before the state was saved
+// fall back to the output of the most recent task in the workflow execution.
+func grantState(savedState *access.GrantState, events []sfntypes.HistoryEvent) (map[string]any, error) {
+	if savedState != nil {
+		return savedState.State, nil
+	}
+
+	// This is usually the activate step, but if the grant has been extended it will be the expire step,
+	// which passes the state through when it skips deactivating access.
+	var exitActivateStepEvent *sfntypes.HistoryEvent
+	for i := len(events) - 2; i >= 0; i-- {
+		if events[i].Type == "TaskStateExited" {
+			exitActivateStepEvent = &events[i]
+			break
+		}
+	}
+	if exitActivateStepEvent == nil || exitActivateStepEvent.StateExitedEventDetails == nil {
+		return nil, errors.New("unexpected workflow state")
+	}
+
+	var gs targetgroupgranter.GrantState
+	err := json.Unmarshal([]byte(aws.ToString(exitActivateStepEvent.StateExitedEventDetails.Output)), &gs)
+	if err != nil {
+		return nil, err
+	}
+	return gs.State, nil
+}
+
 func (r *Runtime) revokeProvider(ctx context.Context, grantID string) error {
 	response, err := r.AHClient.PostGrantsRevokeWithResponse(ctx, grantID, ahTypes.PostGrantsRevokeJSONRequestBody{
 		// @Note revoker ID is unused in the access handler code so it has been left empty here as it will not be included in this new runtime interface
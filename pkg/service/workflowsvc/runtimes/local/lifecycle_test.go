@@ -0,0 +1,138 @@
+package local
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/eventhandler"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	accessmocks "github.com/common-fate/common-fate/pkg/service/accesssvc/mocks"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// requestStore returns the most recently saved request from GetRequest queries,
+// so that the services in the test see each other's changes to the request.
+type requestStore struct {
+	ddb.Storage
+	mock *ddbmock.Client
+}
+
+func (s *requestStore) Put(ctx context.Context, item ddb.Keyer) error {
+	return s.PutBatch(ctx, item)
+}
+
+func (s *requestStore) PutBatch(ctx context.Context, items ...ddb.Keyer) error {
+	for _, item := range items {
+		if req, ok := item.(*access.Request); ok {
+			saved := *req
+			s.mock.MockQuery(&storage.GetRequest{Result: &saved})
+		}
+	}
+	return s.Storage.PutBatch(ctx, items...)
+}
+
+// TestRequestLifecycle runs a request from being created through to its grant expiring,
+// with the grant events handled in process by the event handler.
+func TestRequestLifecycle(t *testing.T) {
+	ctx := context.Background()
+	clk := clock.NewMock()
+	clk.Set(time.Date(2022, 1, 3, 9, 0, 0, 0, time.UTC))
+
+	accessRule := rule.AccessRule{ID: "rul_1", Version: "1", Status: rule.ACTIVE, Groups: []string{"everyone"},
+		Approval:        rule.Approval{Users: []string{"b"}},
+		Target:          rule.Target{ProviderID: "test"},
+		TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 3600},
+	}
+
+	ctrl := gomock.NewController(t)
+	rules := accessmocks.NewMockAccessRuleService(ctrl)
+	rules.EXPECT().RequestArguments(gomock.Any(), accessRule.Target).Return(map[string]types.RequestArgument{}, nil)
+	ep := accessmocks.NewMockEventPutter(ctrl)
+	ep.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	c := ddbmock.New(t)
+	c.MockQuery(&storage.GetAccessRuleCurrent{Result: &accessRule})
+	c.MockQuery(&storage.GetUser{Result: &identity.User{ID: "a", Email: "a@example.com", Groups: []string{"everyone"}}})
+	c.MockQuery(&storage.ListDelegations{})
+	c.MockQuery(&storage.ListRequestReviewers{})
+	c.MockQueryWithErr(&storage.ListRequestsForUserAndRequestend{}, ddb.ErrNoItems)
+	db := &requestStore{Storage: c, mock: c}
+
+	eh, err := eventhandler.New(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runtime := &Runtime{
+		Clock:    clk,
+		Eventbus: &gevent.LocalSender{Clock: clk, Handler: eh},
+	}
+	s := accesssvc.Service{
+		Clock:       clk,
+		DB:          db,
+		EventPutter: ep,
+		Rules:       rules,
+		Workflow: &workflowsvc.Service{
+			Runtime:  runtime,
+			DB:       db,
+			Clk:      clk,
+			Eventbus: ep,
+		},
+	}
+	grantStatus := func() ahTypes.GrantStatus {
+		q := storage.GetRequest{}
+		_, err := db.Query(ctx, &q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if q.Result.Grant == nil {
+			return ""
+		}
+		return q.Result.Grant.Status
+	}
+
+	created, err := s.CreateRequests(ctx, accesssvc.CreateRequestsOpts{
+		User:   identity.User{ID: "a", Email: "a@example.com", Groups: []string{"everyone"}},
+		Create: accesssvc.CreateRequests{AccessRuleId: "rul_1", Timing: types.RequestTiming{DurationSeconds: 3600}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := created[0].Request
+	assert.Equal(t, access.PENDING, req.Status)
+
+	reviewed, err := s.AddReviewAndGrantAccess(ctx, accesssvc.AddReviewOpts{
+		ReviewerID: "b",
+		Reviewers:  created[0].Reviewers,
+		Decision:   access.DecisionApproved,
+		Request:    req,
+		AccessRule: accessRule,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, access.APPROVED, reviewed.Request.Status)
+	assert.Equal(t, ahTypes.GrantStatusPENDING, grantStatus())
+
+	// the grant starts straight away, and the created event, which arrived before the grant was saved, is retried.
+	clk.Add(time.Second)
+	assert.Equal(t, ahTypes.GrantStatusACTIVE, grantStatus())
+
+	clk.Add(30 * time.Minute)
+	assert.Equal(t, ahTypes.GrantStatusACTIVE, grantStatus())
+
+	clk.Add(30 * time.Minute)
+	assert.Equal(t, ahTypes.GrantStatusEXPIRED, grantStatus())
+}
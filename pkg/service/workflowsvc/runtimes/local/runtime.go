@@ -1,18 +1,206 @@
-package mock
+package local
 
 import (
 	"context"
+	"errors"
+	"sync"
+	"time"
 
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/logger"
 	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
+	"github.com/common-fate/iso8601"
 )
 
+// ErrGrantNotFound is returned when revoking or extending a grant which the runtime isn't running.
+var ErrGrantNotFound = errors.New("grant not found")
+
+// Runtime runs grants in process, for tests and local development.
+//
+// Grants are activated and deactivated by timers on Clock rather than by a workflow in AWS Step Functions.
+// When Clock is a mock clock, grants only change state when the clock is advanced,
+// so the events for a set of grants are always emitted in the same order.
 type Runtime struct {
+	Clock    clock.Clock
+	Eventbus workflowsvc.EventPutter
+	// Provisioner is optional. If it is set, it is called to provision and remove access
+	// when grants are activated and deactivated.
+	Provisioner Provisioner
+
+	mu     sync.Mutex
+	grants map[string]*runningGrant
+}
+
+// Provisioner provisions access for grants run by the local runtime,
+// taking the place of the access handler and target group handlers.
+type Provisioner interface {
+	Activate(ctx context.Context, grant ahTypes.Grant) error
+	Deactivate(ctx context.Context, grant ahTypes.Grant) error
+}
+
+type runningGrant struct {
+	grant ahTypes.Grant
+	// timer activates the grant while it is pending, and deactivates it while it is active.
+	timer *clock.Timer
 }
 
+// Grant schedules the grant to be activated at its start time.
+// The created event is emitted before the grant is scheduled, as it is by the first step of the grant workflows.
 func (r *Runtime) Grant(ctx context.Context, grant ahTypes.CreateGrant, isForTargetGroup bool) error {
+	r.mu.Lock()
+	if r.grants == nil {
+		r.grants = make(map[string]*runningGrant)
+	}
+	if _, ok := r.grants[grant.Id]; ok {
+		r.mu.Unlock()
+		return errors.New("grant already exists")
+	}
+	rg := &runningGrant{
+		grant: ahTypes.Grant{
+			ID:       grant.Id,
+			Provider: grant.Provider,
+			Subject:  grant.Subject,
+			With:     ahTypes.Grant_With(grant.With),
+			Start:    grant.Start,
+			End:      grant.End,
+			Status:   ahTypes.GrantStatusPENDING,
+		},
+	}
+	r.grants[grant.Id] = rg
+	created := rg.grant
+	r.mu.Unlock()
+
+	err := r.Eventbus.Put(ctx, &gevent.GrantCreated{Grant: created, IdempotencyKey: gevent.GrantIdempotencyKey(grant.Id, gevent.GrantCreatedType, "CREATE")})
+	if err != nil {
+		r.mu.Lock()
+		delete(r.grants, grant.Id)
+		r.mu.Unlock()
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rg.timer = r.Clock.AfterFunc(r.until(grant.Start.Time), func() { r.activate(grant.Id) })
 	return nil
 }
 
+// Revoke stops the grant from being activated or deactivated, and removes access if it is active.
 func (r *Runtime) Revoke(ctx context.Context, grantID string, isForTargetGroup bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rg, ok := r.grants[grantID]
+	if !ok {
+		return ErrGrantNotFound
+	}
+	if rg.grant.Status == ahTypes.GrantStatusACTIVE && r.Provisioner != nil {
+		err := r.Provisioner.Deactivate(ctx, rg.grant)
+		if err != nil {
+			return err
+		}
+	}
+	if rg.grant.Status == ahTypes.GrantStatusPENDING || rg.grant.Status == ahTypes.GrantStatusACTIVE {
+		rg.timer.Stop()
+	}
+	rg.grant.Status = ahTypes.GrantStatusREVOKED
+	return nil
+}
+
+// Extend moves the time at which an active target group grant is deactivated.
+func (r *Runtime) Extend(ctx context.Context, grantID string, end time.Time, isForTargetGroup bool) error {
+	if !isForTargetGroup {
+		return workflowsvc.ErrExtensionNotSupported
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rg, ok := r.grants[grantID]
+	if !ok {
+		return ErrGrantNotFound
+	}
+	if rg.grant.Status != ahTypes.GrantStatusACTIVE {
+		return workflowsvc.ErrGrantCannotBeExtended
+	}
+	rg.timer.Stop()
+	rg.grant.End = iso8601.New(end)
+	rg.timer = r.Clock.AfterFunc(r.until(end), func() { r.deactivate(grantID) })
 	return nil
 }
+
+// Get returns the current state of a grant run by the runtime.
+func (r *Runtime) Get(grantID string) (ahTypes.Grant, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rg, ok := r.grants[grantID]
+	if !ok {
+		return ahTypes.Grant{}, false
+	}
+	return rg.grant, true
+}
+
+func (r *Runtime) activate(grantID string) {
+	ctx := context.Background()
+	evt := func() gevent.EventTyper {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		rg, ok := r.grants[grantID]
+		if !ok || rg.grant.Status != ahTypes.GrantStatusPENDING {
+			return nil
+		}
+		logger.Get(ctx).Infow("activating grant", "grant", rg.grant)
+		if r.Provisioner != nil {
+			err := r.Provisioner.Activate(ctx, rg.grant)
+			if err != nil {
+				rg.grant.Status = ahTypes.GrantStatusERROR
+				return gevent.GrantFailed{Grant: rg.grant, Reason: err.Error(), IdempotencyKey: gevent.GrantIdempotencyKey(grantID, gevent.GrantFailedType, "ACTIVATE")}
+			}
+		}
+		rg.grant.Status = ahTypes.GrantStatusACTIVE
+		rg.timer = r.Clock.AfterFunc(r.until(rg.grant.End.Time), func() { r.deactivate(grantID) })
+		return &gevent.GrantActivated{Grant: rg.grant, IdempotencyKey: gevent.GrantIdempotencyKey(grantID, gevent.GrantActivatedType, "ACTIVATE")}
+	}()
+	r.put(ctx, evt)
+}
+
+func (r *Runtime) deactivate(grantID string) {
+	ctx := context.Background()
+	evt := func() gevent.EventTyper {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		rg, ok := r.grants[grantID]
+		if !ok || rg.grant.Status != ahTypes.GrantStatusACTIVE {
+			return nil
+		}
+		logger.Get(ctx).Infow("deactivating grant", "grant", rg.grant)
+		if r.Provisioner != nil {
+			err := r.Provisioner.Deactivate(ctx, rg.grant)
+			if err != nil {
+				rg.grant.Status = ahTypes.GrantStatusERROR
+				return gevent.GrantFailed{Grant: rg.grant, Reason: err.Error(), IdempotencyKey: gevent.GrantIdempotencyKey(grantID, gevent.GrantFailedType, "DEACTIVATE")}
+			}
+		}
+		rg.grant.Status = ahTypes.GrantStatusEXPIRED
+		return &gevent.GrantExpired{Grant: rg.grant, IdempotencyKey: gevent.GrantIdempotencyKey(grantID, gevent.GrantExpiredType, "DEACTIVATE")}
+	}()
+	r.put(ctx, evt)
+}
+
+// put emits an event from a timer, where there is no caller to return an error to.
+// Events are emitted without holding the lock, so that the event handler can call back into the runtime.
+func (r *Runtime) put(ctx context.Context, evt gevent.EventTyper) {
+	if evt == nil {
+		return
+	}
+	err := r.Eventbus.Put(ctx, evt)
+	if err != nil {
+		logger.Get(ctx).Errorw("failed to emit event", "event", evt, "error", err)
+	}
+}
+
+func (r *Runtime) until(t time.Time) time.Duration {
+	d := t.Sub(r.Clock.Now())
+	if d < 0 {
+		return 0
+	}
+	return d
+}
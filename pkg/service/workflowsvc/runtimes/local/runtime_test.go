@@ -0,0 +1,164 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/iso8601"
+	"github.com/stretchr/testify/assert"
+)
+
+// eventRecorder records the types of the events which are emitted, in order.
+type eventRecorder struct {
+	events []string
+}
+
+func (e *eventRecorder) Put(ctx context.Context, detail gevent.EventTyper) error {
+	e.events = append(e.events, detail.EventType())
+	return nil
+}
+
+type failingProvisioner struct{}
+
+func (failingProvisioner) Activate(ctx context.Context, grant ahTypes.Grant) error {
+	return errors.New("handler unavailable")
+}
+
+func (failingProvisioner) Deactivate(ctx context.Context, grant ahTypes.Grant) error {
+	return nil
+}
+
+func TestRuntime(t *testing.T) {
+	type testcase struct {
+		name             string
+		isForTargetGroup bool
+		withProvisioner  Provisioner
+		// run is called after the grant has been created, and advances the clock.
+		run        func(t *testing.T, clk *clock.Mock, r *Runtime)
+		wantEvents []string
+		wantStatus ahTypes.GrantStatus
+	}
+
+	testcases := []testcase{
+		{
+			name: "grant is activated and expires",
+			run: func(t *testing.T, clk *clock.Mock, r *Runtime) {
+				clk.Add(time.Minute)
+				clk.Add(time.Hour)
+			},
+			wantEvents: []string{gevent.GrantCreatedType, gevent.GrantActivatedType, gevent.GrantExpiredType},
+			wantStatus: ahTypes.GrantStatusEXPIRED,
+		},
+		{
+			name: "grant isn't activated before it starts",
+			run: func(t *testing.T, clk *clock.Mock, r *Runtime) {
+				clk.Add(30 * time.Second)
+			},
+			wantEvents: []string{gevent.GrantCreatedType},
+			wantStatus: ahTypes.GrantStatusPENDING,
+		},
+		{
+			name:             "target group grant is extended",
+			isForTargetGroup: true,
+			run: func(t *testing.T, clk *clock.Mock, r *Runtime) {
+				clk.Add(time.Minute)
+				err := r.Extend(context.Background(), "req_1", clk.Now().Add(2*time.Hour), true)
+				assert.NoError(t, err)
+				clk.Add(time.Hour)
+				g, _ := r.Get("req_1")
+				assert.Equal(t, ahTypes.GrantStatusACTIVE, g.Status)
+				clk.Add(time.Hour)
+			},
+			wantEvents: []string{gevent.GrantCreatedType, gevent.GrantActivatedType, gevent.GrantExpiredType},
+			wantStatus: ahTypes.GrantStatusEXPIRED,
+		},
+		{
+			name: "revoked grant isn't expired",
+			run: func(t *testing.T, clk *clock.Mock, r *Runtime) {
+				clk.Add(time.Minute)
+				err := r.Revoke(context.Background(), "req_1", false)
+				assert.NoError(t, err)
+				clk.Add(time.Hour)
+			},
+			wantEvents: []string{gevent.GrantCreatedType, gevent.GrantActivatedType},
+			wantStatus: ahTypes.GrantStatusREVOKED,
+		},
+		{
+			name:            "activation fails",
+			withProvisioner: failingProvisioner{},
+			run: func(t *testing.T, clk *clock.Mock, r *Runtime) {
+				clk.Add(time.Hour * 2)
+			},
+			wantEvents: []string{gevent.GrantCreatedType, gevent.GrantFailedType},
+			wantStatus: ahTypes.GrantStatusERROR,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			clk := clock.NewMock()
+			events := &eventRecorder{}
+			r := &Runtime{Clock: clk, Eventbus: events, Provisioner: tc.withProvisioner}
+
+			err := r.Grant(context.Background(), ahTypes.CreateGrant{
+				Id:       "req_1",
+				Provider: "test",
+				Subject:  "alice@example.com",
+				Start:    iso8601.New(clk.Now().Add(time.Minute)),
+				End:      iso8601.New(clk.Now().Add(time.Hour)),
+			}, tc.isForTargetGroup)
+			assert.NoError(t, err)
+
+			tc.run(t, clk, r)
+
+			assert.Equal(t, tc.wantEvents, events.events)
+			g, ok := r.Get("req_1")
+			assert.True(t, ok)
+			assert.Equal(t, tc.wantStatus, g.Status)
+		})
+	}
+}
+
+// TestWorkflow runs a grant through the workflow service using the local runtime,
+// checking the order of the events which are emitted as the clock is advanced.
+func TestWorkflow(t *testing.T) {
+	clk := clock.NewMock()
+	events := &eventRecorder{}
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.GetUser{Result: &identity.User{ID: "usr_1", Email: "alice@example.com"}})
+	s := workflowsvc.Service{
+		Runtime:  &Runtime{Clock: clk, Eventbus: events},
+		DB:       db,
+		Clk:      clk,
+		Eventbus: events,
+	}
+
+	req := access.Request{
+		ID:          "req_1",
+		RequestedBy: "usr_1",
+		RequestedTiming: access.Timing{
+			Duration: time.Hour,
+		},
+	}
+	rul := rule.AccessRule{Target: rule.Target{ProviderID: "test", TargetGroupID: "test"}}
+
+	grant, err := s.Grant(context.Background(), req, rul)
+	assert.NoError(t, err)
+	assert.Equal(t, clk.Now().Add(time.Hour), grant.End)
+
+	clk.Add(0)
+	clk.Add(time.Hour)
+	assert.Equal(t, []string{gevent.GrantCreatedType, gevent.GrantActivatedType, gevent.GrantExpiredType}, events.events)
+}
@@ -31,3 +31,8 @@ func (r *Runtime) Grant(ctx context.Context, grant ahTypes.CreateGrant, isForTar
 func (r *Runtime) Revoke(ctx context.Context, grantID string, isForTargetGroup bool) error {
 	return nil
 }
+
+func (r *Runtime) Extend(ctx context.Context, grantID string, end time.Time, isForTargetGroup bool) error {
+	logger.Get(ctx).Infow("extending grant", "grant.id", grantID, "end", end)
+	return nil
+}
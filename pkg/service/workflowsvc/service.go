@@ -2,8 +2,10 @@ package workflowsvc
 
 import (
 	"context"
+	"time"
 
 	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/logger"
 	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
 	"github.com/common-fate/common-fate/pkg/access"
 	"github.com/common-fate/common-fate/pkg/gevent"
@@ -23,6 +25,9 @@ type Runtime interface {
 	// isForTargetGroup tells the runtime how to process the request
 	// revoke is expected to be syncronous
 	Revoke(ctx context.Context, grantID string, isForTargetGroup bool) error
+	// isForTargetGroup tells the runtime how to process the request
+	// extend moves the end time of an active grant to the provided time
+	Extend(ctx context.Context, grantID string, end time.Time, isForTargetGroup bool) error
 }
 
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/eventputter.go -package=mocks . EventPutter
@@ -47,26 +52,8 @@ func (s *Service) Grant(ctx context.Context, request access.Request, accessRule
 		return nil, err
 	}
 
-	// @TODO because v1 access providers sends this event in the rest API method
-	// We have to skip sending here here unless it is for a target group
-	// in which case the event can be sent from here
-	// There is still some issues with a race condition here, for asap requests, the grant could start in step functions before this event it sent.
-	// or they could arrive at the same times, if instead this event is produced by the step functions or local mock workflow, then the race condition won't exist
-	// SHIFT THIS TO THE STEP FUNCTION
-	if accessRule.Target.IsForTargetGroup() {
-		err = s.Eventbus.Put(ctx, &gevent.GrantCreated{Grant: ahTypes.Grant{
-			ID:       createGrant.Id,
-			Provider: createGrant.Provider,
-			End:      createGrant.End,
-			Start:    createGrant.Start,
-			Status:   ahTypes.GrantStatusPENDING,
-			Subject:  createGrant.Subject,
-			With:     ahTypes.Grant_With(createGrant.With),
-		}})
-		if err != nil {
-			return nil, err
-		}
-	}
+	// the grant created event is emitted by the runtime's workflow rather than here,
+	// so that it can't arrive after the grant has been activated.
 	now := s.Clk.Now()
 	return &access.Grant{
 		Provider:  createGrant.Provider,
@@ -104,6 +91,13 @@ func (s *Service) Revoke(ctx context.Context, request access.Request, revokerID
 	}
 	err = s.Runtime.Revoke(ctx, request.ID, q.Result.Target.IsForTargetGroup())
 	if err != nil {
+		// access may still be provisioned, so the revocation is retried until it succeeds or is abandoned by an administrator.
+		retry := access.NewGrantRetry(request.ID, access.GrantRetryRevoke, s.Clk.Now())
+		retry.Actor = &revokerID
+		_, retryErr := dbupdate.RecordGrantRetryFailure(ctx, s.DB, retry, err, s.Clk.Now())
+		if retryErr != nil {
+			logger.Get(ctx).Errorw("failed to schedule retry for grant revocation", "request.id", request.ID, "error", retryErr)
+		}
 		return nil, err
 	}
 
@@ -135,6 +129,28 @@ func (s *Service) Revoke(ctx context.Context, request access.Request, revokerID
 	return nil, nil
 }
 
+// Extend moves the end time of an active grant.
+// The updated grant is returned, it is the responsibility of the caller to save it along with the request.
+func (s *Service) Extend(ctx context.Context, request access.Request, accessRule rule.AccessRule, end time.Time) (*access.Grant, error) {
+	if request.Grant == nil {
+		return nil, ErrNoGrant
+	}
+	if request.Grant.Status != ahTypes.GrantStatusACTIVE || request.Grant.End.Before(s.Clk.Now()) {
+		return nil, ErrGrantCannotBeExtended
+	}
+	if !end.After(request.Grant.End) {
+		return nil, GrantValidationError{ValidationFailureMsg: "the extended end time must be after the current end time of the grant"}
+	}
+	err := s.Runtime.Extend(ctx, request.ID, end, accessRule.Target.IsForTargetGroup())
+	if err != nil {
+		return nil, err
+	}
+	grant := *request.Grant
+	grant.End = end
+	grant.UpdatedAt = s.Clk.Now()
+	return &grant, nil
+}
+
 // prepareCreateGrantRequest prepares the data for requesting
 func (s *Service) prepareCreateGrantRequest(ctx context.Context, request access.Request, accessRule rule.AccessRule) (ahTypes.CreateGrant, error) {
 	q := &storage.GetUser{
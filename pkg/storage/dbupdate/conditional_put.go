@@ -0,0 +1,88 @@
+package dbupdate
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/ddb"
+)
+
+// ErrConditionFailed is returned by PutIf when the item in the table doesn't meet the condition.
+var ErrConditionFailed = errors.New("conditional check failed")
+
+// Condition is a DynamoDB condition expression, such as "attribute_not_exists(PK)" or "#status = :status".
+type Condition struct {
+	Expression string
+	// Names are the expression attribute names, used for attributes which are DynamoDB reserved words such as status.
+	Names map[string]string
+	// Values are the expression attribute values. They are marshalled in the same way as the item.
+	Values map[string]any
+}
+
+// ItemNotExists is the condition for creating an item only if it doesn't already exist.
+var ItemNotExists = Condition{Expression: "attribute_not_exists(PK)"}
+
+// PutIf writes an item only if the existing item meets the condition, returning ErrConditionFailed if it doesn't.
+//
+// The ddb library doesn't support condition expressions, so the item is written with the underlying DynamoDB client.
+// The mock storage used in unit tests doesn't have a DynamoDB client, in which case the item is written with Put.
+func PutIf(ctx context.Context, db ddb.Storage, item ddb.Keyer, cond Condition) error {
+	client := db.Client()
+	if client == nil {
+		return db.Put(ctx, item)
+	}
+	av, err := marshalItem(item)
+	if err != nil {
+		return err
+	}
+	in := dynamodb.PutItemInput{
+		TableName:           aws.String(db.Table()),
+		Item:                av,
+		ConditionExpression: aws.String(cond.Expression),
+	}
+	if len(cond.Names) > 0 {
+		in.ExpressionAttributeNames = cond.Names
+	}
+	if len(cond.Values) > 0 {
+		in.ExpressionAttributeValues = make(map[string]types.AttributeValue)
+		for k, v := range cond.Values {
+			in.ExpressionAttributeValues[k], err = attributevalue.Marshal(v)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	_, err = client.PutItem(ctx, &in)
+	var ccf *types.ConditionalCheckFailedException
+	if errors.As(err, &ccf) {
+		return ErrConditionFailed
+	}
+	return err
+}
+
+// marshalItem marshals an item along with its keys and entity type, matching the way ddb writes items.
+func marshalItem(item ddb.Keyer) (map[string]types.AttributeValue, error) {
+	keys, err := item.DDBKeys()
+	if err != nil {
+		return nil, err
+	}
+	av, err := attributevalue.MarshalMap(item)
+	if err != nil {
+		return nil, err
+	}
+	v := reflect.ValueOf(keys)
+	for i := 0; i < v.NumField(); i++ {
+		if val := v.Field(i).String(); val != "" {
+			av[v.Type().Field(i).Name] = &types.AttributeValueMemberS{Value: val}
+		}
+	}
+	if et, ok := item.(ddb.EntityTyper); ok {
+		av["ddb:type"] = &types.AttributeValueMemberS{Value: et.EntityType()}
+	}
+	return av, nil
+}
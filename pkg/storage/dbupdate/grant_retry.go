@@ -0,0 +1,37 @@
+package dbupdate
+
+import (
+	"context"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+)
+
+// RecordGrantRetryFailure records a failed change to a grant, scheduling it to be retried.
+//
+// If the change is already being retried, the failure is counted as another attempt of the existing retry,
+// so that repeated failures back off and are eventually marked as exhausted.
+// The saved retry is returned.
+func RecordGrantRetryFailure(ctx context.Context, db ddb.Storage, retry access.GrantRetry, cause error, now time.Time) (*access.GrantRetry, error) {
+	q := storage.GetGrantRetry{ID: retry.ID}
+	_, err := db.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		return nil, err
+	}
+	if err == nil && q.Result.IsOpen() {
+		retry.Attempts = q.Result.Attempts
+		retry.MaxAttempts = q.Result.MaxAttempts
+		retry.CreatedAt = q.Result.CreatedAt
+		if retry.Actor == nil {
+			retry.Actor = q.Result.Actor
+		}
+	}
+	retry.RecordFailure(cause, now)
+	err = db.Put(ctx, &retry)
+	if err != nil {
+		return nil, err
+	}
+	return &retry, nil
+}
@@ -22,6 +22,16 @@ func WithReviewers(r []access.Reviewer) func(*UpdateRequestOpts) {
 // GetUpdateRequestItems returns a slice of ddb.keyers which needs to be written to update this request
 // all the items returned have been updated with the input request
 func GetUpdateRequestItems(ctx context.Context, db ddb.Storage, r access.Request, opts ...func(*UpdateRequestOpts)) ([]ddb.Keyer, error) {
+	reviewers, err := GetUpdateReviewerItems(ctx, db, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return append([]ddb.Keyer{&r}, reviewers...), nil
+}
+
+// GetUpdateReviewerItems returns the reviewer items which need to be written to update this request,
+// for callers which write the request itself separately.
+func GetUpdateReviewerItems(ctx context.Context, db ddb.Storage, r access.Request, opts ...func(*UpdateRequestOpts)) ([]ddb.Keyer, error) {
 	var o UpdateRequestOpts
 	for _, opt := range opts {
 		opt(&o)
@@ -36,12 +46,11 @@ func GetUpdateRequestItems(ctx context.Context, db ddb.Storage, r access.Request
 		o.Reviewers = rq.Result
 	}
 
-	items := make([]ddb.Keyer, len(o.Reviewers)+1)
-	items[0] = &r
+	items := make([]ddb.Keyer, len(o.Reviewers))
 	for i, rv := range o.Reviewers {
 		rvc := rv
 		rvc.Request = r
-		items[1+i] = &rvc
+		items[i] = &rvc
 	}
 	return items, nil
 }
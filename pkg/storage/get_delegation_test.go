@@ -0,0 +1,40 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbtest"
+)
+
+func TestGetDelegation(t *testing.T) {
+	db := newTestingStorage(t)
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	d := access.Delegation{
+		UserID:     types.NewUserID(),
+		DelegateID: types.NewUserID(),
+		StartsAt:   now,
+		EndsAt:     now.Add(time.Hour * 24 * 7),
+		CreatedAt:  now,
+	}
+	ddbtest.PutFixtures(t, db, &d)
+
+	tc := []ddbtest.QueryTestCase{
+		{
+			Name:  "ok",
+			Query: &GetDelegation{UserID: d.UserID},
+			Want:  &GetDelegation{UserID: d.UserID, Result: &d},
+		},
+		{
+			Name:    "delegation not found",
+			Query:   &GetDelegation{UserID: types.NewUserID()},
+			WantErr: ddb.ErrNoItems,
+		},
+	}
+
+	ddbtest.RunQueryTests(t, db, tc)
+}
@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbtest"
+)
+
+func TestGetIdentitySyncState(t *testing.T) {
+	db := newTestingStorage(t)
+
+	now := time.Now().Truncate(time.Second).UTC()
+	state := identity.SyncState{
+		IdpType:        "azure",
+		Cursor:         "cursor",
+		LastFullSyncAt: now,
+		UpdatedAt:      now,
+	}
+	ddbtest.PutFixtures(t, db, &state)
+
+	tc := []ddbtest.QueryTestCase{
+		{
+			Name:  "ok",
+			Query: &GetIdentitySyncState{IdpType: "azure"},
+			Want:  &GetIdentitySyncState{IdpType: "azure", Result: &state},
+		},
+		{
+			Name:    "not found",
+			Query:   &GetIdentitySyncState{IdpType: "okta"},
+			WantErr: ddb.ErrNoItems,
+		},
+	}
+
+	ddbtest.RunQueryTests(t, db, tc)
+}
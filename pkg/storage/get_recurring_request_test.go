@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbtest"
+)
+
+func TestGetRecurringRequest(t *testing.T) {
+	db := newTestingStorage(t)
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	rr := access.RecurringRequest{
+		ID:             types.NewRecurringRequestID(),
+		RequestedBy:    types.NewUserID(),
+		Rule:           types.NewAccessRuleID(),
+		SelectedWith:   map[string]access.Option{},
+		Recurrence:     access.Recurrence{Weekdays: []time.Weekday{time.Monday}, StartTime: "09:00", Duration: time.Hour},
+		Status:         access.RecurringRequestActive,
+		NextOccurrence: now.Add(time.Hour),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	ddbtest.PutFixtures(t, db, &rr)
+
+	tc := []ddbtest.QueryTestCase{
+		{
+			Name:  "ok",
+			Query: &GetRecurringRequest{ID: rr.ID},
+			Want:  &GetRecurringRequest{ID: rr.ID, Result: &rr},
+		},
+		{
+			Name:    "recurring request not found",
+			Query:   &GetRecurringRequest{ID: types.NewRecurringRequestID()},
+			WantErr: ddb.ErrNoItems,
+		},
+	}
+
+	ddbtest.RunQueryTests(t, db, tc)
+}
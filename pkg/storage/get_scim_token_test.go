@@ -0,0 +1,29 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/ddb/ddbtest"
+)
+
+func TestGetSCIMToken(t *testing.T) {
+	db := newTestingStorage(t)
+
+	_, token, err := identity.NewSCIMToken("usr_admin", time.Now().Truncate(time.Second).UTC())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ddbtest.PutFixtures(t, db, &token)
+
+	tc := []ddbtest.QueryTestCase{
+		{
+			Name:  "ok",
+			Query: &GetSCIMToken{},
+			Want:  &GetSCIMToken{Result: &token},
+		},
+	}
+
+	ddbtest.RunQueryTests(t, db, tc)
+}
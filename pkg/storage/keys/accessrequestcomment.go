@@ -0,0 +1,15 @@
+package keys
+
+const AccessRequestCommentKey = "ACCESS_REQUEST_COMMENT#"
+
+type accessRequestCommentKeys struct {
+	PK1        string
+	SK1        func(requestID string, commentID string) string
+	SK1Request func(requestID string) string
+}
+
+var AccessRequestComment = accessRequestCommentKeys{
+	PK1:        AccessRequestCommentKey,
+	SK1:        func(requestID string, commentID string) string { return requestID + "#" + commentID },
+	SK1Request: func(requestID string) string { return requestID + "#" },
+}
@@ -0,0 +1,13 @@
+package keys
+
+const AccessRequestGrantStateKey = "ACCESS_REQUEST_GRANT_STATE#"
+
+type accessRequestGrantStateKeys struct {
+	PK1 string
+	SK1 func(requestID string) string
+}
+
+var AccessRequestGrantState = accessRequestGrantStateKeys{
+	PK1: AccessRequestGrantStateKey,
+	SK1: func(requestID string) string { return requestID + "#" },
+}
@@ -1,13 +1,29 @@
 package keys
 
+import (
+	"time"
+
+	"github.com/common-fate/iso8601"
+)
+
 const AccessReviewKey = "ACCESS_REVIEW#"
 
 type accessReviewKeys struct {
-	PK1 func(reviewerID string) string
-	SK1 func(requestID, reviewID string) string
+	PK1    func(reviewerID string) string
+	SK1    func(requestID, reviewID string) string
+	GSI1PK func(reviewerID, ruleID string) string
+	GSI1SK func(reviewedAt time.Time, reviewID string) string
+	// GSI1SKSince is a prefix of GSI1SK which sorts before the reviews made at or after the given time.
+	GSI1SKSince func(since time.Time) string
 }
 
 var AccessReview = accessReviewKeys{
-	PK1: func(reviewerID string) string { return AccessReviewKey + reviewerID },
-	SK1: func(requestID, reviewID string) string { return requestID + "#" + reviewID },
+	PK1:    func(reviewerID string) string { return AccessReviewKey + reviewerID },
+	SK1:    func(requestID, reviewID string) string { return requestID + "#" + reviewID },
+	GSI1PK: func(reviewerID, ruleID string) string { return AccessReviewKey + reviewerID + "#" + ruleID },
+	// utc iso8601 formatted time string
+	GSI1SK: func(reviewedAt time.Time, reviewID string) string {
+		return iso8601.New(reviewedAt).String() + "#" + reviewID
+	},
+	GSI1SKSince: func(since time.Time) string { return iso8601.New(since).String() },
 }
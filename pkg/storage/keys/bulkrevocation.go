@@ -0,0 +1,27 @@
+package keys
+
+const BulkRevocationKey = "BULK_REVOCATION#"
+
+type bulkRevocationKeys struct {
+	PK1 string
+	SK1 func(bulkRevocationID string) string
+}
+
+var BulkRevocation = bulkRevocationKeys{
+	PK1: BulkRevocationKey,
+	SK1: func(bulkRevocationID string) string { return bulkRevocationID },
+}
+
+const BulkRevocationGrantKey = "BULK_REVOCATION_GRANT#"
+
+type bulkRevocationGrantKeys struct {
+	PK1               string
+	SK1               func(bulkRevocationID string, requestID string) string
+	SK1BulkRevocation func(bulkRevocationID string) string
+}
+
+var BulkRevocationGrant = bulkRevocationGrantKeys{
+	PK1:               BulkRevocationGrantKey,
+	SK1:               func(bulkRevocationID string, requestID string) string { return bulkRevocationID + "#" + requestID },
+	SK1BulkRevocation: func(bulkRevocationID string) string { return bulkRevocationID + "#" },
+}
@@ -0,0 +1,13 @@
+package keys
+
+const DelegationKey = "DELEGATION#"
+
+type delegationKeys struct {
+	PK1 string
+	SK1 func(userID string) string
+}
+
+var Delegation = delegationKeys{
+	PK1: DelegationKey,
+	SK1: func(userID string) string { return userID },
+}
@@ -0,0 +1,13 @@
+package keys
+
+const DriftKey = "DRIFT#"
+
+type driftKeys struct {
+	PK1 string
+	SK1 func(driftID string) string
+}
+
+var Drift = driftKeys{
+	PK1: DriftKey,
+	SK1: func(driftID string) string { return driftID },
+}
@@ -0,0 +1,13 @@
+package keys
+
+const ProcessedGrantEventKey = "PROCESSED_GRANT_EVENT#"
+
+type processedGrantEventKeys struct {
+	PK1 string
+	SK1 func(idempotencyKey string) string
+}
+
+var ProcessedGrantEvent = processedGrantEventKeys{
+	PK1: ProcessedGrantEventKey,
+	SK1: func(idempotencyKey string) string { return idempotencyKey },
+}
@@ -0,0 +1,29 @@
+package keys
+
+import (
+	"time"
+
+	"github.com/common-fate/iso8601"
+)
+
+const GrantRetryKey = "GRANT_RETRY#"
+
+type grantRetryKeys struct {
+	PK1    string
+	SK1    func(retryID string) string
+	GSI1PK func(status string) string
+	GSI1SK func(nextAttemptAt time.Time, retryID string) string
+	// GSI1SKDue is a prefix of GSI1SK which sorts after the retries which are due at the given time.
+	GSI1SKDue func(now time.Time) string
+}
+
+var GrantRetry = grantRetryKeys{
+	PK1:    GrantRetryKey,
+	SK1:    func(retryID string) string { return retryID },
+	GSI1PK: func(status string) string { return GrantRetryKey + status },
+	// utc iso8601 formatted time string
+	GSI1SK: func(nextAttemptAt time.Time, retryID string) string {
+		return iso8601.New(nextAttemptAt).String() + "#" + retryID
+	},
+	GSI1SKDue: func(now time.Time) string { return iso8601.New(now).String() + "#~" },
+}
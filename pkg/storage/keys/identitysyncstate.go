@@ -0,0 +1,13 @@
+package keys
+
+const IdentitySyncStateKey = "IDENTITY_SYNC_STATE#"
+
+type identitySyncStateKeys struct {
+	PK1 string
+	SK1 func(idpType string) string
+}
+
+var IdentitySyncState = identitySyncStateKeys{
+	PK1: IdentitySyncStateKey,
+	SK1: func(idpType string) string { return idpType + "#" },
+}
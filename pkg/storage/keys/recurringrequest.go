@@ -0,0 +1,21 @@
+package keys
+
+const RecurringRequestKey = "RECURRING_REQUEST#"
+
+type recurringRequestKeys struct {
+	PK1    string
+	SK1    func(recurringRequestID string) string
+	GSI1PK func(userID string) string
+	GSI1SK func(recurringRequestID string) string
+	GSI2PK func(status string) string
+	GSI2SK func(recurringRequestID string) string
+}
+
+var RecurringRequest = recurringRequestKeys{
+	PK1:    RecurringRequestKey,
+	SK1:    func(recurringRequestID string) string { return recurringRequestID },
+	GSI1PK: func(userID string) string { return RecurringRequestKey + userID },
+	GSI1SK: func(recurringRequestID string) string { return recurringRequestID },
+	GSI2PK: func(status string) string { return RecurringRequestKey + status },
+	GSI2SK: func(recurringRequestID string) string { return recurringRequestID },
+}
@@ -0,0 +1,14 @@
+package keys
+
+const SCIMTokenKey = "SCIM_TOKEN#"
+
+type scimTokenKeys struct {
+	PK1 string
+	// SK1 is a constant, as a deployment has a single SCIM token.
+	SK1 string
+}
+
+var SCIMToken = scimTokenKeys{
+	PK1: SCIMTokenKey,
+	SK1: "CURRENT",
+}
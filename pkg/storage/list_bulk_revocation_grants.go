@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+// ListBulkRevocationGrants lists the outcome of revoking each grant in a bulk revocation.
+type ListBulkRevocationGrants struct {
+	BulkRevocationID string
+	Result           []access.BulkRevocationGrant `ddb:"result"`
+}
+
+func (l *ListBulkRevocationGrants) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		KeyConditionExpression: aws.String("PK = :pk1 AND begins_with(SK, :sk1)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.BulkRevocationGrant.PK1},
+			":sk1": &types.AttributeValueMemberS{Value: keys.BulkRevocationGrant.SK1BulkRevocation(l.BulkRevocationID)},
+		},
+	}
+	return &qi, nil
+}
@@ -0,0 +1,29 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb/ddbtest"
+)
+
+func TestListBulkRevocationGrants(t *testing.T) {
+	s := newTestingStorage(t)
+
+	bulkID := types.NewBulkRevocationID()
+	g1 := access.BulkRevocationGrant{BulkRevocationID: bulkID, RequestID: types.NewRequestID(), Status: access.BulkRevocationGrantRevoked}
+	g2 := access.BulkRevocationGrant{BulkRevocationID: bulkID, RequestID: types.NewRequestID(), Status: access.BulkRevocationGrantPending}
+	other := access.BulkRevocationGrant{BulkRevocationID: types.NewBulkRevocationID(), RequestID: types.NewRequestID(), Status: access.BulkRevocationGrantRevoked}
+	ddbtest.PutFixtures(t, s, []*access.BulkRevocationGrant{&g1, &g2, &other})
+
+	tc := []ddbtest.QueryTestCase{
+		{
+			Name:  "ok",
+			Query: &ListBulkRevocationGrants{BulkRevocationID: bulkID},
+			Want:  &ListBulkRevocationGrants{BulkRevocationID: bulkID, Result: []access.BulkRevocationGrant{g1, g2}},
+		},
+	}
+
+	ddbtest.RunQueryTests(t, s, tc)
+}
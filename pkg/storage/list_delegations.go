@@ -0,0 +1,24 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+// ListDelegations lists the delegations for all users, including those which have ended.
+type ListDelegations struct {
+	Result []access.Delegation `ddb:"result"`
+}
+
+func (l *ListDelegations) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		KeyConditionExpression: aws.String("PK = :pk1"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.Delegation.PK1},
+		},
+	}
+	return &qi, nil
+}
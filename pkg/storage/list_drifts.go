@@ -0,0 +1,24 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+// ListDrifts lists the drift which has been detected between grants and providers and hasn't been resolved yet.
+type ListDrifts struct {
+	Result []access.Drift `ddb:"result"`
+}
+
+func (l *ListDrifts) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		KeyConditionExpression: aws.String("PK = :pk1"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.Drift.PK1},
+		},
+	}
+	return &qi, nil
+}
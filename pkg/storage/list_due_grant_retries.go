@@ -0,0 +1,29 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+// ListDueGrantRetries lists the pending grant retries which are due to be attempted at the given time.
+type ListDueGrantRetries struct {
+	Now    time.Time
+	Result []access.GrantRetry `ddb:"result"`
+}
+
+func (l *ListDueGrantRetries) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		IndexName:              aws.String(keys.IndexNames.GSI1),
+		KeyConditionExpression: aws.String("GSI1PK = :pk1 AND GSI1SK < :sk1"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.GrantRetry.GSI1PK(string(access.GrantRetryPending))},
+			":sk1": &types.AttributeValueMemberS{Value: keys.GrantRetry.GSI1SKDue(l.Now)},
+		},
+	}
+	return &qi, nil
+}
@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+// ListGrantRetriesForStatus lists the grant retries with a status, ordered by when they are next due to be attempted.
+type ListGrantRetriesForStatus struct {
+	Status access.GrantRetryStatus
+	Result []access.GrantRetry `ddb:"result"`
+}
+
+func (l *ListGrantRetriesForStatus) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		IndexName:              aws.String(keys.IndexNames.GSI1),
+		KeyConditionExpression: aws.String("GSI1PK = :pk1"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.GrantRetry.GSI1PK(string(l.Status))},
+		},
+	}
+	return &qi, nil
+}
@@ -0,0 +1,25 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+type ListRecurringRequestsForStatus struct {
+	Status access.RecurringRequestStatus
+	Result []access.RecurringRequest `ddb:"result"`
+}
+
+func (l *ListRecurringRequestsForStatus) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		IndexName:              aws.String(keys.IndexNames.GSI2),
+		KeyConditionExpression: aws.String("GSI2PK = :pk1"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.RecurringRequest.GSI2PK(string(l.Status))},
+		},
+	}
+	return &qi, nil
+}
@@ -0,0 +1,27 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+type ListRecurringRequestsForUser struct {
+	UserID string
+	Result []access.RecurringRequest `ddb:"result"`
+}
+
+func (l *ListRecurringRequestsForUser) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		// newest to oldest
+		ScanIndexForward:       aws.Bool(false),
+		IndexName:              aws.String(keys.IndexNames.GSI1),
+		KeyConditionExpression: aws.String("GSI1PK = :pk1"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.RecurringRequest.GSI1PK(l.UserID)},
+		},
+	}
+	return &qi, nil
+}
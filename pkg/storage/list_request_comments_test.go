@@ -0,0 +1,30 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb/ddbtest"
+)
+
+func TestListRequestComments(t *testing.T) {
+	s := newTestingStorage(t)
+
+	reqID := types.NewRequestID()
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	c1 := access.Comment{ID: types.NewRequestCommentID(), RequestID: reqID, AuthorID: "usr_1", Body: "why do you need access?", CreatedAt: now}
+	c2 := access.Comment{ID: types.NewRequestCommentID(), RequestID: reqID, AuthorID: "usr_2", Body: "to fix the incident", CreatedAt: now.Add(time.Minute)}
+	ddbtest.PutFixtures(t, s, []*access.Comment{&c1, &c2})
+
+	tc := []ddbtest.QueryTestCase{
+		{
+			Name:  "ok",
+			Query: &ListRequestComments{RequestID: reqID},
+			Want:  &ListRequestComments{RequestID: reqID, Result: []access.Comment{c1, c2}},
+		},
+	}
+
+	ddbtest.RunQueryTests(t, s, tc)
+}
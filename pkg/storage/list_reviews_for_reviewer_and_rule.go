@@ -0,0 +1,31 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+// ListReviewsForReviewerAndRule lists the reviews which a user has made on requests for an Access Rule since the given time.
+type ListReviewsForReviewerAndRule struct {
+	ReviewerID string
+	RuleID     string
+	Since      time.Time
+	Result     []access.Review `ddb:"result"`
+}
+
+func (l *ListReviewsForReviewerAndRule) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		IndexName:              aws.String(keys.IndexNames.GSI1),
+		KeyConditionExpression: aws.String("GSI1PK = :pk1 AND GSI1SK >= :sk1"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.AccessReview.GSI1PK(l.ReviewerID, l.RuleID)},
+			":sk1": &types.AttributeValueMemberS{Value: keys.AccessReview.GSI1SKSince(l.Since)},
+		},
+	}
+	return &qi, nil
+}
@@ -0,0 +1,34 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb/ddbtest"
+	"github.com/segmentio/ksuid"
+)
+
+func TestListReviewsForReviewerAndRule(t *testing.T) {
+	s := newTestingStorage(t)
+
+	reviewerID := ksuid.New().String()
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	r1 := access.Review{ID: types.NewRequestReviewID(), RequestID: "req_a", ReviewerID: reviewerID, Decision: access.DecisionApproved, RuleID: "rul_1", ReviewedAt: now}
+	r2 := access.Review{ID: types.NewRequestReviewID(), RequestID: "req_b", ReviewerID: reviewerID, Decision: access.DecisionDECLINED, RuleID: "rul_1", ReviewedAt: now.Add(time.Minute)}
+	old := access.Review{ID: types.NewRequestReviewID(), RequestID: "req_c", ReviewerID: reviewerID, Decision: access.DecisionApproved, RuleID: "rul_1", ReviewedAt: now.Add(-time.Hour * 48)}
+	otherRule := access.Review{ID: types.NewRequestReviewID(), RequestID: "req_d", ReviewerID: reviewerID, Decision: access.DecisionApproved, RuleID: "rul_2", ReviewedAt: now}
+	otherReviewer := access.Review{ID: types.NewRequestReviewID(), RequestID: "req_a", ReviewerID: ksuid.New().String(), Decision: access.DecisionApproved, RuleID: "rul_1", ReviewedAt: now}
+	ddbtest.PutFixtures(t, s, []*access.Review{&r1, &r2, &old, &otherRule, &otherReviewer})
+
+	tc := []ddbtest.QueryTestCase{
+		{
+			Name:  "ok",
+			Query: &ListReviewsForReviewerAndRule{ReviewerID: reviewerID, RuleID: "rul_1", Since: now.Add(-time.Hour * 24)},
+			Want:  &ListReviewsForReviewerAndRule{ReviewerID: reviewerID, RuleID: "rul_1", Since: now.Add(-time.Hour * 24), Result: []access.Review{r1, r2}},
+		},
+	}
+
+	ddbtest.RunQueryTests(t, s, tc)
+}
@@ -24,10 +24,24 @@ type Group struct {
 	// reference to the SVG icon for the target group
 	Icon string `json:"icon" dynamodbav:"icon"`
 
+	// RoutingStrategy is how requests are routed between the handlers linked to the target group.
+	// Target groups created before routing strategies were supported have an empty value, which is treated as priority routing.
+	RoutingStrategy RoutingStrategy `json:"routingStrategy,omitempty" dynamodbav:"routingStrategy,omitempty"`
+
 	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt" dynamodbav:"updatedAt"`
 }
 
+// RoutingStrategy is how requests are routed between the valid routes of a target group.
+type RoutingStrategy string
+
+const (
+	// RoutingPriority uses the highest priority route, falling back to lower priority routes if it fails.
+	RoutingPriority RoutingStrategy = "PRIORITY"
+	// RoutingWeighted spreads requests between routes in proportion to their weight.
+	RoutingWeighted RoutingStrategy = "WEIGHTED"
+)
+
 func (r *Group) DDBKeys() (ddb.Keys, error) {
 	keys := ddb.Keys{
 		PK: keys.TargetGroup.PK1,
@@ -102,6 +116,10 @@ func (r *Group) ToAPI() types.TargetGroup {
 		CreatedAt: &r.CreatedAt,
 		UpdatedAt: &r.UpdatedAt,
 	}
+	if r.RoutingStrategy != "" {
+		strategy := types.RoutingStrategy(r.RoutingStrategy)
+		tg.RoutingStrategy = &strategy
+	}
 
 	return tg
 }
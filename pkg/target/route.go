@@ -7,14 +7,25 @@ import (
 )
 
 type Route struct {
-	Group       string       `json:"group" dynamodbav:"group"`
-	Handler     string       `json:"handler" dynamodbav:"handler"`
-	Kind        string       `json:"kind" dynamodbav:"kind"`
-	Priority    int          `json:"priority" dynamodbav:"priority"`
+	Group    string `json:"group" dynamodbav:"group"`
+	Handler  string `json:"handler" dynamodbav:"handler"`
+	Kind     string `json:"kind" dynamodbav:"kind"`
+	Priority int    `json:"priority" dynamodbav:"priority"`
+	// Weight is the relative share of requests sent to the route when the target group uses weighted routing.
+	// Routes created before weighted routing was supported don't have a weight, and are treated as having a weight of 1.
+	Weight      *int         `json:"weight,omitempty" dynamodbav:"weight,omitempty"`
 	Valid       bool         `json:"valid" dynamodbav:"valid"`
 	Diagnostics []Diagnostic `json:"diagnostics" dynamodbav:"diagnostics"`
 }
 
+// GetWeight returns the weight of the route, defaulting to 1 if it isn't set.
+func (r Route) GetWeight() int {
+	if r.Weight == nil {
+		return 1
+	}
+	return *r.Weight
+}
+
 func (r Route) SetValidity(v bool) Route {
 	r.Valid = v
 	return r
@@ -59,6 +70,7 @@ func (r *Route) ToAPI() types.TargetRoute {
 		HandlerId:     r.Handler,
 		Kind:          r.Kind,
 		Priority:      r.Priority,
+		Weight:        r.Weight,
 		Valid:         r.Valid,
 		Diagnostics:   diagnostics,
 	}
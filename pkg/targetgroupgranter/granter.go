@@ -3,9 +3,12 @@ package targetgroupgranter
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/ddb"
+	"github.com/common-fate/iso8601"
 	"github.com/common-fate/provider-registry-sdk-go/pkg/msg"
 
 	ahTypes "github.com/common-fate/common-fate/accesshandler/pkg/types"
@@ -15,11 +18,15 @@ import (
 	"github.com/common-fate/common-fate/pkg/handler"
 	"github.com/common-fate/common-fate/pkg/service/requestroutersvc"
 	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/dbupdate"
+	"github.com/common-fate/common-fate/pkg/target"
+	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
 )
 
 type Granter struct {
 	Cfg           config.TargetGroupGranterConfig
+	Clock         clock.Clock
 	DB            ddb.Storage
 	RequestRouter *requestroutersvc.Service
 }
@@ -29,6 +36,8 @@ type WorkflowInput struct {
 type EventType string
 
 const (
+	// CREATE is the first step of the workflow, which emits the event for the grant being created.
+	CREATE     EventType = "CREATE"
 	ACTIVATE   EventType = "ACTIVATE"
 	DEACTIVATE EventType = "DEACTIVATE"
 )
@@ -36,6 +45,9 @@ const (
 type GrantState struct {
 	Grant ahTypes.Grant  `json:"grant"`
 	State map[string]any `json:"state"`
+	// Extended is true if the grant end time was extended when it was due to be deactivated.
+	// The workflow waits for the new end time of the grant before trying to deactivate it again.
+	Extended bool `json:"extended"`
 }
 type InputEvent struct {
 	Action EventType     `json:"action"`
@@ -49,6 +61,34 @@ func (g *Granter) HandleRequest(ctx context.Context, in InputEvent) (GrantState,
 	log := logger.Get(ctx).With("grant.id", grant.ID)
 	log.Infow("Handling event", "event", in)
 
+	if in.Action == CREATE {
+		return g.create(ctx, in)
+	}
+
+	// savedState is the state saved when the grant was activated, which records the handler which provisioned it.
+	var savedState *access.GrantState
+	if in.Action == DEACTIVATE {
+		extended, err := g.checkForExtension(ctx, in)
+		if err != nil {
+			return GrantState{}, err
+		}
+		if extended != nil {
+			log.Infow("grant has been extended, skipping deactivation", "end", extended.Grant.End)
+			return *extended, nil
+		}
+		// the state saved when the grant was activated is used rather than the state passed through the workflow,
+		// so that grants which were activated before state was saved can still be deactivated.
+		sq := storage.GetRequestGrantState{RequestID: in.Grant.ID}
+		_, err = g.DB.Query(ctx, &sq)
+		if err != nil && err != ddb.ErrNoItems {
+			return GrantState{}, err
+		}
+		if err == nil {
+			in.State = sq.Result.State
+			savedState = sq.Result
+		}
+	}
+
 	tgq := storage.GetTargetGroup{
 		ID: in.Grant.Provider,
 	}
@@ -57,20 +97,157 @@ func (g *Granter) HandleRequest(ctx context.Context, in InputEvent) (GrantState,
 	if err != nil {
 		return GrantState{}, err
 	}
-	routeResult, err := g.RequestRouter.Route(ctx, *tgq.Result)
+	routes, err := g.routes(ctx, in, *tgq.Result, savedState)
 	if err != nil {
 		return GrantState{}, err
 	}
-	runtime, err := handler.GetRuntime(ctx, routeResult.Handler)
+	eventsBus, err := gevent.NewSender(ctx, gevent.SenderOpts{EventBusARN: g.Cfg.EventBusArn})
+	if err != nil {
+		return GrantState{}, err
+	}
+
+	// try each route in turn, so that access can still be provisioned if a handler is unavailable.
+	var grantResponse *msg.GrantResponse
+	var routeResult requestroutersvc.RouteResult
+	for _, routeResult = range routes {
+		grantResponse, err = g.invoke(ctx, in, routeResult)
+		if err == nil {
+			break
+		}
+		if in.Action == ACTIVATE {
+			// the handler may have provisioned some access before failing, such as if it timed out.
+			// Only the state of the route which succeeds is saved, so the access is removed now as it couldn't be removed later.
+			err = g.rollback(ctx, in, routeResult, nil, err)
+		}
+		log.Warnw("failed to invoke handler, trying the next route", "error", err, "handler", routeResult.Handler.ID, "kind", routeResult.Route.Kind)
+	}
+
+	// retry is false if the grant failed in a way which retrying wouldn't fix.
+	retry := true
+	if err == nil && in.Action == ACTIVATE && g.Cfg.GrantVerificationTimeout > 0 {
+		verifyErr := g.verify(ctx, in, routeResult)
+		if verifyErr != nil {
+			// access may have been provisioned even though it wasn't visible in time,
+			// so it is removed rather than left in place for a grant which failed.
+			err = g.rollback(ctx, in, routeResult, grantResponse, verifyErr)
+			// once the access has been removed the grant isn't retried, as the retry would be verified the same way
+			// and grant and remove the access again.
+			retry = err != verifyErr
+		}
+	}
+
+	if err == nil && in.Action == ACTIVATE {
+		// handlers rely on the state to remove access, so activation fails if it can't be saved.
+		// The access is removed before failing, so that it isn't left in place without the state needed to remove it.
+		err = g.saveState(ctx, grant.ID, routeResult, grantResponse)
+		if err != nil {
+			err = g.rollback(ctx, in, routeResult, grantResponse, err)
+		}
+	}
+
+	// emit an event and return early if we failed (de)provisioning the grant
 	if err != nil {
+		log.Errorf("error while handling granter event", "error", err.Error(), "event", in)
+		grant.Status = ahTypes.GrantStatusERROR
+
+		// the workflow doesn't continue after a failure, so the change is retried outside of it.
+		if retry {
+			retryErr := g.recordRetry(ctx, in, err)
+			if retryErr != nil {
+				log.Errorw("failed to schedule retry for grant", "error", retryErr)
+			}
+		}
+
+		eventErr := eventsBus.Put(ctx, gevent.GrantFailed{
+			Grant:          grant,
+			Reason:         err.Error(),
+			IdempotencyKey: gevent.GrantIdempotencyKey(grant.ID, gevent.GrantFailedType, string(in.Action)),
+		})
+		if eventErr != nil {
+			return GrantState{}, errors.Wrapf(err, "failed to emit event, emit error: %s", eventErr.Error())
+		}
 		return GrantState{}, err
 	}
-	_ = runtime
+
+	// Emit an event based on whether we activated or deactivated the grant.
+	var evt gevent.EventTyper
+	switch in.Action {
+	case ACTIVATE:
+		grant.Status = ahTypes.GrantStatusACTIVE
+		evt = &gevent.GrantActivated{
+			Grant:          grant,
+			Route:          &access.GrantRoute{HandlerID: routeResult.Handler.ID, Kind: routeResult.Route.Kind},
+			IdempotencyKey: gevent.GrantIdempotencyKey(grant.ID, gevent.GrantActivatedType, string(in.Action)),
+		}
+	case DEACTIVATE:
+		grant.Status = ahTypes.GrantStatusEXPIRED
+		evt = &gevent.GrantExpired{Grant: grant, IdempotencyKey: gevent.GrantIdempotencyKey(grant.ID, gevent.GrantExpiredType, string(in.Action))}
+	}
+
+	log.Infow("emitting event", "event", evt, "action", in.Action)
+	err = eventsBus.Put(ctx, evt)
+	if err != nil {
+		return GrantState{}, err
+	}
+	out := GrantState{
+		Grant: grant,
+	}
+
+	if grantResponse != nil {
+		out.State = grantResponse.State
+		instructions := access.Instructions{
+			Instructions: grantResponse.AccessInstructions,
+			ID:           grant.ID,
+		}
+		err = g.DB.Put(ctx, &instructions)
+		// If there is an error writing instructions, don't return the error.
+		// instead just continue so that the grant can be revoked
+		if err != nil {
+			log.Errorw("failed to write access instructions to DynamoDB", "error", err)
+		}
+	}
+	return out, nil
+}
+
+// routes returns the routes to try in turn for the grant.
+// Access can be activated through any valid route, but is only deactivated through the handler which provisioned it,
+// as other handlers can't remove access which they didn't provision.
+func (g *Granter) routes(ctx context.Context, in InputEvent, tg target.Group, savedState *access.GrantState) ([]requestroutersvc.RouteResult, error) {
+	if in.Action != DEACTIVATE {
+		return g.RequestRouter.Routes(ctx, tg)
+	}
+	route, err := g.RequestRouter.GrantRoute(ctx, tg, savedState)
+	if err != nil {
+		return nil, err
+	}
+	return []requestroutersvc.RouteResult{*route}, nil
+}
+
+// create emits the event for the grant being created.
+// It is emitted by the workflow rather than by the API which starts it,
+// so that it is always emitted before the grant is activated.
+func (g *Granter) create(ctx context.Context, in InputEvent) (GrantState, error) {
 	eventsBus, err := gevent.NewSender(ctx, gevent.SenderOpts{EventBusARN: g.Cfg.EventBusArn})
 	if err != nil {
 		return GrantState{}, err
 	}
-	var grantResponse *msg.GrantResponse
+	grant := in.Grant
+	grant.Status = ahTypes.GrantStatusPENDING
+	err = eventsBus.Put(ctx, &gevent.GrantCreated{Grant: grant, IdempotencyKey: gevent.GrantIdempotencyKey(grant.ID, gevent.GrantCreatedType, string(in.Action))})
+	if err != nil {
+		return GrantState{}, err
+	}
+	return GrantState{Grant: grant}, nil
+}
+
+// invoke activates or deactivates the grant using the handler of the given route.
+func (g *Granter) invoke(ctx context.Context, in InputEvent, routeResult requestroutersvc.RouteResult) (grantResponse *msg.GrantResponse, err error) {
+	grant := in.Grant
+	log := logger.Get(ctx).With("grant.id", grant.ID)
+	runtime, err := handler.GetRuntime(ctx, routeResult.Handler)
+	if err != nil {
+		return nil, err
+	}
 	switch in.Action {
 	case ACTIVATE:
 		log.Infow("activating grant")
@@ -122,50 +299,163 @@ func (g *Granter) HandleRequest(ctx context.Context, in InputEvent) (GrantState,
 		err = fmt.Errorf("invocation type: %s not supported, type must be one of [ACTIVATE, DEACTIVATE]", in.Action)
 	}
 
-	// emit an event and return early if we failed (de)provisioning the grant
-	if err != nil {
-		log.Errorf("error while handling granter event", "error", err.Error(), "event", in)
-		grant.Status = ahTypes.GrantStatusERROR
-
-		eventErr := eventsBus.Put(ctx, gevent.GrantFailed{Grant: grant, Reason: err.Error()})
-		if eventErr != nil {
-			return GrantState{}, errors.Wrapf(err, "failed to emit event, emit error: %s", eventErr.Error())
-		}
-		return GrantState{}, err
-	}
+	return grantResponse, err
+}
 
-	// Emit an event based on whether we activated or deactivated the grant.
-	var evt gevent.EventTyper
-	switch in.Action {
-	case ACTIVATE:
-		grant.Status = ahTypes.GrantStatusACTIVE
-		evt = &gevent.GrantActivated{Grant: grant}
-	case DEACTIVATE:
-		grant.Status = ahTypes.GrantStatusEXPIRED
-		evt = &gevent.GrantExpired{Grant: grant}
+// verify waits until the access for an activated grant is visible in the provider.
+// Providers such as AWS IAM Identity Center and Okta are eventually consistent,
+// so access may not be usable as soon as the handler has provisioned it.
+//
+// Grants are only verified if the handler advertises that it can check grants in its describe response,
+// otherwise verification is skipped.
+func (g *Granter) verify(ctx context.Context, in InputEvent, routeResult requestroutersvc.RouteResult) error {
+	log := logger.Get(ctx).With("grant.id", in.Grant.ID, "handler", routeResult.Handler.ID)
+	runtime, err := handler.GetRuntime(ctx, routeResult.Handler)
+	if err != nil {
+		return err
 	}
-
-	log.Infow("emitting event", "event", evt, "action", in.Action)
-	err = eventsBus.Put(ctx, evt)
+	supported, err := handler.SupportsCheckGrant(ctx, runtime)
 	if err != nil {
-		return GrantState{}, err
+		// the access has already been provisioned, so it isn't removed just because the handler couldn't be described.
+		log.Warnw("failed to describe handler, skipping grant verification", "error", err)
+		return nil
 	}
-	out := GrantState{
-		Grant: grant,
+	if !supported {
+		log.Infow("handler doesn't support checking grants, skipping grant verification")
+		return nil
+	}
+	req := msg.Grant{
+		Subject: string(in.Grant.Subject),
+		Target: msg.Target{
+			Kind:      routeResult.Route.Kind,
+			Arguments: in.Grant.With.AdditionalProperties,
+		},
+		Request: msg.AccessRequest{
+			ID: in.Grant.ID,
+		},
 	}
+	return pollUntilActive(ctx, g.Clock, g.Cfg.GrantVerificationTimeout, g.Cfg.GrantVerificationInterval, func(ctx context.Context) (bool, error) {
+		return handler.CheckGrant(ctx, runtime, req)
+	})
+}
 
+// rollback removes the access provisioned for a grant whose activation failed after the handler was invoked,
+// using the handler which provisioned it. The cause of the failure is returned, along with any error removing access.
+func (g *Granter) rollback(ctx context.Context, in InputEvent, routeResult requestroutersvc.RouteResult, grantResponse *msg.GrantResponse, cause error) error {
+	logger.Get(ctx).Warnw("removing access for grant which failed to activate", "grant.id", in.Grant.ID, "handler", routeResult.Handler.ID, "error", cause)
+	deactivate := in
+	deactivate.Action = DEACTIVATE
 	if grantResponse != nil {
-		out.State = grantResponse.State
-		instructions := access.Instructions{
-			Instructions: grantResponse.AccessInstructions,
-			ID:           grant.ID,
+		deactivate.State = grantResponse.State
+	}
+	_, err := g.invoke(ctx, deactivate, routeResult)
+	if err != nil {
+		return multierror.Append(cause, errors.Wrap(err, "removing access for grant which failed to activate"))
+	}
+	return cause
+}
+
+// pollUntilActive calls check until it reports that access is active, or the timeout passes.
+// Errors from check are retried, as the provider may not know about the access yet.
+func pollUntilActive(ctx context.Context, clk clock.Clock, timeout time.Duration, interval time.Duration, check func(ctx context.Context) (bool, error)) error {
+	log := logger.Get(ctx)
+	deadline := clk.Now().Add(timeout)
+	var lastErr error
+	for {
+		active, err := check(ctx)
+		if err == nil && active {
+			return nil
 		}
-		err = g.DB.Put(ctx, &instructions)
-		// If there is an error writing instructions, don't return the error.
-		// instead just continue so that the grant can be revoked
+		lastErr = err
 		if err != nil {
-			log.Errorw("failed to write access instructions to DynamoDB", "error", err)
+			log.Warnw("failed to check whether access is active", "error", err)
+		}
+		if clk.Now().Add(interval).After(deadline) {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-clk.After(interval):
 		}
 	}
-	return out, nil
+	if lastErr != nil {
+		return fmt.Errorf("access could not be verified within %s: %w", timeout, lastErr)
+	}
+	return fmt.Errorf("access was provisioned but wasn't visible in the provider within %s", timeout)
+}
+
+const (
+	saveStateAttempts      = 3
+	saveStateRetryInterval = time.Second
+)
+
+// saveState saves the state returned by the handler which provisioned the grant.
+// Saving the state is retried, as the access has already been provisioned and must be removed if the state can't be saved.
+func (g *Granter) saveState(ctx context.Context, requestID string, routeResult requestroutersvc.RouteResult, grantResponse *msg.GrantResponse) error {
+	gs := access.GrantState{
+		RequestID: requestID,
+		HandlerID: routeResult.Handler.ID,
+		Kind:      routeResult.Route.Kind,
+		State:     map[string]any{},
+		UpdatedAt: g.Clock.Now(),
+	}
+	if grantResponse != nil && grantResponse.State != nil {
+		gs.State = grantResponse.State
+	}
+	var err error
+	for attempt := 1; attempt <= saveStateAttempts; attempt++ {
+		err = g.DB.Put(ctx, &gs)
+		if err == nil || attempt == saveStateAttempts {
+			break
+		}
+		logger.Get(ctx).Warnw("failed to save grant state, retrying", "grant.id", requestID, "attempt", attempt, "error", err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-g.Clock.After(saveStateRetryInterval):
+		}
+	}
+	if err != nil {
+		return errors.Wrap(err, "saving grant state")
+	}
+	return nil
+}
+
+// recordRetry records that activating or deactivating a grant failed, so that it is retried.
+func (g *Granter) recordRetry(ctx context.Context, in InputEvent, cause error) error {
+	action := access.GrantRetryActivate
+	if in.Action == DEACTIVATE {
+		action = access.GrantRetryDeactivate
+	}
+	now := g.Clock.Now()
+	retry := access.NewGrantRetry(in.Grant.ID, action, now)
+	grant := in.Grant
+	retry.Grant = &grant
+	retry.State = in.State
+	_, err := dbupdate.RecordGrantRetryFailure(ctx, g.DB, retry, cause, now)
+	return err
+}
+
+// checkForExtension looks up the grant in the database to see whether its end time has been extended
+// since the workflow was started. If it has, a GrantState with the new end time is returned.
+func (g *Granter) checkForExtension(ctx context.Context, in InputEvent) (*GrantState, error) {
+	q := storage.GetRequest{ID: in.Grant.ID}
+	_, err := g.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if q.Result.Grant == nil || !q.Result.Grant.End.After(in.Grant.End.Time) {
+		return nil, nil
+	}
+	grant := in.Grant
+	grant.End = iso8601.New(q.Result.Grant.End)
+	return &GrantState{
+		Grant:    grant,
+		State:    in.State,
+		Extended: true,
+	}, nil
 }
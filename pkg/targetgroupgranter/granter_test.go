@@ -0,0 +1,159 @@
+package targetgroupgranter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/handler"
+	"github.com/common-fate/common-fate/pkg/service/requestroutersvc"
+	"github.com/common-fate/common-fate/pkg/target"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPollUntilActive(t *testing.T) {
+	type testcase struct {
+		name string
+		// results are returned by successive checks. The last result is repeated.
+		results []bool
+		errs    []error
+		wantErr string
+		// wantChecks is the minimum number of checks which are made.
+		wantChecks int
+	}
+
+	testcases := []testcase{
+		{
+			name:       "active straight away",
+			results:    []bool{true},
+			wantChecks: 1,
+		},
+		{
+			name:       "becomes active",
+			results:    []bool{false, false, true},
+			wantChecks: 3,
+		},
+		{
+			name:       "never active",
+			results:    []bool{false},
+			wantErr:    "access was provisioned but wasn't visible in the provider within 20ms",
+			wantChecks: 2,
+		},
+		{
+			name:       "check fails",
+			results:    []bool{false},
+			errs:       []error{errors.New("provider unavailable")},
+			wantErr:    "access could not be verified within 20ms: provider unavailable",
+			wantChecks: 2,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			checks := 0
+			check := func(ctx context.Context) (bool, error) {
+				i := checks
+				checks++
+				var err error
+				if len(tc.errs) > 0 {
+					err = tc.errs[min(i, len(tc.errs)-1)]
+				}
+				return tc.results[min(i, len(tc.results)-1)], err
+			}
+			err := pollUntilActive(context.Background(), clock.New(), 20*time.Millisecond, time.Millisecond, check)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.GreaterOrEqual(t, checks, tc.wantChecks)
+		})
+	}
+}
+
+// failingPutDB fails the first puts, up to fail puts.
+type failingPutDB struct {
+	ddb.Storage
+	fail int
+	puts int
+}
+
+func (d *failingPutDB) Put(ctx context.Context, item ddb.Keyer) error {
+	d.puts++
+	if d.puts <= d.fail {
+		return errors.New("throttled")
+	}
+	return d.Storage.Put(ctx, item)
+}
+
+func TestSaveState(t *testing.T) {
+	type testcase struct {
+		name     string
+		fail     int
+		wantErr  string
+		wantPuts int
+	}
+
+	testcases := []testcase{
+		{
+			name:     "saved straight away",
+			wantPuts: 1,
+		},
+		{
+			name:     "saved after retrying",
+			fail:     2,
+			wantPuts: 3,
+		},
+		{
+			name:     "fails after the maximum attempts",
+			fail:     saveStateAttempts,
+			wantErr:  "saving grant state: throttled",
+			wantPuts: saveStateAttempts,
+		},
+	}
+
+	for i := range testcases {
+		tc := testcases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			clk := clock.NewMock()
+			db := &failingPutDB{Storage: ddbmock.New(t), fail: tc.fail}
+			g := Granter{Clock: clk, DB: db}
+
+			// advance the mock clock until the state has been saved, so that retries aren't delayed.
+			done := make(chan struct{})
+			go func() {
+				for {
+					select {
+					case <-done:
+						return
+					default:
+						clk.Add(saveStateRetryInterval)
+						time.Sleep(time.Millisecond)
+					}
+				}
+			}()
+			routeResult := requestroutersvc.RouteResult{Handler: handler.Handler{ID: "handler"}, Route: target.Route{Kind: "Default"}}
+			err := g.saveState(context.Background(), "req_1", routeResult, nil)
+			close(done)
+
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.wantPuts, db.puts)
+		})
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
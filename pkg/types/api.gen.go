@@ -39,8 +39,24 @@ const (
 
 // Defines values for ApprovalMethod.
 const (
-	AUTOMATIC ApprovalMethod = "AUTOMATIC"
-	REVIEWED  ApprovalMethod = "REVIEWED"
+	AUTOMATIC  ApprovalMethod = "AUTOMATIC"
+	BREAKGLASS ApprovalMethod = "BREAKGLASS"
+	REVIEWED   ApprovalMethod = "REVIEWED"
+)
+
+// Defines values for BulkRevocationStatus.
+const (
+	BulkRevocationStatusDRYRUN  BulkRevocationStatus = "DRY_RUN"
+	BulkRevocationStatusFAILED  BulkRevocationStatus = "FAILED"
+	BulkRevocationStatusPENDING BulkRevocationStatus = "PENDING"
+	BulkRevocationStatusREVOKED BulkRevocationStatus = "REVOKED"
+)
+
+// Defines values for BulkRevokeStatus.
+const (
+	BulkRevokeStatusCOMPLETED  BulkRevokeStatus = "COMPLETED"
+	BulkRevokeStatusINPROGRESS BulkRevokeStatus = "IN_PROGRESS"
+	BulkRevokeStatusPENDING    BulkRevokeStatus = "PENDING"
 )
 
 // Defines values for GrantStatus.
@@ -52,6 +68,34 @@ const (
 	GrantStatusREVOKED GrantStatus = "REVOKED"
 )
 
+// Defines values for GrantDriftKind.
+const (
+	MISSING    GrantDriftKind = "MISSING"
+	UNEXPECTED GrantDriftKind = "UNEXPECTED"
+)
+
+// Defines values for GrantRetryAction.
+const (
+	ACTIVATE   GrantRetryAction = "ACTIVATE"
+	DEACTIVATE GrantRetryAction = "DEACTIVATE"
+	REVOKE     GrantRetryAction = "REVOKE"
+)
+
+// Defines values for GrantRetryStatus.
+const (
+	GrantRetryStatusABANDONED GrantRetryStatus = "ABANDONED"
+	GrantRetryStatusEXHAUSTED GrantRetryStatus = "EXHAUSTED"
+	GrantRetryStatusPENDING   GrantRetryStatus = "PENDING"
+	GrantRetryStatusSUCCEEDED GrantRetryStatus = "SUCCEEDED"
+)
+
+// Defines values for IdentitySyncChangeAction.
+const (
+	ARCHIVE IdentitySyncChangeAction = "ARCHIVE"
+	CREATE  IdentitySyncChangeAction = "CREATE"
+	UPDATE  IdentitySyncChangeAction = "UPDATE"
+)
+
 // Defines values for IdpStatus.
 const (
 	IdpStatusACTIVE   IdpStatus = "ACTIVE"
@@ -82,6 +126,12 @@ const (
 	ProviderSetupValidationStatusSUCCESS    ProviderSetupValidationStatus = "SUCCESS"
 )
 
+// Defines values for RecurringRequestStatus.
+const (
+	RecurringRequestStatusACTIVE    RecurringRequestStatus = "ACTIVE"
+	RecurringRequestStatusCANCELLED RecurringRequestStatus = "CANCELLED"
+)
+
 // Defines values for RequestArgumentFormElement.
 const (
 	RequestArgumentFormElementSELECT RequestArgumentFormElement = "SELECT"
@@ -110,6 +160,7 @@ const (
 	RequestStatusAPPROVED  RequestStatus = "APPROVED"
 	RequestStatusCANCELLED RequestStatus = "CANCELLED"
 	RequestStatusDECLINED  RequestStatus = "DECLINED"
+	RequestStatusEXPIRED   RequestStatus = "EXPIRED"
 	RequestStatusPENDING   RequestStatus = "PENDING"
 )
 
@@ -119,6 +170,12 @@ const (
 	DECLINED ReviewDecision = "DECLINED"
 )
 
+// Defines values for RoutingStrategy.
+const (
+	PRIORITY RoutingStrategy = "PRIORITY"
+	WEIGHTED RoutingStrategy = "WEIGHTED"
+)
+
 // Defines values for TargetArgumentRequestFormElement.
 const (
 	TargetArgumentRequestFormElementSELECT TargetArgumentRequestFormElement = "SELECT"
@@ -131,8 +188,21 @@ const (
 	TargetArgumentRuleFormElementSELECT      TargetArgumentRuleFormElement = "SELECT"
 )
 
+// Defines values for Weekday.
+const (
+	FRIDAY    Weekday = "FRIDAY"
+	MONDAY    Weekday = "MONDAY"
+	SATURDAY  Weekday = "SATURDAY"
+	SUNDAY    Weekday = "SUNDAY"
+	THURSDAY  Weekday = "THURSDAY"
+	TUESDAY   Weekday = "TUESDAY"
+	WEDNESDAY Weekday = "WEDNESDAY"
+)
+
 // Access Rule contains information for an end user to make a request for access.
 type AccessRule struct {
+	// true if requests for this rule can use break-glass access to skip approval.
+	BreakGlass  *bool     `json:"breakGlass,omitempty"`
 	CreatedAt   time.Time `json:"createdAt"`
 	Description string    `json:"description"`
 	ID          string    `json:"id"`
@@ -154,8 +224,12 @@ type AccessRule struct {
 // AccessRuleDetail contains detailed information about a rule and is used in administrative apis.
 type AccessRuleDetail struct {
 	// Approver config for access rules
-	Approval    ApproverConfig `json:"approval"`
-	Description string         `json:"description"`
+	Approval ApproverConfig `json:"approval"`
+
+	// If true, users may request break-glass access for this rule during an emergency.
+	// Break-glass requests skip approval, but must include a reason and are reviewed by an approver after access has been granted.
+	BreakGlass  *bool  `json:"breakGlass,omitempty"`
+	Description string `json:"description"`
 
 	// The group IDs that the access rule applies to.
 	Groups    []string           `json:"groups"`
@@ -164,6 +238,10 @@ type AccessRuleDetail struct {
 	Metadata  AccessRuleMetadata `json:"metadata"`
 	Name      string             `json:"name"`
 
+	// Separation-of-duties constraints which are checked when a request is approved.
+	// Requestors can never approve their own requests, regardless of these settings.
+	SeparationOfDuties *SeparationOfDuties `json:"separationOfDuties,omitempty"`
+
 	// The status of an Access Rule.
 	Status AccessRuleStatus `json:"status"`
 
@@ -224,17 +302,100 @@ type AccessRuleTargetDetailArguments_Groupings struct {
 	AdditionalProperties map[string][]string `json:"-"`
 }
 
+// Routes requests for particular argument values to a set of approvers.
+type ApprovalCondition struct {
+	// The ID of the target argument to match.
+	ArgumentId string `json:"argumentId"`
+
+	// The group IDs of the approvers for matching requests.
+	Groups *[]string `json:"groups,omitempty"`
+
+	// The number of distinct approvals required for matching requests. Defaults to 1.
+	Quorum *int `json:"quorum,omitempty"`
+
+	// The user IDs of the approvers for matching requests.
+	Users *[]string `json:"users,omitempty"`
+
+	// The argument values which match the condition.
+	Values []string `json:"values"`
+}
+
 // Describes whether a request has been approved automatically or from a review
 type ApprovalMethod string
 
+// A single stage of a multi-stage approval policy.
+type ApprovalStage struct {
+	// The group IDs of the approvers for this stage.
+	Groups *[]string `json:"groups,omitempty"`
+	Name   string    `json:"name"`
+
+	// The number of distinct approvals required to complete this stage. Defaults to 1.
+	Quorum *int `json:"quorum,omitempty"`
+
+	// The user IDs of the approvers for this stage.
+	Users *[]string `json:"users,omitempty"`
+}
+
 // Approver config for access rules
 type ApproverConfig struct {
-	Groups *[]string `json:"groups,omitempty"`
+	// Conditions which route requests to different approvers based on the argument values selected in the request.
+	// The first matching condition is used in place of the approvers above. A condition without any users or groups approves matching requests automatically.
+	Conditions *[]ApprovalCondition `json:"conditions,omitempty"`
+
+	// If set, requests which are still pending this many seconds after they were made are automatically closed with the EXPIRED status.
+	ExpiresAfterSeconds *int      `json:"expiresAfterSeconds,omitempty"`
+	Groups              *[]string `json:"groups,omitempty"`
+
+	// The number of distinct approvals required before access is granted. Defaults to 1.
+	Quorum *int `json:"quorum,omitempty"`
+
+	// If set, once an occurrence of a recurring request has been approved, later occurrences made within this many seconds are approved automatically.
+	// Otherwise, each occurrence of a recurring request must be approved.
+	RecurringApprovalValiditySeconds *int `json:"recurringApprovalValiditySeconds,omitempty"`
+
+	// Ordered approval stages. When provided, each stage must reach its quorum in order before access is granted,
+	// and the top-level users, groups and quorum are ignored.
+	Stages *[]ApprovalStage `json:"stages,omitempty"`
 
 	// The user IDs of the approvers for the request.
 	Users *[]string `json:"users,omitempty"`
 }
 
+// The review of a break-glass request, made by an approver after access was granted.
+type BreakGlassReview struct {
+	Comment *string `json:"comment,omitempty"`
+
+	// A decision made on an Access Request.
+	Decision   ReviewDecision `json:"decision"`
+	ReviewedAt time.Time      `json:"reviewedAt"`
+	ReviewerId string         `json:"reviewerId"`
+}
+
+// The outcome of revoking the grant for a single request as part of a bulk revocation.
+type BulkRevocation struct {
+	AccessRuleId string `json:"accessRuleId"`
+
+	// The reason the grant couldn't be revoked. Failed revocations are retried automatically.
+	Error *string `json:"error,omitempty"`
+
+	// The provider or target group of the grant.
+	Provider  string `json:"provider"`
+	RequestId string `json:"requestId"`
+
+	// PENDING grants are queued to be revoked. REVOKED grants were revoked, and FAILED grants couldn't be revoked.
+	// DRY_RUN grants would be revoked if the bulk revocation wasn't a dry run.
+	Status BulkRevocationStatus `json:"status"`
+	UserId string               `json:"userId"`
+}
+
+// PENDING grants are queued to be revoked. REVOKED grants were revoked, and FAILED grants couldn't be revoked.
+// DRY_RUN grants would be revoked if the bulk revocation wasn't a dry run.
+type BulkRevocationStatus string
+
+// PENDING bulk revocations are queued, IN_PROGRESS bulk revocations are revoking grants,
+// and COMPLETED bulk revocations have attempted to revoke every grant.
+type BulkRevokeStatus string
+
 // a request body for creating a Access Rule Target
 type CreateAccessRuleTarget struct {
 	ProviderId string                      `json:"providerId"`
@@ -265,6 +426,20 @@ type CreateRequestWith struct {
 // CreateRequestWithSubRequest defines model for CreateRequestWithSubRequest.
 type CreateRequestWithSubRequest = []CreateRequestWith
 
+// A time-boxed delegation of a user's reviews to another user.
+type Delegation struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// The ID of the user who reviews requests on their behalf.
+	DelegateId string    `json:"delegateId"`
+	EndsAt     time.Time `json:"endsAt"`
+	Reason     *string   `json:"reason,omitempty"`
+	StartsAt   time.Time `json:"startsAt"`
+
+	// The ID of the user whose reviews are delegated.
+	UserId string `json:"userId"`
+}
+
 // Diagnostic defines model for Diagnostic.
 type Diagnostic struct {
 	Code    string   `json:"code"`
@@ -272,6 +447,31 @@ type Diagnostic struct {
 	Message string   `json:"message"`
 }
 
+// What would happen if a request was made for a combination of arguments.
+type DryRunRequest struct {
+	// The IDs of the users who would be asked to review the request.
+	Approvers []string `json:"approvers"`
+
+	// The time the grant would end.
+	End time.Time `json:"end"`
+
+	// The IDs of requests with grants for the same access during the same time. Requests which don't require approval can't be made while they overlap an existing grant.
+	OverlappingRequests []string `json:"overlappingRequests"`
+
+	// false if the request would be approved automatically.
+	RequiresApproval bool `json:"requiresApproval"`
+
+	// The time the grant would start.
+	Start time.Time `json:"start"`
+
+	// false if the provider doesn't support validating grants.
+	ValidationSupported bool                           `json:"validationSupported"`
+	Validations         []externalRef0.GrantValidation `json:"validations"`
+
+	// The selected argument values.
+	With map[string]string `json:"with"`
+}
+
 // Favorite defines model for Favorite.
 type Favorite struct {
 	Id     string `json:"id"`
@@ -296,6 +496,9 @@ type Grant struct {
 	// The ID of the provider to grant access to.
 	Provider string `json:"provider"`
 
+	// The target group route which was used to provision a grant.
+	Route *GrantRoute `json:"route,omitempty"`
+
 	// The start time of the grant.
 	Start time.Time `json:"start"`
 
@@ -309,6 +512,71 @@ type Grant struct {
 // The current state of the grant.
 type GrantStatus string
 
+// How the access in a provider differs from a grant.
+// MISSING means the grant is active but the access has been removed from the provider.
+// UNEXPECTED means the user has access in the provider after their grant ended.
+type GrantDriftKind string
+
+// A change to a grant which failed and is retried with exponential backoff.
+type GrantRetry struct {
+	// The change to the grant which is being retried.
+	// ACTIVATE and DEACTIVATE provision and remove access for target group grants.
+	// REVOKE removes access before the grant ends.
+	Action GrantRetryAction `json:"action"`
+
+	// The number of times the change has failed.
+	Attempts  int       `json:"attempts"`
+	CreatedAt time.Time `json:"createdAt"`
+	Id        string    `json:"id"`
+
+	// The error from the most recent failed attempt.
+	LastError *string `json:"lastError,omitempty"`
+
+	// The number of failures after which the change is no longer retried automatically.
+	MaxAttempts   int       `json:"maxAttempts"`
+	NextAttemptAt time.Time `json:"nextAttemptAt"`
+	RequestId     string    `json:"requestId"`
+
+	// PENDING retries are attempted automatically once they are due.
+	// EXHAUSTED retries have failed too many times to be attempted automatically, and must be retried or abandoned by an administrator.
+	// SUCCEEDED retries have been completed, and ABANDONED retries won't be attempted again.
+	Status    GrantRetryStatus `json:"status"`
+	UpdatedAt time.Time        `json:"updatedAt"`
+}
+
+// The change to the grant which is being retried.
+// ACTIVATE and DEACTIVATE provision and remove access for target group grants.
+// REVOKE removes access before the grant ends.
+type GrantRetryAction string
+
+// PENDING retries are attempted automatically once they are due.
+// EXHAUSTED retries have failed too many times to be attempted automatically, and must be retried or abandoned by an administrator.
+// SUCCEEDED retries have been completed, and ABANDONED retries won't be attempted again.
+type GrantRetryStatus string
+
+// The target group route which was used to provision a grant.
+type GrantRoute struct {
+	HandlerId string `json:"handlerId"`
+	Kind      string `json:"kind"`
+}
+
+// The state returned by a target group handler when it provisioned access for a grant.
+type GrantState struct {
+	// The handler which provisioned the grant.
+	HandlerId string `json:"handlerId"`
+	Kind      string `json:"kind"`
+	RequestId string `json:"requestId"`
+
+	// The state is defined by the handler.
+	State     GrantState_State `json:"state"`
+	UpdatedAt time.Time        `json:"updatedAt"`
+}
+
+// The state is defined by the handler.
+type GrantState_State struct {
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
 // Group defines model for Group.
 type Group struct {
 	Description string   `json:"description"`
@@ -319,6 +587,45 @@ type Group struct {
 	Source      string   `json:"source"`
 }
 
+// The change a sync would make to a user or group.
+// UPDATE is used for users and groups which would be re-mapped to different groups or users, have their details changed, or be reactivated.
+type IdentitySyncChangeAction string
+
+// IdentitySyncGroupChange defines model for IdentitySyncGroupChange.
+type IdentitySyncGroupChange struct {
+	// The change a sync would make to a user or group.
+	// UPDATE is used for users and groups which would be re-mapped to different groups or users, have their details changed, or be reactivated.
+	Action IdentitySyncChangeAction `json:"action"`
+
+	// The IDs of the users who would be added to the group.
+	AddedUsers []string `json:"addedUsers"`
+	Group      Group    `json:"group"`
+
+	// The IDs of the users who would be removed from the group.
+	RemovedUsers []string `json:"removedUsers"`
+}
+
+// An identity provider which users and groups are synced from.
+type IdentitySyncSource struct {
+	// The group filter which was used. It is empty if groups are not filtered.
+	GroupFilter string `json:"groupFilter"`
+	IdpType     string `json:"idpType"`
+}
+
+// IdentitySyncUserChange defines model for IdentitySyncUserChange.
+type IdentitySyncUserChange struct {
+	// The change a sync would make to a user or group.
+	// UPDATE is used for users and groups which would be re-mapped to different groups or users, have their details changed, or be reactivated.
+	Action IdentitySyncChangeAction `json:"action"`
+
+	// The IDs of the groups the user would be added to.
+	AddedGroups []string `json:"addedGroups"`
+
+	// The IDs of the groups the user would be removed from.
+	RemovedGroups []string `json:"removedGroups"`
+	User          User     `json:"user"`
+}
+
 // IdpStatus defines model for IdpStatus.
 type IdpStatus string
 
@@ -431,6 +738,44 @@ type ProviderSetupValidation struct {
 // The status of the validation.
 type ProviderSetupValidationStatus string
 
+// A weekly schedule for access.
+type Recurrence struct {
+	// How long access lasts for each occurrence.
+	DurationSeconds int `json:"durationSeconds"`
+
+	// The time of day that access starts, in 24 hour HH:MM format.
+	StartTime string `json:"startTime"`
+
+	// The IANA time zone that the start time is in. Defaults to UTC.
+	Timezone *string `json:"timezone,omitempty"`
+
+	// The days of the week which access starts on.
+	Weekdays []Weekday `json:"weekdays"`
+}
+
+// A series of requests which are made on a weekly schedule.
+type RecurringRequest struct {
+	AccessRuleId string `json:"accessRuleId"`
+
+	// The last time an occurrence of the series was approved by a reviewer.
+	ApprovedAt *time.Time `json:"approvedAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ID         string     `json:"id"`
+
+	// The start time of the next occurrence, which hasn't been requested yet.
+	NextOccurrence time.Time `json:"nextOccurrence"`
+	Reason         *string   `json:"reason,omitempty"`
+
+	// A weekly schedule for access.
+	Recurrence Recurrence             `json:"recurrence"`
+	Requestor  string                 `json:"requestor"`
+	Status     RecurringRequestStatus `json:"status"`
+	UpdatedAt  time.Time              `json:"updatedAt"`
+}
+
+// RecurringRequestStatus defines model for RecurringRequestStatus.
+type RecurringRequestStatus string
+
 // A request to access something made by an end user in Common Fate.
 type Request struct {
 	AccessRuleId      string `json:"accessRuleId"`
@@ -440,11 +785,14 @@ type Request struct {
 	ApprovalMethod *ApprovalMethod `json:"approvalMethod,omitempty"`
 
 	// A temporary assignment of a user to a principal.
-	Grant       *Grant    `json:"grant,omitempty"`
-	ID          string    `json:"id"`
-	Reason      *string   `json:"reason,omitempty"`
-	RequestedAt time.Time `json:"requestedAt"`
-	Requestor   string    `json:"requestor"`
+	Grant  *Grant  `json:"grant,omitempty"`
+	ID     string  `json:"id"`
+	Reason *string `json:"reason,omitempty"`
+
+	// The ID of the recurring request which created this request, if any.
+	RecurringRequestId *string   `json:"recurringRequestId,omitempty"`
+	RequestedAt        time.Time `json:"requestedAt"`
+	Requestor          string    `json:"requestor"`
 
 	// The status of an Access Request.
 	Status    RequestStatus `json:"status"`
@@ -484,6 +832,17 @@ type RequestAccessRuleTarget_Arguments struct {
 	AdditionalProperties map[string]RequestArgument `json:"-"`
 }
 
+// The approval progress of a stage for an Access Request.
+type RequestApprovalStage struct {
+	// The user IDs of the reviewers who approved this stage.
+	ApprovedBy []string `json:"approvedBy"`
+	Complete   bool     `json:"complete"`
+	Name       string   `json:"name"`
+
+	// The number of approvals required to complete the stage.
+	Quorum int `json:"quorum"`
+}
+
 // RequestArgument defines model for RequestArgument.
 type RequestArgument struct {
 	Description *string                     `json:"description,omitempty"`
@@ -498,24 +857,43 @@ type RequestArgument struct {
 // RequestArgumentFormElement defines model for RequestArgument.FormElement.
 type RequestArgumentFormElement string
 
+// A comment in the discussion on an access request.
+type RequestComment struct {
+	// The ID of the user who wrote the comment.
+	AuthorId  string    `json:"authorId"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+	Id        string    `json:"id"`
+	RequestId string    `json:"requestId"`
+}
+
 // A request to access something made by an end user in Common Fate.
 type RequestDetail struct {
 	// Access Rule contains information for an end user to make a request for access.
 	AccessRule AccessRule `json:"accessRule"`
 
 	// Describes whether a request has been approved automatically or from a review
-	ApprovalMethod *ApprovalMethod         `json:"approvalMethod,omitempty"`
+	ApprovalMethod *ApprovalMethod `json:"approvalMethod,omitempty"`
+
+	// The approval progress for rules which require more than a single approval.
+	ApprovalStages *[]RequestApprovalStage `json:"approvalStages,omitempty"`
 	Arguments      RequestDetail_Arguments `json:"arguments"`
 
+	// The review of a break-glass request, made by an approver after access was granted.
+	BreakGlassReview *BreakGlassReview `json:"breakGlassReview,omitempty"`
+
 	// true if the requesting user is a reviewer of this request.
 	CanReview bool `json:"canReview"`
 
 	// A temporary assignment of a user to a principal.
-	Grant       *Grant    `json:"grant,omitempty"`
-	ID          string    `json:"id"`
-	Reason      *string   `json:"reason,omitempty"`
-	RequestedAt time.Time `json:"requestedAt"`
-	Requestor   string    `json:"requestor"`
+	Grant *Grant `json:"grant,omitempty"`
+	ID    string `json:"id"`
+
+	// An extension to an access request which is awaiting approval.
+	PendingExtension *RequestExtension `json:"pendingExtension,omitempty"`
+	Reason           *string           `json:"reason,omitempty"`
+	RequestedAt      time.Time         `json:"requestedAt"`
+	Requestor        string            `json:"requestor"`
 
 	// The status of an Access Request.
 	Status    RequestStatus `json:"status"`
@@ -530,23 +908,52 @@ type RequestDetail_Arguments struct {
 
 // RequestEvent defines model for RequestEvent.
 type RequestEvent struct {
-	Actor     *string   `json:"actor,omitempty"`
-	CreatedAt time.Time `json:"createdAt"`
+	Actor *string `json:"actor,omitempty"`
+
+	// The approval progress of a stage for an Access Request.
+	ApprovalStage *RequestApprovalStage `json:"approvalStage,omitempty"`
+
+	// true if the request was approved using break-glass access.
+	BreakGlass *bool `json:"breakGlass,omitempty"`
+
+	// If the grant was revoked as part of a bulk revocation, the ID of the bulk revocation.
+	BulkRevocationId *string `json:"bulkRevocationId,omitempty"`
+
+	// A comment in the discussion on an access request.
+	Comment   *RequestComment `json:"comment,omitempty"`
+	CreatedAt time.Time       `json:"createdAt"`
+
+	// If the event was caused by a review made by a delegate, the ID of the user who delegated their reviews.
+	DelegatedFrom *string `json:"delegatedFrom,omitempty"`
 
 	// The current state of the grant.
 	FromGrantStatus *RequestEventFromGrantStatus `json:"fromGrantStatus,omitempty"`
 
 	// The status of an Access Request.
-	FromStatus         *RequestStatus `json:"fromStatus,omitempty"`
-	FromTiming         *RequestTiming `json:"fromTiming,omitempty"`
-	GrantCreated       *bool          `json:"grantCreated,omitempty"`
-	GrantFailureReason *string        `json:"grantFailureReason,omitempty"`
-	Id                 string         `json:"id"`
+	FromStatus   *RequestStatus `json:"fromStatus,omitempty"`
+	FromTiming   *RequestTiming `json:"fromTiming,omitempty"`
+	GrantCreated *bool          `json:"grantCreated,omitempty"`
+
+	// How the access in a provider differs from a grant.
+	// MISSING means the grant is active but the access has been removed from the provider.
+	// UNEXPECTED means the user has access in the provider after their grant ended.
+	GrantDrift         *GrantDriftKind `json:"grantDrift,omitempty"`
+	GrantFailureReason *string         `json:"grantFailureReason,omitempty"`
+	Id                 string          `json:"id"`
 
 	// An event which was recorded relating to the grant.
-	RecordedEvent  *map[string]string `json:"recordedEvent,omitempty"`
-	RequestCreated *bool              `json:"requestCreated,omitempty"`
-	RequestId      string             `json:"requestId"`
+	RecordedEvent *map[string]string `json:"recordedEvent,omitempty"`
+
+	// The provider or handler which recorded the event, such as 'ecs-shell'.
+	RecordedEventSource *string `json:"recordedEventSource,omitempty"`
+
+	// true if the reviewers of the pending request were sent a reminder.
+	ReminderSent   *bool  `json:"reminderSent,omitempty"`
+	RequestCreated *bool  `json:"requestCreated,omitempty"`
+	RequestId      string `json:"requestId"`
+
+	// The session which the recorded event occurred in, if the source tracks sessions.
+	SessionId *string `json:"sessionId,omitempty"`
 
 	// The current state of the grant.
 	ToGrantStatus *RequestEventToGrantStatus `json:"toGrantStatus,omitempty"`
@@ -562,6 +969,14 @@ type RequestEventFromGrantStatus string
 // The current state of the grant.
 type RequestEventToGrantStatus string
 
+// An extension to an access request which is awaiting approval.
+type RequestExtension struct {
+	// The number of seconds the grant will be extended by.
+	DurationSeconds int       `json:"durationSeconds"`
+	Reason          *string   `json:"reason,omitempty"`
+	RequestedAt     time.Time `json:"requestedAt"`
+}
+
 // The status of an Access Request.
 type RequestStatus string
 
@@ -576,6 +991,23 @@ type RequestTiming struct {
 // A decision made on an Access Request.
 type ReviewDecision string
 
+// How requests are routed between the valid handlers linked to a target group.
+// PRIORITY uses the highest priority route, falling back to lower priority routes if it fails.
+// WEIGHTED spreads requests between routes in proportion to their weight, falling back to the other routes if the chosen route fails.
+// Target groups without a routing strategy use PRIORITY.
+type RoutingStrategy string
+
+// Separation-of-duties constraints which are checked when a request is approved.
+// Requestors can never approve their own requests, regardless of these settings.
+type SeparationOfDuties struct {
+	// Group IDs whose members cannot approve requests made by other members of the same group.
+	// If the requestor belongs to one of these groups, the request must be approved by a user outside of it.
+	ExclusiveGroups *[]string `json:"exclusiveGroups,omitempty"`
+
+	// If set, the maximum number of requests for this rule that a single reviewer may approve in a 24 hour period.
+	MaxApprovalsPerDay *int `json:"maxApprovalsPerDay,omitempty"`
+}
+
 // Handler represents a deployment of a provider.
 // Handlers can be linked to target groups via routes
 type TGHandler struct {
@@ -623,11 +1055,17 @@ type TargetGroup struct {
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
 
 	// Specifies a particular Access Provider to create a Target Group schema from.
-	From      TargetGroupFrom `json:"from"`
-	Icon      string          `json:"icon"`
-	Id        string          `json:"id"`
-	Schema    TargetSchema    `json:"schema"`
-	UpdatedAt *time.Time      `json:"updatedAt,omitempty"`
+	From TargetGroupFrom `json:"from"`
+	Icon string          `json:"icon"`
+	Id   string          `json:"id"`
+
+	// How requests are routed between the valid handlers linked to a target group.
+	// PRIORITY uses the highest priority route, falling back to lower priority routes if it fails.
+	// WEIGHTED spreads requests between routes in proportion to their weight, falling back to the other routes if the chosen route fails.
+	// Target groups without a routing strategy use PRIORITY.
+	RoutingStrategy *RoutingStrategy `json:"routingStrategy,omitempty"`
+	Schema          TargetSchema     `json:"schema"`
+	UpdatedAt       *time.Time       `json:"updatedAt,omitempty"`
 }
 
 // Specifies a particular Access Provider to create a Target Group schema from.
@@ -646,6 +1084,9 @@ type TargetRoute struct {
 	Priority      int          `json:"priority"`
 	TargetGroupId string       `json:"targetGroupId"`
 	Valid         bool         `json:"valid"`
+
+	// The relative share of requests sent to this route when the target group uses weighted routing.
+	Weight *int `json:"weight,omitempty"`
 }
 
 // TargetSchema defines model for TargetSchema.
@@ -671,6 +1112,9 @@ type User struct {
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// Weekday defines model for Weekday.
+type Weekday string
+
 // With defines model for With.
 type With struct {
 	FieldDescription  *string `json:"fieldDescription,omitempty"`
@@ -701,6 +1145,18 @@ type AuthUserResponse struct {
 	User    User `json:"user"`
 }
 
+// BulkRevokeResponse defines model for BulkRevokeResponse.
+type BulkRevokeResponse struct {
+	CompletedAt *time.Time       `json:"completedAt,omitempty"`
+	DryRun      bool             `json:"dryRun"`
+	Id          string           `json:"id"`
+	Revocations []BulkRevocation `json:"revocations"`
+
+	// PENDING bulk revocations are queued, IN_PROGRESS bulk revocations are revoking grants,
+	// and COMPLETED bulk revocations have attempted to revoke every grant.
+	Status *BulkRevokeStatus `json:"status,omitempty"`
+}
+
 // CompleteProviderSetupResponse defines model for CompleteProviderSetupResponse.
 type CompleteProviderSetupResponse struct {
 	// Whether a manual update is required to the Common Fate deployment configuration (`deployment.yml`) to activate the provider.
@@ -712,23 +1168,60 @@ type CreateRequestResponse struct {
 	Requests []Request `json:"requests"`
 }
 
+// A time-boxed delegation of a user's reviews to another user.
+type DelegationResponse = Delegation
+
 // DeploymentVersionResponse defines model for DeploymentVersionResponse.
 type DeploymentVersionResponse struct {
 	// The deployment version. Will be a semver, such as "v0.9.0" for official releases, or "dev+GIT_HASH" for pre-release builds.
 	Version string `json:"version"`
 }
 
+// DryRunRequestResponse defines model for DryRunRequestResponse.
+type DryRunRequestResponse struct {
+	Requests []DryRunRequest `json:"requests"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error string `json:"error"`
 }
 
+// ExtendRequestResponse defines model for ExtendRequestResponse.
+type ExtendRequestResponse struct {
+	// true if the extension requires approval before it takes effect.
+	ExtensionPending bool `json:"extensionPending"`
+
+	// A request to access something made by an end user in Common Fate.
+	Request Request `json:"request"`
+}
+
+// A change to a grant which failed and is retried with exponential backoff.
+type GrantRetryResponse = GrantRetry
+
+// The state returned by a target group handler when it provisioned access for a grant.
+type GrantStateResponse = GrantState
+
 // IdentityConfigurationResponse defines model for IdentityConfigurationResponse.
 type IdentityConfigurationResponse struct {
 	AdministratorGroupId string `json:"administratorGroupId"`
 	IdentityProvider     string `json:"identityProvider"`
 }
 
+// IdentitySyncDryRunResponse defines model for IdentitySyncDryRunResponse.
+type IdentitySyncDryRunResponse struct {
+	// The group filter which was used for the identity provider with the highest precedence. It is empty if groups are not filtered.
+	GroupFilter string                    `json:"groupFilter"`
+	Groups      []IdentitySyncGroupChange `json:"groups"`
+
+	// The identity provider with the highest precedence.
+	IdpType string `json:"idpType"`
+
+	// The identity providers which were listed, in order of precedence.
+	Sources []IdentitySyncSource     `json:"sources"`
+	Users   []IdentitySyncUserChange `json:"users"`
+}
+
 // ListAccessRuleApproversResponse defines model for ListAccessRuleApproversResponse.
 type ListAccessRuleApproversResponse struct {
 	Next  *string  `json:"next"`
@@ -753,6 +1246,11 @@ type ListFavoritesResponse struct {
 	Next      *string    `json:"next"`
 }
 
+// ListGrantRetriesResponse defines model for ListGrantRetriesResponse.
+type ListGrantRetriesResponse struct {
+	GrantRetries []GrantRetry `json:"grantRetries"`
+}
+
 // ListGroupsResponse defines model for ListGroupsResponse.
 type ListGroupsResponse struct {
 	Groups []Group `json:"groups"`
@@ -770,6 +1268,16 @@ type ListProviderSetupsResponse struct {
 	ProviderSetups []ProviderSetup `json:"providerSetups"`
 }
 
+// ListRecurringRequestsResponse defines model for ListRecurringRequestsResponse.
+type ListRecurringRequestsResponse struct {
+	RecurringRequests []RecurringRequest `json:"recurringRequests"`
+}
+
+// ListRequestCommentsResponse defines model for ListRequestCommentsResponse.
+type ListRequestCommentsResponse struct {
+	Comments []RequestComment `json:"comments"`
+}
+
 // ListRequestEventsResponse defines model for ListRequestEventsResponse.
 type ListRequestEventsResponse struct {
 	Events []RequestEvent `json:"events"`
@@ -803,22 +1311,65 @@ type ListUserResponse struct {
 // A provider in the process of being set up through the guided setup workflow in Common Fate. These providers are **not** yet active.
 type ProviderSetupResponse = ProviderSetup
 
+// RecurringRequestDetailResponse defines model for RecurringRequestDetailResponse.
+type RecurringRequestDetailResponse struct {
+	// A series of requests which are made on a weekly schedule.
+	RecurringRequest RecurringRequest `json:"recurringRequest"`
+	Requests         []Request        `json:"requests"`
+}
+
+// A series of requests which are made on a weekly schedule.
+type RecurringRequestResponse = RecurringRequest
+
+// A comment in the discussion on an access request.
+type RequestCommentResponse = RequestComment
+
 // ReviewResponse defines model for ReviewResponse.
 type ReviewResponse struct {
 	// A request to access something made by an end user in Common Fate.
 	Request *Request `json:"request,omitempty"`
 }
 
+// SCIMTokenResponse defines model for SCIMTokenResponse.
+type SCIMTokenResponse struct {
+	Token string `json:"token"`
+}
+
+// BulkRevokeRequest defines model for BulkRevokeRequest.
+type BulkRevokeRequest struct {
+	AccessRuleId *string `json:"accessRuleId,omitempty"`
+
+	// List the grants which would be revoked without revoking them.
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// Revoke grants for access rules which use this Access Handler provider.
+	ProviderId *string `json:"providerId,omitempty"`
+
+	// Why the grants are being revoked. It is included in the audit record for the bulk revocation.
+	Reason *string `json:"reason,omitempty"`
+
+	// Revoke grants for access rules which use this target group.
+	TargetGroupId *string `json:"targetGroupId,omitempty"`
+	UserId        *string `json:"userId,omitempty"`
+}
+
 // CreateAccessRuleRequest defines model for CreateAccessRuleRequest.
 type CreateAccessRuleRequest struct {
 	// Approver config for access rules
-	Approval    ApproverConfig `json:"approval"`
-	Description string         `json:"description"`
+	Approval ApproverConfig `json:"approval"`
+
+	// Allow users to request break-glass access for this rule, skipping approval.
+	BreakGlass  *bool  `json:"breakGlass,omitempty"`
+	Description string `json:"description"`
 
 	// The group IDs that the access rule applies to.
 	Groups []string `json:"groups"`
 	Name   string   `json:"name"`
 
+	// Separation-of-duties constraints which are checked when a request is approved.
+	// Requestors can never approve their own requests, regardless of these settings.
+	SeparationOfDuties *SeparationOfDuties `json:"separationOfDuties,omitempty"`
+
 	// a request body for creating a Access Rule Target
 	Target CreateAccessRuleTarget `json:"target"`
 
@@ -848,12 +1399,31 @@ type CreateProviderSetupRequest struct {
 	ProviderType string `json:"providerType"`
 }
 
+// CreateRecurringRequestRequest defines model for CreateRecurringRequestRequest.
+type CreateRecurringRequestRequest struct {
+	AccessRuleId string  `json:"accessRuleId"`
+	Reason       *string `json:"reason,omitempty"`
+
+	// A weekly schedule for access.
+	Recurrence Recurrence         `json:"recurrence"`
+	With       *CreateRequestWith `json:"with,omitempty"`
+}
+
+// CreateRequestComment defines model for CreateRequestComment.
+type CreateRequestComment struct {
+	Body string `json:"body"`
+}
+
 // CreateRequestRequest defines model for CreateRequestRequest.
 type CreateRequestRequest struct {
-	AccessRuleId string                       `json:"accessRuleId"`
-	Reason       *string                      `json:"reason,omitempty"`
-	Timing       RequestTiming                `json:"timing"`
-	With         *CreateRequestWithSubRequest `json:"with,omitempty"`
+	AccessRuleId string `json:"accessRuleId"`
+
+	// Request break-glass access, skipping approval. Only allowed for Access Rules with break-glass enabled.
+	// A reason is required and the request will be reviewed by an approver after access is granted.
+	BreakGlass *bool                        `json:"breakGlass,omitempty"`
+	Reason     *string                      `json:"reason,omitempty"`
+	Timing     RequestTiming                `json:"timing"`
+	With       *CreateRequestWithSubRequest `json:"with,omitempty"`
 }
 
 // CreateTargetGroupLink defines model for CreateTargetGroupLink.
@@ -861,6 +1431,9 @@ type CreateTargetGroupLink struct {
 	DeploymentId string `json:"deploymentId"`
 	Kind         string `json:"kind"`
 	Priority     int    `json:"priority"`
+
+	// The relative share of requests sent to this route when the target group uses weighted routing. Defaults to 1.
+	Weight *int `json:"weight,omitempty"`
 }
 
 // CreateTargetGroupRequest defines model for CreateTargetGroupRequest.
@@ -868,6 +1441,12 @@ type CreateTargetGroupRequest struct {
 	// Specifies a particular Access Provider to create a Target Group schema from.
 	From TargetGroupFrom `json:"from"`
 	Id   string          `json:"id"`
+
+	// How requests are routed between the valid handlers linked to a target group.
+	// PRIORITY uses the highest priority route, falling back to lower priority routes if it fails.
+	// WEIGHTED spreads requests between routes in proportion to their weight, falling back to the other routes if the chosen route fails.
+	// Target groups without a routing strategy use PRIORITY.
+	RoutingStrategy *RoutingStrategy `json:"routingStrategy,omitempty"`
 }
 
 // CreateUserRequest defines model for CreateUserRequest.
@@ -878,6 +1457,22 @@ type CreateUserRequest struct {
 	LastName  string              `json:"lastName"`
 }
 
+// ExtendRequest defines model for ExtendRequest.
+type ExtendRequest struct {
+	// The number of seconds to extend the grant by.
+	ExtensionDurationSeconds int     `json:"extensionDurationSeconds"`
+	Reason                   *string `json:"reason,omitempty"`
+}
+
+// IdentitySyncDryRunRequest defines model for IdentitySyncDryRunRequest.
+type IdentitySyncDryRunRequest struct {
+	// A group filter to use in place of the configured identity group filter. Use an empty string to preview removing the filter.
+	GroupFilter *string `json:"groupFilter,omitempty"`
+
+	// The identity provider which the group filter is applied to. Defaults to the identity provider with the highest precedence.
+	Source *string `json:"source,omitempty"`
+}
+
 // ProviderSetupStepCompleteRequest defines model for ProviderSetupStepCompleteRequest.
 type ProviderSetupStepCompleteRequest struct {
 	// Whether the step is complete or not.
@@ -897,6 +1492,12 @@ type RegisterHandlerRequest struct {
 	Runtime string `json:"runtime"`
 }
 
+// ReviewExtensionRequest defines model for ReviewExtensionRequest.
+type ReviewExtensionRequest struct {
+	// A decision made on an Access Request.
+	Decision ReviewDecision `json:"decision"`
+}
+
 // ReviewRequest defines model for ReviewRequest.
 type ReviewRequest struct {
 	Comment *string `json:"comment,omitempty"`
@@ -906,6 +1507,19 @@ type ReviewRequest struct {
 	OverrideTiming *RequestTiming `json:"overrideTiming,omitempty"`
 }
 
+// SetDelegateRequest defines model for SetDelegateRequest.
+type SetDelegateRequest struct {
+	// The ID of the user who will review requests on your behalf.
+	DelegateId string `json:"delegateId"`
+
+	// When the delegation ends.
+	EndsAt time.Time `json:"endsAt"`
+	Reason *string   `json:"reason,omitempty"`
+
+	// When the delegation starts. Defaults to the current time.
+	StartsAt *time.Time `json:"startsAt,omitempty"`
+}
+
 // UserLookupAccessRuleParams defines parameters for UserLookupAccessRule.
 type UserLookupAccessRuleParams struct {
 	// the provider type i.e. commonfate/aws-sso. type should be encoded i.e.  backslash -> %2
@@ -933,6 +1547,12 @@ type AdminListAccessRulesParams struct {
 // AdminListAccessRulesParamsStatus defines parameters for AdminListAccessRules.
 type AdminListAccessRulesParamsStatus string
 
+// AdminListGrantRetriesParams defines parameters for AdminListGrantRetries.
+type AdminListGrantRetriesParams struct {
+	// omit this param to view all results
+	Status *GrantRetryStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
 // AdminListGroupsParams defines parameters for AdminListGroups.
 type AdminListGroupsParams struct {
 	// encrypted token containing pagination info
@@ -1005,12 +1625,21 @@ type UserListRequestsUpcomingParams struct {
 	NextToken *string `form:"nextToken,omitempty" json:"nextToken,omitempty"`
 }
 
+// UserListRequestEventsParams defines parameters for UserListRequestEvents.
+type UserListRequestEventsParams struct {
+	// encrypted token containing pagination info
+	NextToken *string `form:"nextToken,omitempty" json:"nextToken,omitempty"`
+}
+
 // AdminCreateAccessRuleJSONRequestBody defines body for AdminCreateAccessRule for application/json ContentType.
 type AdminCreateAccessRuleJSONRequestBody CreateAccessRuleRequest
 
 // AdminUpdateAccessRuleJSONRequestBody defines body for AdminUpdateAccessRule for application/json ContentType.
 type AdminUpdateAccessRuleJSONRequestBody CreateAccessRuleRequest
 
+// AdminBulkRevokeJSONRequestBody defines body for AdminBulkRevoke for application/json ContentType.
+type AdminBulkRevokeJSONRequestBody BulkRevokeRequest
+
 // AdminCreateGroupJSONRequestBody defines body for AdminCreateGroup for application/json ContentType.
 type AdminCreateGroupJSONRequestBody CreateGroupRequest
 
@@ -1020,6 +1649,9 @@ type AdminUpdateGroupJSONRequestBody CreateGroupRequest
 // AdminRegisterHandlerJSONRequestBody defines body for AdminRegisterHandler for application/json ContentType.
 type AdminRegisterHandlerJSONRequestBody RegisterHandlerRequest
 
+// AdminDryRunIdentitySyncJSONRequestBody defines body for AdminDryRunIdentitySync for application/json ContentType.
+type AdminDryRunIdentitySyncJSONRequestBody IdentitySyncDryRunRequest
+
 // AdminCreateProvidersetupJSONRequestBody defines body for AdminCreateProvidersetup for application/json ContentType.
 type AdminCreateProvidersetupJSONRequestBody CreateProviderSetupRequest
 
@@ -1044,12 +1676,30 @@ type UserCreateFavoriteJSONRequestBody CreateFavoriteRequest
 // UserUpdateFavoriteJSONRequestBody defines body for UserUpdateFavorite for application/json ContentType.
 type UserUpdateFavoriteJSONRequestBody CreateFavoriteRequest
 
+// UserCreateRecurringRequestJSONRequestBody defines body for UserCreateRecurringRequest for application/json ContentType.
+type UserCreateRecurringRequestJSONRequestBody CreateRecurringRequestRequest
+
 // UserCreateRequestJSONRequestBody defines body for UserCreateRequest for application/json ContentType.
 type UserCreateRequestJSONRequestBody CreateRequestRequest
 
+// UserDryRunRequestJSONRequestBody defines body for UserDryRunRequest for application/json ContentType.
+type UserDryRunRequestJSONRequestBody CreateRequestRequest
+
+// UserCreateRequestCommentJSONRequestBody defines body for UserCreateRequestComment for application/json ContentType.
+type UserCreateRequestCommentJSONRequestBody CreateRequestComment
+
+// UserExtendRequestJSONRequestBody defines body for UserExtendRequest for application/json ContentType.
+type UserExtendRequestJSONRequestBody ExtendRequest
+
+// UserReviewExtensionJSONRequestBody defines body for UserReviewExtension for application/json ContentType.
+type UserReviewExtensionJSONRequestBody ReviewExtensionRequest
+
 // UserReviewRequestJSONRequestBody defines body for UserReviewRequest for application/json ContentType.
 type UserReviewRequestJSONRequestBody ReviewRequest
 
+// UserSetDelegateJSONRequestBody defines body for UserSetDelegate for application/json ContentType.
+type UserSetDelegateJSONRequestBody SetDelegateRequest
+
 // Getter for additional properties for AccessRuleTargetDetail_With. Returns the specified
 // element and whether it was found
 func (a AccessRuleTargetDetail_With) Get(fieldName string) (value AccessRuleTargetDetailArguments, found bool) {
@@ -1315,25 +1965,25 @@ func (a CreateRequestWith) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for RequestAccessRuleTarget_Arguments. Returns the specified
+// Getter for additional properties for GrantState_State. Returns the specified
 // element and whether it was found
-func (a RequestAccessRuleTarget_Arguments) Get(fieldName string) (value RequestArgument, found bool) {
+func (a GrantState_State) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for RequestAccessRuleTarget_Arguments
-func (a *RequestAccessRuleTarget_Arguments) Set(fieldName string, value RequestArgument) {
+// Setter for additional properties for GrantState_State
+func (a *GrantState_State) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]RequestArgument)
+		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for RequestAccessRuleTarget_Arguments to handle AdditionalProperties
-func (a *RequestAccessRuleTarget_Arguments) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for GrantState_State to handle AdditionalProperties
+func (a *GrantState_State) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -1341,9 +1991,9 @@ func (a *RequestAccessRuleTarget_Arguments) UnmarshalJSON(b []byte) error {
 	}
 
 	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]RequestArgument)
+		a.AdditionalProperties = make(map[string]interface{})
 		for fieldName, fieldBuf := range object {
-			var fieldVal RequestArgument
+			var fieldVal interface{}
 			err := json.Unmarshal(fieldBuf, &fieldVal)
 			if err != nil {
 				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
@@ -1354,8 +2004,8 @@ func (a *RequestAccessRuleTarget_Arguments) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for RequestAccessRuleTarget_Arguments to handle AdditionalProperties
-func (a RequestAccessRuleTarget_Arguments) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for GrantState_State to handle AdditionalProperties
+func (a GrantState_State) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
@@ -1368,25 +2018,78 @@ func (a RequestAccessRuleTarget_Arguments) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for RequestDetail_Arguments. Returns the specified
+// Getter for additional properties for RequestAccessRuleTarget_Arguments. Returns the specified
 // element and whether it was found
-func (a RequestDetail_Arguments) Get(fieldName string) (value With, found bool) {
+func (a RequestAccessRuleTarget_Arguments) Get(fieldName string) (value RequestArgument, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for RequestDetail_Arguments
-func (a *RequestDetail_Arguments) Set(fieldName string, value With) {
+// Setter for additional properties for RequestAccessRuleTarget_Arguments
+func (a *RequestAccessRuleTarget_Arguments) Set(fieldName string, value RequestArgument) {
 	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]With)
+		a.AdditionalProperties = make(map[string]RequestArgument)
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for RequestDetail_Arguments to handle AdditionalProperties
-func (a *RequestDetail_Arguments) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for RequestAccessRuleTarget_Arguments to handle AdditionalProperties
+func (a *RequestAccessRuleTarget_Arguments) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]RequestArgument)
+		for fieldName, fieldBuf := range object {
+			var fieldVal RequestArgument
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for RequestAccessRuleTarget_Arguments to handle AdditionalProperties
+func (a RequestAccessRuleTarget_Arguments) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for RequestDetail_Arguments. Returns the specified
+// element and whether it was found
+func (a RequestDetail_Arguments) Get(fieldName string) (value With, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for RequestDetail_Arguments
+func (a *RequestDetail_Arguments) Set(fieldName string, value With) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]With)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for RequestDetail_Arguments to handle AdditionalProperties
+func (a *RequestDetail_Arguments) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -1637,9 +2340,26 @@ type ClientInterface interface {
 	// AdminGetAccessRuleVersion request
 	AdminGetAccessRuleVersion(ctx context.Context, ruleId string, version string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AdminBulkRevoke request with any body
+	AdminBulkRevokeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminBulkRevoke(ctx context.Context, body AdminBulkRevokeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetBulkRevocation request
+	AdminGetBulkRevocation(ctx context.Context, bulkRevocationId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AdminGetDeploymentVersion request
 	AdminGetDeploymentVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AdminListGrantRetries request
+	AdminListGrantRetries(ctx context.Context, params *AdminListGrantRetriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminAbandonGrantRetry request
+	AdminAbandonGrantRetry(ctx context.Context, retryId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminRetryGrantRetry request
+	AdminRetryGrantRetry(ctx context.Context, retryId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AdminListGroups request
 	AdminListGroups(ctx context.Context, params *AdminListGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1679,9 +2399,20 @@ type ClientInterface interface {
 	// AdminGetIdentityConfiguration request
 	AdminGetIdentityConfiguration(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AdminDeleteScimToken request
+	AdminDeleteScimToken(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminCreateScimToken request
+	AdminCreateScimToken(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AdminSyncIdentity request
 	AdminSyncIdentity(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AdminDryRunIdentitySync request with any body
+	AdminDryRunIdentitySyncWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminDryRunIdentitySync(ctx context.Context, body AdminDryRunIdentitySyncJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AdminListProviders request
 	AdminListProviders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1728,6 +2459,9 @@ type ClientInterface interface {
 	// AdminGetRequest request
 	AdminGetRequest(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AdminGetRequestGrantState request
+	AdminGetRequestGrantState(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AdminListTargetGroups request
 	AdminListTargetGroups(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1785,6 +2519,20 @@ type ClientInterface interface {
 
 	UserUpdateFavorite(ctx context.Context, id string, body UserUpdateFavoriteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UserListRecurringRequests request
+	UserListRecurringRequests(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserCreateRecurringRequest request with any body
+	UserCreateRecurringRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserCreateRecurringRequest(ctx context.Context, body UserCreateRecurringRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserGetRecurringRequest request
+	UserGetRecurringRequest(ctx context.Context, recurringRequestId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserCancelRecurringRequest request
+	UserCancelRecurringRequest(ctx context.Context, recurringRequestId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UserListRequests request
 	UserListRequests(ctx context.Context, params *UserListRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1793,6 +2541,11 @@ type ClientInterface interface {
 
 	UserCreateRequest(ctx context.Context, body UserCreateRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UserDryRunRequest request with any body
+	UserDryRunRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserDryRunRequest(ctx context.Context, body UserDryRunRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UserListRequestsPast request
 	UserListRequestsPast(ctx context.Context, params *UserListRequestsPastParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1811,8 +2564,26 @@ type ClientInterface interface {
 	// UserCancelRequest request
 	UserCancelRequest(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UserListRequestComments request
+	UserListRequestComments(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserCreateRequestComment request with any body
+	UserCreateRequestCommentWithBody(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserCreateRequestComment(ctx context.Context, requestId string, body UserCreateRequestCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UserListRequestEvents request
-	UserListRequestEvents(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UserListRequestEvents(ctx context.Context, requestId string, params *UserListRequestEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserExtendRequest request with any body
+	UserExtendRequestWithBody(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserExtendRequest(ctx context.Context, requestId string, body UserExtendRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserReviewExtension request with any body
+	UserReviewExtensionWithBody(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserReviewExtension(ctx context.Context, requestId string, body UserReviewExtensionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UserReviewRequest request with any body
 	UserReviewRequestWithBody(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -1825,6 +2596,17 @@ type ClientInterface interface {
 	// UserGetMe request
 	UserGetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UserRemoveDelegate request
+	UserRemoveDelegate(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserGetDelegate request
+	UserGetDelegate(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserSetDelegate request with any body
+	UserSetDelegateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserSetDelegate(ctx context.Context, body UserSetDelegateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UserGetUser request
 	UserGetUser(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
@@ -1985,6 +2767,42 @@ func (c *Client) AdminGetAccessRuleVersion(ctx context.Context, ruleId string, v
 	return c.Client.Do(req)
 }
 
+func (c *Client) AdminBulkRevokeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminBulkRevokeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminBulkRevoke(ctx context.Context, body AdminBulkRevokeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminBulkRevokeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminGetBulkRevocation(ctx context.Context, bulkRevocationId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetBulkRevocationRequest(c.Server, bulkRevocationId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) AdminGetDeploymentVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewAdminGetDeploymentVersionRequest(c.Server)
 	if err != nil {
@@ -1997,6 +2815,42 @@ func (c *Client) AdminGetDeploymentVersion(ctx context.Context, reqEditors ...Re
 	return c.Client.Do(req)
 }
 
+func (c *Client) AdminListGrantRetries(ctx context.Context, params *AdminListGrantRetriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListGrantRetriesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminAbandonGrantRetry(ctx context.Context, retryId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminAbandonGrantRetryRequest(c.Server, retryId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminRetryGrantRetry(ctx context.Context, retryId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminRetryGrantRetryRequest(c.Server, retryId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) AdminListGroups(ctx context.Context, params *AdminListGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewAdminListGroupsRequest(c.Server, params)
 	if err != nil {
@@ -2165,6 +3019,30 @@ func (c *Client) AdminGetIdentityConfiguration(ctx context.Context, reqEditors .
 	return c.Client.Do(req)
 }
 
+func (c *Client) AdminDeleteScimToken(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminDeleteScimTokenRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminCreateScimToken(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateScimTokenRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) AdminSyncIdentity(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewAdminSyncIdentityRequest(c.Server)
 	if err != nil {
@@ -2177,6 +3055,30 @@ func (c *Client) AdminSyncIdentity(ctx context.Context, reqEditors ...RequestEdi
 	return c.Client.Do(req)
 }
 
+func (c *Client) AdminDryRunIdentitySyncWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminDryRunIdentitySyncRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminDryRunIdentitySync(ctx context.Context, body AdminDryRunIdentitySyncJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminDryRunIdentitySyncRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) AdminListProviders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewAdminListProvidersRequest(c.Server)
 	if err != nil {
@@ -2369,6 +3271,18 @@ func (c *Client) AdminGetRequest(ctx context.Context, requestId string, reqEdito
 	return c.Client.Do(req)
 }
 
+func (c *Client) AdminGetRequestGrantState(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetRequestGrantStateRequest(c.Server, requestId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) AdminListTargetGroups(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewAdminListTargetGroupsRequest(c.Server)
 	if err != nil {
@@ -2621,8 +3535,8 @@ func (c *Client) UserUpdateFavorite(ctx context.Context, id string, body UserUpd
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserListRequests(ctx context.Context, params *UserListRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserListRequestsRequest(c.Server, params)
+func (c *Client) UserListRecurringRequests(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserListRecurringRequestsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -2633,8 +3547,8 @@ func (c *Client) UserListRequests(ctx context.Context, params *UserListRequestsP
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserCreateRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserCreateRequestRequestWithBody(c.Server, contentType, body)
+func (c *Client) UserCreateRecurringRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCreateRecurringRequestRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2645,8 +3559,8 @@ func (c *Client) UserCreateRequestWithBody(ctx context.Context, contentType stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserCreateRequest(ctx context.Context, body UserCreateRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserCreateRequestRequest(c.Server, body)
+func (c *Client) UserCreateRecurringRequest(ctx context.Context, body UserCreateRecurringRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCreateRecurringRequestRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2657,8 +3571,8 @@ func (c *Client) UserCreateRequest(ctx context.Context, body UserCreateRequestJS
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserListRequestsPast(ctx context.Context, params *UserListRequestsPastParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserListRequestsPastRequest(c.Server, params)
+func (c *Client) UserGetRecurringRequest(ctx context.Context, recurringRequestId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserGetRecurringRequestRequest(c.Server, recurringRequestId)
 	if err != nil {
 		return nil, err
 	}
@@ -2669,8 +3583,8 @@ func (c *Client) UserListRequestsPast(ctx context.Context, params *UserListReque
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserListRequestsUpcoming(ctx context.Context, params *UserListRequestsUpcomingParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserListRequestsUpcomingRequest(c.Server, params)
+func (c *Client) UserCancelRecurringRequest(ctx context.Context, recurringRequestId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCancelRecurringRequestRequest(c.Server, recurringRequestId)
 	if err != nil {
 		return nil, err
 	}
@@ -2681,8 +3595,8 @@ func (c *Client) UserListRequestsUpcoming(ctx context.Context, params *UserListR
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserGetRequest(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserGetRequestRequest(c.Server, requestId)
+func (c *Client) UserListRequests(ctx context.Context, params *UserListRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserListRequestsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2693,8 +3607,8 @@ func (c *Client) UserGetRequest(ctx context.Context, requestId string, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserGetAccessInstructions(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserGetAccessInstructionsRequest(c.Server, requestId)
+func (c *Client) UserCreateRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCreateRequestRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2705,8 +3619,8 @@ func (c *Client) UserGetAccessInstructions(ctx context.Context, requestId string
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserGetAccessToken(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserGetAccessTokenRequest(c.Server, requestId)
+func (c *Client) UserCreateRequest(ctx context.Context, body UserCreateRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCreateRequestRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2717,8 +3631,8 @@ func (c *Client) UserGetAccessToken(ctx context.Context, requestId string, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserCancelRequest(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserCancelRequestRequest(c.Server, requestId)
+func (c *Client) UserDryRunRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserDryRunRequestRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2729,8 +3643,8 @@ func (c *Client) UserCancelRequest(ctx context.Context, requestId string, reqEdi
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserListRequestEvents(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserListRequestEventsRequest(c.Server, requestId)
+func (c *Client) UserDryRunRequest(ctx context.Context, body UserDryRunRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserDryRunRequestRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2741,8 +3655,8 @@ func (c *Client) UserListRequestEvents(ctx context.Context, requestId string, re
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserReviewRequestWithBody(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserReviewRequestRequestWithBody(c.Server, requestId, contentType, body)
+func (c *Client) UserListRequestsPast(ctx context.Context, params *UserListRequestsPastParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserListRequestsPastRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2753,8 +3667,8 @@ func (c *Client) UserReviewRequestWithBody(ctx context.Context, requestId string
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserReviewRequest(ctx context.Context, requestId string, body UserReviewRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserReviewRequestRequest(c.Server, requestId, body)
+func (c *Client) UserListRequestsUpcoming(ctx context.Context, params *UserListRequestsUpcomingParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserListRequestsUpcomingRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2765,8 +3679,8 @@ func (c *Client) UserReviewRequest(ctx context.Context, requestId string, body U
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserRevokeRequest(ctx context.Context, requestid string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserRevokeRequestRequest(c.Server, requestid)
+func (c *Client) UserGetRequest(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserGetRequestRequest(c.Server, requestId)
 	if err != nil {
 		return nil, err
 	}
@@ -2777,8 +3691,8 @@ func (c *Client) UserRevokeRequest(ctx context.Context, requestid string, reqEdi
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserGetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserGetMeRequest(c.Server)
+func (c *Client) UserGetAccessInstructions(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserGetAccessInstructionsRequest(c.Server, requestId)
 	if err != nil {
 		return nil, err
 	}
@@ -2789,8 +3703,8 @@ func (c *Client) UserGetMe(ctx context.Context, reqEditors ...RequestEditorFn) (
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserGetUser(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserGetUserRequest(c.Server, userId)
+func (c *Client) UserGetAccessToken(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserGetAccessTokenRequest(c.Server, requestId)
 	if err != nil {
 		return nil, err
 	}
@@ -2801,26 +3715,242 @@ func (c *Client) UserGetUser(ctx context.Context, userId string, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-// NewUserListAccessRulesRequest generates requests for UserListAccessRules
-func NewUserListAccessRulesRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UserCancelRequest(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCancelRequestRequest(c.Server, requestId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/access-rules")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) UserListRequestComments(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserListRequestCommentsRequest(c.Server, requestId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserCreateRequestCommentWithBody(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCreateRequestCommentRequestWithBody(c.Server, requestId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserCreateRequestComment(ctx context.Context, requestId string, body UserCreateRequestCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCreateRequestCommentRequest(c.Server, requestId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserListRequestEvents(ctx context.Context, requestId string, params *UserListRequestEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserListRequestEventsRequest(c.Server, requestId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserExtendRequestWithBody(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserExtendRequestRequestWithBody(c.Server, requestId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserExtendRequest(ctx context.Context, requestId string, body UserExtendRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserExtendRequestRequest(c.Server, requestId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserReviewExtensionWithBody(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserReviewExtensionRequestWithBody(c.Server, requestId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserReviewExtension(ctx context.Context, requestId string, body UserReviewExtensionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserReviewExtensionRequest(c.Server, requestId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserReviewRequestWithBody(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserReviewRequestRequestWithBody(c.Server, requestId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserReviewRequest(ctx context.Context, requestId string, body UserReviewRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserReviewRequestRequest(c.Server, requestId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserRevokeRequest(ctx context.Context, requestid string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserRevokeRequestRequest(c.Server, requestid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserGetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserGetMeRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserRemoveDelegate(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserRemoveDelegateRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserGetDelegate(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserGetDelegateRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserSetDelegateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserSetDelegateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserSetDelegate(ctx context.Context, body UserSetDelegateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserSetDelegateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserGetUser(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserGetUserRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewUserListAccessRulesRequest generates requests for UserListAccessRules
+func NewUserListAccessRulesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/access-rules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3268,8 +4398,19 @@ func NewAdminGetAccessRuleVersionRequest(server string, ruleId string, version s
 	return req, nil
 }
 
-// NewAdminGetDeploymentVersionRequest generates requests for AdminGetDeploymentVersion
-func NewAdminGetDeploymentVersionRequest(server string) (*http.Request, error) {
+// NewAdminBulkRevokeRequest calls the generic AdminBulkRevoke builder with application/json body
+func NewAdminBulkRevokeRequest(server string, body AdminBulkRevokeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminBulkRevokeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAdminBulkRevokeRequestWithBody generates requests for AdminBulkRevoke with any type of body
+func NewAdminBulkRevokeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3277,7 +4418,7 @@ func NewAdminGetDeploymentVersionRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/deployment/version")
+	operationPath := fmt.Sprintf("/api/v1/admin/bulk-revoke")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3287,24 +4428,33 @@ func NewAdminGetDeploymentVersionRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewAdminListGroupsRequest generates requests for AdminListGroups
-func NewAdminListGroupsRequest(server string, params *AdminListGroupsParams) (*http.Request, error) {
+// NewAdminGetBulkRevocationRequest generates requests for AdminGetBulkRevocation
+func NewAdminGetBulkRevocationRequest(server string, bulkRevocationId string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "bulkRevocationId", runtime.ParamLocationPath, bulkRevocationId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/groups")
+	operationPath := fmt.Sprintf("/api/v1/admin/bulk-revoke/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3314,28 +4464,197 @@ func NewAdminListGroupsRequest(server string, params *AdminListGroupsParams) (*h
 		return nil, err
 	}
 
-	queryValues := queryURL.Query()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	if params.NextToken != nil {
+	return req, nil
+}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextToken", runtime.ParamLocationQuery, *params.NextToken); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+// NewAdminGetDeploymentVersionRequest generates requests for AdminGetDeploymentVersion
+func NewAdminGetDeploymentVersionRequest(server string) (*http.Request, error) {
+	var err error
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	if params.Source != nil {
+	operationPath := fmt.Sprintf("/api/v1/admin/deployment/version")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source", runtime.ParamLocationQuery, *params.Source); err != nil {
-			return nil, err
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminListGrantRetriesRequest generates requests for AdminListGrantRetries
+func NewAdminListGrantRetriesRequest(server string, params *AdminListGrantRetriesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/grant-retries")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Status != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminAbandonGrantRetryRequest generates requests for AdminAbandonGrantRetry
+func NewAdminAbandonGrantRetryRequest(server string, retryId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "retryId", runtime.ParamLocationPath, retryId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/grant-retries/%s/abandon", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminRetryGrantRetryRequest generates requests for AdminRetryGrantRetry
+func NewAdminRetryGrantRetryRequest(server string, retryId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "retryId", runtime.ParamLocationPath, retryId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/grant-retries/%s/retry", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminListGroupsRequest generates requests for AdminListGroups
+func NewAdminListGroupsRequest(server string, params *AdminListGroupsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/groups")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.NextToken != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextToken", runtime.ParamLocationQuery, *params.NextToken); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Source != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source", runtime.ParamLocationQuery, *params.Source); err != nil {
+			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
 		} else {
@@ -3702,6 +5021,60 @@ func NewAdminGetIdentityConfigurationRequest(server string) (*http.Request, erro
 	return req, nil
 }
 
+// NewAdminDeleteScimTokenRequest generates requests for AdminDeleteScimToken
+func NewAdminDeleteScimTokenRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/identity/scim-token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminCreateScimTokenRequest generates requests for AdminCreateScimToken
+func NewAdminCreateScimTokenRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/identity/scim-token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewAdminSyncIdentityRequest generates requests for AdminSyncIdentity
 func NewAdminSyncIdentityRequest(server string) (*http.Request, error) {
 	var err error
@@ -3729,6 +5102,46 @@ func NewAdminSyncIdentityRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
+// NewAdminDryRunIdentitySyncRequest calls the generic AdminDryRunIdentitySync builder with application/json body
+func NewAdminDryRunIdentitySyncRequest(server string, body AdminDryRunIdentitySyncJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminDryRunIdentitySyncRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAdminDryRunIdentitySyncRequestWithBody generates requests for AdminDryRunIdentitySync with any type of body
+func NewAdminDryRunIdentitySyncRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/identity/sync/dry-run")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewAdminListProvidersRequest generates requests for AdminListProviders
 func NewAdminListProvidersRequest(server string) (*http.Request, error) {
 	var err error
@@ -4273,16 +5686,23 @@ func NewAdminGetRequestRequest(server string, requestId string) (*http.Request,
 	return req, nil
 }
 
-// NewAdminListTargetGroupsRequest generates requests for AdminListTargetGroups
-func NewAdminListTargetGroupsRequest(server string) (*http.Request, error) {
+// NewAdminGetRequestGrantStateRequest generates requests for AdminGetRequestGrantState
+func NewAdminGetRequestGrantStateRequest(server string, requestId string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestId", runtime.ParamLocationPath, requestId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/target-groups")
+	operationPath := fmt.Sprintf("/api/v1/admin/requests/%s/grant-state", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4300,12 +5720,39 @@ func NewAdminListTargetGroupsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewAdminCreateTargetGroupRequest calls the generic AdminCreateTargetGroup builder with application/json body
-func NewAdminCreateTargetGroupRequest(server string, body AdminCreateTargetGroupJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
+// NewAdminListTargetGroupsRequest generates requests for AdminListTargetGroups
+func NewAdminListTargetGroupsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/target-groups")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminCreateTargetGroupRequest calls the generic AdminCreateTargetGroup builder with application/json body
+func NewAdminCreateTargetGroupRequest(server string, body AdminCreateTargetGroupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
 	return NewAdminCreateTargetGroupRequestWithBody(server, "application/json", bodyReader)
@@ -4867,6 +6314,141 @@ func NewUserUpdateFavoriteRequestWithBody(server string, id string, contentType
 	return req, nil
 }
 
+// NewUserListRecurringRequestsRequest generates requests for UserListRecurringRequests
+func NewUserListRecurringRequestsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/recurring-requests")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserCreateRecurringRequestRequest calls the generic UserCreateRecurringRequest builder with application/json body
+func NewUserCreateRecurringRequestRequest(server string, body UserCreateRecurringRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserCreateRecurringRequestRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserCreateRecurringRequestRequestWithBody generates requests for UserCreateRecurringRequest with any type of body
+func NewUserCreateRecurringRequestRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/recurring-requests")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserGetRecurringRequestRequest generates requests for UserGetRecurringRequest
+func NewUserGetRecurringRequestRequest(server string, recurringRequestId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "recurringRequestId", runtime.ParamLocationPath, recurringRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/recurring-requests/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserCancelRecurringRequestRequest generates requests for UserCancelRecurringRequest
+func NewUserCancelRecurringRequestRequest(server string, recurringRequestId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "recurringRequestId", runtime.ParamLocationPath, recurringRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/recurring-requests/%s/cancel", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewUserListRequestsRequest generates requests for UserListRequests
 func NewUserListRequestsRequest(server string, params *UserListRequestsParams) (*http.Request, error) {
 	var err error
@@ -4986,6 +6568,46 @@ func NewUserCreateRequestRequestWithBody(server string, contentType string, body
 	return req, nil
 }
 
+// NewUserDryRunRequestRequest calls the generic UserDryRunRequest builder with application/json body
+func NewUserDryRunRequestRequest(server string, body UserDryRunRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserDryRunRequestRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserDryRunRequestRequestWithBody generates requests for UserDryRunRequest with any type of body
+func NewUserDryRunRequestRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/requests/dry-run")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewUserListRequestsPastRequest generates requests for UserListRequestsPast
 func NewUserListRequestsPastRequest(server string, params *UserListRequestsPastParams) (*http.Request, error) {
 	var err error
@@ -5216,8 +6838,8 @@ func NewUserCancelRequestRequest(server string, requestId string) (*http.Request
 	return req, nil
 }
 
-// NewUserListRequestEventsRequest generates requests for UserListRequestEvents
-func NewUserListRequestEventsRequest(server string, requestId string) (*http.Request, error) {
+// NewUserListRequestCommentsRequest generates requests for UserListRequestComments
+func NewUserListRequestCommentsRequest(server string, requestId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5232,7 +6854,7 @@ func NewUserListRequestEventsRequest(server string, requestId string) (*http.Req
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/requests/%s/events", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/requests/%s/comments", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5250,19 +6872,19 @@ func NewUserListRequestEventsRequest(server string, requestId string) (*http.Req
 	return req, nil
 }
 
-// NewUserReviewRequestRequest calls the generic UserReviewRequest builder with application/json body
-func NewUserReviewRequestRequest(server string, requestId string, body UserReviewRequestJSONRequestBody) (*http.Request, error) {
+// NewUserCreateRequestCommentRequest calls the generic UserCreateRequestComment builder with application/json body
+func NewUserCreateRequestCommentRequest(server string, requestId string, body UserCreateRequestCommentJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUserReviewRequestRequestWithBody(server, requestId, "application/json", bodyReader)
+	return NewUserCreateRequestCommentRequestWithBody(server, requestId, "application/json", bodyReader)
 }
 
-// NewUserReviewRequestRequestWithBody generates requests for UserReviewRequest with any type of body
-func NewUserReviewRequestRequestWithBody(server string, requestId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUserCreateRequestCommentRequestWithBody generates requests for UserCreateRequestComment with any type of body
+func NewUserCreateRequestCommentRequestWithBody(server string, requestId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5277,7 +6899,7 @@ func NewUserReviewRequestRequestWithBody(server string, requestId string, conten
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/requests/%s/review", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/requests/%s/comments", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5297,13 +6919,13 @@ func NewUserReviewRequestRequestWithBody(server string, requestId string, conten
 	return req, nil
 }
 
-// NewUserRevokeRequestRequest generates requests for UserRevokeRequest
-func NewUserRevokeRequestRequest(server string, requestid string) (*http.Request, error) {
+// NewUserListRequestEventsRequest generates requests for UserListRequestEvents
+func NewUserListRequestEventsRequest(server string, requestId string, params *UserListRequestEventsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestid", runtime.ParamLocationPath, requestid)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestId", runtime.ParamLocationPath, requestId)
 	if err != nil {
 		return nil, err
 	}
@@ -5313,7 +6935,7 @@ func NewUserRevokeRequestRequest(server string, requestid string) (*http.Request
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/requests/%s/revoke", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/requests/%s/events", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5323,48 +6945,52 @@ func NewUserRevokeRequestRequest(server string, requestid string) (*http.Request
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	queryValues := queryURL.Query()
 
-	return req, nil
-}
+	if params.NextToken != nil {
 
-// NewUserGetMeRequest generates requests for UserGetMe
-func NewUserGetMeRequest(server string) (*http.Request, error) {
-	var err error
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextToken", runtime.ParamLocationQuery, *params.NextToken); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/me")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	queryURL.RawQuery = queryValues.Encode()
 
-	queryURL, err := serverURL.Parse(operationPath)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	return req, nil
+}
+
+// NewUserExtendRequestRequest calls the generic UserExtendRequest builder with application/json body
+func NewUserExtendRequestRequest(server string, requestId string, body UserExtendRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewUserExtendRequestRequestWithBody(server, requestId, "application/json", bodyReader)
 }
 
-// NewUserGetUserRequest generates requests for UserGetUser
-func NewUserGetUserRequest(server string, userId string) (*http.Request, error) {
+// NewUserExtendRequestRequestWithBody generates requests for UserExtendRequest with any type of body
+func NewUserExtendRequestRequestWithBody(server string, requestId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestId", runtime.ParamLocationPath, requestId)
 	if err != nil {
 		return nil, err
 	}
@@ -5374,7 +7000,7 @@ func NewUserGetUserRequest(server string, userId string) (*http.Request, error)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/requests/%s/extend", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5384,185 +7010,501 @@ func NewUserGetUserRequest(server string, userId string) (*http.Request, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewUserReviewExtensionRequest calls the generic UserReviewExtension builder with application/json body
+func NewUserReviewExtensionRequest(server string, requestId string, body UserReviewExtensionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	bodyReader = bytes.NewReader(buf)
+	return NewUserReviewExtensionRequestWithBody(server, requestId, "application/json", bodyReader)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewUserReviewExtensionRequestWithBody generates requests for UserReviewExtension with any type of body
+func NewUserReviewExtensionRequestWithBody(server string, requestId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestId", runtime.ParamLocationPath, requestId)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// UserListAccessRules request
-	UserListAccessRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListAccessRulesResponse, error)
-
-	// UserLookupAccessRule request
-	UserLookupAccessRuleWithResponse(ctx context.Context, params *UserLookupAccessRuleParams, reqEditors ...RequestEditorFn) (*UserLookupAccessRuleResponse, error)
 
-	// UserGetAccessRule request
-	UserGetAccessRuleWithResponse(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*UserGetAccessRuleResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/requests/%s/extend/review", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// UserGetAccessRuleApprovers request
-	UserGetAccessRuleApproversWithResponse(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*UserGetAccessRuleApproversResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminListAccessRules request
-	AdminListAccessRulesWithResponse(ctx context.Context, params *AdminListAccessRulesParams, reqEditors ...RequestEditorFn) (*AdminListAccessRulesResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminCreateAccessRule request with any body
-	AdminCreateAccessRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateAccessRuleResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	AdminCreateAccessRuleWithResponse(ctx context.Context, body AdminCreateAccessRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateAccessRuleResponse, error)
+	return req, nil
+}
 
-	// AdminGetAccessRule request
-	AdminGetAccessRuleWithResponse(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*AdminGetAccessRuleResponse, error)
+// NewUserReviewRequestRequest calls the generic UserReviewRequest builder with application/json body
+func NewUserReviewRequestRequest(server string, requestId string, body UserReviewRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserReviewRequestRequestWithBody(server, requestId, "application/json", bodyReader)
+}
 
-	// AdminUpdateAccessRule request with any body
-	AdminUpdateAccessRuleWithBodyWithResponse(ctx context.Context, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateAccessRuleResponse, error)
+// NewUserReviewRequestRequestWithBody generates requests for UserReviewRequest with any type of body
+func NewUserReviewRequestRequestWithBody(server string, requestId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	AdminUpdateAccessRuleWithResponse(ctx context.Context, ruleId string, body AdminUpdateAccessRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateAccessRuleResponse, error)
+	var pathParam0 string
 
-	// AdminArchiveAccessRule request
-	AdminArchiveAccessRuleWithResponse(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*AdminArchiveAccessRuleResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestId", runtime.ParamLocationPath, requestId)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminGetAccessRuleVersions request
-	AdminGetAccessRuleVersionsWithResponse(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*AdminGetAccessRuleVersionsResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminGetAccessRuleVersion request
-	AdminGetAccessRuleVersionWithResponse(ctx context.Context, ruleId string, version string, reqEditors ...RequestEditorFn) (*AdminGetAccessRuleVersionResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/requests/%s/review", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// AdminGetDeploymentVersion request
-	AdminGetDeploymentVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminGetDeploymentVersionResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminListGroups request
-	AdminListGroupsWithResponse(ctx context.Context, params *AdminListGroupsParams, reqEditors ...RequestEditorFn) (*AdminListGroupsResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminCreateGroup request with any body
-	AdminCreateGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateGroupResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	AdminCreateGroupWithResponse(ctx context.Context, body AdminCreateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateGroupResponse, error)
+	return req, nil
+}
 
-	// AdminDeleteGroup request
-	AdminDeleteGroupWithResponse(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*AdminDeleteGroupResponse, error)
+// NewUserRevokeRequestRequest generates requests for UserRevokeRequest
+func NewUserRevokeRequestRequest(server string, requestid string) (*http.Request, error) {
+	var err error
 
-	// AdminGetGroup request
-	AdminGetGroupWithResponse(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*AdminGetGroupResponse, error)
+	var pathParam0 string
 
-	// AdminUpdateGroup request with any body
-	AdminUpdateGroupWithBodyWithResponse(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateGroupResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestid", runtime.ParamLocationPath, requestid)
+	if err != nil {
+		return nil, err
+	}
 
-	AdminUpdateGroupWithResponse(ctx context.Context, groupId string, body AdminUpdateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateGroupResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminListHandlers request
-	AdminListHandlersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListHandlersResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/requests/%s/revoke", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// AdminRegisterHandler request with any body
-	AdminRegisterHandlerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminRegisterHandlerResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	AdminRegisterHandlerWithResponse(ctx context.Context, body AdminRegisterHandlerJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminRegisterHandlerResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminDeleteHandler request
-	AdminDeleteHandlerWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminDeleteHandlerResponse, error)
+	return req, nil
+}
 
-	// AdminGetHandler request
-	AdminGetHandlerWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminGetHandlerResponse, error)
+// NewUserGetMeRequest generates requests for UserGetMe
+func NewUserGetMeRequest(server string) (*http.Request, error) {
+	var err error
 
-	// AdminHealthcheckHandlers request
-	AdminHealthcheckHandlersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminHealthcheckHandlersResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminGetIdentityConfiguration request
-	AdminGetIdentityConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminGetIdentityConfigurationResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/users/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// AdminSyncIdentity request
-	AdminSyncIdentityWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminSyncIdentityResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminListProviders request
-	AdminListProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListProvidersResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminGetProvider request
-	AdminGetProviderWithResponse(ctx context.Context, providerId string, reqEditors ...RequestEditorFn) (*AdminGetProviderResponse, error)
+	return req, nil
+}
 
-	// AdminGetProviderArgs request
-	AdminGetProviderArgsWithResponse(ctx context.Context, providerId string, reqEditors ...RequestEditorFn) (*AdminGetProviderArgsResponse, error)
+// NewUserRemoveDelegateRequest generates requests for UserRemoveDelegate
+func NewUserRemoveDelegateRequest(server string) (*http.Request, error) {
+	var err error
 
-	// AdminListProviderArgOptions request
-	AdminListProviderArgOptionsWithResponse(ctx context.Context, providerId string, argId string, params *AdminListProviderArgOptionsParams, reqEditors ...RequestEditorFn) (*AdminListProviderArgOptionsResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminListProvidersetups request
-	AdminListProvidersetupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListProvidersetupsResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/users/me/delegate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// AdminCreateProvidersetup request with any body
-	AdminCreateProvidersetupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateProvidersetupResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	AdminCreateProvidersetupWithResponse(ctx context.Context, body AdminCreateProvidersetupJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateProvidersetupResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminDeleteProvidersetup request
-	AdminDeleteProvidersetupWithResponse(ctx context.Context, providersetupId string, reqEditors ...RequestEditorFn) (*AdminDeleteProvidersetupResponse, error)
+	return req, nil
+}
 
-	// AdminGetProvidersetup request
-	AdminGetProvidersetupWithResponse(ctx context.Context, providersetupId string, reqEditors ...RequestEditorFn) (*AdminGetProvidersetupResponse, error)
+// NewUserGetDelegateRequest generates requests for UserGetDelegate
+func NewUserGetDelegateRequest(server string) (*http.Request, error) {
+	var err error
 
-	// AdminCompleteProvidersetup request
-	AdminCompleteProvidersetupWithResponse(ctx context.Context, providersetupId string, reqEditors ...RequestEditorFn) (*AdminCompleteProvidersetupResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminGetProvidersetupInstructions request
-	AdminGetProvidersetupInstructionsWithResponse(ctx context.Context, providersetupId string, reqEditors ...RequestEditorFn) (*AdminGetProvidersetupInstructionsResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/users/me/delegate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// AdminSubmitProvidersetupStep request with any body
-	AdminSubmitProvidersetupStepWithBodyWithResponse(ctx context.Context, providersetupId string, stepIndex int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminSubmitProvidersetupStepResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	AdminSubmitProvidersetupStepWithResponse(ctx context.Context, providersetupId string, stepIndex int, body AdminSubmitProvidersetupStepJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminSubmitProvidersetupStepResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminValidateProvidersetup request
-	AdminValidateProvidersetupWithResponse(ctx context.Context, providersetupId string, reqEditors ...RequestEditorFn) (*AdminValidateProvidersetupResponse, error)
+	return req, nil
+}
 
-	// AdminListRequests request
-	AdminListRequestsWithResponse(ctx context.Context, params *AdminListRequestsParams, reqEditors ...RequestEditorFn) (*AdminListRequestsResponse, error)
+// NewUserSetDelegateRequest calls the generic UserSetDelegate builder with application/json body
+func NewUserSetDelegateRequest(server string, body UserSetDelegateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserSetDelegateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserSetDelegateRequestWithBody generates requests for UserSetDelegate with any type of body
+func NewUserSetDelegateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/me/delegate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserGetUserRequest generates requests for UserGetUser
+func NewUserGetUserRequest(server string, userId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// UserListAccessRules request
+	UserListAccessRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListAccessRulesResponse, error)
+
+	// UserLookupAccessRule request
+	UserLookupAccessRuleWithResponse(ctx context.Context, params *UserLookupAccessRuleParams, reqEditors ...RequestEditorFn) (*UserLookupAccessRuleResponse, error)
+
+	// UserGetAccessRule request
+	UserGetAccessRuleWithResponse(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*UserGetAccessRuleResponse, error)
+
+	// UserGetAccessRuleApprovers request
+	UserGetAccessRuleApproversWithResponse(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*UserGetAccessRuleApproversResponse, error)
+
+	// AdminListAccessRules request
+	AdminListAccessRulesWithResponse(ctx context.Context, params *AdminListAccessRulesParams, reqEditors ...RequestEditorFn) (*AdminListAccessRulesResponse, error)
+
+	// AdminCreateAccessRule request with any body
+	AdminCreateAccessRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateAccessRuleResponse, error)
+
+	AdminCreateAccessRuleWithResponse(ctx context.Context, body AdminCreateAccessRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateAccessRuleResponse, error)
+
+	// AdminGetAccessRule request
+	AdminGetAccessRuleWithResponse(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*AdminGetAccessRuleResponse, error)
+
+	// AdminUpdateAccessRule request with any body
+	AdminUpdateAccessRuleWithBodyWithResponse(ctx context.Context, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateAccessRuleResponse, error)
+
+	AdminUpdateAccessRuleWithResponse(ctx context.Context, ruleId string, body AdminUpdateAccessRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateAccessRuleResponse, error)
+
+	// AdminArchiveAccessRule request
+	AdminArchiveAccessRuleWithResponse(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*AdminArchiveAccessRuleResponse, error)
+
+	// AdminGetAccessRuleVersions request
+	AdminGetAccessRuleVersionsWithResponse(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*AdminGetAccessRuleVersionsResponse, error)
+
+	// AdminGetAccessRuleVersion request
+	AdminGetAccessRuleVersionWithResponse(ctx context.Context, ruleId string, version string, reqEditors ...RequestEditorFn) (*AdminGetAccessRuleVersionResponse, error)
+
+	// AdminBulkRevoke request with any body
+	AdminBulkRevokeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminBulkRevokeResponse, error)
+
+	AdminBulkRevokeWithResponse(ctx context.Context, body AdminBulkRevokeJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminBulkRevokeResponse, error)
+
+	// AdminGetBulkRevocation request
+	AdminGetBulkRevocationWithResponse(ctx context.Context, bulkRevocationId string, reqEditors ...RequestEditorFn) (*AdminGetBulkRevocationResponse, error)
+
+	// AdminGetDeploymentVersion request
+	AdminGetDeploymentVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminGetDeploymentVersionResponse, error)
+
+	// AdminListGrantRetries request
+	AdminListGrantRetriesWithResponse(ctx context.Context, params *AdminListGrantRetriesParams, reqEditors ...RequestEditorFn) (*AdminListGrantRetriesResponse, error)
+
+	// AdminAbandonGrantRetry request
+	AdminAbandonGrantRetryWithResponse(ctx context.Context, retryId string, reqEditors ...RequestEditorFn) (*AdminAbandonGrantRetryResponse, error)
+
+	// AdminRetryGrantRetry request
+	AdminRetryGrantRetryWithResponse(ctx context.Context, retryId string, reqEditors ...RequestEditorFn) (*AdminRetryGrantRetryResponse, error)
+
+	// AdminListGroups request
+	AdminListGroupsWithResponse(ctx context.Context, params *AdminListGroupsParams, reqEditors ...RequestEditorFn) (*AdminListGroupsResponse, error)
+
+	// AdminCreateGroup request with any body
+	AdminCreateGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateGroupResponse, error)
+
+	AdminCreateGroupWithResponse(ctx context.Context, body AdminCreateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateGroupResponse, error)
+
+	// AdminDeleteGroup request
+	AdminDeleteGroupWithResponse(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*AdminDeleteGroupResponse, error)
+
+	// AdminGetGroup request
+	AdminGetGroupWithResponse(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*AdminGetGroupResponse, error)
+
+	// AdminUpdateGroup request with any body
+	AdminUpdateGroupWithBodyWithResponse(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateGroupResponse, error)
+
+	AdminUpdateGroupWithResponse(ctx context.Context, groupId string, body AdminUpdateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateGroupResponse, error)
+
+	// AdminListHandlers request
+	AdminListHandlersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListHandlersResponse, error)
+
+	// AdminRegisterHandler request with any body
+	AdminRegisterHandlerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminRegisterHandlerResponse, error)
+
+	AdminRegisterHandlerWithResponse(ctx context.Context, body AdminRegisterHandlerJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminRegisterHandlerResponse, error)
+
+	// AdminDeleteHandler request
+	AdminDeleteHandlerWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminDeleteHandlerResponse, error)
+
+	// AdminGetHandler request
+	AdminGetHandlerWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminGetHandlerResponse, error)
+
+	// AdminHealthcheckHandlers request
+	AdminHealthcheckHandlersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminHealthcheckHandlersResponse, error)
+
+	// AdminGetIdentityConfiguration request
+	AdminGetIdentityConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminGetIdentityConfigurationResponse, error)
+
+	// AdminDeleteScimToken request
+	AdminDeleteScimTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminDeleteScimTokenResponse, error)
+
+	// AdminCreateScimToken request
+	AdminCreateScimTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminCreateScimTokenResponse, error)
+
+	// AdminSyncIdentity request
+	AdminSyncIdentityWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminSyncIdentityResponse, error)
+
+	// AdminDryRunIdentitySync request with any body
+	AdminDryRunIdentitySyncWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminDryRunIdentitySyncResponse, error)
+
+	AdminDryRunIdentitySyncWithResponse(ctx context.Context, body AdminDryRunIdentitySyncJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminDryRunIdentitySyncResponse, error)
+
+	// AdminListProviders request
+	AdminListProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListProvidersResponse, error)
+
+	// AdminGetProvider request
+	AdminGetProviderWithResponse(ctx context.Context, providerId string, reqEditors ...RequestEditorFn) (*AdminGetProviderResponse, error)
+
+	// AdminGetProviderArgs request
+	AdminGetProviderArgsWithResponse(ctx context.Context, providerId string, reqEditors ...RequestEditorFn) (*AdminGetProviderArgsResponse, error)
+
+	// AdminListProviderArgOptions request
+	AdminListProviderArgOptionsWithResponse(ctx context.Context, providerId string, argId string, params *AdminListProviderArgOptionsParams, reqEditors ...RequestEditorFn) (*AdminListProviderArgOptionsResponse, error)
+
+	// AdminListProvidersetups request
+	AdminListProvidersetupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListProvidersetupsResponse, error)
+
+	// AdminCreateProvidersetup request with any body
+	AdminCreateProvidersetupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateProvidersetupResponse, error)
+
+	AdminCreateProvidersetupWithResponse(ctx context.Context, body AdminCreateProvidersetupJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateProvidersetupResponse, error)
+
+	// AdminDeleteProvidersetup request
+	AdminDeleteProvidersetupWithResponse(ctx context.Context, providersetupId string, reqEditors ...RequestEditorFn) (*AdminDeleteProvidersetupResponse, error)
+
+	// AdminGetProvidersetup request
+	AdminGetProvidersetupWithResponse(ctx context.Context, providersetupId string, reqEditors ...RequestEditorFn) (*AdminGetProvidersetupResponse, error)
+
+	// AdminCompleteProvidersetup request
+	AdminCompleteProvidersetupWithResponse(ctx context.Context, providersetupId string, reqEditors ...RequestEditorFn) (*AdminCompleteProvidersetupResponse, error)
+
+	// AdminGetProvidersetupInstructions request
+	AdminGetProvidersetupInstructionsWithResponse(ctx context.Context, providersetupId string, reqEditors ...RequestEditorFn) (*AdminGetProvidersetupInstructionsResponse, error)
+
+	// AdminSubmitProvidersetupStep request with any body
+	AdminSubmitProvidersetupStepWithBodyWithResponse(ctx context.Context, providersetupId string, stepIndex int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminSubmitProvidersetupStepResponse, error)
+
+	AdminSubmitProvidersetupStepWithResponse(ctx context.Context, providersetupId string, stepIndex int, body AdminSubmitProvidersetupStepJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminSubmitProvidersetupStepResponse, error)
+
+	// AdminValidateProvidersetup request
+	AdminValidateProvidersetupWithResponse(ctx context.Context, providersetupId string, reqEditors ...RequestEditorFn) (*AdminValidateProvidersetupResponse, error)
+
+	// AdminListRequests request
+	AdminListRequestsWithResponse(ctx context.Context, params *AdminListRequestsParams, reqEditors ...RequestEditorFn) (*AdminListRequestsResponse, error)
 
 	// AdminGetRequest request
 	AdminGetRequestWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*AdminGetRequestResponse, error)
 
+	// AdminGetRequestGrantState request
+	AdminGetRequestGrantStateWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*AdminGetRequestGrantStateResponse, error)
+
 	// AdminListTargetGroups request
 	AdminListTargetGroupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListTargetGroupsResponse, error)
 
@@ -5620,6 +7562,20 @@ type ClientWithResponsesInterface interface {
 
 	UserUpdateFavoriteWithResponse(ctx context.Context, id string, body UserUpdateFavoriteJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdateFavoriteResponse, error)
 
+	// UserListRecurringRequests request
+	UserListRecurringRequestsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListRecurringRequestsResponse, error)
+
+	// UserCreateRecurringRequest request with any body
+	UserCreateRecurringRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateRecurringRequestResponse, error)
+
+	UserCreateRecurringRequestWithResponse(ctx context.Context, body UserCreateRecurringRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateRecurringRequestResponse, error)
+
+	// UserGetRecurringRequest request
+	UserGetRecurringRequestWithResponse(ctx context.Context, recurringRequestId string, reqEditors ...RequestEditorFn) (*UserGetRecurringRequestResponse, error)
+
+	// UserCancelRecurringRequest request
+	UserCancelRecurringRequestWithResponse(ctx context.Context, recurringRequestId string, reqEditors ...RequestEditorFn) (*UserCancelRecurringRequestResponse, error)
+
 	// UserListRequests request
 	UserListRequestsWithResponse(ctx context.Context, params *UserListRequestsParams, reqEditors ...RequestEditorFn) (*UserListRequestsResponse, error)
 
@@ -5628,6 +7584,11 @@ type ClientWithResponsesInterface interface {
 
 	UserCreateRequestWithResponse(ctx context.Context, body UserCreateRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateRequestResponse, error)
 
+	// UserDryRunRequest request with any body
+	UserDryRunRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserDryRunRequestResponse, error)
+
+	UserDryRunRequestWithResponse(ctx context.Context, body UserDryRunRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UserDryRunRequestResponse, error)
+
 	// UserListRequestsPast request
 	UserListRequestsPastWithResponse(ctx context.Context, params *UserListRequestsPastParams, reqEditors ...RequestEditorFn) (*UserListRequestsPastResponse, error)
 
@@ -5646,11 +7607,29 @@ type ClientWithResponsesInterface interface {
 	// UserCancelRequest request
 	UserCancelRequestWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*UserCancelRequestResponse, error)
 
-	// UserListRequestEvents request
-	UserListRequestEventsWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*UserListRequestEventsResponse, error)
+	// UserListRequestComments request
+	UserListRequestCommentsWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*UserListRequestCommentsResponse, error)
 
-	// UserReviewRequest request with any body
-	UserReviewRequestWithBodyWithResponse(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserReviewRequestResponse, error)
+	// UserCreateRequestComment request with any body
+	UserCreateRequestCommentWithBodyWithResponse(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateRequestCommentResponse, error)
+
+	UserCreateRequestCommentWithResponse(ctx context.Context, requestId string, body UserCreateRequestCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateRequestCommentResponse, error)
+
+	// UserListRequestEvents request
+	UserListRequestEventsWithResponse(ctx context.Context, requestId string, params *UserListRequestEventsParams, reqEditors ...RequestEditorFn) (*UserListRequestEventsResponse, error)
+
+	// UserExtendRequest request with any body
+	UserExtendRequestWithBodyWithResponse(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserExtendRequestResponse, error)
+
+	UserExtendRequestWithResponse(ctx context.Context, requestId string, body UserExtendRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UserExtendRequestResponse, error)
+
+	// UserReviewExtension request with any body
+	UserReviewExtensionWithBodyWithResponse(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserReviewExtensionResponse, error)
+
+	UserReviewExtensionWithResponse(ctx context.Context, requestId string, body UserReviewExtensionJSONRequestBody, reqEditors ...RequestEditorFn) (*UserReviewExtensionResponse, error)
+
+	// UserReviewRequest request with any body
+	UserReviewRequestWithBodyWithResponse(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserReviewRequestResponse, error)
 
 	UserReviewRequestWithResponse(ctx context.Context, requestId string, body UserReviewRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UserReviewRequestResponse, error)
 
@@ -5660,6 +7639,17 @@ type ClientWithResponsesInterface interface {
 	// UserGetMe request
 	UserGetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetMeResponse, error)
 
+	// UserRemoveDelegate request
+	UserRemoveDelegateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserRemoveDelegateResponse, error)
+
+	// UserGetDelegate request
+	UserGetDelegateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetDelegateResponse, error)
+
+	// UserSetDelegate request with any body
+	UserSetDelegateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserSetDelegateResponse, error)
+
+	UserSetDelegateWithResponse(ctx context.Context, body UserSetDelegateJSONRequestBody, reqEditors ...RequestEditorFn) (*UserSetDelegateResponse, error)
+
 	// UserGetUser request
 	UserGetUserWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*UserGetUserResponse, error)
 }
@@ -5960,6 +7950,86 @@ func (r AdminGetAccessRuleVersionResponse) StatusCode() int {
 	return 0
 }
 
+type AdminBulkRevokeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		CompletedAt *time.Time       `json:"completedAt,omitempty"`
+		DryRun      bool             `json:"dryRun"`
+		Id          string           `json:"id"`
+		Revocations []BulkRevocation `json:"revocations"`
+
+		// PENDING bulk revocations are queued, IN_PROGRESS bulk revocations are revoking grants,
+		// and COMPLETED bulk revocations have attempted to revoke every grant.
+		Status *BulkRevokeStatus `json:"status,omitempty"`
+	}
+	JSON400 *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminBulkRevokeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminBulkRevokeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetBulkRevocationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		CompletedAt *time.Time       `json:"completedAt,omitempty"`
+		DryRun      bool             `json:"dryRun"`
+		Id          string           `json:"id"`
+		Revocations []BulkRevocation `json:"revocations"`
+
+		// PENDING bulk revocations are queued, IN_PROGRESS bulk revocations are revoking grants,
+		// and COMPLETED bulk revocations have attempted to revoke every grant.
+		Status *BulkRevokeStatus `json:"status,omitempty"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetBulkRevocationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetBulkRevocationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type AdminGetDeploymentVersionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -5985,6 +8055,104 @@ func (r AdminGetDeploymentVersionResponse) StatusCode() int {
 	return 0
 }
 
+type AdminListGrantRetriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		GrantRetries []GrantRetry `json:"grantRetries"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListGrantRetriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListGrantRetriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminAbandonGrantRetryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GrantRetry
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminAbandonGrantRetryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminAbandonGrantRetryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminRetryGrantRetryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GrantRetry
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminRetryGrantRetryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminRetryGrantRetryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type AdminListGroupsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -6300,6 +8468,63 @@ func (r AdminGetIdentityConfigurationResponse) StatusCode() int {
 	return 0
 }
 
+type AdminDeleteScimTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminDeleteScimTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminDeleteScimTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminCreateScimTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Token string `json:"token"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminCreateScimTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminCreateScimTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type AdminSyncIdentityResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -6327,6 +8552,48 @@ func (r AdminSyncIdentityResponse) StatusCode() int {
 	return 0
 }
 
+type AdminDryRunIdentitySyncResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// The group filter which was used for the identity provider with the highest precedence. It is empty if groups are not filtered.
+		GroupFilter string                    `json:"groupFilter"`
+		Groups      []IdentitySyncGroupChange `json:"groups"`
+
+		// The identity provider with the highest precedence.
+		IdpType string `json:"idpType"`
+
+		// The identity providers which were listed, in order of precedence.
+		Sources []IdentitySyncSource     `json:"sources"`
+		Users   []IdentitySyncUserChange `json:"users"`
+	}
+	JSON400 *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminDryRunIdentitySyncResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminDryRunIdentitySyncResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type AdminListProvidersResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -6676,14 +8943,14 @@ func (r AdminGetRequestResponse) StatusCode() int {
 	return 0
 }
 
-type AdminListTargetGroupsResponse struct {
+type AdminGetRequestGrantStateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		Next         *string       `json:"next,omitempty"`
-		TargetGroups []TargetGroup `json:"targetGroups"`
+	JSON200      *GrantState
+	JSON401      *struct {
+		Error string `json:"error"`
 	}
-	JSON401 *struct {
+	JSON404 *struct {
 		Error string `json:"error"`
 	}
 	JSON500 *struct {
@@ -6692,7 +8959,7 @@ type AdminListTargetGroupsResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r AdminListTargetGroupsResponse) Status() string {
+func (r AdminGetRequestGrantStateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6700,19 +8967,50 @@ func (r AdminListTargetGroupsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AdminListTargetGroupsResponse) StatusCode() int {
+func (r AdminGetRequestGrantStateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AdminCreateTargetGroupResponse struct {
+type AdminListTargetGroupsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *TargetGroup
-	JSON400      *struct {
-		Error string `json:"error"`
+	JSON200      *struct {
+		Next         *string       `json:"next,omitempty"`
+		TargetGroups []TargetGroup `json:"targetGroups"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListTargetGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListTargetGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminCreateTargetGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TargetGroup
+	JSON400      *struct {
+		Error string `json:"error"`
 	}
 	JSON401 *struct {
 		Error string `json:"error"`
@@ -7114,6 +9412,115 @@ func (r UserUpdateFavoriteResponse) StatusCode() int {
 	return 0
 }
 
+type UserListRecurringRequestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		RecurringRequests []RecurringRequest `json:"recurringRequests"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserListRecurringRequestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserListRecurringRequestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserCreateRecurringRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *RecurringRequest
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserCreateRecurringRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserCreateRecurringRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserGetRecurringRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// A series of requests which are made on a weekly schedule.
+		RecurringRequest RecurringRequest `json:"recurringRequest"`
+		Requests         []Request        `json:"requests"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserGetRecurringRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserGetRecurringRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserCancelRecurringRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecurringRequest
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserCancelRecurringRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserCancelRecurringRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type UserListRequestsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -7163,6 +9570,39 @@ func (r UserCreateRequestResponse) StatusCode() int {
 	return 0
 }
 
+type UserDryRunRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Requests []DryRunRequest `json:"requests"`
+	}
+	JSON400 *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserDryRunRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserDryRunRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type UserListRequestsPastResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -7325,23 +9765,25 @@ func (r UserCancelRequestResponse) StatusCode() int {
 	return 0
 }
 
-type UserListRequestEventsResponse struct {
+type UserListRequestCommentsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
-		Events []RequestEvent `json:"events"`
-		Next   *string        `json:"next"`
+		Comments []RequestComment `json:"comments"`
 	}
 	JSON401 *struct {
 		Error string `json:"error"`
 	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
 	JSON500 *struct {
 		Error string `json:"error"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r UserListRequestEventsResponse) Status() string {
+func (r UserListRequestCommentsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7349,24 +9791,33 @@ func (r UserListRequestEventsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserListRequestEventsResponse) StatusCode() int {
+func (r UserListRequestCommentsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserReviewRequestResponse struct {
+type UserCreateRequestCommentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		// A request to access something made by an end user in Common Fate.
-		Request *Request `json:"request,omitempty"`
+	JSON201      *RequestComment
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r UserReviewRequestResponse) Status() string {
+func (r UserCreateRequestCommentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7374,20 +9825,21 @@ func (r UserReviewRequestResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserReviewRequestResponse) StatusCode() int {
+func (r UserCreateRequestCommentResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserRevokeRequestResponse struct {
+type UserListRequestEventsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON400      *struct {
-		Error string `json:"error"`
+	JSON200      *struct {
+		Events []RequestEvent `json:"events"`
+		Next   *string        `json:"next"`
 	}
-	JSON404 *struct {
+	JSON401 *struct {
 		Error string `json:"error"`
 	}
 	JSON500 *struct {
@@ -7396,7 +9848,7 @@ type UserRevokeRequestResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r UserRevokeRequestResponse) Status() string {
+func (r UserListRequestEventsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7404,25 +9856,39 @@ func (r UserRevokeRequestResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserRevokeRequestResponse) StatusCode() int {
+func (r UserListRequestEventsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserGetMeResponse struct {
+type UserExtendRequestResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
-		// Whether the user is an administrator of Common Fate.
-		IsAdmin bool `json:"isAdmin"`
-		User    User `json:"user"`
+		// true if the extension requires approval before it takes effect.
+		ExtensionPending bool `json:"extensionPending"`
+
+		// A request to access something made by an end user in Common Fate.
+		Request Request `json:"request"`
+	}
+	JSON400 *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r UserGetMeResponse) Status() string {
+func (r UserExtendRequestResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7430,21 +9896,39 @@ func (r UserGetMeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserGetMeResponse) StatusCode() int {
+func (r UserExtendRequestResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserGetUserResponse struct {
+type UserReviewExtensionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *User
+	JSON200      *struct {
+		// true if the extension requires approval before it takes effect.
+		ExtensionPending bool `json:"extensionPending"`
+
+		// A request to access something made by an end user in Common Fate.
+		Request Request `json:"request"`
+	}
+	JSON400 *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
 }
 
 // Status returns HTTPResponse.Status
-func (r UserGetUserResponse) Status() string {
+func (r UserReviewExtensionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7452,38 +9936,218 @@ func (r UserGetUserResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserGetUserResponse) StatusCode() int {
+func (r UserReviewExtensionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-// UserListAccessRulesWithResponse request returning *UserListAccessRulesResponse
-func (c *ClientWithResponses) UserListAccessRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListAccessRulesResponse, error) {
-	rsp, err := c.UserListAccessRules(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+type UserReviewRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// A request to access something made by an end user in Common Fate.
+		Request *Request `json:"request,omitempty"`
 	}
-	return ParseUserListAccessRulesResponse(rsp)
 }
 
-// UserLookupAccessRuleWithResponse request returning *UserLookupAccessRuleResponse
-func (c *ClientWithResponses) UserLookupAccessRuleWithResponse(ctx context.Context, params *UserLookupAccessRuleParams, reqEditors ...RequestEditorFn) (*UserLookupAccessRuleResponse, error) {
-	rsp, err := c.UserLookupAccessRule(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r UserReviewRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseUserLookupAccessRuleResponse(rsp)
+	return http.StatusText(0)
 }
 
-// UserGetAccessRuleWithResponse request returning *UserGetAccessRuleResponse
-func (c *ClientWithResponses) UserGetAccessRuleWithResponse(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*UserGetAccessRuleResponse, error) {
-	rsp, err := c.UserGetAccessRule(ctx, ruleId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUserGetAccessRuleResponse(rsp)
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserReviewRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserRevokeRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserRevokeRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserRevokeRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserGetMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// Whether the user is an administrator of Common Fate.
+		IsAdmin bool `json:"isAdmin"`
+		User    User `json:"user"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserGetMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserGetMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserRemoveDelegateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserRemoveDelegateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserRemoveDelegateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserGetDelegateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Delegation
+	JSON404      *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserGetDelegateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserGetDelegateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserSetDelegateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Delegation
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserSetDelegateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserSetDelegateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserGetUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+}
+
+// Status returns HTTPResponse.Status
+func (r UserGetUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserGetUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// UserListAccessRulesWithResponse request returning *UserListAccessRulesResponse
+func (c *ClientWithResponses) UserListAccessRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListAccessRulesResponse, error) {
+	rsp, err := c.UserListAccessRules(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserListAccessRulesResponse(rsp)
+}
+
+// UserLookupAccessRuleWithResponse request returning *UserLookupAccessRuleResponse
+func (c *ClientWithResponses) UserLookupAccessRuleWithResponse(ctx context.Context, params *UserLookupAccessRuleParams, reqEditors ...RequestEditorFn) (*UserLookupAccessRuleResponse, error) {
+	rsp, err := c.UserLookupAccessRule(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserLookupAccessRuleResponse(rsp)
+}
+
+// UserGetAccessRuleWithResponse request returning *UserGetAccessRuleResponse
+func (c *ClientWithResponses) UserGetAccessRuleWithResponse(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*UserGetAccessRuleResponse, error) {
+	rsp, err := c.UserGetAccessRule(ctx, ruleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserGetAccessRuleResponse(rsp)
 }
 
 // UserGetAccessRuleApproversWithResponse request returning *UserGetAccessRuleApproversResponse
@@ -7574,6 +10238,32 @@ func (c *ClientWithResponses) AdminGetAccessRuleVersionWithResponse(ctx context.
 	return ParseAdminGetAccessRuleVersionResponse(rsp)
 }
 
+// AdminBulkRevokeWithBodyWithResponse request with arbitrary body returning *AdminBulkRevokeResponse
+func (c *ClientWithResponses) AdminBulkRevokeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminBulkRevokeResponse, error) {
+	rsp, err := c.AdminBulkRevokeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminBulkRevokeResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminBulkRevokeWithResponse(ctx context.Context, body AdminBulkRevokeJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminBulkRevokeResponse, error) {
+	rsp, err := c.AdminBulkRevoke(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminBulkRevokeResponse(rsp)
+}
+
+// AdminGetBulkRevocationWithResponse request returning *AdminGetBulkRevocationResponse
+func (c *ClientWithResponses) AdminGetBulkRevocationWithResponse(ctx context.Context, bulkRevocationId string, reqEditors ...RequestEditorFn) (*AdminGetBulkRevocationResponse, error) {
+	rsp, err := c.AdminGetBulkRevocation(ctx, bulkRevocationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetBulkRevocationResponse(rsp)
+}
+
 // AdminGetDeploymentVersionWithResponse request returning *AdminGetDeploymentVersionResponse
 func (c *ClientWithResponses) AdminGetDeploymentVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminGetDeploymentVersionResponse, error) {
 	rsp, err := c.AdminGetDeploymentVersion(ctx, reqEditors...)
@@ -7583,6 +10273,33 @@ func (c *ClientWithResponses) AdminGetDeploymentVersionWithResponse(ctx context.
 	return ParseAdminGetDeploymentVersionResponse(rsp)
 }
 
+// AdminListGrantRetriesWithResponse request returning *AdminListGrantRetriesResponse
+func (c *ClientWithResponses) AdminListGrantRetriesWithResponse(ctx context.Context, params *AdminListGrantRetriesParams, reqEditors ...RequestEditorFn) (*AdminListGrantRetriesResponse, error) {
+	rsp, err := c.AdminListGrantRetries(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListGrantRetriesResponse(rsp)
+}
+
+// AdminAbandonGrantRetryWithResponse request returning *AdminAbandonGrantRetryResponse
+func (c *ClientWithResponses) AdminAbandonGrantRetryWithResponse(ctx context.Context, retryId string, reqEditors ...RequestEditorFn) (*AdminAbandonGrantRetryResponse, error) {
+	rsp, err := c.AdminAbandonGrantRetry(ctx, retryId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminAbandonGrantRetryResponse(rsp)
+}
+
+// AdminRetryGrantRetryWithResponse request returning *AdminRetryGrantRetryResponse
+func (c *ClientWithResponses) AdminRetryGrantRetryWithResponse(ctx context.Context, retryId string, reqEditors ...RequestEditorFn) (*AdminRetryGrantRetryResponse, error) {
+	rsp, err := c.AdminRetryGrantRetry(ctx, retryId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminRetryGrantRetryResponse(rsp)
+}
+
 // AdminListGroupsWithResponse request returning *AdminListGroupsResponse
 func (c *ClientWithResponses) AdminListGroupsWithResponse(ctx context.Context, params *AdminListGroupsParams, reqEditors ...RequestEditorFn) (*AdminListGroupsResponse, error) {
 	rsp, err := c.AdminListGroups(ctx, params, reqEditors...)
@@ -7706,6 +10423,24 @@ func (c *ClientWithResponses) AdminGetIdentityConfigurationWithResponse(ctx cont
 	return ParseAdminGetIdentityConfigurationResponse(rsp)
 }
 
+// AdminDeleteScimTokenWithResponse request returning *AdminDeleteScimTokenResponse
+func (c *ClientWithResponses) AdminDeleteScimTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminDeleteScimTokenResponse, error) {
+	rsp, err := c.AdminDeleteScimToken(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminDeleteScimTokenResponse(rsp)
+}
+
+// AdminCreateScimTokenWithResponse request returning *AdminCreateScimTokenResponse
+func (c *ClientWithResponses) AdminCreateScimTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminCreateScimTokenResponse, error) {
+	rsp, err := c.AdminCreateScimToken(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateScimTokenResponse(rsp)
+}
+
 // AdminSyncIdentityWithResponse request returning *AdminSyncIdentityResponse
 func (c *ClientWithResponses) AdminSyncIdentityWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminSyncIdentityResponse, error) {
 	rsp, err := c.AdminSyncIdentity(ctx, reqEditors...)
@@ -7715,6 +10450,23 @@ func (c *ClientWithResponses) AdminSyncIdentityWithResponse(ctx context.Context,
 	return ParseAdminSyncIdentityResponse(rsp)
 }
 
+// AdminDryRunIdentitySyncWithBodyWithResponse request with arbitrary body returning *AdminDryRunIdentitySyncResponse
+func (c *ClientWithResponses) AdminDryRunIdentitySyncWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminDryRunIdentitySyncResponse, error) {
+	rsp, err := c.AdminDryRunIdentitySyncWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminDryRunIdentitySyncResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminDryRunIdentitySyncWithResponse(ctx context.Context, body AdminDryRunIdentitySyncJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminDryRunIdentitySyncResponse, error) {
+	rsp, err := c.AdminDryRunIdentitySync(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminDryRunIdentitySyncResponse(rsp)
+}
+
 // AdminListProvidersWithResponse request returning *AdminListProvidersResponse
 func (c *ClientWithResponses) AdminListProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListProvidersResponse, error) {
 	rsp, err := c.AdminListProviders(ctx, reqEditors...)
@@ -7857,6 +10609,15 @@ func (c *ClientWithResponses) AdminGetRequestWithResponse(ctx context.Context, r
 	return ParseAdminGetRequestResponse(rsp)
 }
 
+// AdminGetRequestGrantStateWithResponse request returning *AdminGetRequestGrantStateResponse
+func (c *ClientWithResponses) AdminGetRequestGrantStateWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*AdminGetRequestGrantStateResponse, error) {
+	rsp, err := c.AdminGetRequestGrantState(ctx, requestId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetRequestGrantStateResponse(rsp)
+}
+
 // AdminListTargetGroupsWithResponse request returning *AdminListTargetGroupsResponse
 func (c *ClientWithResponses) AdminListTargetGroupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListTargetGroupsResponse, error) {
 	rsp, err := c.AdminListTargetGroups(ctx, reqEditors...)
@@ -8040,55 +10801,116 @@ func (c *ClientWithResponses) UserUpdateFavoriteWithResponse(ctx context.Context
 	return ParseUserUpdateFavoriteResponse(rsp)
 }
 
-// UserListRequestsWithResponse request returning *UserListRequestsResponse
-func (c *ClientWithResponses) UserListRequestsWithResponse(ctx context.Context, params *UserListRequestsParams, reqEditors ...RequestEditorFn) (*UserListRequestsResponse, error) {
-	rsp, err := c.UserListRequests(ctx, params, reqEditors...)
+// UserListRecurringRequestsWithResponse request returning *UserListRecurringRequestsResponse
+func (c *ClientWithResponses) UserListRecurringRequestsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListRecurringRequestsResponse, error) {
+	rsp, err := c.UserListRecurringRequests(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserListRequestsResponse(rsp)
+	return ParseUserListRecurringRequestsResponse(rsp)
 }
 
-// UserCreateRequestWithBodyWithResponse request with arbitrary body returning *UserCreateRequestResponse
-func (c *ClientWithResponses) UserCreateRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateRequestResponse, error) {
-	rsp, err := c.UserCreateRequestWithBody(ctx, contentType, body, reqEditors...)
+// UserCreateRecurringRequestWithBodyWithResponse request with arbitrary body returning *UserCreateRecurringRequestResponse
+func (c *ClientWithResponses) UserCreateRecurringRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateRecurringRequestResponse, error) {
+	rsp, err := c.UserCreateRecurringRequestWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserCreateRequestResponse(rsp)
+	return ParseUserCreateRecurringRequestResponse(rsp)
 }
 
-func (c *ClientWithResponses) UserCreateRequestWithResponse(ctx context.Context, body UserCreateRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateRequestResponse, error) {
-	rsp, err := c.UserCreateRequest(ctx, body, reqEditors...)
+func (c *ClientWithResponses) UserCreateRecurringRequestWithResponse(ctx context.Context, body UserCreateRecurringRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateRecurringRequestResponse, error) {
+	rsp, err := c.UserCreateRecurringRequest(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserCreateRequestResponse(rsp)
+	return ParseUserCreateRecurringRequestResponse(rsp)
 }
 
-// UserListRequestsPastWithResponse request returning *UserListRequestsPastResponse
-func (c *ClientWithResponses) UserListRequestsPastWithResponse(ctx context.Context, params *UserListRequestsPastParams, reqEditors ...RequestEditorFn) (*UserListRequestsPastResponse, error) {
-	rsp, err := c.UserListRequestsPast(ctx, params, reqEditors...)
+// UserGetRecurringRequestWithResponse request returning *UserGetRecurringRequestResponse
+func (c *ClientWithResponses) UserGetRecurringRequestWithResponse(ctx context.Context, recurringRequestId string, reqEditors ...RequestEditorFn) (*UserGetRecurringRequestResponse, error) {
+	rsp, err := c.UserGetRecurringRequest(ctx, recurringRequestId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserListRequestsPastResponse(rsp)
+	return ParseUserGetRecurringRequestResponse(rsp)
 }
 
-// UserListRequestsUpcomingWithResponse request returning *UserListRequestsUpcomingResponse
-func (c *ClientWithResponses) UserListRequestsUpcomingWithResponse(ctx context.Context, params *UserListRequestsUpcomingParams, reqEditors ...RequestEditorFn) (*UserListRequestsUpcomingResponse, error) {
-	rsp, err := c.UserListRequestsUpcoming(ctx, params, reqEditors...)
+// UserCancelRecurringRequestWithResponse request returning *UserCancelRecurringRequestResponse
+func (c *ClientWithResponses) UserCancelRecurringRequestWithResponse(ctx context.Context, recurringRequestId string, reqEditors ...RequestEditorFn) (*UserCancelRecurringRequestResponse, error) {
+	rsp, err := c.UserCancelRecurringRequest(ctx, recurringRequestId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserListRequestsUpcomingResponse(rsp)
+	return ParseUserCancelRecurringRequestResponse(rsp)
 }
 
-// UserGetRequestWithResponse request returning *UserGetRequestResponse
-func (c *ClientWithResponses) UserGetRequestWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*UserGetRequestResponse, error) {
-	rsp, err := c.UserGetRequest(ctx, requestId, reqEditors...)
-	if err != nil {
-		return nil, err
+// UserListRequestsWithResponse request returning *UserListRequestsResponse
+func (c *ClientWithResponses) UserListRequestsWithResponse(ctx context.Context, params *UserListRequestsParams, reqEditors ...RequestEditorFn) (*UserListRequestsResponse, error) {
+	rsp, err := c.UserListRequests(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserListRequestsResponse(rsp)
+}
+
+// UserCreateRequestWithBodyWithResponse request with arbitrary body returning *UserCreateRequestResponse
+func (c *ClientWithResponses) UserCreateRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateRequestResponse, error) {
+	rsp, err := c.UserCreateRequestWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserCreateRequestResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserCreateRequestWithResponse(ctx context.Context, body UserCreateRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateRequestResponse, error) {
+	rsp, err := c.UserCreateRequest(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserCreateRequestResponse(rsp)
+}
+
+// UserDryRunRequestWithBodyWithResponse request with arbitrary body returning *UserDryRunRequestResponse
+func (c *ClientWithResponses) UserDryRunRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserDryRunRequestResponse, error) {
+	rsp, err := c.UserDryRunRequestWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserDryRunRequestResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserDryRunRequestWithResponse(ctx context.Context, body UserDryRunRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UserDryRunRequestResponse, error) {
+	rsp, err := c.UserDryRunRequest(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserDryRunRequestResponse(rsp)
+}
+
+// UserListRequestsPastWithResponse request returning *UserListRequestsPastResponse
+func (c *ClientWithResponses) UserListRequestsPastWithResponse(ctx context.Context, params *UserListRequestsPastParams, reqEditors ...RequestEditorFn) (*UserListRequestsPastResponse, error) {
+	rsp, err := c.UserListRequestsPast(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserListRequestsPastResponse(rsp)
+}
+
+// UserListRequestsUpcomingWithResponse request returning *UserListRequestsUpcomingResponse
+func (c *ClientWithResponses) UserListRequestsUpcomingWithResponse(ctx context.Context, params *UserListRequestsUpcomingParams, reqEditors ...RequestEditorFn) (*UserListRequestsUpcomingResponse, error) {
+	rsp, err := c.UserListRequestsUpcoming(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserListRequestsUpcomingResponse(rsp)
+}
+
+// UserGetRequestWithResponse request returning *UserGetRequestResponse
+func (c *ClientWithResponses) UserGetRequestWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*UserGetRequestResponse, error) {
+	rsp, err := c.UserGetRequest(ctx, requestId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
 	return ParseUserGetRequestResponse(rsp)
 }
@@ -8120,15 +10942,75 @@ func (c *ClientWithResponses) UserCancelRequestWithResponse(ctx context.Context,
 	return ParseUserCancelRequestResponse(rsp)
 }
 
+// UserListRequestCommentsWithResponse request returning *UserListRequestCommentsResponse
+func (c *ClientWithResponses) UserListRequestCommentsWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*UserListRequestCommentsResponse, error) {
+	rsp, err := c.UserListRequestComments(ctx, requestId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserListRequestCommentsResponse(rsp)
+}
+
+// UserCreateRequestCommentWithBodyWithResponse request with arbitrary body returning *UserCreateRequestCommentResponse
+func (c *ClientWithResponses) UserCreateRequestCommentWithBodyWithResponse(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateRequestCommentResponse, error) {
+	rsp, err := c.UserCreateRequestCommentWithBody(ctx, requestId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserCreateRequestCommentResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserCreateRequestCommentWithResponse(ctx context.Context, requestId string, body UserCreateRequestCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateRequestCommentResponse, error) {
+	rsp, err := c.UserCreateRequestComment(ctx, requestId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserCreateRequestCommentResponse(rsp)
+}
+
 // UserListRequestEventsWithResponse request returning *UserListRequestEventsResponse
-func (c *ClientWithResponses) UserListRequestEventsWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*UserListRequestEventsResponse, error) {
-	rsp, err := c.UserListRequestEvents(ctx, requestId, reqEditors...)
+func (c *ClientWithResponses) UserListRequestEventsWithResponse(ctx context.Context, requestId string, params *UserListRequestEventsParams, reqEditors ...RequestEditorFn) (*UserListRequestEventsResponse, error) {
+	rsp, err := c.UserListRequestEvents(ctx, requestId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseUserListRequestEventsResponse(rsp)
 }
 
+// UserExtendRequestWithBodyWithResponse request with arbitrary body returning *UserExtendRequestResponse
+func (c *ClientWithResponses) UserExtendRequestWithBodyWithResponse(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserExtendRequestResponse, error) {
+	rsp, err := c.UserExtendRequestWithBody(ctx, requestId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserExtendRequestResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserExtendRequestWithResponse(ctx context.Context, requestId string, body UserExtendRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UserExtendRequestResponse, error) {
+	rsp, err := c.UserExtendRequest(ctx, requestId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserExtendRequestResponse(rsp)
+}
+
+// UserReviewExtensionWithBodyWithResponse request with arbitrary body returning *UserReviewExtensionResponse
+func (c *ClientWithResponses) UserReviewExtensionWithBodyWithResponse(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserReviewExtensionResponse, error) {
+	rsp, err := c.UserReviewExtensionWithBody(ctx, requestId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserReviewExtensionResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserReviewExtensionWithResponse(ctx context.Context, requestId string, body UserReviewExtensionJSONRequestBody, reqEditors ...RequestEditorFn) (*UserReviewExtensionResponse, error) {
+	rsp, err := c.UserReviewExtension(ctx, requestId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserReviewExtensionResponse(rsp)
+}
+
 // UserReviewRequestWithBodyWithResponse request with arbitrary body returning *UserReviewRequestResponse
 func (c *ClientWithResponses) UserReviewRequestWithBodyWithResponse(ctx context.Context, requestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserReviewRequestResponse, error) {
 	rsp, err := c.UserReviewRequestWithBody(ctx, requestId, contentType, body, reqEditors...)
@@ -8164,6 +11046,41 @@ func (c *ClientWithResponses) UserGetMeWithResponse(ctx context.Context, reqEdit
 	return ParseUserGetMeResponse(rsp)
 }
 
+// UserRemoveDelegateWithResponse request returning *UserRemoveDelegateResponse
+func (c *ClientWithResponses) UserRemoveDelegateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserRemoveDelegateResponse, error) {
+	rsp, err := c.UserRemoveDelegate(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserRemoveDelegateResponse(rsp)
+}
+
+// UserGetDelegateWithResponse request returning *UserGetDelegateResponse
+func (c *ClientWithResponses) UserGetDelegateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetDelegateResponse, error) {
+	rsp, err := c.UserGetDelegate(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserGetDelegateResponse(rsp)
+}
+
+// UserSetDelegateWithBodyWithResponse request with arbitrary body returning *UserSetDelegateResponse
+func (c *ClientWithResponses) UserSetDelegateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserSetDelegateResponse, error) {
+	rsp, err := c.UserSetDelegateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserSetDelegateResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserSetDelegateWithResponse(ctx context.Context, body UserSetDelegateJSONRequestBody, reqEditors ...RequestEditorFn) (*UserSetDelegateResponse, error) {
+	rsp, err := c.UserSetDelegate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserSetDelegateResponse(rsp)
+}
+
 // UserGetUserWithResponse request returning *UserGetUserResponse
 func (c *ClientWithResponses) UserGetUserWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*UserGetUserResponse, error) {
 	rsp, err := c.UserGetUser(ctx, userId, reqEditors...)
@@ -8181,115 +11098,943 @@ func ParseUserListAccessRulesResponse(rsp *http.Response) (*UserListAccessRulesR
 		return nil, err
 	}
 
-	response := &UserListAccessRulesResponse{
+	response := &UserListAccessRulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			AccessRules []AccessRule `json:"accessRules"`
+			Next        *string      `json:"next"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserLookupAccessRuleResponse parses an HTTP response from a UserLookupAccessRuleWithResponse call
+func ParseUserLookupAccessRuleResponse(rsp *http.Response) (*UserLookupAccessRuleResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserLookupAccessRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []LookupAccessRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserGetAccessRuleResponse parses an HTTP response from a UserGetAccessRuleWithResponse call
+func ParseUserGetAccessRuleResponse(rsp *http.Response) (*UserGetAccessRuleResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserGetAccessRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RequestAccessRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserGetAccessRuleApproversResponse parses an HTTP response from a UserGetAccessRuleApproversWithResponse call
+func ParseUserGetAccessRuleApproversResponse(rsp *http.Response) (*UserGetAccessRuleApproversResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserGetAccessRuleApproversResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Next  *string  `json:"next"`
+			Users []string `json:"users"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListAccessRulesResponse parses an HTTP response from a AdminListAccessRulesWithResponse call
+func ParseAdminListAccessRulesResponse(rsp *http.Response) (*AdminListAccessRulesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListAccessRulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			AccessRules []AccessRuleDetail `json:"accessRules"`
+			Next        *string            `json:"next"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminCreateAccessRuleResponse parses an HTTP response from a AdminCreateAccessRuleWithResponse call
+func ParseAdminCreateAccessRuleResponse(rsp *http.Response) (*AdminCreateAccessRuleResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminCreateAccessRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AccessRuleDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetAccessRuleResponse parses an HTTP response from a AdminGetAccessRuleWithResponse call
+func ParseAdminGetAccessRuleResponse(rsp *http.Response) (*AdminGetAccessRuleResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetAccessRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccessRuleDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminUpdateAccessRuleResponse parses an HTTP response from a AdminUpdateAccessRuleWithResponse call
+func ParseAdminUpdateAccessRuleResponse(rsp *http.Response) (*AdminUpdateAccessRuleResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminUpdateAccessRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccessRuleDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminArchiveAccessRuleResponse parses an HTTP response from a AdminArchiveAccessRuleWithResponse call
+func ParseAdminArchiveAccessRuleResponse(rsp *http.Response) (*AdminArchiveAccessRuleResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminArchiveAccessRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccessRuleDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetAccessRuleVersionsResponse parses an HTTP response from a AdminGetAccessRuleVersionsWithResponse call
+func ParseAdminGetAccessRuleVersionsResponse(rsp *http.Response) (*AdminGetAccessRuleVersionsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetAccessRuleVersionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			AccessRules []AccessRuleDetail `json:"accessRules"`
+			Next        *string            `json:"next"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetAccessRuleVersionResponse parses an HTTP response from a AdminGetAccessRuleVersionWithResponse call
+func ParseAdminGetAccessRuleVersionResponse(rsp *http.Response) (*AdminGetAccessRuleVersionResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetAccessRuleVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccessRuleDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminBulkRevokeResponse parses an HTTP response from a AdminBulkRevokeWithResponse call
+func ParseAdminBulkRevokeResponse(rsp *http.Response) (*AdminBulkRevokeResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminBulkRevokeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			CompletedAt *time.Time       `json:"completedAt,omitempty"`
+			DryRun      bool             `json:"dryRun"`
+			Id          string           `json:"id"`
+			Revocations []BulkRevocation `json:"revocations"`
+
+			// PENDING bulk revocations are queued, IN_PROGRESS bulk revocations are revoking grants,
+			// and COMPLETED bulk revocations have attempted to revoke every grant.
+			Status *BulkRevokeStatus `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetBulkRevocationResponse parses an HTTP response from a AdminGetBulkRevocationWithResponse call
+func ParseAdminGetBulkRevocationResponse(rsp *http.Response) (*AdminGetBulkRevocationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetBulkRevocationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			CompletedAt *time.Time       `json:"completedAt,omitempty"`
+			DryRun      bool             `json:"dryRun"`
+			Id          string           `json:"id"`
+			Revocations []BulkRevocation `json:"revocations"`
+
+			// PENDING bulk revocations are queued, IN_PROGRESS bulk revocations are revoking grants,
+			// and COMPLETED bulk revocations have attempted to revoke every grant.
+			Status *BulkRevokeStatus `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetDeploymentVersionResponse parses an HTTP response from a AdminGetDeploymentVersionWithResponse call
+func ParseAdminGetDeploymentVersionResponse(rsp *http.Response) (*AdminGetDeploymentVersionResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetDeploymentVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// The deployment version. Will be a semver, such as "v0.9.0" for official releases, or "dev+GIT_HASH" for pre-release builds.
+			Version string `json:"version"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListGrantRetriesResponse parses an HTTP response from a AdminListGrantRetriesWithResponse call
+func ParseAdminListGrantRetriesResponse(rsp *http.Response) (*AdminListGrantRetriesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListGrantRetriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			GrantRetries []GrantRetry `json:"grantRetries"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminAbandonGrantRetryResponse parses an HTTP response from a AdminAbandonGrantRetryWithResponse call
+func ParseAdminAbandonGrantRetryResponse(rsp *http.Response) (*AdminAbandonGrantRetryResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminAbandonGrantRetryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GrantRetry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminRetryGrantRetryResponse parses an HTTP response from a AdminRetryGrantRetryWithResponse call
+func ParseAdminRetryGrantRetryResponse(rsp *http.Response) (*AdminRetryGrantRetryResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminRetryGrantRetryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GrantRetry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListGroupsResponse parses an HTTP response from a AdminListGroupsWithResponse call
+func ParseAdminListGroupsResponse(rsp *http.Response) (*AdminListGroupsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListGroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Groups []Group `json:"groups"`
+			Next   *string `json:"next"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminCreateGroupResponse parses an HTTP response from a AdminCreateGroupWithResponse call
+func ParseAdminCreateGroupResponse(rsp *http.Response) (*AdminCreateGroupResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminCreateGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Group
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminDeleteGroupResponse parses an HTTP response from a AdminDeleteGroupWithResponse call
+func ParseAdminDeleteGroupResponse(rsp *http.Response) (*AdminDeleteGroupResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminDeleteGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
-			AccessRules []AccessRule `json:"accessRules"`
-			Next        *string      `json:"next"`
+			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUserLookupAccessRuleResponse parses an HTTP response from a UserLookupAccessRuleWithResponse call
-func ParseUserLookupAccessRuleResponse(rsp *http.Response) (*UserLookupAccessRuleResponse, error) {
+// ParseAdminGetGroupResponse parses an HTTP response from a AdminGetGroupWithResponse call
+func ParseAdminGetGroupResponse(rsp *http.Response) (*AdminGetGroupResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserLookupAccessRuleResponse{
+	response := &AdminGetGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []LookupAccessRule
+		var dest Group
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseUserGetAccessRuleResponse parses an HTTP response from a UserGetAccessRuleWithResponse call
-func ParseUserGetAccessRuleResponse(rsp *http.Response) (*UserGetAccessRuleResponse, error) {
+// ParseAdminUpdateGroupResponse parses an HTTP response from a AdminUpdateGroupWithResponse call
+func ParseAdminUpdateGroupResponse(rsp *http.Response) (*AdminUpdateGroupResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserGetAccessRuleResponse{
+	response := &AdminUpdateGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RequestAccessRule
+		var dest Group
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUserGetAccessRuleApproversResponse parses an HTTP response from a UserGetAccessRuleApproversWithResponse call
-func ParseUserGetAccessRuleApproversResponse(rsp *http.Response) (*UserGetAccessRuleApproversResponse, error) {
+// ParseAdminListHandlersResponse parses an HTTP response from a AdminListHandlersWithResponse call
+func ParseAdminListHandlersResponse(rsp *http.Response) (*AdminListHandlersResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserGetAccessRuleApproversResponse{
+	response := &AdminListHandlersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -8297,8 +12042,8 @@ func ParseUserGetAccessRuleApproversResponse(rsp *http.Response) (*UserGetAccess
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Next  *string  `json:"next"`
-			Users []string `json:"users"`
+			Next string      `json:"next"`
+			Res  []TGHandler `json:"res"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -8314,109 +12059,142 @@ func ParseUserGetAccessRuleApproversResponse(rsp *http.Response) (*UserGetAccess
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAdminListAccessRulesResponse parses an HTTP response from a AdminListAccessRulesWithResponse call
-func ParseAdminListAccessRulesResponse(rsp *http.Response) (*AdminListAccessRulesResponse, error) {
+// ParseAdminRegisterHandlerResponse parses an HTTP response from a AdminRegisterHandlerWithResponse call
+func ParseAdminRegisterHandlerResponse(rsp *http.Response) (*AdminRegisterHandlerResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminListAccessRulesResponse{
+	response := &AdminRegisterHandlerResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TGHandler
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
-			AccessRules []AccessRuleDetail `json:"accessRules"`
-			Next        *string            `json:"next"`
+			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAdminCreateAccessRuleResponse parses an HTTP response from a AdminCreateAccessRuleWithResponse call
-func ParseAdminCreateAccessRuleResponse(rsp *http.Response) (*AdminCreateAccessRuleResponse, error) {
+// ParseAdminDeleteHandlerResponse parses an HTTP response from a AdminDeleteHandlerWithResponse call
+func ParseAdminDeleteHandlerResponse(rsp *http.Response) (*AdminDeleteHandlerResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminCreateAccessRuleResponse{
+	response := &AdminDeleteHandlerResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest AccessRuleDetail
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 204:
+		var dest map[string]interface{}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON204 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAdminGetAccessRuleResponse parses an HTTP response from a AdminGetAccessRuleWithResponse call
-func ParseAdminGetAccessRuleResponse(rsp *http.Response) (*AdminGetAccessRuleResponse, error) {
+// ParseAdminGetHandlerResponse parses an HTTP response from a AdminGetHandlerWithResponse call
+func ParseAdminGetHandlerResponse(rsp *http.Response) (*AdminGetHandlerResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminGetAccessRuleResponse{
+	response := &AdminGetHandlerResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AccessRuleDetail
+		var dest TGHandler
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8440,53 +12218,76 @@ func ParseAdminGetAccessRuleResponse(rsp *http.Response) (*AdminGetAccessRuleRes
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseAdminUpdateAccessRuleResponse parses an HTTP response from a AdminUpdateAccessRuleWithResponse call
-func ParseAdminUpdateAccessRuleResponse(rsp *http.Response) (*AdminUpdateAccessRuleResponse, error) {
+// ParseAdminHealthcheckHandlersResponse parses an HTTP response from a AdminHealthcheckHandlersWithResponse call
+func ParseAdminHealthcheckHandlersResponse(rsp *http.Response) (*AdminHealthcheckHandlersResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminUpdateAccessRuleResponse{
+	response := &AdminHealthcheckHandlersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AccessRuleDetail
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAdminArchiveAccessRuleResponse parses an HTTP response from a AdminArchiveAccessRuleWithResponse call
-func ParseAdminArchiveAccessRuleResponse(rsp *http.Response) (*AdminArchiveAccessRuleResponse, error) {
+// ParseAdminGetIdentityConfigurationResponse parses an HTTP response from a AdminGetIdentityConfigurationWithResponse call
+func ParseAdminGetIdentityConfigurationResponse(rsp *http.Response) (*AdminGetIdentityConfigurationResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminArchiveAccessRuleResponse{
+	response := &AdminGetIdentityConfigurationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AccessRuleDetail
+		var dest struct {
+			AdministratorGroupId string `json:"administratorGroupId"`
+			IdentityProvider     string `json:"identityProvider"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8501,15 +12302,6 @@ func ParseAdminArchiveAccessRuleResponse(rsp *http.Response) (*AdminArchiveAcces
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
 			Error string `json:"error"`
@@ -8524,55 +12316,65 @@ func ParseAdminArchiveAccessRuleResponse(rsp *http.Response) (*AdminArchiveAcces
 	return response, nil
 }
 
-// ParseAdminGetAccessRuleVersionsResponse parses an HTTP response from a AdminGetAccessRuleVersionsWithResponse call
-func ParseAdminGetAccessRuleVersionsResponse(rsp *http.Response) (*AdminGetAccessRuleVersionsResponse, error) {
+// ParseAdminDeleteScimTokenResponse parses an HTTP response from a AdminDeleteScimTokenWithResponse call
+func ParseAdminDeleteScimTokenResponse(rsp *http.Response) (*AdminDeleteScimTokenResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminGetAccessRuleVersionsResponse{
+	response := &AdminDeleteScimTokenResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
-			AccessRules []AccessRuleDetail `json:"accessRules"`
-			Next        *string            `json:"next"`
+			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAdminGetAccessRuleVersionResponse parses an HTTP response from a AdminGetAccessRuleVersionWithResponse call
-func ParseAdminGetAccessRuleVersionResponse(rsp *http.Response) (*AdminGetAccessRuleVersionResponse, error) {
+// ParseAdminCreateScimTokenResponse parses an HTTP response from a AdminCreateScimTokenWithResponse call
+func ParseAdminCreateScimTokenResponse(rsp *http.Response) (*AdminCreateScimTokenResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminGetAccessRuleVersionResponse{
+	response := &AdminCreateScimTokenResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AccessRuleDetail
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Token string `json:"token"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
@@ -8583,98 +12385,88 @@ func ParseAdminGetAccessRuleVersionResponse(rsp *http.Response) (*AdminGetAccess
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAdminGetDeploymentVersionResponse parses an HTTP response from a AdminGetDeploymentVersionWithResponse call
-func ParseAdminGetDeploymentVersionResponse(rsp *http.Response) (*AdminGetDeploymentVersionResponse, error) {
+// ParseAdminSyncIdentityResponse parses an HTTP response from a AdminSyncIdentityWithResponse call
+func ParseAdminSyncIdentityResponse(rsp *http.Response) (*AdminSyncIdentityResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminGetDeploymentVersionResponse{
+	response := &AdminSyncIdentityResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
-			// The deployment version. Will be a semver, such as "v0.9.0" for official releases, or "dev+GIT_HASH" for pre-release builds.
-			Version string `json:"version"`
+			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseAdminListGroupsResponse parses an HTTP response from a AdminListGroupsWithResponse call
-func ParseAdminListGroupsResponse(rsp *http.Response) (*AdminListGroupsResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &AdminListGroupsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+		}
+		response.JSON401 = &dest
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
-			Groups []Group `json:"groups"`
-			Next   *string `json:"next"`
+			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAdminCreateGroupResponse parses an HTTP response from a AdminCreateGroupWithResponse call
-func ParseAdminCreateGroupResponse(rsp *http.Response) (*AdminCreateGroupResponse, error) {
+// ParseAdminDryRunIdentitySyncResponse parses an HTTP response from a AdminDryRunIdentitySyncWithResponse call
+func ParseAdminDryRunIdentitySyncResponse(rsp *http.Response) (*AdminDryRunIdentitySyncResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminCreateGroupResponse{
+	response := &AdminDryRunIdentitySyncResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Group
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// The group filter which was used for the identity provider with the highest precedence. It is empty if groups are not filtered.
+			GroupFilter string                    `json:"groupFilter"`
+			Groups      []IdentitySyncGroupChange `json:"groups"`
+
+			// The identity provider with the highest precedence.
+			IdpType string `json:"idpType"`
+
+			// The identity providers which were listed, in order of precedence.
+			Sources []IdentitySyncSource     `json:"sources"`
+			Users   []IdentitySyncUserChange `json:"users"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
@@ -8708,28 +12500,35 @@ func ParseAdminCreateGroupResponse(rsp *http.Response) (*AdminCreateGroupRespons
 	return response, nil
 }
 
-// ParseAdminDeleteGroupResponse parses an HTTP response from a AdminDeleteGroupWithResponse call
-func ParseAdminDeleteGroupResponse(rsp *http.Response) (*AdminDeleteGroupResponse, error) {
+// ParseAdminListProvidersResponse parses an HTTP response from a AdminListProvidersWithResponse call
+func ParseAdminListProvidersResponse(rsp *http.Response) (*AdminListProvidersResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminDeleteGroupResponse{
+	response := &AdminListProvidersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Provider
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON401 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
@@ -8745,61 +12544,79 @@ func ParseAdminDeleteGroupResponse(rsp *http.Response) (*AdminDeleteGroupRespons
 	return response, nil
 }
 
-// ParseAdminGetGroupResponse parses an HTTP response from a AdminGetGroupWithResponse call
-func ParseAdminGetGroupResponse(rsp *http.Response) (*AdminGetGroupResponse, error) {
+// ParseAdminGetProviderResponse parses an HTTP response from a AdminGetProviderWithResponse call
+func ParseAdminGetProviderResponse(rsp *http.Response) (*AdminGetProviderResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminGetGroupResponse{
+	response := &AdminGetProviderResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Group
+		var dest Provider
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseAdminUpdateGroupResponse parses an HTTP response from a AdminUpdateGroupWithResponse call
-func ParseAdminUpdateGroupResponse(rsp *http.Response) (*AdminUpdateGroupResponse, error) {
+// ParseAdminGetProviderArgsResponse parses an HTTP response from a AdminGetProviderArgsWithResponse call
+func ParseAdminGetProviderArgsResponse(rsp *http.Response) (*AdminGetProviderArgsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminUpdateGroupResponse{
+	response := &AdminGetProviderArgsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Group
+		var dest externalRef0.ArgSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
@@ -8815,25 +12632,22 @@ func ParseAdminUpdateGroupResponse(rsp *http.Response) (*AdminUpdateGroupRespons
 	return response, nil
 }
 
-// ParseAdminListHandlersResponse parses an HTTP response from a AdminListHandlersWithResponse call
-func ParseAdminListHandlersResponse(rsp *http.Response) (*AdminListHandlersResponse, error) {
+// ParseAdminListProviderArgOptionsResponse parses an HTTP response from a AdminListProviderArgOptionsWithResponse call
+func ParseAdminListProviderArgOptionsResponse(rsp *http.Response) (*AdminListProviderArgOptionsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminListHandlersResponse{
+	response := &AdminListProviderArgOptionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Next string      `json:"next"`
-			Res  []TGHandler `json:"res"`
-		}
+		var dest externalRef0.ArgOptions
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8862,211 +12676,237 @@ func ParseAdminListHandlersResponse(rsp *http.Response) (*AdminListHandlersRespo
 	return response, nil
 }
 
-// ParseAdminRegisterHandlerResponse parses an HTTP response from a AdminRegisterHandlerWithResponse call
-func ParseAdminRegisterHandlerResponse(rsp *http.Response) (*AdminRegisterHandlerResponse, error) {
+// ParseAdminListProvidersetupsResponse parses an HTTP response from a AdminListProvidersetupsWithResponse call
+func ParseAdminListProvidersetupsResponse(rsp *http.Response) (*AdminListProvidersetupsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminRegisterHandlerResponse{
+	response := &AdminListProvidersetupsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TGHandler
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Error string `json:"error"`
+			ProviderSetups []ProviderSetup `json:"providerSetups"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			Error string `json:"error"`
-		}
+	}
+
+	return response, nil
+}
+
+// ParseAdminCreateProvidersetupResponse parses an HTTP response from a AdminCreateProvidersetupWithResponse call
+func ParseAdminCreateProvidersetupResponse(rsp *http.Response) (*AdminCreateProvidersetupResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminCreateProvidersetupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ProviderSetup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON201 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON400 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAdminDeleteHandlerResponse parses an HTTP response from a AdminDeleteHandlerWithResponse call
-func ParseAdminDeleteHandlerResponse(rsp *http.Response) (*AdminDeleteHandlerResponse, error) {
+// ParseAdminDeleteProvidersetupResponse parses an HTTP response from a AdminDeleteProvidersetupWithResponse call
+func ParseAdminDeleteProvidersetupResponse(rsp *http.Response) (*AdminDeleteProvidersetupResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminDeleteHandlerResponse{
+	response := &AdminDeleteProvidersetupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 204:
-		var dest map[string]interface{}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProviderSetup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON204 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			Error string `json:"error"`
-		}
+	return response, nil
+}
+
+// ParseAdminGetProvidersetupResponse parses an HTTP response from a AdminGetProvidersetupWithResponse call
+func ParseAdminGetProvidersetupResponse(rsp *http.Response) (*AdminGetProvidersetupResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetProvidersetupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProviderSetup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+	}
+
+	return response, nil
+}
+
+// ParseAdminCompleteProvidersetupResponse parses an HTTP response from a AdminCompleteProvidersetupWithResponse call
+func ParseAdminCompleteProvidersetupResponse(rsp *http.Response) (*AdminCompleteProvidersetupResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminCompleteProvidersetupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Error string `json:"error"`
+			// Whether a manual update is required to the Common Fate deployment configuration (`deployment.yml`) to activate the provider.
+			DeploymentConfigUpdateRequired bool `json:"deploymentConfigUpdateRequired"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAdminGetHandlerResponse parses an HTTP response from a AdminGetHandlerWithResponse call
-func ParseAdminGetHandlerResponse(rsp *http.Response) (*AdminGetHandlerResponse, error) {
+// ParseAdminGetProvidersetupInstructionsResponse parses an HTTP response from a AdminGetProvidersetupInstructionsWithResponse call
+func ParseAdminGetProvidersetupInstructionsResponse(rsp *http.Response) (*AdminGetProvidersetupInstructionsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminGetHandlerResponse{
+	response := &AdminGetProvidersetupInstructionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TGHandler
+		var dest ProviderSetupInstructions
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	return response, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
+// ParseAdminSubmitProvidersetupStepResponse parses an HTTP response from a AdminSubmitProvidersetupStepWithResponse call
+func ParseAdminSubmitProvidersetupStepResponse(rsp *http.Response) (*AdminSubmitProvidersetupStepResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminSubmitProvidersetupStepResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProviderSetup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAdminHealthcheckHandlersResponse parses an HTTP response from a AdminHealthcheckHandlersWithResponse call
-func ParseAdminHealthcheckHandlersResponse(rsp *http.Response) (*AdminHealthcheckHandlersResponse, error) {
+// ParseAdminValidateProvidersetupResponse parses an HTTP response from a AdminValidateProvidersetupWithResponse call
+func ParseAdminValidateProvidersetupResponse(rsp *http.Response) (*AdminValidateProvidersetupResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminHealthcheckHandlersResponse{
+	response := &AdminValidateProvidersetupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProviderSetup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAdminGetIdentityConfigurationResponse parses an HTTP response from a AdminGetIdentityConfigurationWithResponse call
-func ParseAdminGetIdentityConfigurationResponse(rsp *http.Response) (*AdminGetIdentityConfigurationResponse, error) {
+// ParseAdminListRequestsResponse parses an HTTP response from a AdminListRequestsWithResponse call
+func ParseAdminListRequestsResponse(rsp *http.Response) (*AdminListRequestsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminGetIdentityConfigurationResponse{
+	response := &AdminListRequestsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -9074,59 +12914,48 @@ func ParseAdminGetIdentityConfigurationResponse(rsp *http.Response) (*AdminGetId
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			AdministratorGroupId string `json:"administratorGroupId"`
-			IdentityProvider     string `json:"identityProvider"`
+			Next     *string   `json:"next"`
+			Requests []Request `json:"requests"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseAdminSyncIdentityResponse parses an HTTP response from a AdminSyncIdentityWithResponse call
-func ParseAdminSyncIdentityResponse(rsp *http.Response) (*AdminSyncIdentityResponse, error) {
+// ParseAdminGetRequestResponse parses an HTTP response from a AdminGetRequestWithResponse call
+func ParseAdminGetRequestResponse(rsp *http.Response) (*AdminGetRequestResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminSyncIdentityResponse{
+	response := &AdminGetRequestResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RequestDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
@@ -9142,22 +12971,22 @@ func ParseAdminSyncIdentityResponse(rsp *http.Response) (*AdminSyncIdentityRespo
 	return response, nil
 }
 
-// ParseAdminListProvidersResponse parses an HTTP response from a AdminListProvidersWithResponse call
-func ParseAdminListProvidersResponse(rsp *http.Response) (*AdminListProvidersResponse, error) {
+// ParseAdminGetRequestGrantStateResponse parses an HTTP response from a AdminGetRequestGrantStateWithResponse call
+func ParseAdminGetRequestGrantStateResponse(rsp *http.Response) (*AdminGetRequestGrantStateResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminListProvidersResponse{
+	response := &AdminGetRequestGrantStateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Provider
+		var dest GrantState
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9172,6 +13001,15 @@ func ParseAdminListProvidersResponse(rsp *http.Response) (*AdminListProvidersRes
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
 			Error string `json:"error"`
@@ -9186,22 +13024,25 @@ func ParseAdminListProvidersResponse(rsp *http.Response) (*AdminListProvidersRes
 	return response, nil
 }
 
-// ParseAdminGetProviderResponse parses an HTTP response from a AdminGetProviderWithResponse call
-func ParseAdminGetProviderResponse(rsp *http.Response) (*AdminGetProviderResponse, error) {
+// ParseAdminListTargetGroupsResponse parses an HTTP response from a AdminListTargetGroupsWithResponse call
+func ParseAdminListTargetGroupsResponse(rsp *http.Response) (*AdminListTargetGroupsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminGetProviderResponse{
+	response := &AdminListTargetGroupsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Provider
+		var dest struct {
+			Next         *string       `json:"next,omitempty"`
+			TargetGroups []TargetGroup `json:"targetGroups"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9230,26 +13071,35 @@ func ParseAdminGetProviderResponse(rsp *http.Response) (*AdminGetProviderRespons
 	return response, nil
 }
 
-// ParseAdminGetProviderArgsResponse parses an HTTP response from a AdminGetProviderArgsWithResponse call
-func ParseAdminGetProviderArgsResponse(rsp *http.Response) (*AdminGetProviderArgsResponse, error) {
+// ParseAdminCreateTargetGroupResponse parses an HTTP response from a AdminCreateTargetGroupWithResponse call
+func ParseAdminCreateTargetGroupResponse(rsp *http.Response) (*AdminCreateTargetGroupResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminGetProviderArgsResponse{
+	response := &AdminCreateTargetGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest externalRef0.ArgSchema
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TargetGroup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
@@ -9260,6 +13110,15 @@ func ParseAdminGetProviderArgsResponse(rsp *http.Response) (*AdminGetProviderArg
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
 			Error string `json:"error"`
@@ -9274,35 +13133,37 @@ func ParseAdminGetProviderArgsResponse(rsp *http.Response) (*AdminGetProviderArg
 	return response, nil
 }
 
-// ParseAdminListProviderArgOptionsResponse parses an HTTP response from a AdminListProviderArgOptionsWithResponse call
-func ParseAdminListProviderArgOptionsResponse(rsp *http.Response) (*AdminListProviderArgOptionsResponse, error) {
+// ParseAdminDeleteTargetGroupResponse parses an HTTP response from a AdminDeleteTargetGroupWithResponse call
+func ParseAdminDeleteTargetGroupResponse(rsp *http.Response) (*AdminDeleteTargetGroupResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminListProviderArgOptionsResponse{
+	response := &AdminDeleteTargetGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest externalRef0.ArgOptions
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
@@ -9318,237 +13179,232 @@ func ParseAdminListProviderArgOptionsResponse(rsp *http.Response) (*AdminListPro
 	return response, nil
 }
 
-// ParseAdminListProvidersetupsResponse parses an HTTP response from a AdminListProvidersetupsWithResponse call
-func ParseAdminListProvidersetupsResponse(rsp *http.Response) (*AdminListProvidersetupsResponse, error) {
+// ParseAdminGetTargetGroupResponse parses an HTTP response from a AdminGetTargetGroupWithResponse call
+func ParseAdminGetTargetGroupResponse(rsp *http.Response) (*AdminGetTargetGroupResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminListProvidersetupsResponse{
+	response := &AdminGetTargetGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			ProviderSetups []ProviderSetup `json:"providerSetups"`
-		}
+		var dest TargetGroup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	}
-
-	return response, nil
-}
-
-// ParseAdminCreateProvidersetupResponse parses an HTTP response from a AdminCreateProvidersetupWithResponse call
-func ParseAdminCreateProvidersetupResponse(rsp *http.Response) (*AdminCreateProvidersetupResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &AdminCreateProvidersetupResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ProviderSetup
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseAdminDeleteProvidersetupResponse parses an HTTP response from a AdminDeleteProvidersetupWithResponse call
-func ParseAdminDeleteProvidersetupResponse(rsp *http.Response) (*AdminDeleteProvidersetupResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &AdminDeleteProvidersetupResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+		response.JSON404 = &dest
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProviderSetup
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAdminGetProvidersetupResponse parses an HTTP response from a AdminGetProvidersetupWithResponse call
-func ParseAdminGetProvidersetupResponse(rsp *http.Response) (*AdminGetProvidersetupResponse, error) {
+// ParseAdminCreateTargetGroupLinkResponse parses an HTTP response from a AdminCreateTargetGroupLinkWithResponse call
+func ParseAdminCreateTargetGroupLinkResponse(rsp *http.Response) (*AdminCreateTargetGroupLinkResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminGetProvidersetupResponse{
+	response := &AdminCreateTargetGroupLinkResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProviderSetup
+		var dest TargetRoute
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	}
-
-	return response, nil
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// ParseAdminCompleteProvidersetupResponse parses an HTTP response from a AdminCompleteProvidersetupWithResponse call
-func ParseAdminCompleteProvidersetupResponse(rsp *http.Response) (*AdminCompleteProvidersetupResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-	response := &AdminCompleteProvidersetupResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
-			// Whether a manual update is required to the Common Fate deployment configuration (`deployment.yml`) to activate the provider.
-			DeploymentConfigUpdateRequired bool `json:"deploymentConfigUpdateRequired"`
+			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAdminGetProvidersetupInstructionsResponse parses an HTTP response from a AdminGetProvidersetupInstructionsWithResponse call
-func ParseAdminGetProvidersetupInstructionsResponse(rsp *http.Response) (*AdminGetProvidersetupInstructionsResponse, error) {
+// ParseAdminListTargetRoutesResponse parses an HTTP response from a AdminListTargetRoutesWithResponse call
+func ParseAdminListTargetRoutesResponse(rsp *http.Response) (*AdminListTargetRoutesResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminGetProvidersetupInstructionsResponse{
+	response := &AdminListTargetRoutesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProviderSetupInstructions
+		var dest struct {
+			Next   *string       `json:"next,omitempty"`
+			Routes []TargetRoute `json:"routes"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseAdminSubmitProvidersetupStepResponse parses an HTTP response from a AdminSubmitProvidersetupStepWithResponse call
-func ParseAdminSubmitProvidersetupStepResponse(rsp *http.Response) (*AdminSubmitProvidersetupStepResponse, error) {
+// ParseAdminRemoveTargetGroupLinkResponse parses an HTTP response from a AdminRemoveTargetGroupLinkWithResponse call
+func ParseAdminRemoveTargetGroupLinkResponse(rsp *http.Response) (*AdminRemoveTargetGroupLinkResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminSubmitProvidersetupStepResponse{
+	response := &AdminRemoveTargetGroupLinkResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProviderSetup
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
-
-	}
-
-	return response, nil
-}
+		response.JSON400 = &dest
 
-// ParseAdminValidateProvidersetupResponse parses an HTTP response from a AdminValidateProvidersetupWithResponse call
-func ParseAdminValidateProvidersetupResponse(rsp *http.Response) (*AdminValidateProvidersetupResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-	response := &AdminValidateProvidersetupResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProviderSetup
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAdminListRequestsResponse parses an HTTP response from a AdminListRequestsWithResponse call
-func ParseAdminListRequestsResponse(rsp *http.Response) (*AdminListRequestsResponse, error) {
+// ParseAdminListUsersResponse parses an HTTP response from a AdminListUsersWithResponse call
+func ParseAdminListUsersResponse(rsp *http.Response) (*AdminListUsersResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminListRequestsResponse{
+	response := &AdminListUsersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -9556,8 +13412,8 @@ func ParseAdminListRequestsResponse(rsp *http.Response) (*AdminListRequestsRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Next     *string   `json:"next"`
-			Requests []Request `json:"requests"`
+			Next  *string `json:"next"`
+			Users []User  `json:"users"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -9569,35 +13425,44 @@ func ParseAdminListRequestsResponse(rsp *http.Response) (*AdminListRequestsRespo
 	return response, nil
 }
 
-// ParseAdminGetRequestResponse parses an HTTP response from a AdminGetRequestWithResponse call
-func ParseAdminGetRequestResponse(rsp *http.Response) (*AdminGetRequestResponse, error) {
+// ParseAdminCreateUserResponse parses an HTTP response from a AdminCreateUserWithResponse call
+func ParseAdminCreateUserResponse(rsp *http.Response) (*AdminCreateUserResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminGetRequestResponse{
+	response := &AdminCreateUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RequestDetail
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest User
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
@@ -9613,29 +13478,35 @@ func ParseAdminGetRequestResponse(rsp *http.Response) (*AdminGetRequestResponse,
 	return response, nil
 }
 
-// ParseAdminListTargetGroupsResponse parses an HTTP response from a AdminListTargetGroupsWithResponse call
-func ParseAdminListTargetGroupsResponse(rsp *http.Response) (*AdminListTargetGroupsResponse, error) {
+// ParseAdminUpdateUserResponse parses an HTTP response from a AdminUpdateUserWithResponse call
+func ParseAdminUpdateUserResponse(rsp *http.Response) (*AdminUpdateUserResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminListTargetGroupsResponse{
+	response := &AdminUpdateUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
-			Next         *string       `json:"next,omitempty"`
-			TargetGroups []TargetGroup `json:"targetGroups"`
+			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
@@ -9660,53 +13531,29 @@ func ParseAdminListTargetGroupsResponse(rsp *http.Response) (*AdminListTargetGro
 	return response, nil
 }
 
-// ParseAdminCreateTargetGroupResponse parses an HTTP response from a AdminCreateTargetGroupWithResponse call
-func ParseAdminCreateTargetGroupResponse(rsp *http.Response) (*AdminCreateTargetGroupResponse, error) {
+// ParseUserListFavoritesResponse parses an HTTP response from a UserListFavoritesWithResponse call
+func ParseUserListFavoritesResponse(rsp *http.Response) (*UserListFavoritesResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminCreateTargetGroupResponse{
+	response := &UserListFavoritesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TargetGroup
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Error string `json:"error"`
+			Favorites []Favorite `json:"favorites"`
+			Next      *string    `json:"next"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
@@ -9722,37 +13569,35 @@ func ParseAdminCreateTargetGroupResponse(rsp *http.Response) (*AdminCreateTarget
 	return response, nil
 }
 
-// ParseAdminDeleteTargetGroupResponse parses an HTTP response from a AdminDeleteTargetGroupWithResponse call
-func ParseAdminDeleteTargetGroupResponse(rsp *http.Response) (*AdminDeleteTargetGroupResponse, error) {
+// ParseUserCreateFavoriteResponse parses an HTTP response from a UserCreateFavoriteWithResponse call
+func ParseUserCreateFavoriteResponse(rsp *http.Response) (*UserCreateFavoriteResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminDeleteTargetGroupResponse{
+	response := &UserCreateFavoriteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			Error string `json:"error"`
-		}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest FavoriteDetail
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON201 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
@@ -9768,97 +13613,149 @@ func ParseAdminDeleteTargetGroupResponse(rsp *http.Response) (*AdminDeleteTarget
 	return response, nil
 }
 
-// ParseAdminGetTargetGroupResponse parses an HTTP response from a AdminGetTargetGroupWithResponse call
-func ParseAdminGetTargetGroupResponse(rsp *http.Response) (*AdminGetTargetGroupResponse, error) {
+// ParseUserDeleteFavoriteResponse parses an HTTP response from a UserDeleteFavoriteWithResponse call
+func ParseUserDeleteFavoriteResponse(rsp *http.Response) (*UserDeleteFavoriteResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminGetTargetGroupResponse{
+	response := &UserDeleteFavoriteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUserGetFavoriteResponse parses an HTTP response from a UserGetFavoriteWithResponse call
+func ParseUserGetFavoriteResponse(rsp *http.Response) (*UserGetFavoriteResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserGetFavoriteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TargetGroup
+		var dest FavoriteDetail
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON500 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
+	}
+
+	return response, nil
+}
+
+// ParseUserUpdateFavoriteResponse parses an HTTP response from a UserUpdateFavoriteWithResponse call
+func ParseUserUpdateFavoriteResponse(rsp *http.Response) (*UserUpdateFavoriteResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserUpdateFavoriteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FavoriteDetail
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAdminCreateTargetGroupLinkResponse parses an HTTP response from a AdminCreateTargetGroupLinkWithResponse call
-func ParseAdminCreateTargetGroupLinkResponse(rsp *http.Response) (*AdminCreateTargetGroupLinkResponse, error) {
+// ParseUserListRecurringRequestsResponse parses an HTTP response from a UserListRecurringRequestsWithResponse call
+func ParseUserListRecurringRequestsResponse(rsp *http.Response) (*UserListRecurringRequestsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminCreateTargetGroupLinkResponse{
+	response := &UserListRecurringRequestsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TargetRoute
+		var dest struct {
+			RecurringRequests []RecurringRequest `json:"recurringRequests"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			Error string `json:"error"`
-		}
+	}
+
+	return response, nil
+}
+
+// ParseUserCreateRecurringRequestResponse parses an HTTP response from a UserCreateRecurringRequestWithResponse call
+func ParseUserCreateRecurringRequestResponse(rsp *http.Response) (*UserCreateRecurringRequestResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserCreateRecurringRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest RecurringRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON201 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
@@ -9869,29 +13766,20 @@ func ParseAdminCreateTargetGroupLinkResponse(rsp *http.Response) (*AdminCreateTa
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseAdminListTargetRoutesResponse parses an HTTP response from a AdminListTargetRoutesWithResponse call
-func ParseAdminListTargetRoutesResponse(rsp *http.Response) (*AdminListTargetRoutesResponse, error) {
+// ParseUserGetRecurringRequestResponse parses an HTTP response from a UserGetRecurringRequestWithResponse call
+func ParseUserGetRecurringRequestResponse(rsp *http.Response) (*UserGetRecurringRequestResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminListTargetRoutesResponse{
+	response := &UserGetRecurringRequestResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -9899,8 +13787,9 @@ func ParseAdminListTargetRoutesResponse(rsp *http.Response) (*AdminListTargetRou
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Next   *string       `json:"next,omitempty"`
-			Routes []TargetRoute `json:"routes"`
+			// A series of requests which are made on a weekly schedule.
+			RecurringRequest RecurringRequest `json:"recurringRequest"`
+			Requests         []Request        `json:"requests"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -9916,51 +13805,40 @@ func ParseAdminListTargetRoutesResponse(rsp *http.Response) (*AdminListTargetRou
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseAdminRemoveTargetGroupLinkResponse parses an HTTP response from a AdminRemoveTargetGroupLinkWithResponse call
-func ParseAdminRemoveTargetGroupLinkResponse(rsp *http.Response) (*AdminRemoveTargetGroupLinkResponse, error) {
+// ParseUserCancelRecurringRequestResponse parses an HTTP response from a UserCancelRecurringRequestWithResponse call
+func ParseUserCancelRecurringRequestResponse(rsp *http.Response) (*UserCancelRecurringRequestResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminRemoveTargetGroupLinkResponse{
+	response := &UserCancelRecurringRequestResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			Error string `json:"error"`
-		}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecurringRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
@@ -9971,29 +13849,20 @@ func ParseAdminRemoveTargetGroupLinkResponse(rsp *http.Response) (*AdminRemoveTa
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseAdminListUsersResponse parses an HTTP response from a AdminListUsersWithResponse call
-func ParseAdminListUsersResponse(rsp *http.Response) (*AdminListUsersResponse, error) {
+// ParseUserListRequestsResponse parses an HTTP response from a UserListRequestsWithResponse call
+func ParseUserListRequestsResponse(rsp *http.Response) (*UserListRequestsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminListUsersResponse{
+	response := &UserListRequestsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -10001,8 +13870,8 @@ func ParseAdminListUsersResponse(rsp *http.Response) (*AdminListUsersResponse, e
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Next  *string `json:"next"`
-			Users []User  `json:"users"`
+			Next     *string   `json:"next"`
+			Requests []Request `json:"requests"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -10014,75 +13883,52 @@ func ParseAdminListUsersResponse(rsp *http.Response) (*AdminListUsersResponse, e
 	return response, nil
 }
 
-// ParseAdminCreateUserResponse parses an HTTP response from a AdminCreateUserWithResponse call
-func ParseAdminCreateUserResponse(rsp *http.Response) (*AdminCreateUserResponse, error) {
+// ParseUserCreateRequestResponse parses an HTTP response from a UserCreateRequestWithResponse call
+func ParseUserCreateRequestResponse(rsp *http.Response) (*UserCreateRequestResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminCreateUserResponse{
+	response := &UserCreateRequestResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest User
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Error string `json:"error"`
+			Requests []Request `json:"requests"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAdminUpdateUserResponse parses an HTTP response from a AdminUpdateUserWithResponse call
-func ParseAdminUpdateUserResponse(rsp *http.Response) (*AdminUpdateUserResponse, error) {
+// ParseUserDryRunRequestResponse parses an HTTP response from a UserDryRunRequestWithResponse call
+func ParseUserDryRunRequestResponse(rsp *http.Response) (*UserDryRunRequestResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminUpdateUserResponse{
+	response := &UserDryRunRequestResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
+		var dest struct {
+			Requests []DryRunRequest `json:"requests"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10120,15 +13966,15 @@ func ParseAdminUpdateUserResponse(rsp *http.Response) (*AdminUpdateUserResponse,
 	return response, nil
 }
 
-// ParseUserListFavoritesResponse parses an HTTP response from a UserListFavoritesWithResponse call
-func ParseUserListFavoritesResponse(rsp *http.Response) (*UserListFavoritesResponse, error) {
+// ParseUserListRequestsPastResponse parses an HTTP response from a UserListRequestsPastWithResponse call
+func ParseUserListRequestsPastResponse(rsp *http.Response) (*UserListRequestsPastResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserListFavoritesResponse{
+	response := &UserListRequestsPastResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -10136,104 +13982,64 @@ func ParseUserListFavoritesResponse(rsp *http.Response) (*UserListFavoritesRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Favorites []Favorite `json:"favorites"`
-			Next      *string    `json:"next"`
+			Next     *string   `json:"next"`
+			Requests []Request `json:"requests"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseUserCreateFavoriteResponse parses an HTTP response from a UserCreateFavoriteWithResponse call
-func ParseUserCreateFavoriteResponse(rsp *http.Response) (*UserCreateFavoriteResponse, error) {
+// ParseUserListRequestsUpcomingResponse parses an HTTP response from a UserListRequestsUpcomingWithResponse call
+func ParseUserListRequestsUpcomingResponse(rsp *http.Response) (*UserListRequestsUpcomingResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserCreateFavoriteResponse{
+	response := &UserListRequestsUpcomingResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest FavoriteDetail
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Error string `json:"error"`
+			Next     *string   `json:"next"`
+			Requests []Request `json:"requests"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseUserDeleteFavoriteResponse parses an HTTP response from a UserDeleteFavoriteWithResponse call
-func ParseUserDeleteFavoriteResponse(rsp *http.Response) (*UserDeleteFavoriteResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+		response.JSON200 = &dest
 
-	response := &UserDeleteFavoriteResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParseUserGetFavoriteResponse parses an HTTP response from a UserGetFavoriteWithResponse call
-func ParseUserGetFavoriteResponse(rsp *http.Response) (*UserGetFavoriteResponse, error) {
+// ParseUserGetRequestResponse parses an HTTP response from a UserGetRequestWithResponse call
+func ParseUserGetRequestResponse(rsp *http.Response) (*UserGetRequestResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserGetFavoriteResponse{
+	response := &UserGetRequestResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FavoriteDetail
+		var dest RequestDetail
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10262,22 +14068,22 @@ func ParseUserGetFavoriteResponse(rsp *http.Response) (*UserGetFavoriteResponse,
 	return response, nil
 }
 
-// ParseUserUpdateFavoriteResponse parses an HTTP response from a UserUpdateFavoriteWithResponse call
-func ParseUserUpdateFavoriteResponse(rsp *http.Response) (*UserUpdateFavoriteResponse, error) {
+// ParseUserGetAccessInstructionsResponse parses an HTTP response from a UserGetAccessInstructionsWithResponse call
+func ParseUserGetAccessInstructionsResponse(rsp *http.Response) (*UserGetAccessInstructionsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserUpdateFavoriteResponse{
+	response := &UserGetAccessInstructionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FavoriteDetail
+		var dest externalRef0.AccessInstructions
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10288,15 +14094,15 @@ func ParseUserUpdateFavoriteResponse(rsp *http.Response) (*UserUpdateFavoriteRes
 	return response, nil
 }
 
-// ParseUserListRequestsResponse parses an HTTP response from a UserListRequestsWithResponse call
-func ParseUserListRequestsResponse(rsp *http.Response) (*UserListRequestsResponse, error) {
+// ParseUserGetAccessTokenResponse parses an HTTP response from a UserGetAccessTokenWithResponse call
+func ParseUserGetAccessTokenResponse(rsp *http.Response) (*UserGetAccessTokenResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserListRequestsResponse{
+	response := &UserGetAccessTokenResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -10304,126 +14110,122 @@ func ParseUserListRequestsResponse(rsp *http.Response) (*UserListRequestsRespons
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Next     *string   `json:"next"`
-			Requests []Request `json:"requests"`
+			HasToken bool    `json:"hasToken"`
+			Token    *string `json:"token,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	}
-
-	return response, nil
-}
-
-// ParseUserCreateRequestResponse parses an HTTP response from a UserCreateRequestWithResponse call
-func ParseUserCreateRequestResponse(rsp *http.Response) (*UserCreateRequestResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &UserCreateRequestResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
-			Requests []Request `json:"requests"`
+			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUserListRequestsPastResponse parses an HTTP response from a UserListRequestsPastWithResponse call
-func ParseUserListRequestsPastResponse(rsp *http.Response) (*UserListRequestsPastResponse, error) {
+// ParseUserCancelRequestResponse parses an HTTP response from a UserCancelRequestWithResponse call
+func ParseUserCancelRequestResponse(rsp *http.Response) (*UserCancelRequestResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserListRequestsPastResponse{
+	response := &UserCancelRequestResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Next     *string   `json:"next"`
-			Requests []Request `json:"requests"`
-		}
+		var dest map[string]interface{}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	}
-
-	return response, nil
-}
-
-// ParseUserListRequestsUpcomingResponse parses an HTTP response from a UserListRequestsUpcomingWithResponse call
-func ParseUserListRequestsUpcomingResponse(rsp *http.Response) (*UserListRequestsUpcomingResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	response := &UserListRequestsUpcomingResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
-			Next     *string   `json:"next"`
-			Requests []Request `json:"requests"`
+			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUserGetRequestResponse parses an HTTP response from a UserGetRequestWithResponse call
-func ParseUserGetRequestResponse(rsp *http.Response) (*UserGetRequestResponse, error) {
+// ParseUserListRequestCommentsResponse parses an HTTP response from a UserListRequestCommentsWithResponse call
+func ParseUserListRequestCommentsResponse(rsp *http.Response) (*UserListRequestCommentsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserGetRequestResponse{
+	response := &UserListRequestCommentsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RequestDetail
+		var dest struct {
+			Comments []RequestComment `json:"comments"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			Error string `json:"error"`
@@ -10447,41 +14249,77 @@ func ParseUserGetRequestResponse(rsp *http.Response) (*UserGetRequestResponse, e
 	return response, nil
 }
 
-// ParseUserGetAccessInstructionsResponse parses an HTTP response from a UserGetAccessInstructionsWithResponse call
-func ParseUserGetAccessInstructionsResponse(rsp *http.Response) (*UserGetAccessInstructionsResponse, error) {
+// ParseUserCreateRequestCommentResponse parses an HTTP response from a UserCreateRequestCommentWithResponse call
+func ParseUserCreateRequestCommentResponse(rsp *http.Response) (*UserCreateRequestCommentResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserGetAccessInstructionsResponse{
+	response := &UserCreateRequestCommentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest externalRef0.AccessInstructions
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest RequestComment
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUserGetAccessTokenResponse parses an HTTP response from a UserGetAccessTokenWithResponse call
-func ParseUserGetAccessTokenResponse(rsp *http.Response) (*UserGetAccessTokenResponse, error) {
+// ParseUserListRequestEventsResponse parses an HTTP response from a UserListRequestEventsWithResponse call
+func ParseUserListRequestEventsResponse(rsp *http.Response) (*UserListRequestEventsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserGetAccessTokenResponse{
+	response := &UserListRequestEventsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -10489,22 +14327,22 @@ func ParseUserGetAccessTokenResponse(rsp *http.Response) (*UserGetAccessTokenRes
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			HasToken bool    `json:"hasToken"`
-			Token    *string `json:"token,omitempty"`
+			Events []RequestEvent `json:"events"`
+			Next   *string        `json:"next"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON401 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
@@ -10520,22 +14358,28 @@ func ParseUserGetAccessTokenResponse(rsp *http.Response) (*UserGetAccessTokenRes
 	return response, nil
 }
 
-// ParseUserCancelRequestResponse parses an HTTP response from a UserCancelRequestWithResponse call
-func ParseUserCancelRequestResponse(rsp *http.Response) (*UserCancelRequestResponse, error) {
+// ParseUserExtendRequestResponse parses an HTTP response from a UserExtendRequestWithResponse call
+func ParseUserExtendRequestResponse(rsp *http.Response) (*UserExtendRequestResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserCancelRequestResponse{
+	response := &UserExtendRequestResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest map[string]interface{}
+		var dest struct {
+			// true if the extension requires approval before it takes effect.
+			ExtensionPending bool `json:"extensionPending"`
+
+			// A request to access something made by an end user in Common Fate.
+			Request Request `json:"request"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10550,6 +14394,15 @@ func ParseUserCancelRequestResponse(rsp *http.Response) (*UserCancelRequestRespo
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			Error string `json:"error"`
@@ -10573,15 +14426,15 @@ func ParseUserCancelRequestResponse(rsp *http.Response) (*UserCancelRequestRespo
 	return response, nil
 }
 
-// ParseUserListRequestEventsResponse parses an HTTP response from a UserListRequestEventsWithResponse call
-func ParseUserListRequestEventsResponse(rsp *http.Response) (*UserListRequestEventsResponse, error) {
+// ParseUserReviewExtensionResponse parses an HTTP response from a UserReviewExtensionWithResponse call
+func ParseUserReviewExtensionResponse(rsp *http.Response) (*UserReviewExtensionResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserListRequestEventsResponse{
+	response := &UserReviewExtensionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -10589,14 +14442,26 @@ func ParseUserListRequestEventsResponse(rsp *http.Response) (*UserListRequestEve
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Events []RequestEvent `json:"events"`
-			Next   *string        `json:"next"`
+			// true if the extension requires approval before it takes effect.
+			ExtensionPending bool `json:"extensionPending"`
+
+			// A request to access something made by an end user in Common Fate.
+			Request Request `json:"request"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			Error string `json:"error"`
@@ -10606,6 +14471,15 @@ func ParseUserListRequestEventsResponse(rsp *http.Response) (*UserListRequestEve
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
 			Error string `json:"error"`
@@ -10703,22 +14577,129 @@ func ParseUserGetMeResponse(rsp *http.Response) (*UserGetMeResponse, error) {
 		return nil, err
 	}
 
-	response := &UserGetMeResponse{
+	response := &UserGetMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// Whether the user is an administrator of Common Fate.
+			IsAdmin bool `json:"isAdmin"`
+			User    User `json:"user"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserRemoveDelegateResponse parses an HTTP response from a UserRemoveDelegateWithResponse call
+func ParseUserRemoveDelegateResponse(rsp *http.Response) (*UserRemoveDelegateResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserRemoveDelegateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserGetDelegateResponse parses an HTTP response from a UserGetDelegateWithResponse call
+func ParseUserGetDelegateResponse(rsp *http.Response) (*UserGetDelegateResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserGetDelegateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Delegation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserSetDelegateResponse parses an HTTP response from a UserSetDelegateWithResponse call
+func ParseUserSetDelegateResponse(rsp *http.Response) (*UserSetDelegateResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserSetDelegateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Delegation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
-			// Whether the user is an administrator of Common Fate.
-			IsAdmin bool `json:"isAdmin"`
-			User    User `json:"user"`
+			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
 	}
 
@@ -10786,9 +14767,24 @@ type ServerInterface interface {
 	// Get Access Rule Version
 	// (GET /api/v1/admin/access-rules/{ruleId}/versions/{version})
 	AdminGetAccessRuleVersion(w http.ResponseWriter, r *http.Request, ruleId string, version string)
+	// Bulk revoke grants
+	// (POST /api/v1/admin/bulk-revoke)
+	AdminBulkRevoke(w http.ResponseWriter, r *http.Request)
+	// Get bulk revocation
+	// (GET /api/v1/admin/bulk-revoke/{bulkRevocationId})
+	AdminGetBulkRevocation(w http.ResponseWriter, r *http.Request, bulkRevocationId string)
 	// Get deployment version details
 	// (GET /api/v1/admin/deployment/version)
 	AdminGetDeploymentVersion(w http.ResponseWriter, r *http.Request)
+	// List grant retries
+	// (GET /api/v1/admin/grant-retries)
+	AdminListGrantRetries(w http.ResponseWriter, r *http.Request, params AdminListGrantRetriesParams)
+	// Abandon a grant change
+	// (POST /api/v1/admin/grant-retries/{retryId}/abandon)
+	AdminAbandonGrantRetry(w http.ResponseWriter, r *http.Request, retryId string)
+	// Retry a grant change
+	// (POST /api/v1/admin/grant-retries/{retryId}/retry)
+	AdminRetryGrantRetry(w http.ResponseWriter, r *http.Request, retryId string)
 	// List groups
 	// (GET /api/v1/admin/groups)
 	AdminListGroups(w http.ResponseWriter, r *http.Request, params AdminListGroupsParams)
@@ -10822,9 +14818,18 @@ type ServerInterface interface {
 	// Get identity configuration
 	// (GET /api/v1/admin/identity)
 	AdminGetIdentityConfiguration(w http.ResponseWriter, r *http.Request)
+	// Delete SCIM token
+	// (DELETE /api/v1/admin/identity/scim-token)
+	AdminDeleteScimToken(w http.ResponseWriter, r *http.Request)
+	// Create SCIM token
+	// (POST /api/v1/admin/identity/scim-token)
+	AdminCreateScimToken(w http.ResponseWriter, r *http.Request)
 	// Sync Identity
 	// (POST /api/v1/admin/identity/sync)
 	AdminSyncIdentity(w http.ResponseWriter, r *http.Request)
+	// Dry run Identity Sync
+	// (POST /api/v1/admin/identity/sync/dry-run)
+	AdminDryRunIdentitySync(w http.ResponseWriter, r *http.Request)
 	// List providers
 	// (GET /api/v1/admin/providers)
 	AdminListProviders(w http.ResponseWriter, r *http.Request)
@@ -10867,6 +14872,9 @@ type ServerInterface interface {
 	// Get a request
 	// (GET /api/v1/admin/requests/{requestId})
 	AdminGetRequest(w http.ResponseWriter, r *http.Request, requestId string)
+	// Get grant state
+	// (GET /api/v1/admin/requests/{requestId}/grant-state)
+	AdminGetRequestGrantState(w http.ResponseWriter, r *http.Request, requestId string)
 	// Get target groups
 	// (GET /api/v1/admin/target-groups)
 	AdminListTargetGroups(w http.ResponseWriter, r *http.Request)
@@ -10912,12 +14920,27 @@ type ServerInterface interface {
 
 	// (PUT /api/v1/favorites/{id})
 	UserUpdateFavorite(w http.ResponseWriter, r *http.Request, id string)
+	// List my recurring requests
+	// (GET /api/v1/recurring-requests)
+	UserListRecurringRequests(w http.ResponseWriter, r *http.Request)
+	// Create a recurring request
+	// (POST /api/v1/recurring-requests)
+	UserCreateRecurringRequest(w http.ResponseWriter, r *http.Request)
+	// Get a recurring request
+	// (GET /api/v1/recurring-requests/{recurringRequestId})
+	UserGetRecurringRequest(w http.ResponseWriter, r *http.Request, recurringRequestId string)
+	// Cancel a recurring request
+	// (POST /api/v1/recurring-requests/{recurringRequestId}/cancel)
+	UserCancelRecurringRequest(w http.ResponseWriter, r *http.Request, recurringRequestId string)
 	// List my requests
 	// (GET /api/v1/requests)
 	UserListRequests(w http.ResponseWriter, r *http.Request, params UserListRequestsParams)
 	// Create a request
 	// (POST /api/v1/requests)
 	UserCreateRequest(w http.ResponseWriter, r *http.Request)
+	// Dry run a request
+	// (POST /api/v1/requests/dry-run)
+	UserDryRunRequest(w http.ResponseWriter, r *http.Request)
 	// Your GET endpoint
 	// (GET /api/v1/requests/past)
 	UserListRequestsPast(w http.ResponseWriter, r *http.Request, params UserListRequestsPastParams)
@@ -10936,9 +14959,21 @@ type ServerInterface interface {
 	// Cancel a request
 	// (POST /api/v1/requests/{requestId}/cancel)
 	UserCancelRequest(w http.ResponseWriter, r *http.Request, requestId string)
+	// List request comments
+	// (GET /api/v1/requests/{requestId}/comments)
+	UserListRequestComments(w http.ResponseWriter, r *http.Request, requestId string)
+	// Comment on a request
+	// (POST /api/v1/requests/{requestId}/comments)
+	UserCreateRequestComment(w http.ResponseWriter, r *http.Request, requestId string)
 	// List request events
 	// (GET /api/v1/requests/{requestId}/events)
-	UserListRequestEvents(w http.ResponseWriter, r *http.Request, requestId string)
+	UserListRequestEvents(w http.ResponseWriter, r *http.Request, requestId string, params UserListRequestEventsParams)
+	// Extend an active request
+	// (POST /api/v1/requests/{requestId}/extend)
+	UserExtendRequest(w http.ResponseWriter, r *http.Request, requestId string)
+	// Review a request extension
+	// (POST /api/v1/requests/{requestId}/extend/review)
+	UserReviewExtension(w http.ResponseWriter, r *http.Request, requestId string)
 	// Review a request
 	// (POST /api/v1/requests/{requestId}/review)
 	UserReviewRequest(w http.ResponseWriter, r *http.Request, requestId string)
@@ -10948,6 +14983,15 @@ type ServerInterface interface {
 	// Get details for the current user
 	// (GET /api/v1/users/me)
 	UserGetMe(w http.ResponseWriter, r *http.Request)
+	// Remove your delegate
+	// (DELETE /api/v1/users/me/delegate)
+	UserRemoveDelegate(w http.ResponseWriter, r *http.Request)
+	// Get your delegate
+	// (GET /api/v1/users/me/delegate)
+	UserGetDelegate(w http.ResponseWriter, r *http.Request)
+	// Set your delegate
+	// (PUT /api/v1/users/me/delegate)
+	UserSetDelegate(w http.ResponseWriter, r *http.Request)
 	// Get a user
 	// (GET /api/v1/users/{userId})
 	UserGetUser(w http.ResponseWriter, r *http.Request, userId string)
@@ -11278,6 +15322,47 @@ func (siw *ServerInterfaceWrapper) AdminGetAccessRuleVersion(w http.ResponseWrit
 	handler(w, r.WithContext(ctx))
 }
 
+// AdminBulkRevoke operation middleware
+func (siw *ServerInterfaceWrapper) AdminBulkRevoke(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminBulkRevoke(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AdminGetBulkRevocation operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetBulkRevocation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "bulkRevocationId" -------------
+	var bulkRevocationId string
+
+	err = runtime.BindStyledParameter("simple", false, "bulkRevocationId", chi.URLParam(r, "bulkRevocationId"), &bulkRevocationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bulkRevocationId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminGetBulkRevocation(w, r, bulkRevocationId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // AdminGetDeploymentVersion operation middleware
 func (siw *ServerInterfaceWrapper) AdminGetDeploymentVersion(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -11293,6 +15378,89 @@ func (siw *ServerInterfaceWrapper) AdminGetDeploymentVersion(w http.ResponseWrit
 	handler(w, r.WithContext(ctx))
 }
 
+// AdminListGrantRetries operation middleware
+func (siw *ServerInterfaceWrapper) AdminListGrantRetries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminListGrantRetriesParams
+
+	// ------------- Optional query parameter "status" -------------
+	if paramValue := r.URL.Query().Get("status"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminListGrantRetries(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AdminAbandonGrantRetry operation middleware
+func (siw *ServerInterfaceWrapper) AdminAbandonGrantRetry(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "retryId" -------------
+	var retryId string
+
+	err = runtime.BindStyledParameter("simple", false, "retryId", chi.URLParam(r, "retryId"), &retryId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "retryId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminAbandonGrantRetry(w, r, retryId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AdminRetryGrantRetry operation middleware
+func (siw *ServerInterfaceWrapper) AdminRetryGrantRetry(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "retryId" -------------
+	var retryId string
+
+	err = runtime.BindStyledParameter("simple", false, "retryId", chi.URLParam(r, "retryId"), &retryId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "retryId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminRetryGrantRetry(w, r, retryId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // AdminListGroups operation middleware
 func (siw *ServerInterfaceWrapper) AdminListGroups(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -11540,6 +15708,36 @@ func (siw *ServerInterfaceWrapper) AdminGetIdentityConfiguration(w http.Response
 	handler(w, r.WithContext(ctx))
 }
 
+// AdminDeleteScimToken operation middleware
+func (siw *ServerInterfaceWrapper) AdminDeleteScimToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminDeleteScimToken(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AdminCreateScimToken operation middleware
+func (siw *ServerInterfaceWrapper) AdminCreateScimToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminCreateScimToken(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // AdminSyncIdentity operation middleware
 func (siw *ServerInterfaceWrapper) AdminSyncIdentity(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -11555,6 +15753,21 @@ func (siw *ServerInterfaceWrapper) AdminSyncIdentity(w http.ResponseWriter, r *h
 	handler(w, r.WithContext(ctx))
 }
 
+// AdminDryRunIdentitySync operation middleware
+func (siw *ServerInterfaceWrapper) AdminDryRunIdentitySync(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminDryRunIdentitySync(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // AdminListProviders operation middleware
 func (siw *ServerInterfaceWrapper) AdminListProviders(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -11934,6 +16147,32 @@ func (siw *ServerInterfaceWrapper) AdminGetRequest(w http.ResponseWriter, r *htt
 	handler(w, r.WithContext(ctx))
 }
 
+// AdminGetRequestGrantState operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetRequestGrantState(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "requestId" -------------
+	var requestId string
+
+	err = runtime.BindStyledParameter("simple", false, "requestId", chi.URLParam(r, "requestId"), &requestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminGetRequestGrantState(w, r, requestId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // AdminListTargetGroups operation middleware
 func (siw *ServerInterfaceWrapper) AdminListTargetGroups(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -12177,17 +16416,99 @@ func (siw *ServerInterfaceWrapper) AdminUpdateUser(w http.ResponseWriter, r *htt
 
 	var err error
 
-	// ------------- Path parameter "userId" -------------
-	var userId string
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameter("simple", false, "userId", chi.URLParam(r, "userId"), &userId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminUpdateUser(w, r, userId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UserListFavorites operation middleware
+func (siw *ServerInterfaceWrapper) UserListFavorites(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserListFavorites(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UserCreateFavorite operation middleware
+func (siw *ServerInterfaceWrapper) UserCreateFavorite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserCreateFavorite(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UserDeleteFavorite operation middleware
+func (siw *ServerInterfaceWrapper) UserDeleteFavorite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserDeleteFavorite(w, r, id)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UserGetFavorite operation middleware
+func (siw *ServerInterfaceWrapper) UserGetFavorite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindStyledParameter("simple", false, "userId", chi.URLParam(r, "userId"), &userId)
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminUpdateUser(w, r, userId)
+		siw.Handler.UserGetFavorite(w, r, id)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -12197,12 +16518,23 @@ func (siw *ServerInterfaceWrapper) AdminUpdateUser(w http.ResponseWriter, r *htt
 	handler(w, r.WithContext(ctx))
 }
 
-// UserListFavorites operation middleware
-func (siw *ServerInterfaceWrapper) UserListFavorites(w http.ResponseWriter, r *http.Request) {
+// UserUpdateFavorite operation middleware
+func (siw *ServerInterfaceWrapper) UserUpdateFavorite(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UserListFavorites(w, r)
+		siw.Handler.UserUpdateFavorite(w, r, id)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -12212,12 +16544,12 @@ func (siw *ServerInterfaceWrapper) UserListFavorites(w http.ResponseWriter, r *h
 	handler(w, r.WithContext(ctx))
 }
 
-// UserCreateFavorite operation middleware
-func (siw *ServerInterfaceWrapper) UserCreateFavorite(w http.ResponseWriter, r *http.Request) {
+// UserListRecurringRequests operation middleware
+func (siw *ServerInterfaceWrapper) UserListRecurringRequests(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UserCreateFavorite(w, r)
+		siw.Handler.UserListRecurringRequests(w, r)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -12227,23 +16559,12 @@ func (siw *ServerInterfaceWrapper) UserCreateFavorite(w http.ResponseWriter, r *
 	handler(w, r.WithContext(ctx))
 }
 
-// UserDeleteFavorite operation middleware
-func (siw *ServerInterfaceWrapper) UserDeleteFavorite(w http.ResponseWriter, r *http.Request) {
+// UserCreateRecurringRequest operation middleware
+func (siw *ServerInterfaceWrapper) UserCreateRecurringRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id string
-
-	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
-
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UserDeleteFavorite(w, r, id)
+		siw.Handler.UserCreateRecurringRequest(w, r)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -12253,23 +16574,23 @@ func (siw *ServerInterfaceWrapper) UserDeleteFavorite(w http.ResponseWriter, r *
 	handler(w, r.WithContext(ctx))
 }
 
-// UserGetFavorite operation middleware
-func (siw *ServerInterfaceWrapper) UserGetFavorite(w http.ResponseWriter, r *http.Request) {
+// UserGetRecurringRequest operation middleware
+func (siw *ServerInterfaceWrapper) UserGetRecurringRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// ------------- Path parameter "recurringRequestId" -------------
+	var recurringRequestId string
 
-	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	err = runtime.BindStyledParameter("simple", false, "recurringRequestId", chi.URLParam(r, "recurringRequestId"), &recurringRequestId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recurringRequestId", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UserGetFavorite(w, r, id)
+		siw.Handler.UserGetRecurringRequest(w, r, recurringRequestId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -12279,23 +16600,23 @@ func (siw *ServerInterfaceWrapper) UserGetFavorite(w http.ResponseWriter, r *htt
 	handler(w, r.WithContext(ctx))
 }
 
-// UserUpdateFavorite operation middleware
-func (siw *ServerInterfaceWrapper) UserUpdateFavorite(w http.ResponseWriter, r *http.Request) {
+// UserCancelRecurringRequest operation middleware
+func (siw *ServerInterfaceWrapper) UserCancelRecurringRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// ------------- Path parameter "recurringRequestId" -------------
+	var recurringRequestId string
 
-	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	err = runtime.BindStyledParameter("simple", false, "recurringRequestId", chi.URLParam(r, "recurringRequestId"), &recurringRequestId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recurringRequestId", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UserUpdateFavorite(w, r, id)
+		siw.Handler.UserCancelRecurringRequest(w, r, recurringRequestId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -12373,6 +16694,21 @@ func (siw *ServerInterfaceWrapper) UserCreateRequest(w http.ResponseWriter, r *h
 	handler(w, r.WithContext(ctx))
 }
 
+// UserDryRunRequest operation middleware
+func (siw *ServerInterfaceWrapper) UserDryRunRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserDryRunRequest(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // UserListRequestsPast operation middleware
 func (siw *ServerInterfaceWrapper) UserListRequestsPast(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -12539,6 +16875,58 @@ func (siw *ServerInterfaceWrapper) UserCancelRequest(w http.ResponseWriter, r *h
 	handler(w, r.WithContext(ctx))
 }
 
+// UserListRequestComments operation middleware
+func (siw *ServerInterfaceWrapper) UserListRequestComments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "requestId" -------------
+	var requestId string
+
+	err = runtime.BindStyledParameter("simple", false, "requestId", chi.URLParam(r, "requestId"), &requestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserListRequestComments(w, r, requestId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UserCreateRequestComment operation middleware
+func (siw *ServerInterfaceWrapper) UserCreateRequestComment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "requestId" -------------
+	var requestId string
+
+	err = runtime.BindStyledParameter("simple", false, "requestId", chi.URLParam(r, "requestId"), &requestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserCreateRequestComment(w, r, requestId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // UserListRequestEvents operation middleware
 func (siw *ServerInterfaceWrapper) UserListRequestEvents(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -12554,8 +16942,74 @@ func (siw *ServerInterfaceWrapper) UserListRequestEvents(w http.ResponseWriter,
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UserListRequestEventsParams
+
+	// ------------- Optional query parameter "nextToken" -------------
+	if paramValue := r.URL.Query().Get("nextToken"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "nextToken", r.URL.Query(), &params.NextToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nextToken", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserListRequestEvents(w, r, requestId, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UserExtendRequest operation middleware
+func (siw *ServerInterfaceWrapper) UserExtendRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "requestId" -------------
+	var requestId string
+
+	err = runtime.BindStyledParameter("simple", false, "requestId", chi.URLParam(r, "requestId"), &requestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserExtendRequest(w, r, requestId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UserReviewExtension operation middleware
+func (siw *ServerInterfaceWrapper) UserReviewExtension(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "requestId" -------------
+	var requestId string
+
+	err = runtime.BindStyledParameter("simple", false, "requestId", chi.URLParam(r, "requestId"), &requestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UserListRequestEvents(w, r, requestId)
+		siw.Handler.UserReviewExtension(w, r, requestId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -12632,6 +17086,51 @@ func (siw *ServerInterfaceWrapper) UserGetMe(w http.ResponseWriter, r *http.Requ
 	handler(w, r.WithContext(ctx))
 }
 
+// UserRemoveDelegate operation middleware
+func (siw *ServerInterfaceWrapper) UserRemoveDelegate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserRemoveDelegate(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UserGetDelegate operation middleware
+func (siw *ServerInterfaceWrapper) UserGetDelegate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserGetDelegate(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UserSetDelegate operation middleware
+func (siw *ServerInterfaceWrapper) UserSetDelegate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserSetDelegate(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // UserGetUser operation middleware
 func (siw *ServerInterfaceWrapper) UserGetUser(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -12804,9 +17303,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/access-rules/{ruleId}/versions/{version}", wrapper.AdminGetAccessRuleVersion)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin/bulk-revoke", wrapper.AdminBulkRevoke)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/bulk-revoke/{bulkRevocationId}", wrapper.AdminGetBulkRevocation)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/deployment/version", wrapper.AdminGetDeploymentVersion)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/grant-retries", wrapper.AdminListGrantRetries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin/grant-retries/{retryId}/abandon", wrapper.AdminAbandonGrantRetry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin/grant-retries/{retryId}/retry", wrapper.AdminRetryGrantRetry)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/groups", wrapper.AdminListGroups)
 	})
@@ -12840,9 +17354,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/identity", wrapper.AdminGetIdentityConfiguration)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/admin/identity/scim-token", wrapper.AdminDeleteScimToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin/identity/scim-token", wrapper.AdminCreateScimToken)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/admin/identity/sync", wrapper.AdminSyncIdentity)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin/identity/sync/dry-run", wrapper.AdminDryRunIdentitySync)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/providers", wrapper.AdminListProviders)
 	})
@@ -12885,6 +17408,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/requests/{requestId}", wrapper.AdminGetRequest)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/requests/{requestId}/grant-state", wrapper.AdminGetRequestGrantState)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/target-groups", wrapper.AdminListTargetGroups)
 	})
@@ -12930,12 +17456,27 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/favorites/{id}", wrapper.UserUpdateFavorite)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/recurring-requests", wrapper.UserListRecurringRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/recurring-requests", wrapper.UserCreateRecurringRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/recurring-requests/{recurringRequestId}", wrapper.UserGetRecurringRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/recurring-requests/{recurringRequestId}/cancel", wrapper.UserCancelRecurringRequest)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/requests", wrapper.UserListRequests)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/requests", wrapper.UserCreateRequest)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/requests/dry-run", wrapper.UserDryRunRequest)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/requests/past", wrapper.UserListRequestsPast)
 	})
@@ -12954,9 +17495,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/requests/{requestId}/cancel", wrapper.UserCancelRequest)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/requests/{requestId}/comments", wrapper.UserListRequestComments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/requests/{requestId}/comments", wrapper.UserCreateRequestComment)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/requests/{requestId}/events", wrapper.UserListRequestEvents)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/requests/{requestId}/extend", wrapper.UserExtendRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/requests/{requestId}/extend/review", wrapper.UserReviewExtension)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/requests/{requestId}/review", wrapper.UserReviewRequest)
 	})
@@ -12966,6 +17519,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/users/me", wrapper.UserGetMe)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/users/me/delegate", wrapper.UserRemoveDelegate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/users/me/delegate", wrapper.UserGetDelegate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/users/me/delegate", wrapper.UserSetDelegate)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/users/{userId}", wrapper.UserGetUser)
 	})
@@ -12976,173 +17538,289 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9+Xvbtrbgv4LR3PmadGRJXrLY883Xcb2kus3iZ8vJm3ed10IkJKEmAQUAZauJ52+f",
-	"DxsJkiBFLV7S11/ubSwQODg4OBvO8rUV0HhKCSKCtw6+thj6kiAufqYhRuoPRwxBgQ6DAHF+nkToXA+Q",
-	"PwWUCETUf8LpNMIBFJiS7h+cEvk3HkxQDOV/TRmdIibMjHA6ZXQGI/nf/2Bo1Dpo/fduBkVXf8e7h2oc",
-	"YkeUjPC4ddduhYgHDE/lKvJjdAvjaYRaB63DMMYEQAUkEBR8uBaw1W7F8PYtImMxaR3s9PZet1tTKARi",
-	"pHXQ+hfc+vNw6z96W/vtzv86ePb8X1dXn3/6b1dXW7/9/v+ukl5v52X36opcXfHP3/7zH612S8ynciEu",
-	"GCYKljGjyVTtJwdVazBBQP0G+scciAkUQEyQhY0lEQIKWUgC2mm1W1igWM1TWsL8ATIG5/LfBMYov2+5",
-	"TwDl5vO73ev12q0YE/vv7dW27tu3gGyMxKKzK1LNQH8lv8cxOqKECwaxobm6iQaF4Xd3bUWjmKGwdfAv",
-	"ewztjKoMnvLUksJdBuBzukk6/AMFonV3JxfROziFM8qw2ATVp7joh/LfD3NalmRKPzAEDcD3eUkEjuV/",
-	"LThjg9yBHnzXbt1gCU0TCjOffsJicpEM7SkViSSH+xQqg53a838jyWv9wy8wrhLKS4iLUTxETH27PH8o",
-	"TV9FW/+ZHfBvnS0PARXwaC6WBa4Wc2eMznCI2AUSm8Dg1Ew3UAv6mK4EBdCR4rZ2tJQFHAmQTDubEgcL",
-	"kZSD1Ieighxr/YzGmCiwxwkOUSghTqZyD0pkjCgDEBB0AzQ7BRaznVaKbIPf75VL1TCj74ul1N4ILQMV",
-	"R3mLyfVa7GQa0XmMiNCnVELSNSb+H6YMS3k2N7jGcRK3Dvb399XJ6n/10j1gItAYsdLec8s7c5p1myJh",
-	"fWodMRovVCCyBU/l8Lt2CxdJ++VenitupdT8+cd/LLzwCgo1a+3OLzli628ZxRArzXlEWQxF68D8pb2I",
-	"65dIYYQZF++XFRnrXXTMlabukOaQ0ghBIn+M4APDUzhHi8gMMQ5MGewVh5yTdxcCTY+oVNI3oToGZqay",
-	"3Ps0QWIixdwEAS7QFGAO7GhAGSBUdLJ9O7gOlE31EUaJ4fthiOWcMDrLLV06wbLc1VOBmZoLICIQQyEY",
-	"zhVQCUcM3ExwMAEBZQzxKSWhlMoKYiXnJNwOkAap7dbt1phumT/GcPovDcPnisNLcVTYW8VpnaMx5gKx",
-	"XyAJo03cS3jDD4OAJvpLl5lILvJ1e+fOdx/gDZeQFM3ZhG8hyMXWditH+Ps5LvUs4c+2xnT2/KdvcPot",
-	"gN8C8g0l3zh8vvUsQEQwGH17RigTk2+cJmLy/KdnctJvN4iL5z8937q6Cr06u+aO5XPuH1vtSptSxsoV",
-	"FGh5IM1ZIJlc26pfYZ4rLcll2y2WEGmsaXBGMIkku4M3fCuC8TCECy80lgDYSdruEbmYr6SQGUY3G7m8",
-	"sflssXITogBzQw716o0E7tiOvmu36AwxhkM0WEU9Ksl3M28T7fWQAGgcNT9wwBRgklAgsfqqWaxzRQaO",
-	"I0T/EWjFCQSQgCECdhdE8g9MgigJ5a/2z3a0UZftHEMazjtXpD8CWEgOSGMsBArbahBleIwJjIor3uAo",
-	"kksmHIUdgwLJnbg+Ng37gF4jcm7+vgYRTKCeyi/4ROGnCnJOJ2lyLP1RDkUTyOWJpB6ya0TawE6Y4kKw",
-	"BEmADhMx0erK2jt3JH616FJSAmsI5WjMBYOCMklHRzSOKQGnUCC/KJMfL6J3uZkSPtWH9XK9iNVjJCCO",
-	"OIBDmhi/XiImiAiJDhSqjSirzEijghG8NjYzzVs7RS+nobFY9KaqkAxBDEkCI5CoDySuLSasMHbwDLJl",
-	"jHRPmIIPPPs9+6kzj6Pfn8vPYSDwTH7nmt6+w6q0I7y7aXIgA0XjGq/gZoKIVX/kFc9YkD2HnIXts5/X",
-	"PiFz4fKemwasuOzPKSArnXg1Og3UPsMCT+YKB8fpOXxETDL+DeBhpmfyqxIOfZlxHfDJ8CAIOIpnUovg",
-	"STABkIOr1qzX2e/0rlrKF0JHIxxgxdAjBDnibanpXrVCNPufb/qD3345vPjFDJ0ytGVGgWGCo5B3FioN",
-	"FvBmaC7uA2CiDTO5J4nbE8boJvgokvMslhF6WEO5rQYDhkTCCAqBtGONXs5mOEAK/n4ouZuYH7l8YAP7",
-	"yfF5ZZ1XuDKwAcBe4cU4KH3R9q/WBEvnCjncPVbnUtmVClxSqcmYO2SuUPkWc5G9iNjXLb4BZBJ0q74h",
-	"SRTBoTQhpCT36JdSPi3lVPaITN5q6wUbURmIMBcSI1rGhxzcTKhS96zW6Ogl6nWMZQwxjzGuudomiC+b",
-	"szGfzuDQYHgd8M3OodKVuBRqT7S5mIo/D8IeHVWPjqSM/qzgkzOk19E+720CUyM7V2M82dU3hyX1VdsB",
-	"ZRkeB1NcpWixeFIscxNIyl7NG2FIrbs59KSPxY3pp4wbjQqLGOM/2iQT97yONEfY4I2BqIE+uSQijJJN",
-	"h38o3Uru3vGtZ6rQ4Vn/vMCScmbQJnA1zU3YGDs5OBZiqLDIcuwGk60po2MmeU7BCOFgiKR5oh8mrafU",
-	"tb9y6krGrYzSfjKTm9qETjmzUT/LWCpq+c3dSQPEEqR4BseYKGPGIrsImYOsB1Wv7t/42wSaUuaVe4u7",
-	"P/4lsmWWYGTZRwuxk1tgHdXJRQhNhHXgbpidpzMvgQgFzmIy0VOvg4INuf3WsEgW+/E2baSUb4qeoviq",
-	"txJelpBHdepHbijQe1GCwb5TbMpz1Zhl3S2jNYyUo0FCqhxz1sjTjnczdeZ2V6ZDyXPkaPDS4BYQFwxz",
-	"FSlDACLaEQsEBTG8RtlyeoSapqMCF3IPNdpDdui5tfnHSIFj1BngGK0Sklrx3JZ9x5Lot53XNzsnaCh2",
-	"/u01Of23f+6Ev8Lt08HJ/r/3/lmawsCmY79a/WP9zH6UMGZooOwxXxBHumLI530Ee7Zb2mG97KlUeh8P",
-	"QULwlwRl/jrlwhlhxBRxSE3MobMOUD5pQ7OK8BSVcBORlXovr8inCSJ2EObG0R62ARY/cNA/BgzFimAD",
-	"Sjjm8oJ2rkizJ0y7m2UjW11CaDv07WJV8kcsNMVmd690tdutkv+j4n5mI7JLGqp/o9DjRjMYgyRUWONq",
-	"kKsA4xkCcIo9F/bh4sifQvD3I/CJGAkYQgGb3/x39osVuAwXUCRL+JYu9PiV+ZPjxlubS/1V+Y05k3bz",
-	"MPuUZhryJS//MUdTy4XeOcS5CUFuvvp57r2NGr3vEOdwjGpGmFXT4DwTetIYCjOLF4pixJPDzzPgXUDc",
-	"6bx4fuccVjWmL9KLWWZ2mkAK8R6SkFvtFiJJLAE9PBr0P5602q3D86Nf+h9Pjv3AXFhaK6G2pFl4rpmJ",
-	"STIqoMNwS2Jj6jwkNVHOK/1C/m0MUqqvxmiOAXk2k8rL+9xVzjD3krSNma4KDlye2x6ycRIjwzSL1kMF",
-	"lg0cNchuwi78UJSd+JTFJxGyEVuWhPvvzy4HrXbr3eXbQf/i5O3J0cCxLAt6ASbj2pjK5jK/tJ9ZGrC5",
-	"4gOemcCFtJ3b9EI0Z8jzhWxyQacRHk8U9qTK0kJ7k90h353coi/zWwXPoREh75CYUE/QyrH61xBxcJOG",
-	"r7hxTEOE0tfDEMBEUKlRBjCK5oAy/YwNbSSay4cuBx/eHQ76R6126/zkY//kU4EV5eHyce3y9l6+3o8j",
-	"8Rp+uSW3e872UnWzfL3tu6cJnM1sUnWzeelqe95MFlJO6lYps2xlGkv11ERz2ndYniokqXHeXobM8mhM",
-	"9++h4orkwBK0MBfhp8BTgk6rR647IGW6fqZYEVqwDn/z72FlLqcSKYp8rgJPjTG6kNt9j+xqIX42xaZK",
-	"2UAbQlIOeHf6ZoC+pnA32Lt5FUevRAWgTtpSU8dqGZo6UJ0FChtsCvMxhmNCucCBL2I69KvYEZqhhdb+",
-	"Wzp+q8YpA7ZKXy/QmZ65rZfOvnNozQG42TmNhi93guFwfxjs7WnBkD73l4Nk/fypOnk3zc9rYM0ZM818",
-	"42wphafZhm7m8zHcebH3ivFtkttQlSJ7bNVYPa/JZLRfdUCJXy+NhzRv8KmmCboncGMueZYqWDiICj22",
-	"6XG8YZD4bSMUTymDbA4g53hMVMCiNNlSNzkEU4ZJgKcwKvvZEKnICEEkBNJ6tZrEWAKgLL/U5bPT29nZ",
-	"6r3c2t4d9HYPdvcPdnud/Z3t/zBap7KRpYm6tayh7No7dbkqbiawgi/z7uUhpaZkRH3SHBeQiUpTmIlH",
-	"wwevMdID7WtRxroHOKMen528P+6/fyPtdGuwn5yffzjX2vKHX0+O5V/+/ax/btTmEm4STa9+WokhjgAM",
-	"QxURYWCw5Oc5mHJ6Y93BFG5d6rayILVdc1KfYVvRtXML9fXxaDGpgVyb01/xsFOR2X9kM8WKqbZrpv6X",
-	"D4UmLGggAb3OPMW9XIAz6NKZcxiUiPJgsB9OMydSao5Zb1BKcM5U2RfNzDC4OwrZ9qtxMOntQbW5X9Fc",
-	"pQCWD+4a+X18Mzu8HlPyczvYgThdrxn73n3xxwxFyf7t9k60o9ZIFZec3+H0Q6vd+nR4/l5fTX0jnWXT",
-	"r5rhaRrMr4PX0fYs3KN2WXqdTGvfXUEMRTCRtrbzjiGlGaBqjM39xOpWzwFkKEsvsUalL8S3XRlsulyI",
-	"KUcRCgQcRkiK4w8KqCy31ZsY5dvSBHKQTQVGGEUhb+tYbnXTdKKUedbKTWMwIKhNKZP/OWVoJD+QAyU/",
-	"0wkqZvP6jBop5ylpLTKXHPzlSKRwws0odDq5ZV96+2JnNNv5s+WmOJeRmubXNFXphCnj0UB/FbaOhtmO",
-	"E9Zf3oaRtDqW4NNFupn0Upj3r/TfFp83ylhX731Nv1EaQy73W7s8TiXdbExeYK7pGUb1OXRuGrZKgjRf",
-	"+ZPmML9AAfM5XeycOnvcnVrdB5WmB7j6GDyL8DVSnv+zPrhGyv0GwRRyfkNZ+Ny7crWkUnOeQa2A54FS",
-	"mhwUE3mrbiaIIZOnoqCw2YtcUKZewEgKIQcxJHCMGFCQHn66ABcX78AZZDBGAjFwIb/pNHsW84vI7Hgc",
-	"rHrI1aWNhhr+Czi7+RPRm53hH/utMp1ViLfF+dvueXZ8Dy6pJCzPounLk+XfEIkluenbU0MLe7bHJsPw",
-	"Zjq6xnn86HgqjyBLrQHDvm2NHTrKx+SKCaPJeFIuynND2fUoojdyAjczFQwmiGfWBlcy8McfCRU//gjm",
-	"SOgcSeSJOrLbxiG0rKEoFDpdzdgnOrS7S6eIwCnuzOOo9o3nqDi3R3FsVghiBCOO2jWmRT4BSkvDFYo6",
-	"+KsPpI/o/eNUnUhPUqdygoEU0oo3MUhCGoNfLy77x8q2nVEcgikViAgMlfgeRTgQXKswkna3+BQFeIRR",
-	"mM3bP+aWSopJr2CEI9Spj2GoeyrNal8Y+nPNsKMP787engyk+fXx8G3/+HDQ//D+t9PD/lulKtu/KW2w",
-	"/74/6B++/e3ow/vT/pvLcz22//63s/MPb85PLi7yk1xcHp2cHFdZbwL5IloOicq+t1n9ttCIxE2oIgvJ",
-	"OH2mSZU/W4yj01TDKRVP+WDWrHYzLyoBVsz8de+3n+nVZaza8I2CV6Eh01NDvHEVGuuFa9gucwUPw9RM",
-	"rhmr3CbxLkOz/S/oz/1hmVX2CRcsCeRKnlcCCaNJKF4t0+LCmWCRCusuVrXpHLjrytIShB5/dCq5l0eA",
-	"K/Z9UV0FzHv8mCJqoCnrYYX52nnQq9Dpbn5j2EwvcNkA04wj97ybVQKqqGC0ckGk9MHYfhM2KEyQzl+H",
-	"snSHG7mCeQ2gKP9m6a8AjqE8ZEeRy0vdCv5XRqI2b826qEJpnEImcJBEkOW0Rm4hUvr2CEAyd3l9Zfhi",
-	"nVaa7TFL/f89wlxscU631IP2717GHdFx83vpvON4oGwuvzNoXeGdF70Xl0dH+r8y32rmwFksNlIpUTyq",
-	"KrJ0iGhVonQeDotEmBbQodZrwmmMxETK1RiGSFoDbvh9QUWu8fZUvM9nAz5msrk8qhROsjgYOB2tYnbM",
-	"s0l9IirUqWU+K90XWlvzNGXwuHasoJnHWxaiaTitOW4nlna1d7NNRD96q3ele3SuQ1q/18Vku1iSs0w9",
-	"FXHvpafszHFtfnrMrBRInCtZdqf8nX9SoMn7SUP5O6HEk1CSkWb5NtUnk1Sd1UqxsNXPCW7k0ypBXhZM",
-	"M483xmlz4cRtB+CVol2L4C7tgq6Ifq0JeNVe5ubqV/Y+41O/DFr4hXqEqTDGMc/Vq1Pqp3m2UfcvLW1m",
-	"6oFlEYOsyOjdGnzL2Vl23z6YPZfBnkhDrYyP2TjYe4Vvxi+3Xa2sOmL8/nSz5V7i1lXG1r6yaexa8XIo",
-	"buW3SC0ZObGvEm9pVUITS4xYWtnJiZAtk9LfCuVfTqEspNVktFTBsxerlLpWhafcUhX6c1lNKx/xiNFY",
-	"kd7F48cLSVguViMq+elgNcJS+9AhfKFfPVUjTiGOEobOq29dxXstQwFlIQrTAy6X3ZO/mKLYN1AyE/0F",
-	"YCjSosqU5ExRvvQriqHj2m2aMRW2t6BPhUwEXZFIBN1EMWSXayi7MruI5QuvD72ZlL/dfvHniy9BhHj4",
-	"Zd+V8kvn26X1ld1kl7Oz8w86uio7gaPD90cnb/VD0vHJ0dv++3wGTB4Az1nkUVXWL40r8gIFlITcH9+m",
-	"wu8GaV1vd4eY09cve9sqiJILGE+lnnI5OFJ/+JMS5AYGriULipCWkTCwMqHJWe5ROv8SjV7fDuEL60fL",
-	"Vej2Gjb6N62fUeI5Uf95+k8ut5zn6LLKYCVYzA+AoSlDXN4NAN0arSpUOH3yAlfEVj2zZbsjTK5NJWGn",
-	"NDwHMwyBqUnTrq2XX18Yv1wmPXXibsrxO0qIUt4PmX/FCYKRmMz9nLRKEmTl65eqU+/CUlm1PgMpjw6H",
-	"JrITb0bE4Sh89Xp3hIKXvZcqg/92S8AxlxBqy9PWvvssyUn9xTU1i7H/I0x0mI5NCW8D+b/6kRaSEFz2",
-	"AdLGZpZ6BjNLaTnTNUuSW8VqyO+mWHUqU94qZb66r6d58zmPEBsmpCMBzcaLKXfqR/WiQqhI2yi0gWl/",
-	"oBx5cRIJrO1dhz/U2OgsidBp87zWdt1cDc1k/eptbOXi+l58pSfoEnCexhoaz8Pe6x6EuzuvXgY6wtV3",
-	"uD69zNKe5l5LU2BVpOPSGKvEgD++2o+G4csXIdoPh9uvdnagg4aKYPbVaias2n8pWA6FzYpp6YUu9Nh7",
-	"tCANNO2071OQ9/m4eG74nB7M/xyR7evp/u31bfGsTg2O8+R6YQKWuIq3TN9oi0Evghr/MoDA5eFAb0Ll",
-	"SpedP5XdwypjN6fJMMJ8gvym66zy9a7oCk2nST3Tmas67S1WxvOpPolm7AGh4X442gtevAodXOsCe2WN",
-	"duOahgniW6dxW1mndio5VEys3qt96kt1LUVl7WTgGuBy/d70rNUaiIPaZscDd3d39+Fwd3t7Z3vbOZ6L",
-	"lAWsL97d1Nbc7M1AFNev6DBGcI9Phua2lp+XCpYbjovBGuYZo1AyJU98Mbw9LltUZaPQNPID1qiRVhPX",
-	"H7ipF5gDGEX0RidlmIaYuuff9otXO3uvTYNH/aeXi/sAeuBzD7/0eFRSpy5N65aKFnf1LetqVMBlq2qV",
-	"Q0pgzTpTHIiEoTWcpFl20z3KKV87Owu64/t0Oty5Ls6yNnbJG+RcHE0Ydg+xFcg//J9AvTWMoEAdTHVi",
-	"WTm/Qn0L3ksMEAfWg9ZEiCk/6HbhDArIeGeMxSQZJhwxU+qyE9C4m3S393a293Z6vZ9m/3tPYvaflE9c",
-	"WNIF69M7Vlj41d5Ob/flvl5YnoatF+AJuTpeoEdGcIj85K8fnRZ9X6VwNk5ws3q7BsQTsr9EtYLyU5bz",
-	"Arj0+2Q1airFW+Ndz0zmgbNrHBZ3/WG6RHDVLUV/4ORFgHsvwsR0lcNkRG2NVqhzZS31Z89x8iqyyKG/",
-	"/PUpVVl1S4cfnvVbTuWG3KSpHtba7vQ0QalEgtZBa7fT6/Raqj/fRB1FF05xd7ZtMg+2mG0A4X2lf4OE",
-	"FC25MjZAdxyzL44d9WiKtLSQOoriJ4V+Fa1CE7adXq+KlabjulU9L1Rl2SSOIZu3DlRt41wPCBXnoL0b",
-	"JyRUjQtbn+U3vp13I5VDV4mAExJOKSbCNNPRncxUxiAdqT5FMyfBWaPnma11GdB4iIkW3CojQbnQUQiC",
-	"CD/3Y62c0De1GU1yQ97sqdQkmE8RwB3UARlVdeEN3+KcdvSvfEKTKARDBBAJaCjBlOPBEAbXPIJ8Arau",
-	"kl5vF4H/saNijlsHrS8JYvOMmZqI98xysx6H8qLeYEjvFhCLMedK2xCHjAB1VdsSZlP9iSGO4qEiPsBo",
-	"hICERgOvwqFMRX2NuQrIi6t0LEPI9tIIWnjD5YHThAig5LFvMTOgH9bO/9l/LRrXeW5ktZSIqhypX2I8",
-	"H36Vo/Z6e4tvab4PVuFuqqWLUT1DKC8IJU7L0eXu7Fdd8uSulm2FplOaRx+/IlfkxLAvndBFSTQHKjdY",
-	"UKBSYlyOkst/hkBnymfvYqpZ6gTZkKVIRX0JqrqXuV+GiOOxLkdumjbagmHeeLJ+WscjpIiTHwSIEVLh",
-	"4VzZHNpa4m0AwS+DwdlebxskBCZiQhn+E4WmCZiKadB9wPyc+g3Kh3StRZBLhfHVEd720oS3AXKVZOMc",
-	"gZ8oSyxZXX8pXrPbz2yoaqaH6Cr5NaxgEbF303JutWRfLvyWv30dcEUGk5Qq3AY4tn7c3/ej8n6kvd02",
-	"oNCU+8Q9HuUXlaiMhB7vEki53kxLVdAX1dTSYSpFoayX1ipYpzgSiOWJfTjPu2a1wd2pUASyhMCSxuQv",
-	"n7tIBUEkYPOpUK+y1yr9SYWmYzIGU93uwnarrICIoFuh2w2voJospbEX2vo119tN315JZtSXsnJkXN/F",
-	"yrmeAy+WEsweqX6m4bx6S3YIRuWSkE5znwKOtjcmLctdCcvC0kYBKQ7QW4lvbK/HN8xB+IWmPcXaS91M",
-	"mSs7VD1H/WCaTJOzeaKKjHOz7oWBt1vTxHOGugE0L55jw5wJ/3HrOR/qZj8O9fTKqPwZhsAB01BYAd2O",
-	"nuMQVH7QeyrAKU2IGvHCt1SfCMQIjMAFYlINUyRXIDV9ChvhAF3Iggme6ce6+6JOrzx5B9k1L5qpUgfV",
-	"AIWdK3JI5mCKSCiJ1TZtM3op5oX6WTqHIYAkQFHk0ysVXg715P91WVZKdaszOoPDzZCfYTfVembWtMvm",
-	"hE0wF5TNTQHU8nt9U2n10S59D1rXhnhEnYAp4uMBBc6SZ9v9av7rrsEpm+IxQbo9/9tuw8P9WyNxCCbD",
-	"yQMRSts70cw5mtVJLouo7ToRMbXEJZzSM05OcSU1ZV1466mp/oRKs9SclhMmbAENs1oiizhs9mRfabFz",
-	"Y7KrRmBpG6IKm/2N/b3WXH8g29jSUtHU13VTfaZ+//3g5Pz94VuVEWH+02Psr2x0F9p4eyztFMFL2thS",
-	"D9cuPFMz64iOCRZUe96mlEYAjwAWAHOACBxW6zt6Qhs+t6Kqbnra3r/9bUKFn4jRvQFVyZxnGr7Y7AZ3",
-	"v451xNidphB/cZ5j9XcpGrE1GWyorYcQ9OiMEMoEv6nHqA2gzWytCm3tejZfKheh8VKtNdRh5X7pWmK5",
-	"JAR0TOlxJd9vIqzHacjhpvwIFo11foH75TMPdB6rspi1qd7guTGzMMGkrsCvEOQ2w2hlS8dO8ARYqrwh",
-	"k2w/1ZLVg4tzNMZcIJZl8ixNqYUpHkIqZplHfyHJaPEIoD3NZUi++xXXC8dzFNOZ8mlms1dKRZcccme4",
-	"t9QZNujfrQUUocBO+n34fioFsF+eVuKz9zB34jv0qTlcbUWJj9f14egMyGCCgustV7T4LZXzxBjUzmdK",
-	"23J4s4c6fslGVwslr08MHK17ZdY+JAd48Eu1CCphVhex0tkmla9t5WbeErv203y+Q6Um2zfDjwqjl5f6",
-	"3pmeiPivRErjk+jyOQlqiTuPfTkcpCgHyi0TQ5W/4zmIizkJLP6WMrYeBaMSWuCAuxCJaVn2+tiQbFil",
-	"g+nMGXL/MZm5Zr0NYzEf5URK6Gt+JN2vWQ/M+tf9NIR5ONcRtX6O4vQHuTdh7hRz+84OoolgzjUlXUdA",
-	"+w+5C9m4NjqR21IAOi8P6AFDVRlH/+C8qTrl8+rp4ZCN+YZoYtmGDId2K0+OXnL3CrIxSLObnyrhdL9C",
-	"Npb/cEoPLnxDMWMrH2DPHBSoHFHV0CP9LIZz/VQfTFDYAQMKGBoxxHWDEPXntmp7o9tFmB9/B8rzD1K8",
-	"dRbLlUM2/pCWFqx9xMDEluDOgFBlNVzQLPJ+sP2AKgMQzVe+B40sVfjzo10fi5QnzW/VBcoqQz7gDfI/",
-	"W6qLsqmbiMSilzqn+40ubgYZAlzgKFrQbqfBvTDLr+oNzFVJr30AK/eWUW1gpoyOmU4aavoy9jMaY8N+",
-	"ci2ELBI0LyJZkLxbqr/yYSyHkNUd1zmELHAJ1qO3MJNrbq15txQCy81yqhG3lN6pjjYTL+rf/YYuOgIw",
-	"2bIkUSAXxYP1DPrpWhU9ckv7YaFSJ1z5pMeHub4ylc6/MgkseyUqzsz3olW71+YvXTbnRKPI7VJSiJZZ",
-	"cAkcfe5+t2/CiZfce3MGb+hto9y5TM1dt1PKPQNXxQf7I3Cua5uBnGvGCQUwPTZVSOQNw0al8RSzKTc9",
-	"4YIyONaajwoSkQoRFirvp3LZEHN3XWSzd0OKOCBUlU2u4sIGoetTYXGmOmq0YwEEpfZP63K8brEH0YNe",
-	"4EIvp3u32MsNpJo+ci+1+++DO6juY92v8v/6JES3tfzCV4wUSWSE6FbeTV09xFxRNYu+n6pgqSk449ly",
-	"uniTzTqVaO6FgSUV3ctNZZRi18EKZ2oyjHGeyi8EWklZKzWdsoxgQczB+vLvcsFpOpzI6d61EX5k7dpH",
-	"lFm21RKvbrOVz5mdltoMJlOl5X2SMk2njpoM051eD3z4FdjjUOWgTYIrQ8pmcrp9qexTrr0R+r9tzdUR",
-	"TYhqwIoJn6JAWO+Y87FTlSttZVnsLPi7aQPrh3Wv18sAxfnehxIQQoWEJW0GBp5JtJiCQO1SX2Jebt8p",
-	"94uJZTvPK66UPY+H0f0+5hwr5bJdGeU3FsI2F2SBt8pJs4bqLMxXlfbxeTaill3TGAvjL5XD0vxsvQpP",
-	"IsFXyEz11CTOF5m2paf/ChmrFtUVRPN/acLAm5NBqk0uQxbdr2mF8QYZB1kCUlYn2q9qZX0I7rtew+KE",
-	"gkeLUsg1flkxSd2p/76ORqbLmGyVwt4rbrdT4nJ135czyRN5Dc8VCV82Ik77r/JFVld0guUw8wBRcQ7M",
-	"Tycubq+3/4hx5i4pNGGYuQu0MKZO/724SKVjrUhUDxHi80h80Y+ZJaPmavHVe6h7853GzrmoB89sl7vn",
-	"jxVLV75Y3QiT68V+AHcb/WO/jY9XNsuaCIC3Es5NCAE10d29U7Kuh7zhWP7viv4logHMXwEnkVC9kfAN",
-	"yIauaT6yOL9QD7TJN1ia5G699GoDzDnSzahoeqaHPZu79iNc8ibnl5AnyoT0U2CZCfk4dcFMzQh9xUf+",
-	"wnymKvtSO2se4PmX50WXisRquNGI0XglfqTq4lXynlMkgkmhrF6No+fS/PwUEptXdqHITVRm1/jqDK6Y",
-	"iWxbnG4gEdlUPV9RudAbvn/TUkH518tCtiXnG9207lf5f8aBtlhj1oM381yQ5psawguiRFUA0rxEF7rk",
-	"E1ybieontMbUkS+kvnwrhEI1dKf8fzFH7D4V5Co6fnhptKnc2MUkPIIzynCdmlrmjZKxSVr7gQP5eSK/",
-	"DwteYd4BlTXXT9M1V2Xl6QwbD6d0YfO7a713MP2s7B/PqfWqGD1QtW0xB6oIu/x5pMY6Rdpt8S4OZ+pp",
-	"Dcc2vHaKdPFZHQBQgWXNwSxQq0sQO8NDSBG71j2WktyUZHAQW18RPL1dC52FNuBNnrgkimyF8unqsbnT",
-	"baJQ31VQ9IJbb51DKUzpm2yBkAUFIebTCM4BTAf/wNMi56aY+ogpagkrSPcNEgt29kDU9ugvR/VUdh9+",
-	"uUUFLVIKUJ0ipK6bRyP3nqj+/CGY0UOTx92i+7/w4V1Ffaci84oMVDdO0+/fSeDQvbM4mNNE3rORMt9S",
-	"KaED3uVvASTm++qWJ3+hV3s+oTcZGsQEilQ5UQ3HMlyOKGsDBsUEMTmOVH01gVy3RBYTFHMUzRCvzFnR",
-	"U9cnrfzVAg0UwcZzNzhkCTXpHbxG2Yu4pCij8HAaIx34ekWuiHI3gDjhwpQ3nBcqGoKbCSIghtemLK+J",
-	"RACXaTMAp4a+oHKku65b0B8TW6knowR3JYZGiCESIN4BHyT53GCObL1+sNfby0KUbFHV+lr9mpm5sREr",
-	"cUMzwVqheIWZ6lSeBWEMHp7XnUJNAl7Gd2w0BXWD0yq0bYBup1JYtY22O6PXKHQZ5EKudgYVjN+1p2qN",
-	"YJ8FZ5JMA2q759eeS6mMsApx1w0XwgLzlNcoSBhDRERzWyyRMpXNGSaRvoJDlU8j77m2ZjABo0QkDC0W",
-	"VJcW6L+PteJYlwrgSjuNFGMrrdgkVLhtwSkDyoK1Ak+xaMWkweJ4MtslRQ7ApgNWqkkUK8+lZCUhUFJ4",
-	"ONdhkJq6NITPCBXoABi91iv23Z50uaWfV/ZP+TtY7akEq/nIyFZMbpwtYrvOlpIlUmXBzYJzCZESIGVS",
-	"ppuoq0AjxdIY0gVUF7ThuYe8kqXTlsuANM01MepPYRNPkh4Uo29CCFoiPBwFWDGyvKRwvn8iwUKGHuyW",
-	"nhYhaN2xYTuG1UCofHhR1oY0uTUQHt+vlU1zMIEz2zoklAZ7hMw7oHkpNGavSnKpIK4jtcqGBNXC6nvr",
-	"vXo8CrEemWNY3lZxKQrNEBH8QfSoRarviQZlTYVTz/IEXqVcbxdAdm9Pi5/o03kUfnKOtH+txEWsKgzT",
-	"9yPrJZS2jSI35SwZolJHwpJzxORN6c8leWIG6E3mD2u7KWGmW+KCLodeKtabWcPNkZ9gJf+GnaIi5kNj",
-	"e3VmgTW50Gu0FLngDZGLbirsGuZGGmmYsuRANMM04dI0N/Z7B5yMRkjb6TiOUYihQNEcVB0kvUb1Uue7",
-	"lxznBmXEui+aEoUO/ojRQnHhr1eZuU0iOh7rltzVDcvfIPEOraZUJmKSj39q1HHH03Aja1hcNMwb4soN",
-	"lFkgYF0zvhYrafjKI0WG3EfrIliD1PY9hRgpKFQfNT1twqLWQWsixPSg241oAKMJ5eLgde91ryUZkwHt",
-	"q10zBfGunf5NB504f3BDm3nr7vPd/w8AAP//g+TqbgYAAQA=",
+	"H4sIAAAAAAAC/+y9e3cbN7Ig/lXw4+/uSTJLUQ8rD3vPnllGkm1N/Lqi7My9o9wE7AZJRM0GA6AlMY73",
+	"s+8BCq/uRjebD1l2bv6ZcUQ0UCgUqgr1fN9L2HzBcpJL0XvyvsfJbwUR8nuWUqL/8H2RXV+QG3ZNLuAn",
+	"9ceE5ZLk+p94schogiVl+f6vguXqbyKZkTlW/1pwtiBcmrlwkhAhLoqMnKfqv+VyQXpPekJymk97H/q9",
+	"lC8vCj1FSkTC6UJN23vSe0GFRHJG0JTjXAp0O6PJDN2yIkvRmCCu4UvRLZUzVkj4b5pP1SfzQa9vFxoz",
+	"lhGcq5UWnN3QlPDztL4abNeuNWEcAeCIFxmxixeCIDmjAg3ht+c4TzPCkZ04WNbvjxNsUFRe8cfZMtwe",
+	"5gSNidqA2dkAnUtEBaJ5khUpSRHN9XhcpFRtN2E81YCqP46L7Fp/CKcSBURiPiXyGWfFYnsMwGRoqmaL",
+	"rlYIi+jKTx/cYDb+lSSy9+GD+tsJJ1iSoaOWHVDeQp0LztS//42TSe9J7//f95S/D9+J/aEeR/gJyydU",
+	"wz7mBF8/y7AQdTQNs4zdKjxwgSRD5u4g/cneVH1j8QZnQwGBfSSu6WKhztfCFSfS0mrve+QOzxeZGjNM",
+	"5zS3c0uGXl9L3Ov35vjuBcmnctZ7cnRw/F2/t8BSEq5A/Rfe+324958He4/7g//15Muv/nV19dPf/7+r",
+	"q72ff/m/V8XBwdE3+1dX+dWV+OmP//q32Bnq043g4FKTLSsW6PxUIDnDcFEDekH6oIgCVG2TSjIX0dtv",
+	"/oA5x0v13zmek/K+1T4RVpsv7/b44KDfm9Pc/vfhZluP7VuQBeaayF5PTgtLT200NKp/4W7cqm+rlH8J",
+	"X6nv6ZycsFxIjqnh1W0TXVaGf9Dc57eCcpL2nvzLHmff3wyD7zLVObjrAPzUdnef4hvGqbwHmfFxTt2S",
+	"XgsHv8/LJulc/WvFGRvkXsLgD/2ekn/dKMx8+iOVs1ExNv9VI5IS7h1UBjut568ly/aHX2GANZTXEDcn",
+	"8zHh+tv1+Uxt+iba+i9/wD8P9iIEVMGjuVgWuFbMvTH6w4jIXWDQqiOXesEY81agIDbRXNuOVjJFEIlA",
+	"nu+E0lciqQRpDEUVedj7nkyNGjQtqFKKhEKZ2oMTuRjl5NZqaG+cZuaQfUGSgitoDKI/gorbwkAiYxV4",
+	"JE/IalbgRm7KB1bd/gCYLqfzEl8ThBG3GLba0QCd4WSGMJ8Wc5JLpcl+oeD9As0LIdEM36jPBM2nGUE3",
+	"OCtIH2GByA3hS8QSC4PShs2MRvHXlCDwnLi5xeAqD89ajz5h8znJtzniMUuXHdhFO7XrSbrgcYgSgFjd",
+	"SZym+v+c7meRWtvm5yp429Tti0b9OqZRo9d5tkRYqegEHkeGD1zAG0aRTDgTyfE4I+ngKh8iuKSWxtSZ",
+	"IZynmsKskn9Ls8y8PSlRK4yX+lzMAwLhiSTu2UQFvKX09FFVfx228ElrB63C7dI/O1/Q/HorzWCRsaW6",
+	"Fw189prm8R8WnDJOpb3CdF7Me08eP36sKRr+68DtgeaSTAnX6CN0OpNxGcpJhiW9IUjMMNfS1JCJQMJc",
+	"XXj9sUISdDsjILfCh7N6RgoEi5BUj6T5dIBOyQQXmdTPvEMjjQHKQ3MLG2GunFcJZQEeDK66Htz2nGXC",
+	"2Xzl+8Uv+FQN/9Dv0Sob+ua4rJTtOc7z09+iarVB6khyLMl0ufIGVYZXEar3oeFqxd1bQfj2SCNzTLX5",
+	"YsL4HMveE/OX/ppyqN+bUC7kq3V13u3YOhXaZBFcyID3Zfgjw1M5R4tIj5gAJg97wyGf3UmSpzs4YDWP",
+	"oCw/LcB6MCIJy9MGk0teqLeE4jQChin+oKdIvSURjZeDXsAjDmN8rbvkqaKtCeBuao0VpB7umloDQlr/",
+	"WXNXvSmt65ynJJdULkfLPDnVRuPtD0Cz4ac0k4RHTH2GS0/07wroQhClvC4ynLj3U6LNhoXSF6iBsPTd",
+	"AL0VRG2IzBdyiQCxarIFqBGIkzm7MZZr+03UKsUKnjQ86NzK7jUH9lrpLHVmE1QY05xSKsuSRsbnsWr2",
+	"jE5n6nwWnCQkJXlCBtFbFrsvpQfuSJLFCVPWvV3YihIzU8zATuSMgH1cSLJQe7ejEeMoZzJugoUTfafe",
+	"IXoJnKZUzYmzN6Wl666M2rnAVPCmEYjkknBQGxVQhXDnlDDOiViwPLUnAQ9bBfegV0Vqv3e3N2V75o9z",
+	"vPgXwPBTw611OKrsrYG7XZApFZJw497YwbPiVgyThBXwZSi+ldx+f3j0IUbv+FYoSKp28ELsESzk3mGv",
+	"JCgel/SCLwvx5d6U3Xz19z/w4o8E/5Hkf5DiD4G/2vsyIbnkOPvjy5xxOftDsELOvvr7l2rSP26JkF/9",
+	"/au9q6s0qk3QNH7/zk8tOyjpd5Ih0MD0ZVNKQd/erLTX306vKXJJ54bs9SXuPVEo28vwfJzilQKQpj0/",
+	"ST88ohDzjRSiONeZFQa7MPolVJijbn/gqIVP7ei6umt+6CqO1GyEfyGQ/RKxHGG0IHka2C+QE3taEAEQ",
+	"O2Fd1iyx+gG4KYb6PfU05TQll5s8IbdCsH8afyEMstU1wbl7lhtTxlV+GfiPrJKgQUAJztWb2+4iV9wT",
+	"fKLqV/tnO9pYB51bjqXLwVV+PkFUO1PZnEpJ0r4exDid0hxn1RXtM78QJNUHPiLylGRkinchsFIz0/lK",
+	"VmLkAwOAnLZgHpksR0tWcDQmM5xNohoDyVMxlFHJCGgysCi6V2PVJO6pkWJJ9gx32MqiKSTmsjMcMLqu",
+	"mYARUJ8R6QpnjXgd5h1uutDxiEiELYwEvL7tZwErK3ku4NCB3i/ZNckvzN+3IKEZhqniTytZ+akBG26S",
+	"Lig4n5Su1QyLQHPXC/aRndDdH8kLbZoeFnIGD+Ktdx68KZuVvUIYRTcHvzEVkmPJ9MtJmYJZjp5iSeLK",
+	"n/p4FY9Um6nhU3/Y/nKsYvWUSEwzgfBYRbBAcIeckVwqdJBUb0TBFMbjbI1CqwymcCO73XcfpFPHGG1y",
+	"etholLJrrg2xdqPwXcxvJySWRed5rskIxke1H7OpMqhdzu2yFpVEuI9IUkbhekCOMg0ZzFfcfVufqDfy",
+	"QQjL20VqJBVst+muYDTHeYEzVOgPSqZvw3WD64L8Mu7NCyz7y1/8T4PlPPvlK/W5fr+r70InY+zONZos",
+	"o7vpej6W/YLl1RC9dhbkVedgyZcYc6tsfUJWUHS+CM7+Xr0BFWS5iTdjN4neZ1pRx4TGwamTyRshoG13",
+	"fuq4Xh6oA0pd1HzQaZAWOksl7wgXmwJZPqUbmCmulgXUb8YN0I9G0GEkyPyG8D4ShfJzCnTVuzkYPB4c",
+	"XPW0F4pNJjShWtPMCBZE9JUB4qqXkpv/+ez88ufnw9FzM3TByZ4ZhcYFzUAraxflFvBuRFDdB6I5CAHL",
+	"p0rGtYeg/hIAu70DP6pgNQgineHFguSIllWbWyzQHKdkgNT7UBSZBL4oC54bnyLB2mIzH9PcE6nzPyt4",
+	"zzhnu1B3iJpntSoHwzo+yfTgYEOczY3Bid/QhAD8oX17F/uw7+c38LCuXzHJC2KPwo224kg4Dy8akwnj",
+	"RL3mJL4mApHJhCQNdjzu32qdeG2crnr9OvhdUG3RBiSjEarFT8l//0xpERdE8uXOmayfuiHEYIbzqX7N",
+	"YOM5AF2GCheHLDk1L2A9mdKlyP3AqaduEuVC/QiSAADV2oQ6D6ttlYxuMzBblnwGJ6HCsgOCLr0rgpDq",
+	"iMEQALC6xurLXPuiH1+tGw2qWy5CDh9If7tSRZ3T1gcqAonX6H7ZGo+t/pfLqvPCKNtYaNOMC35fz2lh",
+	"wurBG0MnsALE3+dMmqUU2bcGRHeSYyHK9LGd6DsXe9fQdNEcrretV8b6jkTH6UvPmowKbTGjOWI8Bf9j",
+	"ebW1cTHS0MTQoOPqN8KvepM3obd2wwDX/RL5eSRZMNx5d31zAEsVSh9c5olRM+YqKA58h1zokCKYFfwB",
+	"4V/CFJf8C2k5tKVMmwiib6PKjvEh4zaFQezgSubkTn+TF1mmoqN6T5RsbsjwWCvqNmIwUUjWC3Yz2Cta",
+	"VPQHFp5UaOtoUgrByktpCNzrkGWMCXgM7UIU+Dk7E66HA8CIRih3O4fGAK21UHsG7jX3ao4g7MFR9eBI",
+	"8vQXRhW662jzH3aBqYmdqzOe7Oq7w5L+qh+Aso7GgR2uHFosnpxiSneCqmkwXWdshcrxCjZVmr8LCk6M",
+	"FJCsbCScYJqZoNIw2c8r2YAcJQl2pVmtgxBWLHZHOy7VqPPlqhMOoMIixgQj7FLCRUzW3RF2+cxA1ME+",
+	"sSYizLuRjX/VKq7afRAa6Q04wzfnFxV+XTIt7wJXi9KEnbFTgmMlhiqLrMeLab634GzKiRBVw659yUJa",
+	"iw27CW3apZeVZ+XVHBGxEytYZc41jMHlLztQXHWp7lbzSuoGGMPglV0IwgMUhakVYjeOqTnJ18JLCMJK",
+	"rLj5uyDjhaGu+hrB5s9udrR1crPJxs9uDEi74dkGiDVY1Rus4ickSd1lrEIWIOujvk3u3+GyCzQ54VYK",
+	"tb8/+RZUAFhD0PmPVmKntMA2744QIayQRNwHOribeQ1EaHBWkwlMvQ0KdhQxscVzfnUIxK5f+PWbAlNU",
+	"Q4h3boSu6Ctt6mlpKIK9mLDAstTdmYWhKs430RfukSFWFuuv55Ab1nWOat6dfUBRCDwyTusozndOGHVc",
+	"dtqCAS7UHe4BtLJq0pZHyvLQMlaCEWJZd+XkXcfZtp4LDbz/dRfa6OT85a4C6zqGzsnOcXM62R6C4oCE",
+	"66Z2XVKHlSK/bPSN2hoiebpgVDthPvQN5EFMobaM1VNXvIFKeXckphUvkMIo1lGfYEyVDAzVDrdBBaBB",
+	"r1/BU1vGrvXkustbqoOjrbVqy5GCOZLplN4VBXLM9R9GZHo5L0JHiqqaKJuU1WmI/Pff8SL7+ei726Mz",
+	"MpZH//5d/vTf/3GU/oAPn16ePf7nwT96/ThsUHeid36q5xQnENUaD6xbUQunQb3rblDdXaGZfg9CyNY9",
+	"lcaImyEqcvpbQXyMir44E0q4c/sFND5AOkrMMAdNZppKhKkG4SJ2rnIdc2wGUWFC35R7S34hVOw1J3N9",
+	"WRKWCyqk8n5e5d2yKexu1q2qExJCSN8hVhWzoRIo1t/7Ggfq92quhQbe4Ed4BpHq/yZpiVOAv9hgTElm",
+	"atyvtBReS28IwgsaYRb3U4dLRSQrRdb41eZ42bkWF0oLbiIByZzwKckTlSTwffCZT+UOGVIfjQsJNSuM",
+	"M07zS103wJp1O9UGUGrMmJB8VYWArbnWA1TwegBGOScSp1ji7qzvpf1iAza7q+pg3UKZPcw2lHlTRh+4",
+	"Grdm939Wxm3OpN+9VpqjvY4MPsrIzdG0svOXAZFXDKcbaUTmq++X0VsN6H1JhMBT0jKimkUQTSBogcLM",
+	"EoWiar8NBKMHPgQknC6K55fBYTVjeuQuZjwWrRCVLDZFyL1+j+Qq4f5fveHJ5fm7s16/N7w4eX7+7uw0",
+	"DszI0loNtTUVLXLNTMib0eMDxl2Tv4sg9KyLDaTRPRPfxqWj+maMlhhQZDNO8bjPXZXsn1GSttVymhK+",
+	"1+e2QxsW3Iu8dxuwbOBoQXYXdhGHoh5owPj8LCM2D9WS8PmrN28ve/3ey7cvLs9HZy/OTi6D925Fv6D5",
+	"tDVPvrvuUNvPjUvC3zDIyEwQQtovbXolmj3yYmn4QrJFZosEKdWnR45nj8bi0eyO/La80/AMjQg5YTkg",
+	"KFLmStuLy2/mBeaSJkWGua+cBtuBoF1BtIXUapgxzdt8dt41a92to80BMpkNev1AL8KQIX6eelSsq2qa",
+	"9RzQeqd6qdDpuJ7G+VvBeDFfVTMlpULSPJFOmw+yneJA1Osw1SupOKt5fWn1073t2V+M+sJVagHTk17Q",
+	"ViwBOlxnyWoIlSctB0x4lWokH2NWZtBLImcsQqKn+r/Gegc2Yy3MQNUvKIPVVBnQmHqyJjjLlohxyGzA",
+	"Nlc3lM9vL1+/HF6en/T6vYuzd+dnP56d9vq97y/Ohj88ezEcjWI7MUDGVJs6D/jmu8fzTH6Hf7vL745L",
+	"PGAkjT5VFYGm+qFQv0Os+7zIJN2DP7j8hwXLaLKsX/Utbp9+F+tlti3VPCJJYcqL1b7e/pJK5su3BDDf",
+	"6y3dDDexUrARkgJaaLwYzhJSpxbzuy0vUy3WXqMOd+MjSHBX1DIKKFjnRJFkKKWTCdHZ9x47YyxIqvwK",
+	"MsJxBMlIIn3Nel6q86BrbnkG6IALLUulEkt+VTxmNzo1y39kGwDgfGlsQYy7oH74UNS5bZldwEuxWzxq",
+	"jbNFrga5W1BOxHAilZ+woaTX+QQJIvtVLxfmBAmpMgttFRJNhHO1P1v3C4xJckaWEKOvY3TUh2UmmGRM",
+	"hJVSz/755vzi7NQ8YkrP4+CyRCIHP4IINold9eKZXe6387/Z03mHM5qqzIBVyGd5ootzBVVmNfOtOyVr",
+	"EqePMqyOwX9qgqUUvmkeOzZOGuTV4Cp/rSTcLRWkDwmGqyHShsixn7LpQDX/iuDgNU91OSonXGDgAGlT",
+	"i61TZMDRv8GSXP+BSoHg2H1qSNMh9q9y69OVbLGXkRuSwWXtu6uap3Y6hSY6zRm3W1rrYgJXbcstWV8I",
+	"eA62nhQoc3zH0CMs/3tn6gaPbBzOoIZOycptoOv7YL0my/MtdocyiIgJV5Boh/WHrEV8S0uRmaaxqUg5",
+	"KMGNDSAvgRLI4xruY+dTrkwRPR1WyITNTSlY340G8G1qkxs1z0U6CP3MMydab+KyZqFxly8cIx3tpvDw",
+	"JDbNyDfTGaCnYIbxQAjj1NBR6hWeFVPzQrNMHQj7qxLRpZxNNvGQRec1CGvY93pVQWBn3pze1qomEvun",
+	"icp8069WRA6sOAaokNDKRLSSzJoMkW/OXp2ev3oWtg76rSAF6MjhcV6cvXv9w9mpHRiWKelrjvt0eP7C",
+	"/x4jiav89OI/fr54+8pNUu3AZLK2K9SrOI2aCqOULxEvcmDl9g1mtgAvMAVjr98DYHr9nlmxGXXNptNa",
+	"5ZdG5FXgDdHYR+evfn5z8frZxdloFB/oLjhgxQi4k9cv37w4uzw7rX8ERe6lJPOFhIMC/Jkq90D5DSgK",
+	"oOn1e26RGH6uW8zKDY1mahjCpfJpmnFpezt4acLQEmf7jdtmG27rNmbW+B42NrbqO1s1tzbgKXJdu0ET",
+	"z835zKymK/GzK2tpvUnFbpBUAj6cvhug3zH8KDm+/XaefSsbAA3q5neNsqxD0wZqsEBlg11hDirvxHxK",
+	"dE72xuyOpCtK8EBDCqZtcpAV0m/zSXasMbZ+YUQLTliIT84I7VgVcd1ah62lDbtN5nWNLhsUxG1RCR2L",
+	"oXR1SSCnn5SKHjp4HRbCeKPgigd0EuEvpxRPcyYkTWJZPGncX6wffavuwws2faHH6aiOJudzZa8wcx+W",
+	"9t+F2/EAd7vtk/E3R8l4/HicHB+DAbdWcbxDXSFcqypkXgHNxYPiwVOND9fgzQq2L10u1GpoWFw7VUO9",
+	"Gzd8yGpiia+vyDx4U8DKJE+7VxJVm8uw7uMSJsY1btVby5RVK+hW6VsBgYJigrzcn/VjEl2UjW0pUzqq",
+	"ISZvCEmw0YLBnjOjGQFrm4FWh47daWvW1D9auuPTLCiGQWhceccTnAlSq03lDjZuRIrGkOk7v8bp6fHd",
+	"z+9GWdpAKS8WC8YlSVfsxj0CU0b0E0HAh8hO5bTq+Ib8itFshsE+EIApA7TPFiTHC6oKEzangr9zc8aO",
+	"a5XC2qEQvLOIV0zl2xd3vwUdBo4ZrmqEwPoBJ4lfuvhRltEdstQSP4wICVeQoCYiGgqGNvdfbDJ4xGK5",
+	"TJCW+SYA2MHTTQLcLpdTfPT18bdcHEKRSjtBUxjLqQ1igXkNq7dfDVCNt6+Nh2ZF5BNpDxWegKHLoEVU",
+	"5SAaoli6Hoe+t1EtlswXjGO+RFgIOs0hAcYqsRA7seA0T+gCcgzKp9Io63SbFTonNWOVd4AeHRwd7R18",
+	"s3f46PLg0ZNHj588Ohg8Pjr8z27ctMX42W5W84pj2MwRmLqLES5Dykz34Pa2QdoV2K2Whk2LbBE3+qcH",
+	"Q6FoieqzxcVdpbkycDWDjIvwO7u4eH1RMmIZH1s0WkoUQOJx8lIdiVS3P67OK3wIxM+y3hNqjVaELs7V",
+	"glS1XDpZElxcuHERVq9/OOV0In+gsdvznN2G4eVUt1hwWoB2LgsbrmENYS/PRyNlppsTnItASaHCdgYa",
+	"F6Wgdeef0/10wsqWdqnBVf721dk/35ydKAudn1gjWX3u4SvdJedtpdwAQfLU+qYscRh4e/2eX6OGPI+j",
+	"CHUENWkiXK2pXGNQVYYKZ6jXyjG5g6tKVd1MnFyzySTmU5AdvDoetmFitSRjzFzZrErdQkC02YNCNYAd",
+	"9+ZuYDtoEKUZFvKs2ScCpVAdncyZdm4mihNYtMIeo3aEOb4bdkSBmq3QdUwnstSbyWCECpQzlLF8SvhK",
+	"Z0uAKZVYbWBYz6KxvTPFU0TgSImGhHdv0EDTXr/kZTHEGcTlO6Ir47+Kig5pVH4DjSwtJPi43HCXMnhF",
+	"xauoXuVaaAwvz/RdPT1z/+mqmeofgHuV8pZCR5l5GF3lIHHMcMe6jAPeQ6PbepQYlV1X+VnOgv+ACeMY",
+	"GiZlU1CMaa3ytwAiTBCE84NUovbyxDy01ai0IIOr/Oyfz4dvR4pj2xm0K8VcUMkYxFgYLqP9Xw3Tg8vL",
+	"Rk3Ya6a09DHOU1dNttI1YnCVj96enJydnVZB0NLGtVOA2YffD1+dvn4VDL1lxpYQQDXFtNEf5vbb6/fc",
+	"wkrnsDPHj6jZ7RPoZ3ErQEhftltpqdCqZCGResWo2pVEP7jX681aa0pi5wg6lJb2qvfRdGEVEkhz4gbx",
+	"Za8bywZDvwBaLjIc3MZu26+v76dXqA0nb3d8N/a0Xc3ESbPNAmqANGFJF/+d0Ny3qnM1lSOY35brhwy/",
+	"dv52J21MfGRGRGjCZHpU+2WUsiq7KhPQyv/EtrGrS2MYsKZfqvG179s9tqMvmpWmJWkIsIfOzVxCo0JU",
+	"BINhhV2oa9hBHEbL35rXt43NVLr4m1Ml/mhQzbloqYgLPHtvjhcLYEk+KtUMthP0gT2Dwp6aphcAW6ob",
+	"L+iZbH+SNHzinVycgTQE4HwOV4itRqRErm9TCehIbdQuenjj0kofT1OSvhWb+grSFNDqWoauZ9Ke2rvW",
+	"qcqkeaNtDG3tjbc2xLWSsOYEp+YqBNisgNtACeHprrhJo4ZOrsO8sZFr7WboMOFlnhgkNGQEbFhafasi",
+	"6UEp81XqfqwO908rkBcU+r6vS/SsJZkioEuDFO+wrd6ldZ1Cmsi2XTy8GusBsHHrM3d59AxlJFb31XB7",
+	"glONnv/CPy/cQ8Zm1zp7XGnuRYNGHLcv40eTlB9+O01mB8dYb/AHstRtcutUdk3iOdM3dng72avP7eAA",
+	"YrdeN4P4o69/vSFZ8fju8Cg70ms433kpj/Pp616/9+Pw4pV5XWiDZbCs+6obnhbJ8jr5Lju8SY+ZXZZd",
+	"F4vWYkSQfuH1aJ3Dr01UTI+xaSN04h9/pdy8am0K35qlKUB2vbLi4J1T5fGUg+O1BupdQ4adaYUY25Iy",
+	"bPmp0ISSLBV9SATQtwxaI5p6K6VpfGKnaTwKjy4yUR+ogUqxhreJ2TycUacoI0daq6Wgw0qJRCon3I1C",
+	"F7M7/tvBY3k0uTn6vRe2AY8YCcwvnZ1ksqOEsbAG23njDd31bRhHhF56+OPIbcZdCtDX/X9bfN5qC5iu",
+	"w9L1G+2DKfVHh/yAp4pudvZsoQLoGWfNnQBlpVW5bpVrvoo74akYkYQT2TwndFgPp9b3QU2NkdAfoy8z",
+	"eq3Tb4ZvztE10WmbGC2wELeMp19FV25+MOk532A5qwOloFhgOVO36nZGjInMQGH7lQrJuOl0YiHUCTx4",
+	"SjjSkA5/HKHR6CV6gzmeE0k4GqlvBt0sm/GXmj+eAKsRcg1po6PP9Gt8c/s7YbdH418f9+p01iDeVvc4",
+	"D89z0BAUUjTYYfRPsU74HZFYk5uxPXUM8ro55rNxeruYXNMyfqAMaESQOa3c+4gS464rlRqXM86KKdj4",
+	"w+6S6Jbx60nGbtUEYS9adDkjwvucQNX+299yJv/2N7Qk0ji9Yjk7Zts2fmUHITE1lLbExiQe7S3hzDoA",
+	"qN/ieS23oNo4Nibeod8VJTo/deqEO0no+okulZDWvIkrY/Ac/TB6e36qLRc3jKZowaRxpSlQM5rYEDRF",
+	"u3tiQRI6oST18ypd3VBJtT+qej819GnqVHoGeJciJ0N/JROGSQ/o9Xvvhi/OT4eX569f/exyK+zfTGLB",
+	"+eX58MXPJ69fPT1/9vYCxpbTDYJJRm+NNTrq3JYk9mwZ5jpozuatadDVSETzVJflVA3XvRgC5c8a1Tt3",
+	"lypd3JEki9dmzeZ4+RiK1S8WymqT2PB+x5leW/tQ82M1TqMj05PwTI7UqQKsV65hv84VIgwTmFw3VnmY",
+	"zx9xcvP4N/L743GdVZ7nQvIicTF5ZRalYDS9ZzdrIDEKJlilwoaLNW26BO62srQGYSQk2knu9REQiv0I",
+	"LdMK5iORYTLroCnDsMp8/TLoTegMN78zbLoLXH+AAeMolQUBbqjOXvFwVyYCdNBooqse0K4R6+lcWIn9",
+	"Ju3Qw9rN34Yyt8OdXMGyBlCVfz6IE5yPIlTMy1K3gf/VkQjPW7MuaVAagyJGodYoLERa31bF1JYhr28s",
+	"K9mmlfo9+j7Mv2RUyD0h2J42oP4SZdwZm67Rm9inErS2p18lvz20ofAui17t/tX/CtzDzoCzWmw4KVE9",
+	"qiayDIhoU6K8ILZsQYwObwm5zpZIITNVFpO2ItOpocvGGg4qrEzFzJgZUIZt6axKAYVBU1kELnV0YHNw",
+	"vCpagZdQINWsoj8T+pV4dIxmrODo+fMnL18icH2WoxkPHj85OIjRnJr9d5Y3rH0+fAXZWEiN8fVZgwhK",
+	"qjSocnGMt5cn5dWHhZAcZxTvj5ZpTqJ1edSJpHjZQLPqF0uxaqStURKiAlUKSbXdnh9htZVS3EEVHlO/",
+	"RhIBHQd0FzEgX0RaKFRpUxAdr1HKMXEVWXQiiI5+qJDwBln6Nntj2BAJqugYDrlWmMQ06tZxJVj4PBAd",
+	"zmCrHnRP3thRpF2sZK+Kx3qdNPOCeECw+irYct+cwAxyyU14pz4ckqpncfettgTP8xLLWt0NQo/0ERjR",
+	"Du1dw+iqhHk/wXSM1ysV8PDCOElRObYOcXQX9QYgK69fxJljI6pPhq9Ozl68KIc5NXwfOeaW+21woZ/0",
+	"hn+xOZG6LFRQN8W1RKhYaDa4527AO/80bOAGYRW8LlVuzGjteDd5ECujNde5viuviz+M1ems9dJFcK0N",
+	"bZnK7PBTqA42xTztoJbMLm5u5cJumIOzizrKq66+u94GxjIma6yhTriNd7/lyuufHrBJSYLDdN17KK7/",
+	"p2kJUjurXXYG+avHR6THhyfN+m1q7+/RdFYbVdVudqSHxUs2qdNiwTTzRMuU7K4weT8AeKO62Rbc9iqt",
+	"l7OwEqttOqtTC6E8nsGwpVqfah9N6jdV71eXo7OKPQTB2a83LtwaWr/W8K92q/G4snAr8SBX3+TxyFaz",
+	"bj9EWz9qYoueYstpW+Jc29XeUDW9pVA6eNO7m5l8HEpb/YCRDjZpcDpQ4TzbticXNuEpptiqmQbCSoIS",
+	"T7wq1gPyWNOebPcdgzlycvZEOlqfxJRPk+Nv6e30m0NjfSr1xIvl0cFP1jWXUpEUAlwz0R55tbtbyBlb",
+	"o4QLuuXM0L1ZOqrZqkpbUVLb+InuVYRkLn8++l7+ePjryTf/+ebl77Oz/Jv0H/nLy+PR038k2boZYiuT",
+	"tiyGzK4aSsxUjqr5mja3jbi/F9164WPbPuFwyLBEV7mjhI0S4q5us7nNc8gAw7kvNxl21Fun++fKmqpb",
+	"6wmu5lX18MeRgqitxR2r4z8YNSvuRLIcMSivoqgGyEQExjS4z7TEEepc8X4e4aYA9NmdJHm32qvQfduN",
+	"X/WQ/+s1fb+v6Up3Ik+PDQrr6vf02U1UYcFJE/pxVaXd5Np36fdZKlQU2qYLxYQiTfniN2lcKvR5njYG",
+	"4JpsWyxcIdK2Yrp9JEviOVJrty56vRaxTh/eJpm97rVy1d6ecjZvRIPu2q/RkGAdNxy4ArwQdKXjqnhw",
+	"aopbzeRNwQwiihiVWuAy7x64poaCZbQZi1GfXm7GZvQ+oDJOGn9GTV29h05iwVeGsN8+hZoBF838mzYl",
+	"hiaMpyR1rKIWkGVoxqX82C8QJxm8ASQrH9faYXglIJrynaolocs5sg4qR+ber/8FScSemJEs+6LBUDyn",
+	"ufZq53IVy7JvaxuaZbouOF5GOEFCIQwjO22cdZkvWsliRdYu0U+RpteF+TmoH+FwBEdqPGcponnfbhCy",
+	"PZHkOLkWdor4vZbsU7nVkm14pyXb6EavetO0vWDgnnV7sd4dfv37178lGRHpb4/DF2tJx6tfWPsrFF6t",
+	"PFJ9yQl8iym84QOFf82oirI5x7auCCSusSlomFItcJqactyz5lk5tOrOystEzs2hvFndWrsVpGt0E1bc",
+	"ePPm4jUkqvmL4f2cqg7HyYvzV7VLUQZ3pc/TE/7KE18rGIYK9t03B4dQYUPi+UK9o99eniAXw9K/pxOs",
+	"I+HSatxdLtsxY8vfssl3d2P8tQ1OKjWniNrM4Tcf8BEz50ZO1p1hCejScrGjY4W6ryPJsSTTZTzGyfcs",
+	"4gQKdKhET3lLSO5DyazwFCijuSn7Wq5wMbjK31ycv744v/wPpfbBnZ7R6YwIiRacMk7lEubvownOMq22",
+	"4+RaTZUx9RYuj9IJexRqJakiMz+enT97rgq1iAUnOA3KMlto7We6u8yCcWlYGqict0QdX31tBSfUmvbL",
+	"QvEkJuykDojLYMfCN4fSo9SkwqBaoQBZfFRqsZi/qsRJs6XSqVbOLHKso2iL6PLJ+jF7bLKX6lEo8S6j",
+	"IPgomZFEHemt8WsZtk9F2Prnwr5Q1UsgRznRvV/gd4NgduvCZ0QfcTLFPM18tTlBkCBS7c2UDCrzEXKX",
+	"ZIWgN6QpQ/mZ6zMHpatNxQkFTs5c7zBPFfZ1AmdrR7OJr9lr6fa89LzUFRxU4J/2zrKcePjh2Pvh6Fqb",
+	"JHgQQTWKQgqa6u+prPQaWulGUfWnrLPjDeGneNncZgpSPe/ovJgHwrXU7tO3p4doQ98qxhiiVIt7i0Od",
+	"ombDDxeEU5Y2OFQs1UZIMiL2Lp89BzYS4UTwA+JkwYkgikIx8okt8Op2eQ3oKn9uGZIixzEJ+JIs3dEb",
+	"is3Frhtkb8UQeo7GbRu34oJMm5w0qYvU3VV076TItediyOMrzgjO5GwZ1/ybXmtFLum8ay6rHV2GpR8i",
+	"KkSLB6mMjoCb+RPvJlTTSfrtd48mJPnm4BvdPv9uT+KpUBAa1msT/T/0zV9CP1u1ZO6E5uAcsX3U+0j9",
+	"L2TiqHIXb88RAU+bL/ONvZtoPb+db223ia26vBtXy6R2iWhrwaanZd9hpSWbyQWFdG+z8WoTMv2jft8p",
+	"tur7tKVBLLBuHgrOvl6/i4NScZ6n3ZtB99vm6ugj1BRtHYXV9aP4cicYEnCZxjp6DscH3x1g/Ojo228S",
+	"KGMQO9zYU8zSHrKFYnaSpL0+xhoxEK/lFEfD+JuvU/I4HR9+e3SEAzQ0FM4q2TU72zEnxny5+mrpZbW1",
+	"U2EqWQ+FvK5KtxoAKsPVG0j/1A3UEYy9R4+Dgcbgz+CjdurrnPZtsvx9kh9eLx7fXd9VTztuZB6ZvFaB",
+	"cJjKU82NlMwEYyGMQimAYBMNFYoaS9s1hqAsinFGxYzEXR03jVG2Ffz6aVwYl/22XnawiqOuDIaQ8eN0",
+	"cpx8/W0a4NrVYaywjZ3rKhtVY+z37AsvbiWQHhcNE+u3aFwBguddU3dCZXi+IUjMMCcl3ViYfvSgH5vy",
+	"lOblWyrgqN+0sAhJ7XuvQ5hReVPRGoQOK3aDzepUcMrdKAU/evToMR4/Ojw8OjwMKGXkuNH2ukogYsqz",
+	"dwNRXn/LxnOCj8VsbBhHPSy0cqh0Xk0vrATHRXNn5vjutIuB0r6lrMVIPYhCU6XvQIszZbrQZYQGUDRY",
+	"fdh7cvj1t0fH3x0c6Lrp8KdvDlbSSgS+8PBrQZ813fCtqbFV3jaUcI9GmlEu5KsmfrhJq+aWUtmN6yxo",
+	"IgtOtvDv+3pc9ygybSV8jzQPeuC2d1ste+frquVb0aFK0MmM0/AQe4n6w/9JdKDRRMUZUQYVOesVgfS3",
+	"6JXCQB7A+qQ3k3Ihnuzv4xssMReDKZWzYlwIwhOW64DlhM33i/3D46PD46ODg7/f/O9jhdl/MDELYXEL",
+	"thck2mDhb4+PDh598xgWVqdhU/vCeMi3r06Hyoz28rX5x+XbsxH868ez01f235fP316Yfz69OId/jIaX",
+	"by/UP4PzsEtEnhy2UWAkRfl0hUqe4TGJXz4IXlz1fZPu3rkgnH0CASCREjdrtCmsh0QGkaRrx7k2o6ZF",
+	"znfcNQwr7Zqm1V2/XqyRjHzHyK+0+DqhB1+nhSJKXR9hwkwNBomh9Ya9ez4SUDECngXUX768tYZOwaeq",
+	"clUvaNlYmtQppL3DwQEQlC68o8r3DQ4GKjF4geVMH8U+XtD9m0NTqWdPB/apv0dj+58RqQRbGMovlFs8",
+	"CHYc6OBbwl0YjeZmL2iYOgA+MrFguYDFjg4Omhi5G7dfmePC/KAPWBTzOebL3pOeGhXKerWWNRSdKbuO",
+	"ILz3k/omtvP9TNeca0TAWZ4uGM2lKeitdw4V9rTqmJGboF8KoOdLHR1D81LzPd9EjqQoyehXcazVC+At",
+	"bAUwtaFotTH3NlKWLDogA+Spah/fClWVYAC/ipkt3knyhCmfqh6vHSAiw2KG9q6Kg4NHBP2Po56i6d6T",
+	"3m8F0Z0LDDWbCjH+CWt5cH3RaPGA6BYIn1MdLjBSqmSO9FXtK5hNvX5OBJmPNfEhzjKCFDQAvE6iMiX0",
+	"AXMNkFdXGViG4PfSCVp8qwPLlA0U0bRhMTPgPG2d/6f4tTAS0GRwZBRCx/Z/NV5uP1+n51uNqOo58TXG",
+	"8/oHNer44Hj1LdVtT5rupl66mgs0xuqCsPBltd6dfQ9N1z60si1bjzvyGrjKr/Izw77A2cnybIl0LU3J",
+	"kI5oC8aX64Vi40Aq1cfV/zKJTpnOFZNMVwAPv0yJoNNch74BC7Wd8uJZaOeuk5jtXzgnRBr/k3oS6Lea",
+	"6COMnl9evjk+OERFDjHx9HeSmqYzVLheBHFO/YyUE8G2Isi1kv/aCO9wbcLbAbkqsgmOIE6UNZasr78S",
+	"r/72c5/7bvUQaITQwgpWEft+qUNrI9lLF8JPuCP+4PYph9nlzFGFYqiZkqBs4rLB/rofjfdjGPS23Fah",
+	"cXOVafhBKL+qRKGwiecDXQIl17tpqRr6qppaO0ytKNT10lYFC0rFl4l9vCzbqOG5P2hQBHwBvZrG5EIW",
+	"XYHxLgoTyRO+1N19JLsmuU1oVyEnCzy1+qZ+jsQhUpU3LtWnm6gma2nskFC1gd6ujwrIjMVqbJwYH0CJ",
+	"s8UPHIZWJJwWRN+bTLj4luwQSsR+dQ7XlbSGo8OdSUu/GmAxJixtALDmAAcb8Y3D7fiGOYi40LSn2Hqp",
+	"uylzdXNu5Kg/mibT5Ww+UUUmuFn3wsCV6yxyhrqiAhHVc+xYaSF+3DDnx7rZD0M9B3VUfo9TFIBpKKyC",
+	"7kDPCQiqPOgVk+gpKyAT5OvYUue5JFwFiYwIV2qYJrkKqcEp7IQD7GOezOgNeC3vizqj8uQl5tei+kxV",
+	"OigApJsq5stqxkYQTlfuNwFx6wnOE5JlMb1S42UIk//3ZVmO6jZndAaHuyE/w26a9cwL92QyQ9GMCsn4",
+	"0jTsqwcudJVW7+zS96B17YhHtAmYKj4+osBZ82z335t/fehwyqbYeuK2F/csdzzcvzSSgGA8Tj4SofSj",
+	"E90ER7M5yakc3z1IDlYfxGXMvxekIAKRG8KXtrM4406mQM6T7tID+pDplwaNemDy1OclqtdumBRne4aC",
+	"PaTgMIcgSaHTKGie6KJXSpBJlBEspI5nN4vYyHUb4jlAIyJRypcXhU6b0BaiYK1at0QNnUuC0P+tIJAz",
+	"MlepEv5LzP1wUxlFOR+UkShP+2jBTBeiStq0enRTqWtqSYYmLNOdLaRwRSogpj5yE783ad7XG+mH/usV",
+	"mmH7zQinCS/Vx3qz7UDSfm8P5NqeZRdBG9yM/ffVjPvVLNj4t4LyV7VsexW6rYaxQiZsbiK6DPnp6swu",
+	"ZzTOpr8vAdXb5el+RnqUYs8V1G7ImquHvCVr9Xkf+0HU5UqicZX+fJHHRgo4dUu0C+p27NZmacG035QD",
+	"NPVtDVbdKU3Qe6brd6tN1Daj1YH6JdZtmpqry6M4cqmDvBcynhjsd4li+abNeKlPQYOp1bUNp6ttrWxO",
+	"Jbym9DDnaVCijhOhEg6621hX1kEIe5lvYe0MN/gJcGkFklEluMP6ehS1/179Y6nf49CuvuN7HL7azYN8",
+	"JNlCO2b40mhDQLBAz5ByqqEONKKJJKUGjq6hhm1UOsd5oaIJmt7jsFtPHRvxAf/5Q4n6h3qKA/rswZij",
+	"2ob89D8egPiGUpL5QooWskN0PicpxZJky77SrrNCa/GW39pq8jeqfMEMF8LXu8GFZEoiJfaGNpCjpqG/",
+	"iHEzYtR73ogUbZxzi1AFT6N+wJnxLfLP/N4q+T6SS6//Pvqx6dIf81Cev7o8u3g1fKHrRZh/RnyUW0hP",
+	"hZ52WWYQuKZrULkP9Lf2lXnCpjmVDB7IC8YyU1OACkRyFdnQpMbAhDb9aUMPg/78Y7gNAc5PxVe4g5ts",
+	"ztPiv+MN3n8/hTSbD0Ah8R5cp/rvyqJHrafDJltGCAFGe0KoE/yuYuh2gDaztSa09dufULXa+KZAQuMb",
+	"qg0r90vXr3+o7PyZywk8bXxTdXnITl2e1q7cnxaNbe7M++UzH+k8NmUxW1O9wXNnZmFL2QQCv0GQ2xoT",
+	"Gzto7ASfAEtVN2Tm99MsWaNa6ZQKSbiv5bA2pVam+BhS0dee+BNJRotHhO1prkPy++9pu3C80K9mUZq9",
+	"USqG5FA6w+O1zrCSxVg/KyugcobspJ/H06RRAMflaSM+Dz7OnfgMQwECrrahxKfbGquhBo4uorUXipb4",
+	"S+WiMMbq4DOtbQW8OUIdz/3oZqEUdeWjk22vzNaHFACPnjeLoBpmoWMPVAtoDBIMtVY8Vo5IhV37aTlJ",
+	"vFGTPTfDTyqj15f60Zk+EfHfiJTOJ7EvEjrf0/aK1U8soPPRyflLsHDYdospFXichT8Tk283aJM2o4TO",
+	"rbnjMyF88x7zKFjbpAFIGhPMCTd2IkCiO0ubCSh0yUHJ0KIQM5vEkae2DJopdFhCN8IS7d+S8Yyxa3XW",
+	"6nD3b44GV2D+ABs8LMrJIsOJAYfcUehnoH8bIN3PVg+jApJGbHIFYrrNJjZ/VlqFmEE9PKS7CzGu4+za",
+	"jDAt597hUNWG9defjlWjlRxart4yT1rlSpnxqeHIIRVpb+Mc63ojEVSPlnliWddado4HQaSCFgXgrofE",
+	"/ZQv93iRNyMTLL62gH3pHqlaQ2VEu1RcNYoH7mjrFsBwFhA4ozoM9l3MzC2nMgiZucqHsI4N0TG1Dc0S",
+	"urrhwhTfVyuQyYQkOotLr2Vm8qCFcw2QD3GDzKgDZLFtS56W1qZCF4DDpgAsJ1Md40juFhyqfDdxax1A",
+	"ZE9HHdUm78Xwe5hxq1ic2HSfaUzOKV8iXuSO/pFB8cpL4CRFe0qVH9bo4HgTDLn/VGbfoK97CvPD+eFD",
+	"9HU/kv339p+rkmLsOB0clzZqtG9888J7e0z6g/ncDqLLw9AfyJYPxPgh72M+bU3qFbYYKRTTQjBgbJm8",
+	"+tynIgS9KtvpYahW3Q1NDEzUtXm57pvyI4PlPIuHK9utfHL0UrpXmE+Rq474qRLO/nvMp+o/gs6PK+Pj",
+	"zNjGvIU3AQp0YTdQ7e1nqkK0znBJZipY+JIhTiacCOgVov/cRwssYLFfzI+/IO15Rg5vg9VyZcinr11n",
+	"x1YnOs21doIl8UAYRcyDZpH3hbB7acrbNV/FHOquBNHW9Ts2vz4WKZ80v9UXyDfm/Ig3KB7try/Krm4i",
+	"kasiRYIHuS+xL6RKDzNBCQvOEhPaDCGYgkjU6Gct6Vuw/KbeKDvNSE/TdpbhrUGwqmmroKOy1zBjfE+m",
+	"1LCfaaHfMHo6hwTgRbmvLWGBbA3MKCFkc8dpCSErXFLt6K3MtN3TohyCrxCo8dcRcWvpnfpo99+X/vu8",
+	"o4soRzTfc4H6ZXLRPDg1ZkAVOqXLrocNwHRHhKs8lE8wPkWLdhIAq1qdBNa9Eg1nFrPgte61e6SFLdUC",
+	"KNIFrIokkMl5x0sQ6HP3u32Thb/m3rszeENvO+XOdWreD1uS3zNwTXzwfIIuoLsCKrkGglC0PqjyOpNY",
+	"GYZIWP0mLIYtZ9Xit0Iyjqeg+eggRSyJumGobVljh7frOqtwyog2/cxMgeMYFzYI3Z4KqzO1UaMdizAq",
+	"Dd8Fx9sPb+PKWku7vsDn4eIf4cU+qi3aNchqrd1/HtxBSKL+rv7vPE/JXSu/iDVqIwoZKblTdxNK/tqG",
+	"7GoWuJ+6mZupEh3Zslu8y2aD8tH3wsCKhjLqsLe0IgIaSHxUjOe0TOUjSTZS1kpUqyaxjGArk3AXjvN2",
+	"xWkGnMjcCgF73J4f2XftA8qsdwYEEZE4ur5utdSct03ad43ur3WV/0h1OpdS9Yz74ejgAL3+wXsgyA3J",
+	"jRuCE/1mMghQixHOGRdgjYB/W8/IRGUQa1NoLhYksc6U8OOglL59gf0Ce3nnBv2CdC3nBlhjrhK3VdN+",
+	"bOzWJCn6UqHFVPHuB8gLsVaaRO2X5pbtfNVwpex5fBzd713JsFKvte8pv7MQNtd6lbUqqE4IaYDmq8b3",
+	"8YUf8fGSDdu6NJYbcNq2nH+GQm8W1Q1E8x+s4OjZ2aXTJtchi/33riluh0Id1T61zaqW73t/32VOV9fh",
+	"eLAoOdfYcYvajkHL4m00sth5m3xAIY3IW2nM1iODXtWlZi0z103QxKHoni7UGOcEZepvhnwq7c9M5QqY",
+	"XnMKofveB91CfSNxkge9QEzGad3RfhxxtONcBS4KyKomeQkwXWsytp/GSBlP5K7FNtk8d1B//llXNQD8",
+	"CoOGjYrN7IjW4Rj3aimGDZIsaAe1uZ03mOQTiTwMiXnt7AOw1ZYbkm1o8C1h5iNkIAQwfzo5CMcHjx8w",
+	"+i0khS7KQekCrcxfgL9XF2k0IleJ6mNElT4QW4xjZs0MhVZ8HXyse/OZ5imUJPqXUPuFpF89VN5C/WLt",
+	"qx7Kq21e4TbOT3v9XUC3ngB4oeDchRDQE324d0qGhn07zpv8rOhfIbqq1AYFkbQ/UOxANuybVt+raznA",
+	"QJvoTJX5Kewt2mxsCI50NyoazPRxz+ZD/wEueZfzK/JPlAmB27vOhGKcumKS8YS+YUBLZT7TNnStnXWP",
+	"6P/T86K3edbOjXSM/yb8SGcLNPKep0Qms8C2CaMb+cxb8/OnUERmY3Oh2kRztZ5IK5oNq76oT3dU9MW0",
+	"5dxQuYAN3//TUkP556v4YpDf7abtv1f/Z4zFqzVmGLwb15ir7WEIz5YCA14CvZDEjLZW/YgTWmfqKPfa",
+	"XL9Xb6VhZtCftpqPf58KchMdv/7hsyNhQxOrSXiCbxinbWpqnTfaZLQvBFKfF+r7tOIBEQPU2JbzqVtz",
+	"U1buZth56HAIW9w1Eb2D7rO6L6ik1ut+pUi3P6M6S1a360YTPTbo4+lawuMb7UamcxtKviDQnwyCXRqw",
+	"DBzMArW5BLEzfAwpYte6x25Du5IMAWLbm0a627XSWGiDO9WJK6LwK9RPF8aWTreLQv2hgaJX3HprHHIw",
+	"OQ9ShZAlU2F8iwwvEXaDvxCuD6bptznhmlrSBtJ9RuSKnX0kantwL2k7ld2HXW5V8TBHAbqZsNJ1y2gU",
+	"0ROFzz8GM/rY5PFh1f3nJCm4wu7eynATn+ftPvJiYI5Tolyx6mf1I8nlitbXF3aSIB5l0yiLykxtyRrz",
+	"ZQT89UTpS3xNEK5PA3IU5KOu8ntLyHW21PlxaZERX4gZOlzcQgt9eEEfPH5ycKA41OG3Tw4OVBMKNy0V",
+	"pukXiGJdsp8lgOWEIDwjOIUyEQIJibnU0hgCwCaUCxmOpk52kzQs0Tu4yl/r2fJwtCsQbZpdpn2UYUl4",
+	"MAYaVtjffe1e3ZRaY0SgjKnqGKLaoFSZFtlttDGF1xGqp7v59azOtFUOS32y7TPkj3ci+yOU2VufDey/",
+	"55UddoozityKJtbQR1jThWbVmqgdwdsUnnjtZyOEo4SxLv+oThLrDHW8m3iiTmfSLdqiejAbW5Y7nvs+",
+	"NGrrWmh8O/DaC95H+a5ioImrwpMv0aTgckZ48NazsiGsO44zTnC6BA5nWaziZrqUhy4X0tTy+EQj5F5I",
+	"8FPgJHp3G3KSDmqEPxeIH4MqLYSH2c9GOKAlK3RDI20P5uVTVL+p2Gb4vk3X+NOEvIoZu/VokDMsnbVD",
+	"yXbscTlhvI841vdAznDe9JWS8ZpHyxmZC5LdENGY8A1Tt2d8/9midL3auJWyqD9WFGU0RMHmBLLGVBy9",
+	"mkVAkzFoqbesdNGD+Mk5vjatYE38JXrrGtAHfdslQ/PyumETeZrbMsueEsKVOJkQUO0G6LUin1sqiO0R",
+	"j0oRmraRZ3t/eKt+ba2/rVDbumTQlWdapUetx/NW18o6UWUdFffC0pS4muHFApI5/GHdmhvpS1854RbE",
+	"3iLGIXTTCj0grFqisGFc1i4a1HfQXwfZH5B94frao9sZ8x3ssLgGygpKahlI+np5V+iu1EapBPAN4Rle",
+	"CN+STJsNb2mestsg1NevCS0kVCaY31NzmonDn/5eNSYJNueimv1zKk6t5QJaD0qtJVA++xpcG1yoBRay",
+	"UZM4NbY8LRJdK+G+KrqmVM2+sUdDJ8VA41ipJrzBGsbP2pe8RerJijMpFgmbK+hWnUutF7Q6D2coKGsj",
+	"Si6Zx2EW9v60tpMUunyq6g5KcALjoDmaFLLgZLXm99YC/dexNhzrWulEhgUf1liw1UNzJkOWzDjSPiar",
+	"QWohpbUetDrbRbcuy5EeQIXkWE1nVfNqHw5HVgqCJtMD+jJnkjxB5lUa1aNP2HzOcvRUqwLh0l+1WCT+",
+	"Sp36NFKnoklTpu1159oFNlmplrofZD95tSQkRJYjJZO8sq+vAss0S+ME2kk1khHo4vdQ5WDtIlp1QLpW",
+	"PoBPUWUTnyQ9uALdqwhBD/yIFNBQx7nDHQ2+/0TC+Q09XFYKOX8ahLCedXOnRk14visbVmJsbrXoDCub",
+	"lmC0tHbK2xnNiHnPGIO5sSNBKl+73XIngmplL5Lt4pIehFgD0+eab5USRbH5XC3awZlqh2qXYfXw+4hl",
+	"qTZxU665zWtVGb6kWPWdUuWe+OY30LdD1QUoTY12y3Z4D53YzWyptNp5PuO8DG+6dgj86NyssSdrmiq9",
+	"1cBl069TKpJCVyCPEtiOSMosusqTG5LB1pYVO8+G7ttwjv9mrV/NriFIYSs+R25audxu34tnejFtKvAt",
+	"M0L2GDj5lE9ZQOV9sCZQudQ1ebUMnWt7pZ6ujwRzJlI1tXnmxzttVDgjQPQnsSnAZj6VDu2W4ZCbB2Gy",
+	"Kwn/TpI8fWCVEYAANm+rDakyQDUV0vq8TfCv6cuMc1u/QzKJMzdH2K0bOthIkgv9g3ZQqbtK7hIC/brR",
+	"HN/ReTEvgVBxKQ2u8vNJxM9krC9gE8RZv7ycLihibIhFLmkWejfhGyJU4cboNT3TyNnCjF+eYCP7fWmK",
+	"/2ZCBvYeENx2gkbPtg/H/yDXbggEp+RSSpKM5gRhR56eZiWDLZeULOSDHDQxC8LhKo1JSNNlXStO1hd6",
+	"8Jldb7PuoqUp/qLwjbuLQnCIl1XBqWxA4w9I3HYrNclhLejYJYY0E7KLEuUVIxlyIkuJDu8/phyxWx+X",
+	"0g/rGkKoQnXOqlxpuSJbcP7yBB82C+qCKToRzvrkQoFc2DVZi1zozp6ac5qX/HlGIwGYfIVLckNZIbKl",
+	"HZYO0JmOq1MCIYg+Rk0Hya5Ju7Hqszc4XRiUrS0nIatzvroAXLzpp/e2Zmw6JSmieUvE/jMiX25WJG1Y",
+	"yFk5sdmx+Ypem/sIpogF2SbpWDYQ+vM642o/JRmZYpvf1F6zv7qKzhSCz4ER2v9y3d/SlKTaW+llutYH",
+	"ljoVxYUgNBC7WvXUwtelwFIJhlvsi+rtJAgUAFIxltyt0tskQ0uWwJzZCKIgiZCp8rQznE2sClRKIVlR",
+	"IjBnpYMQRDaScDN2uwTjwMe1DrU7CA/vhONo5tOISEiBMwjw8VndkOtzUsCf4CQvy1FG8I16uv1of7HL",
+	"mLcZ8Kt++XypaLoGQcBHGQYTrwVj+1e5f31Wwn0x9zHbRvPWcWFATV5VccCoD+aYXwM49s+p9ZeY6b8Q",
+	"CBcplUhyTLMms8+oQj5r6hTB59uFhDVQ4YNkm4660W6NEYelCFYYDsMwjFbx5AoEPFDu/UqJ5nhFtUqe",
+	"RE9ZkafRWIpm6da/pyIOGgrCb+y0Bc96T3ozKRdP9vczluBsxoR88t3Bdwe9Dz850N7bNR2IH/rub5DW",
+	"H/whLB4leh9++vD/BgAQZVkSCJUBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
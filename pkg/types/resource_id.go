@@ -49,3 +49,15 @@ func NewProviderSetupID() string {
 func NewDeploymentID() string {
 	return newResourceID("dep")
 }
+
+func NewRequestCommentID() string {
+	return newResourceID("cmt")
+}
+
+func NewRecurringRequestID() string {
+	return newResourceID("rrq")
+}
+
+func NewBulkRevocationID() string {
+	return newResourceID("brv")
+}
@@ -1,5 +1,6 @@
 import {
   Box,
+  Button,
   Stack,
   Table,
   TableContainer,
@@ -17,29 +18,56 @@ import {
   Skeleton,
   Text,
 } from "@chakra-ui/react";
-import React, { useMemo } from "react";
+import React, { useMemo, useState } from "react";
 import {
   useUserGetUser,
   useUserListRequestEvents,
+  userListRequestEvents,
 } from "../utils/backend-client/end-user/end-user";
-import { RequestDetail } from "../utils/backend-client/types";
+import {
+  ListRequestEventsResponseResponse,
+  RequestDetail,
+} from "../utils/backend-client/types";
 import { renderTiming } from "../utils/renderTiming";
 import { CFTimelineRow } from "./CFTimelineRow";
 export const AuditLog: React.FC<{ request?: RequestDetail }> = ({
   request,
 }) => {
-  const { data } = useUserListRequestEvents(request?.id || "", {
+  // the first page is refreshed so that new events appear, and later pages are loaded on demand.
+  const { data } = useUserListRequestEvents(request?.id || "", undefined, {
     swr: {
       refreshInterval: 5000,
     },
   });
+  const [morePages, setMorePages] = useState<
+    ListRequestEventsResponseResponse[]
+  >([]);
+  const [loadingMore, setLoadingMore] = useState(false);
+
+  const nextToken =
+    morePages.length > 0 ? morePages[morePages.length - 1].next : data?.next;
+
+  const loadMore = async () => {
+    if (!request || !nextToken) return;
+    setLoadingMore(true);
+    try {
+      const page = await userListRequestEvents(request.id, { nextToken });
+      setMorePages((pages) => [...pages, page]);
+    } finally {
+      setLoadingMore(false);
+    }
+  };
 
   const events = useMemo(() => {
     const items: JSX.Element[] = [];
+    const allEvents = [
+      ...(data?.events ?? []),
+      ...morePages.flatMap((p) => p.events),
+    ];
     // use map here to ensure order is preserved
     // foreach is not synchronous
-    const l = data?.events.length || 0;
-    data?.events.forEach((e, i) => {
+    const l = allEvents.length;
+    allEvents.forEach((e, i) => {
       if (e.grantCreated) {
         items.push(
           <CFTimelineRow
@@ -176,6 +204,32 @@ export const AuditLog: React.FC<{ request?: RequestDetail }> = ({
             timestamp={new Date(e.createdAt)}
           />
         );
+      } else if (e.reminderSent) {
+        items.push(
+          <CFTimelineRow
+            arrLength={l}
+            header={<Text>Reviewers were reminded to review the request</Text>}
+            index={i}
+            key={i}
+            timestamp={new Date(e.createdAt)}
+          />
+        );
+      } else if (e.grantDrift) {
+        items.push(
+          <CFTimelineRow
+            arrLength={l}
+            header={
+              <Text>
+                {e.grantDrift === "MISSING"
+                  ? "Access was removed from the provider outside of Common Fate"
+                  : "Access was found in the provider after the grant ended"}
+              </Text>
+            }
+            index={i}
+            key={i}
+            timestamp={new Date(e.createdAt)}
+          />
+        );
       } else if (e.requestCreated) {
         items.push(
           <CFTimelineRow
@@ -200,7 +254,13 @@ export const AuditLog: React.FC<{ request?: RequestDetail }> = ({
                 <Text>
                   {`Action performed by `}
                   <UserText userId={e.actor || ""} />
+                  {e.recordedEventSource && ` in ${e.recordedEventSource}`}
                 </Text>
+                {e.sessionId && (
+                  <Text fontSize="sm" color="GrayText">
+                    Session {e.sessionId}
+                  </Text>
+                )}
                 <TableContainer>
                   <Table size="sm" variant={"unstyled"}>
                     <Tbody>
@@ -231,7 +291,7 @@ export const AuditLog: React.FC<{ request?: RequestDetail }> = ({
       }
     });
     return items;
-  }, [data]);
+  }, [data, morePages]);
   if (!request || data === undefined) {
     return (
       <VStack flex={1} align="left">
@@ -249,6 +309,17 @@ export const AuditLog: React.FC<{ request?: RequestDetail }> = ({
         Audit Log
       </Box>
       {events}
+      {nextToken && (
+        <Button
+          variant="link"
+          size="sm"
+          alignSelf="start"
+          isLoading={loadingMore}
+          onClick={loadMore}
+        >
+          Load more
+        </Button>
+      )}
     </VStack>
   );
 };
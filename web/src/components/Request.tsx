@@ -165,6 +165,7 @@ export const RequestStatusDisplay: React.FC<{
       danger={[
         RequestStatus.DECLINED,
         RequestStatus.CANCELLED,
+        RequestStatus.EXPIRED,
         GrantStatus.REVOKED,
       ]}
       warning={RequestStatus.PENDING}
@@ -79,7 +79,11 @@ export const RequestStatusCell: React.FC<RequestStatusCellProps> = ({
     <StatusCell
       value={isAuto ? "Automatically approved" : value}
       success={[RequestStatus.APPROVED, "Automatically approved"]}
-      danger={[RequestStatus.DECLINED, RequestStatus.CANCELLED]}
+      danger={[
+        RequestStatus.DECLINED,
+        RequestStatus.CANCELLED,
+        RequestStatus.EXPIRED,
+      ]}
       warning={RequestStatus.PENDING}
       textStyle="Body/Small"
       {...rest}
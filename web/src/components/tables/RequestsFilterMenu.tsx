@@ -30,6 +30,8 @@ export const RequestsFilterMenu: React.FC<{
           ? "Approved only"
           : status === "CANCELLED"
           ? "Cancelled only"
+          : status === "EXPIRED"
+          ? "Expired only"
           : "All"}
       </MenuButton>
       <MenuList>
@@ -47,6 +49,8 @@ export const RequestsFilterMenu: React.FC<{
               ? "apr"
               : status === "CANCELLED"
               ? "can"
+              : status === "EXPIRED"
+              ? "exp"
               : "all"
           }
           onChange={(e) => {
@@ -63,6 +67,9 @@ export const RequestsFilterMenu: React.FC<{
               case "can":
                 onChange(RequestStatus.CANCELLED);
                 break;
+              case "exp":
+                onChange(RequestStatus.EXPIRED);
+                break;
               default:
                 onChange(undefined);
             }
@@ -73,6 +80,7 @@ export const RequestsFilterMenu: React.FC<{
           <MenuItemOption value="den">Declined only</MenuItemOption>
           <MenuItemOption value="apr">Approved only</MenuItemOption>
           <MenuItemOption value="can">Cancelled only</MenuItemOption>
+          <MenuItemOption value="exp">Expired only</MenuItemOption>
         </MenuOptionGroup>
       </MenuList>
     </Menu>
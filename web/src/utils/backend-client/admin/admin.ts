@@ -36,6 +36,9 @@ import type {
   ProviderSetupInstructions,
   CompleteProviderSetupResponseResponse,
   ProviderSetupStepCompleteRequestBody,
+  SCIMTokenResponseResponse,
+  IdentitySyncDryRunResponseResponse,
+  IdentitySyncDryRunRequestBody,
   IdentityConfigurationResponseResponse,
   TGHandler,
   AdminDeleteHandler204,
@@ -46,6 +49,12 @@ import type {
   CreateTargetGroupRequestBody,
   TargetRoute,
   CreateTargetGroupLinkBody,
+  ListGrantRetriesResponseResponse,
+  AdminListGrantRetriesParams,
+  GrantRetry,
+  BulkRevokeResponseResponse,
+  BulkRevokeRequestBody,
+  GrantStateResponseResponse,
   AdminRemoveTargetGroupLinkParams
 } from '.././types'
 import type {
@@ -927,6 +936,54 @@ export const adminSyncIdentity = (
     }
   
 
+/**
+ * Lists the users and groups from the identity provider and returns the changes a sync would make, without writing them.
+
+A group filter can be provided to preview the effect of changing the identity group filter. Returns a HTTP400 response if the group filter is not a valid regular expression.
+ * @summary Dry run Identity Sync
+ */
+export const adminDryRunIdentitySync = (
+    identitySyncDryRunRequestBody: IdentitySyncDryRunRequestBody,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<IdentitySyncDryRunResponseResponse>(
+      {url: `/api/v1/admin/identity/sync/dry-run`, method: 'post',
+      headers: {'Content-Type': 'application/json', },
+      data: identitySyncDryRunRequestBody
+    },
+      options);
+    }
+  
+
+/**
+ * Creates the bearer token which identity providers use to push users and groups to the SCIM endpoint at /webhook/v1/scim/v2.
+Creating a token replaces the existing token. The token is only returned once, as only a hash of it is stored.
+
+ * @summary Create SCIM token
+ */
+export const adminCreateScimToken = (
+    
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<SCIMTokenResponseResponse>(
+      {url: `/api/v1/admin/identity/scim-token`, method: 'post'
+    },
+      options);
+    }
+  
+
+/**
+ * Deletes the SCIM token, which disables the SCIM endpoint.
+ * @summary Delete SCIM token
+ */
+export const adminDeleteScimToken = (
+    
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<void>(
+      {url: `/api/v1/admin/identity/scim-token`, method: 'delete'
+    },
+      options);
+    }
+  
+
 /**
  * Get information about the identity configuration
  * @summary Get identity configuration
@@ -1206,6 +1263,148 @@ export const adminRemoveTargetGroupLink = (
     }
   
 
+/**
+ * Lists changes to grants which failed and are being retried, such as revocations which couldn't be completed.
+ * @summary List grant retries
+ */
+export const adminListGrantRetries = (
+    params?: AdminListGrantRetriesParams,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<ListGrantRetriesResponseResponse>(
+      {url: `/api/v1/admin/grant-retries`, method: 'get',
+        params
+    },
+      options);
+    }
+  
+
+export const getAdminListGrantRetriesKey = (params?: AdminListGrantRetriesParams,) => [`/api/v1/admin/grant-retries`, ...(params ? [params]: [])];
+
+    
+export type AdminListGrantRetriesQueryResult = NonNullable<Awaited<ReturnType<typeof adminListGrantRetries>>>
+export type AdminListGrantRetriesQueryError = ErrorType<ErrorResponseResponse>
+
+export const useAdminListGrantRetries = <TError = ErrorType<ErrorResponseResponse>>(
+ params?: AdminListGrantRetriesParams, options?: { swr?:SWRConfiguration<Awaited<ReturnType<typeof adminListGrantRetries>>, TError> & { swrKey?: Key, enabled?: boolean }, request?: SecondParameter<typeof customInstance> }
+
+  ) => {
+
+  const {swr: swrOptions, request: requestOptions} = options ?? {}
+
+  const isEnabled = swrOptions?.enabled !== false
+    const swrKey = swrOptions?.swrKey ?? (() => isEnabled ? getAdminListGrantRetriesKey(params) : null);
+  const swrFn = () => adminListGrantRetries(params, requestOptions);
+
+  const query = useSwr<Awaited<ReturnType<typeof swrFn>>, TError>(swrKey, swrFn, swrOptions)
+
+  return {
+    swrKey,
+    ...query
+  }
+}
+
+/**
+ * Attempts a failed change to a grant immediately, including changes which have exhausted their automatic retries.
+ * @summary Retry a grant change
+ */
+export const adminRetryGrantRetry = (
+    retryId: string,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<GrantRetry>(
+      {url: `/api/v1/admin/grant-retries/${retryId}/retry`, method: 'post'
+    },
+      options);
+    }
+  
+
+/**
+ * Stops retrying a failed change to a grant, such as after the access has been removed manually.
+ * @summary Abandon a grant change
+ */
+export const adminAbandonGrantRetry = (
+    retryId: string,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<GrantRetry>(
+      {url: `/api/v1/admin/grant-retries/${retryId}/abandon`, method: 'post'
+    },
+      options);
+    }
+  
+
+/**
+ * Queues every active or pending grant matching a filter to be revoked, such as all of the grants for a user during a security incident.
+At least one filter must be provided. Set dryRun to list the grants which would be revoked without revoking them.
+The grants are revoked in the background, poll the bulk revocation by its ID to follow its progress.
+
+ * @summary Bulk revoke grants
+ */
+export const adminBulkRevoke = (
+    bulkRevokeRequestBody: BulkRevokeRequestBody,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<BulkRevokeResponseResponse>(
+      {url: `/api/v1/admin/bulk-revoke`, method: 'post',
+      headers: {'Content-Type': 'application/json', },
+      data: bulkRevokeRequestBody
+    },
+      options);
+    }
+  
+
+/**
+ * Returns the progress of a bulk revocation, and the outcome of revoking each grant.
+ * @summary Get bulk revocation
+ */
+export const adminGetBulkRevocation = (
+    bulkRevocationId: string,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<BulkRevokeResponseResponse>(
+      {url: `/api/v1/admin/bulk-revoke/${bulkRevocationId}`, method: 'get'
+    },
+      options);
+    }
+  
+
+/**
+ * Returns the state which the target group handler returned when it provisioned access for the request.
+The state is passed back to the handler when access is removed. Returns a HTTP404 response if the grant hasn't been provisioned by a target group handler.
+
+ * @summary Get grant state
+ */
+export const adminGetRequestGrantState = (
+    requestId: string,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<GrantStateResponseResponse>(
+      {url: `/api/v1/admin/requests/${requestId}/grant-state`, method: 'get'
+    },
+      options);
+    }
+  
+
+export const getAdminGetRequestGrantStateKey = (requestId: string,) => [`/api/v1/admin/requests/${requestId}/grant-state`];
+
+    
+export type AdminGetRequestGrantStateQueryResult = NonNullable<Awaited<ReturnType<typeof adminGetRequestGrantState>>>
+export type AdminGetRequestGrantStateQueryError = ErrorType<ErrorResponseResponse>
+
+export const useAdminGetRequestGrantState = <TError = ErrorType<ErrorResponseResponse>>(
+ requestId: string, options?: { swr?:SWRConfiguration<Awaited<ReturnType<typeof adminGetRequestGrantState>>, TError> & { swrKey?: Key, enabled?: boolean }, request?: SecondParameter<typeof customInstance> }
+
+  ) => {
+
+  const {swr: swrOptions, request: requestOptions} = options ?? {}
+
+  const isEnabled = swrOptions?.enabled !== false && !!(requestId)
+    const swrKey = swrOptions?.swrKey ?? (() => isEnabled ? getAdminGetRequestGrantStateKey(requestId) : null);
+  const swrFn = () => adminGetRequestGrantState(requestId, requestOptions);
+
+  const query = useSwr<Awaited<ReturnType<typeof swrFn>>, TError>(swrKey, swrFn, swrOptions)
+
+  return {
+    swrKey,
+    ...query
+  }
+}
+
 /**
  * Runs the healthcheck for handlers
  * @summary Healthcheck Handlers
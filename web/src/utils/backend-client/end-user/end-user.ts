@@ -21,10 +21,24 @@ import type {
   CreateRequestRequestBody,
   UserListRequestsUpcomingParams,
   UserListRequestsPastParams,
+  DryRunRequestResponseResponse,
   RequestDetail,
   ListRequestEventsResponseResponse,
+  UserListRequestEventsParams,
+  ListRequestCommentsResponseResponse,
+  RequestComment,
+  CreateRequestCommentBody,
+  Delegation,
+  SetDelegateRequestBody,
+  ListRecurringRequestsResponseResponse,
+  RecurringRequest,
+  CreateRecurringRequestRequestBody,
+  RecurringRequestDetailResponseResponse,
   ReviewResponseResponse,
   ReviewRequestBody,
+  ExtendRequestResponseResponse,
+  ExtendRequestBody,
+  ReviewExtensionRequestBody,
   UserCancelRequest200,
   AccessTokenResponseResponse,
   User,
@@ -313,6 +327,25 @@ export const useUserListRequestsPast = <TError = ErrorType<unknown>>(
   }
 }
 
+/**
+ * Checks what would happen if a request was made, without creating the request or granting any access.
+
+Returns the results of the provider's grant validation, the approvers who would be asked to review the request, any existing grants which the request overlaps, and the time window the grant would be active for.
+Returns a HTTP400 response if the request would fail validation when it is created.
+ * @summary Dry run a request
+ */
+export const userDryRunRequest = (
+    createRequestRequestBody: CreateRequestRequestBody,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<DryRunRequestResponseResponse>(
+      {url: `/api/v1/requests/dry-run`, method: 'post',
+      headers: {'Content-Type': 'application/json', },
+      data: createRequestRequestBody
+    },
+      options);
+    }
+  
+
 /**
  * Returns a HTTP401 response if the user is not the requestor or a reviewer.
 
@@ -362,30 +395,73 @@ export const useUserGetRequest = <TError = ErrorType<ErrorResponseResponse>>(
  */
 export const userListRequestEvents = (
     requestId: string,
+    params?: UserListRequestEventsParams,
  options?: SecondParameter<typeof customInstance>) => {
       return customInstance<ListRequestEventsResponseResponse>(
-      {url: `/api/v1/requests/${requestId}/events`, method: 'get'
+      {url: `/api/v1/requests/${requestId}/events`, method: 'get',
+        params
     },
       options);
     }
   
 
-export const getUserListRequestEventsKey = (requestId: string,) => [`/api/v1/requests/${requestId}/events`];
+export const getUserListRequestEventsKey = (requestId: string,
+    params?: UserListRequestEventsParams,) => [`/api/v1/requests/${requestId}/events`, ...(params ? [params]: [])];
 
     
 export type UserListRequestEventsQueryResult = NonNullable<Awaited<ReturnType<typeof userListRequestEvents>>>
 export type UserListRequestEventsQueryError = ErrorType<ErrorResponseResponse>
 
 export const useUserListRequestEvents = <TError = ErrorType<ErrorResponseResponse>>(
- requestId: string, options?: { swr?:SWRConfiguration<Awaited<ReturnType<typeof userListRequestEvents>>, TError> & { swrKey?: Key, enabled?: boolean }, request?: SecondParameter<typeof customInstance> }
+ requestId: string,
+    params?: UserListRequestEventsParams, options?: { swr?:SWRConfiguration<Awaited<ReturnType<typeof userListRequestEvents>>, TError> & { swrKey?: Key, enabled?: boolean }, request?: SecondParameter<typeof customInstance> }
 
   ) => {
 
   const {swr: swrOptions, request: requestOptions} = options ?? {}
 
   const isEnabled = swrOptions?.enabled !== false && !!(requestId)
-    const swrKey = swrOptions?.swrKey ?? (() => isEnabled ? getUserListRequestEventsKey(requestId) : null);
-  const swrFn = () => userListRequestEvents(requestId, requestOptions);
+    const swrKey = swrOptions?.swrKey ?? (() => isEnabled ? getUserListRequestEventsKey(requestId,params) : null);
+  const swrFn = () => userListRequestEvents(requestId,params, requestOptions);
+
+  const query = useSwr<Awaited<ReturnType<typeof swrFn>>, TError>(swrKey, swrFn, swrOptions)
+
+  return {
+    swrKey,
+    ...query
+  }
+}
+
+/**
+ * Returns the delegate who reviews requests on behalf of the current user. Returns a HTTP404 response if no delegate is set.
+ * @summary Get your delegate
+ */
+export const userGetDelegate = (
+    
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<Delegation>(
+      {url: `/api/v1/users/me/delegate`, method: 'get'
+    },
+      options);
+    }
+  
+
+export const getUserGetDelegateKey = () => [`/api/v1/users/me/delegate`];
+
+    
+export type UserGetDelegateQueryResult = NonNullable<Awaited<ReturnType<typeof userGetDelegate>>>
+export type UserGetDelegateQueryError = ErrorType<ErrorResponseResponse>
+
+export const useUserGetDelegate = <TError = ErrorType<ErrorResponseResponse>>(
+  options?: { swr?:SWRConfiguration<Awaited<ReturnType<typeof userGetDelegate>>, TError> & { swrKey?: Key, enabled?: boolean }, request?: SecondParameter<typeof customInstance> }
+
+  ) => {
+
+  const {swr: swrOptions, request: requestOptions} = options ?? {}
+
+  const isEnabled = swrOptions?.enabled !== false
+    const swrKey = swrOptions?.swrKey ?? (() => isEnabled ? getUserGetDelegateKey() : null);
+  const swrFn = () => userGetDelegate(requestOptions);
 
   const query = useSwr<Awaited<ReturnType<typeof swrFn>>, TError>(swrKey, swrFn, swrOptions)
 
@@ -395,6 +471,211 @@ export const useUserListRequestEvents = <TError = ErrorType<ErrorResponseRespons
   }
 }
 
+/**
+ * Sets a delegate to review requests on behalf of the current user, such as while they are on leave.
+While the delegation is active, the delegate is added as a reviewer to requests the current user would review,
+including requests which are already pending.
+Reviews made by a delegate are marked as delegated in the request's audit trail.
+
+ * @summary Set your delegate
+ */
+export const userSetDelegate = (
+    setDelegateRequestBody: SetDelegateRequestBody,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<Delegation>(
+      {url: `/api/v1/users/me/delegate`, method: 'put',
+      headers: {'Content-Type': 'application/json', },
+      data: setDelegateRequestBody
+    },
+      options);
+    }
+  
+
+/**
+ * Removes the current user's delegate. The delegate is not added as a reviewer to any new requests.
+ * @summary Remove your delegate
+ */
+export const userRemoveDelegate = (
+    
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<void>(
+      {url: `/api/v1/users/me/delegate`, method: 'delete'
+    },
+      options);
+    }
+  
+
+/**
+ * Lists the recurring requests made by the current user.
+ * @summary List my recurring requests
+ */
+export const userListRecurringRequests = (
+    
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<ListRecurringRequestsResponseResponse>(
+      {url: `/api/v1/recurring-requests`, method: 'get'
+    },
+      options);
+    }
+  
+
+export const getUserListRecurringRequestsKey = () => [`/api/v1/recurring-requests`];
+
+    
+export type UserListRecurringRequestsQueryResult = NonNullable<Awaited<ReturnType<typeof userListRecurringRequests>>>
+export type UserListRecurringRequestsQueryError = ErrorType<unknown>
+
+export const useUserListRecurringRequests = <TError = ErrorType<unknown>>(
+  options?: { swr?:SWRConfiguration<Awaited<ReturnType<typeof userListRecurringRequests>>, TError> & { swrKey?: Key, enabled?: boolean }, request?: SecondParameter<typeof customInstance> }
+
+  ) => {
+
+  const {swr: swrOptions, request: requestOptions} = options ?? {}
+
+  const isEnabled = swrOptions?.enabled !== false
+    const swrKey = swrOptions?.swrKey ?? (() => isEnabled ? getUserListRecurringRequestsKey() : null);
+  const swrFn = () => userListRecurringRequests(requestOptions);
+
+  const query = useSwr<Awaited<ReturnType<typeof swrFn>>, TError>(swrKey, swrFn, swrOptions)
+
+  return {
+    swrKey,
+    ...query
+  }
+}
+
+/**
+ * Make a recurring request for access on a weekly schedule, such as every weekday from 09:00 to 17:00.
+A request is created for each occurrence ahead of its start time. The first occurrence is requested immediately.
+Once an occurrence has been approved, later occurrences are approved automatically for as long as the Access Rule allows.
+
+ * @summary Create a recurring request
+ */
+export const userCreateRecurringRequest = (
+    createRecurringRequestRequestBody: CreateRecurringRequestRequestBody,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<RecurringRequest>(
+      {url: `/api/v1/recurring-requests`, method: 'post',
+      headers: {'Content-Type': 'application/json', },
+      data: createRecurringRequestRequestBody
+    },
+      options);
+    }
+  
+
+/**
+ * Returns a recurring request made by the current user, along with each request in the series.
+ * @summary Get a recurring request
+ */
+export const userGetRecurringRequest = (
+    recurringRequestId: string,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<RecurringRequestDetailResponseResponse>(
+      {url: `/api/v1/recurring-requests/${recurringRequestId}`, method: 'get'
+    },
+      options);
+    }
+  
+
+export const getUserGetRecurringRequestKey = (recurringRequestId: string,) => [`/api/v1/recurring-requests/${recurringRequestId}`];
+
+    
+export type UserGetRecurringRequestQueryResult = NonNullable<Awaited<ReturnType<typeof userGetRecurringRequest>>>
+export type UserGetRecurringRequestQueryError = ErrorType<ErrorResponseResponse>
+
+export const useUserGetRecurringRequest = <TError = ErrorType<ErrorResponseResponse>>(
+ recurringRequestId: string, options?: { swr?:SWRConfiguration<Awaited<ReturnType<typeof userGetRecurringRequest>>, TError> & { swrKey?: Key, enabled?: boolean }, request?: SecondParameter<typeof customInstance> }
+
+  ) => {
+
+  const {swr: swrOptions, request: requestOptions} = options ?? {}
+
+  const isEnabled = swrOptions?.enabled !== false && !!(recurringRequestId)
+    const swrKey = swrOptions?.swrKey ?? (() => isEnabled ? getUserGetRecurringRequestKey(recurringRequestId) : null);
+  const swrFn = () => userGetRecurringRequest(recurringRequestId, requestOptions);
+
+  const query = useSwr<Awaited<ReturnType<typeof swrFn>>, TError>(swrKey, swrFn, swrOptions)
+
+  return {
+    swrKey,
+    ...query
+  }
+}
+
+/**
+ * Stops a recurring request from creating any further requests. Requests which have already been created are not affected.
+ * @summary Cancel a recurring request
+ */
+export const userCancelRecurringRequest = (
+    recurringRequestId: string,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<RecurringRequest>(
+      {url: `/api/v1/recurring-requests/${recurringRequestId}/cancel`, method: 'post'
+    },
+      options);
+    }
+  
+
+/**
+ * Lists the comments on an access request, oldest first.
+Only the requestor, reviewers of the request and administrators can view comments.
+
+ * @summary List request comments
+ */
+export const userListRequestComments = (
+    requestId: string,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<ListRequestCommentsResponseResponse>(
+      {url: `/api/v1/requests/${requestId}/comments`, method: 'get'
+    },
+      options);
+    }
+  
+
+export const getUserListRequestCommentsKey = (requestId: string,) => [`/api/v1/requests/${requestId}/comments`];
+
+    
+export type UserListRequestCommentsQueryResult = NonNullable<Awaited<ReturnType<typeof userListRequestComments>>>
+export type UserListRequestCommentsQueryError = ErrorType<ErrorResponseResponse>
+
+export const useUserListRequestComments = <TError = ErrorType<ErrorResponseResponse>>(
+ requestId: string, options?: { swr?:SWRConfiguration<Awaited<ReturnType<typeof userListRequestComments>>, TError> & { swrKey?: Key, enabled?: boolean }, request?: SecondParameter<typeof customInstance> }
+
+  ) => {
+
+  const {swr: swrOptions, request: requestOptions} = options ?? {}
+
+  const isEnabled = swrOptions?.enabled !== false && !!(requestId)
+    const swrKey = swrOptions?.swrKey ?? (() => isEnabled ? getUserListRequestCommentsKey(requestId) : null);
+  const swrFn = () => userListRequestComments(requestId, requestOptions);
+
+  const query = useSwr<Awaited<ReturnType<typeof swrFn>>, TError>(swrKey, swrFn, swrOptions)
+
+  return {
+    swrKey,
+    ...query
+  }
+}
+
+/**
+ * Adds a comment to the discussion on an access request.
+Only the requestor, reviewers of the request and administrators can comment.
+
+ * @summary Comment on a request
+ */
+export const userCreateRequestComment = (
+    requestId: string,
+    createRequestCommentBody: CreateRequestCommentBody,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<RequestComment>(
+      {url: `/api/v1/requests/${requestId}/comments`, method: 'post',
+      headers: {'Content-Type': 'application/json', },
+      data: createRequestCommentBody
+    },
+      options);
+    }
+  
+
 /**
  * Review an access request made by a user. The reviewing user must be an approver for a request. Users cannot review their own requests, even if they are an approver for the Access Rule.
  * @summary Review a request
@@ -412,6 +693,42 @@ export const userReviewRequest = (
     }
   
 
+/**
+ * Users can extend the duration of an access request which has an active grant.
+The total duration, including the extension, must not exceed the maximum duration of the Access Rule.
+If the Access Rule requires approval, the extension is pending until a reviewer approves it.
+ * @summary Extend an active request
+ */
+export const userExtendRequest = (
+    requestId: string,
+    extendRequestBody: ExtendRequestBody,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<ExtendRequestResponseResponse>(
+      {url: `/api/v1/requests/${requestId}/extend`, method: 'post',
+      headers: {'Content-Type': 'application/json', },
+      data: extendRequestBody
+    },
+      options);
+    }
+  
+
+/**
+ * Approve or decline a pending extension to an access request. The reviewing user must be a reviewer of the request.
+ * @summary Review a request extension
+ */
+export const userReviewExtension = (
+    requestId: string,
+    reviewExtensionRequestBody: ReviewExtensionRequestBody,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<ExtendRequestResponseResponse>(
+      {url: `/api/v1/requests/${requestId}/extend/review`, method: 'post',
+      headers: {'Content-Type': 'application/json', },
+      data: reviewExtensionRequestBody
+    },
+      options);
+    }
+  
+
 /**
  * Users can cancel an access request that they have created while it is in the PENDING state.
  * @summary Cancel a request
@@ -22,6 +22,8 @@ When a rule is updated, it's ID remains consistent.
   target: AccessRuleTarget;
   timeConstraints: TimeConstraints;
   isCurrent: boolean;
+  /** true if requests for this rule can use break-glass access to skip approval. */
+  breakGlass?: boolean;
   createdAt: string;
   updatedAt: string;
 }
@@ -10,6 +10,7 @@ import type { ApproverConfig } from './approverConfig';
 import type { AccessRuleMetadata } from './accessRuleMetadata';
 import type { AccessRuleTargetDetail } from './accessRuleTargetDetail';
 import type { TimeConstraints } from './timeConstraints';
+import type { SeparationOfDuties } from './separationOfDuties';
 
 /**
  * AccessRuleDetail contains detailed information about a rule and is used in administrative apis.
@@ -30,4 +31,9 @@ When a rule is updated, it's ID remains consistent.
   target: AccessRuleTargetDetail;
   timeConstraints: TimeConstraints;
   isCurrent: boolean;
+  /** If true, users may request break-glass access for this rule during an emergency.
+Break-glass requests skip approval, but must include a reason and are reviewed by an approver after access has been granted.
+ */
+  breakGlass?: boolean;
+  separationOfDuties?: SeparationOfDuties;
 }
@@ -0,0 +1,20 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { GrantValidationStatus } from './grantValidationStatus';
+import type { Log } from '../accesshandler-openapi.yml/log';
+
+/**
+ * A check that a grant will succeed, run without granting the access.
+ */
+export interface GrantValidation {
+  /** The ID of the validation step, such as `user-exists`. */
+  id: string;
+  name: string;
+  status: GrantValidationStatus;
+  logs: Log[];
+}
@@ -0,0 +1,19 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * The status of a grant validation step.
+ */
+export type GrantValidationStatus = typeof GrantValidationStatus[keyof typeof GrantValidationStatus];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const GrantValidationStatus = {
+  PASSED: 'PASSED',
+  FAILED: 'FAILED',
+} as const;
@@ -12,6 +12,8 @@ export * from './errorResponseResponse';
 export * from './grant';
 export * from './grantResponseResponse';
 export * from './grantStatus';
+export * from './grantValidation';
+export * from './grantValidationStatus';
 export * from './grantWith';
 export * from './group';
 export * from './groupOption';
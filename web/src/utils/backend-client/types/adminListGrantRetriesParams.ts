@@ -0,0 +1,10 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { GrantRetryStatus } from './grantRetryStatus';
+
+export type AdminListGrantRetriesParams = { status?: GrantRetryStatus };
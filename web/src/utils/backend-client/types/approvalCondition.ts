@@ -0,0 +1,23 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * Routes requests for particular argument values to a set of approvers.
+ */
+export interface ApprovalCondition {
+  /** The ID of the target argument to match. */
+  argumentId: string;
+  /** The argument values which match the condition. */
+  values: string[];
+  /** The user IDs of the approvers for matching requests. */
+  users?: string[];
+  /** The group IDs of the approvers for matching requests. */
+  groups?: string[];
+  /** The number of distinct approvals required for matching requests. Defaults to 1. */
+  quorum?: number;
+}
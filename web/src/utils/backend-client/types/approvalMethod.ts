@@ -16,4 +16,5 @@ export type ApprovalMethod = typeof ApprovalMethod[keyof typeof ApprovalMethod];
 export const ApprovalMethod = {
   AUTOMATIC: 'AUTOMATIC',
   REVIEWED: 'REVIEWED',
+  BREAKGLASS: 'BREAKGLASS',
 } as const;
@@ -0,0 +1,20 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * A single stage of a multi-stage approval policy.
+ */
+export interface ApprovalStage {
+  name: string;
+  /** The user IDs of the approvers for this stage. */
+  users?: string[];
+  /** The group IDs of the approvers for this stage. */
+  groups?: string[];
+  /** The number of distinct approvals required to complete this stage. Defaults to 1. */
+  quorum?: number;
+}
@@ -5,6 +5,8 @@
  * Common Fate API
  * OpenAPI spec version: 1.0
  */
+import type { ApprovalStage } from './approvalStage';
+import type { ApprovalCondition } from './approvalCondition';
 
 /**
  * Approver config for access rules
@@ -13,4 +15,21 @@ export interface ApproverConfig {
   /** The user IDs of the approvers for the request. */
   users?: string[];
   groups?: string[];
+  /** The number of distinct approvals required before access is granted. Defaults to 1. */
+  quorum?: number;
+  /** Ordered approval stages. When provided, each stage must reach its quorum in order before access is granted,
+and the top-level users, groups and quorum are ignored.
+ */
+  stages?: ApprovalStage[];
+  /** Conditions which route requests to different approvers based on the argument values selected in the request.
+The first matching condition is used in place of the approvers above. A condition without any users or groups approves matching requests automatically.
+ */
+  conditions?: ApprovalCondition[];
+  /** If set, requests which are still pending this many seconds after they were made are automatically closed with the EXPIRED status.
+ */
+  expiresAfterSeconds?: number;
+  /** If set, once an occurrence of a recurring request has been approved, later occurrences made within this many seconds are approved automatically.
+Otherwise, each occurrence of a recurring request must be approved.
+ */
+  recurringApprovalValiditySeconds?: number;
 }
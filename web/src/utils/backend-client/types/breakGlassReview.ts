@@ -0,0 +1,18 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { ReviewDecision } from './reviewDecision';
+
+/**
+ * The review of a break-glass request, made by an approver after access was granted.
+ */
+export interface BreakGlassReview {
+  reviewerId: string;
+  decision: ReviewDecision;
+  comment?: string;
+  reviewedAt: string;
+}
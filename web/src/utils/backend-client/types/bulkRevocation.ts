@@ -0,0 +1,22 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { BulkRevocationStatus } from './bulkRevocationStatus';
+
+/**
+ * The outcome of revoking the grant for a single request as part of a bulk revocation.
+ */
+export interface BulkRevocation {
+  requestId: string;
+  userId: string;
+  accessRuleId: string;
+  /** The provider or target group of the grant. */
+  provider: string;
+  status: BulkRevocationStatus;
+  /** The reason the grant couldn't be revoked. Failed revocations are retried automatically. */
+  error?: string;
+}
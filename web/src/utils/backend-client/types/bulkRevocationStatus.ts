@@ -0,0 +1,23 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * PENDING grants are queued to be revoked. REVOKED grants were revoked, and FAILED grants couldn't be revoked.
+DRY_RUN grants would be revoked if the bulk revocation wasn't a dry run.
+
+ */
+export type BulkRevocationStatus = typeof BulkRevocationStatus[keyof typeof BulkRevocationStatus];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const BulkRevocationStatus = {
+  PENDING: 'PENDING',
+  REVOKED: 'REVOKED',
+  FAILED: 'FAILED',
+  DRY_RUN: 'DRY_RUN',
+} as const;
@@ -0,0 +1,20 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+export type BulkRevokeRequestBody = {
+  userId?: string;
+  accessRuleId?: string;
+  /** Revoke grants for access rules which use this target group. */
+  targetGroupId?: string;
+  /** Revoke grants for access rules which use this Access Handler provider. */
+  providerId?: string;
+  /** Why the grants are being revoked. It is included in the audit record for the bulk revocation. */
+  reason?: string;
+  /** List the grants which would be revoked without revoking them. */
+  dryRun?: boolean;
+};
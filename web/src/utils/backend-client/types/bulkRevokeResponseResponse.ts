@@ -0,0 +1,17 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { BulkRevokeStatus } from './bulkRevokeStatus';
+import type { BulkRevocation } from './bulkRevocation';
+
+export type BulkRevokeResponseResponse = {
+  id: string;
+  dryRun: boolean;
+  status?: BulkRevokeStatus;
+  completedAt?: string;
+  revocations: BulkRevocation[];
+};
@@ -0,0 +1,22 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * PENDING bulk revocations are queued, IN_PROGRESS bulk revocations are revoking grants,
+and COMPLETED bulk revocations have attempted to revoke every grant.
+
+ */
+export type BulkRevokeStatus = typeof BulkRevokeStatus[keyof typeof BulkRevokeStatus];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const BulkRevokeStatus = {
+  PENDING: 'PENDING',
+  IN_PROGRESS: 'IN_PROGRESS',
+  COMPLETED: 'COMPLETED',
+} as const;
@@ -8,6 +8,7 @@
 import type { ApproverConfig } from './approverConfig';
 import type { CreateAccessRuleTarget } from './createAccessRuleTarget';
 import type { TimeConstraints } from './timeConstraints';
+import type { SeparationOfDuties } from './separationOfDuties';
 
 export type CreateAccessRuleRequestBody = {
   /** The group IDs that the access rule applies to. */
@@ -17,4 +18,7 @@ export type CreateAccessRuleRequestBody = {
   description: string;
   target: CreateAccessRuleTarget;
   timeConstraints: TimeConstraints;
+  /** Allow users to request break-glass access for this rule, skipping approval. */
+  breakGlass?: boolean;
+  separationOfDuties?: SeparationOfDuties;
 };
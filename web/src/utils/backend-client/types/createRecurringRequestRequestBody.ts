@@ -0,0 +1,16 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { CreateRequestWith } from './createRequestWith';
+import type { Recurrence } from './recurrence';
+
+export type CreateRecurringRequestRequestBody = {
+  accessRuleId: string;
+  reason?: string;
+  with?: CreateRequestWith;
+  recurrence: Recurrence;
+};
@@ -13,4 +13,8 @@ export type CreateRequestRequestBody = {
   reason?: string;
   timing: RequestTiming;
   with?: CreateRequestWithSubRequest;
+  /** Request break-glass access, skipping approval. Only allowed for Access Rules with break-glass enabled.
+A reason is required and the request will be reviewed by an approver after access is granted.
+ */
+  breakGlass?: boolean;
 };
@@ -9,5 +9,7 @@
 export type CreateTargetGroupLinkBody = {
   deploymentId: string;
   priority: number;
+  /** The relative share of requests sent to this route when the target group uses weighted routing. Defaults to 1. */
+  weight?: number;
   kind: string;
 };
@@ -6,8 +6,10 @@
  * OpenAPI spec version: 1.0
  */
 import type { TargetGroupFrom } from './targetGroupFrom';
+import type { RoutingStrategy } from './routingStrategy';
 
 export type CreateTargetGroupRequestBody = {
   from: TargetGroupFrom;
   id: string;
+  routingStrategy?: RoutingStrategy;
 };
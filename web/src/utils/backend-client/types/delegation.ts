@@ -0,0 +1,21 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * A time-boxed delegation of a user's reviews to another user.
+ */
+export interface Delegation {
+  /** The ID of the user whose reviews are delegated. */
+  userId: string;
+  /** The ID of the user who reviews requests on their behalf. */
+  delegateId: string;
+  startsAt: string;
+  endsAt: string;
+  reason?: string;
+  createdAt: string;
+}
@@ -0,0 +1,30 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { DryRunRequestWith } from './dryRunRequestWith';
+import type { GrantValidation } from './accesshandler-openapi.yml/grantValidation';
+
+/**
+ * What would happen if a request was made for a combination of arguments.
+ */
+export interface DryRunRequest {
+  /** The selected argument values. */
+  with: DryRunRequestWith;
+  /** The time the grant would start. */
+  start: string;
+  /** The time the grant would end. */
+  end: string;
+  /** false if the request would be approved automatically. */
+  requiresApproval: boolean;
+  /** The IDs of the users who would be asked to review the request. */
+  approvers: string[];
+  /** The IDs of requests with grants for the same access during the same time. Requests which don't require approval can't be made while they overlap an existing grant. */
+  overlappingRequests: string[];
+  /** false if the provider doesn't support validating grants. */
+  validationSupported: boolean;
+  validations: GrantValidation[];
+}
@@ -0,0 +1,12 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { DryRunRequest } from './dryRunRequest';
+
+export type DryRunRequestResponseResponse = {
+  requests: DryRunRequest[];
+};
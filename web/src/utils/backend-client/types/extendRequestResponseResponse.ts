@@ -0,0 +1,14 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { Request } from './request';
+
+export type ExtendRequestResponseResponse = {
+  request: Request;
+  /** true if the extension requires approval before it takes effect. */
+  extensionPending: boolean;
+};
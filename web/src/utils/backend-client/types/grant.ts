@@ -6,6 +6,7 @@
  * OpenAPI spec version: 1.0
  */
 import type { GrantStatus } from './grantStatus';
+import type { GrantRoute } from './grantRoute';
 
 /**
  * A temporary assignment of a user to a principal.
@@ -21,4 +22,5 @@ export interface Grant {
   start: string;
   /** The end time of the grant. */
   end: string;
+  route?: GrantRoute;
 }
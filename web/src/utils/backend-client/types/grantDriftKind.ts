@@ -0,0 +1,22 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * How the access in a provider differs from a grant.
+MISSING means the grant is active but the access has been removed from the provider.
+UNEXPECTED means the user has access in the provider after their grant ended.
+
+ */
+export type GrantDriftKind = typeof GrantDriftKind[keyof typeof GrantDriftKind];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const GrantDriftKind = {
+  MISSING: 'MISSING',
+  UNEXPECTED: 'UNEXPECTED',
+} as const;
@@ -0,0 +1,28 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { GrantRetryAction } from './grantRetryAction';
+import type { GrantRetryStatus } from './grantRetryStatus';
+
+/**
+ * A change to a grant which failed and is retried with exponential backoff.
+ */
+export interface GrantRetry {
+  id: string;
+  requestId: string;
+  action: GrantRetryAction;
+  status: GrantRetryStatus;
+  /** The number of times the change has failed. */
+  attempts: number;
+  /** The number of failures after which the change is no longer retried automatically. */
+  maxAttempts: number;
+  /** The error from the most recent failed attempt. */
+  lastError?: string;
+  nextAttemptAt: string;
+  createdAt: string;
+  updatedAt: string;
+}
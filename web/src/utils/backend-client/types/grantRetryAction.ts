@@ -0,0 +1,23 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * The change to the grant which is being retried.
+ACTIVATE and DEACTIVATE provision and remove access for target group grants.
+REVOKE removes access before the grant ends.
+
+ */
+export type GrantRetryAction = typeof GrantRetryAction[keyof typeof GrantRetryAction];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const GrantRetryAction = {
+  ACTIVATE: 'ACTIVATE',
+  DEACTIVATE: 'DEACTIVATE',
+  REVOKE: 'REVOKE',
+} as const;
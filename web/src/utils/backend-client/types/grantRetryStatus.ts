@@ -0,0 +1,24 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * PENDING retries are attempted automatically once they are due.
+EXHAUSTED retries have failed too many times to be attempted automatically, and must be retried or abandoned by an administrator.
+SUCCEEDED retries have been completed, and ABANDONED retries won't be attempted again.
+
+ */
+export type GrantRetryStatus = typeof GrantRetryStatus[keyof typeof GrantRetryStatus];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const GrantRetryStatus = {
+  PENDING: 'PENDING',
+  EXHAUSTED: 'EXHAUSTED',
+  SUCCEEDED: 'SUCCEEDED',
+  ABANDONED: 'ABANDONED',
+} as const;
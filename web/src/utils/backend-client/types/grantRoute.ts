@@ -0,0 +1,15 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * The target group route which was used to provision a grant.
+ */
+export interface GrantRoute {
+  handlerId: string;
+  kind: string;
+}
@@ -0,0 +1,21 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { GrantStateState } from './grantStateState';
+
+/**
+ * The state returned by a target group handler when it provisioned access for a grant.
+ */
+export interface GrantState {
+  requestId: string;
+  /** The handler which provisioned the grant. */
+  handlerId: string;
+  kind: string;
+  /** The state is defined by the handler. */
+  state: GrantStateState;
+  updatedAt: string;
+}
@@ -0,0 +1,13 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { GrantState } from './grantState';
+
+/**
+ * The state of a grant provisioned by a target group handler.
+ */
+export type GrantStateResponseResponse = GrantState;
@@ -0,0 +1,21 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * The change a sync would make to a user or group.
+UPDATE is used for users and groups which would be re-mapped to different groups or users, have their details changed, or be reactivated.
+ */
+export type IdentitySyncChangeAction = typeof IdentitySyncChangeAction[keyof typeof IdentitySyncChangeAction];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const IdentitySyncChangeAction = {
+  CREATE: 'CREATE',
+  UPDATE: 'UPDATE',
+  ARCHIVE: 'ARCHIVE',
+} as const;
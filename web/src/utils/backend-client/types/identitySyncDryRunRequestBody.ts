@@ -0,0 +1,14 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+export type IdentitySyncDryRunRequestBody = {
+  /** A group filter to use in place of the configured identity group filter. Use an empty string to preview removing the filter. */
+  groupFilter?: string;
+  /** The identity provider which the group filter is applied to. Defaults to the identity provider with the highest precedence. */
+  source?: string;
+};
@@ -0,0 +1,21 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { IdentitySyncSource } from './identitySyncSource';
+import type { IdentitySyncUserChange } from './identitySyncUserChange';
+import type { IdentitySyncGroupChange } from './identitySyncGroupChange';
+
+export type IdentitySyncDryRunResponseResponse = {
+  /** The identity provider with the highest precedence. */
+  idpType: string;
+  /** The group filter which was used for the identity provider with the highest precedence. It is empty if groups are not filtered. */
+  groupFilter: string;
+  /** The identity providers which were listed, in order of precedence. */
+  sources: IdentitySyncSource[];
+  users: IdentitySyncUserChange[];
+  groups: IdentitySyncGroupChange[];
+};
@@ -0,0 +1,18 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { IdentitySyncChangeAction } from './identitySyncChangeAction';
+import type { Group } from './group';
+
+export interface IdentitySyncGroupChange {
+  action: IdentitySyncChangeAction;
+  group: Group;
+  /** The IDs of the users who would be added to the group. */
+  addedUsers: string[];
+  /** The IDs of the users who would be removed from the group. */
+  removedUsers: string[];
+}
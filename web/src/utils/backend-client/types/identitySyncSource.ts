@@ -0,0 +1,16 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * An identity provider which users and groups are synced from.
+ */
+export interface IdentitySyncSource {
+  idpType: string;
+  /** The group filter which was used. It is empty if groups are not filtered. */
+  groupFilter: string;
+}
@@ -0,0 +1,18 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { IdentitySyncChangeAction } from './identitySyncChangeAction';
+import type { User } from './user';
+
+export interface IdentitySyncUserChange {
+  action: IdentitySyncChangeAction;
+  user: User;
+  /** The IDs of the groups the user would be added to. */
+  addedGroups: string[];
+  /** The IDs of the groups the user would be removed from. */
+  removedGroups: string[];
+}
@@ -12,6 +12,7 @@ export * from './accessTokenResponseResponse';
 export * from './adminDeleteHandler204';
 export * from './adminListAccessRulesParams';
 export * from './adminListAccessRulesStatus';
+export * from './adminListGrantRetriesParams';
 export * from './adminListGroupsParams';
 export * from './adminListGroupsSource';
 export * from './adminListProviderArgOptionsParams';
@@ -20,9 +21,17 @@ export * from './adminListRequestsStatus';
 export * from './adminListUsersParams';
 export * from './adminRemoveTargetGroupLinkParams';
 export * from './adminUpdateUserBody';
+export * from './approvalCondition';
 export * from './approvalMethod';
+export * from './approvalStage';
 export * from './approverConfig';
 export * from './authUserResponseResponse';
+export * from './breakGlassReview';
+export * from './bulkRevocation';
+export * from './bulkRevocationStatus';
+export * from './bulkRevokeRequestBody';
+export * from './bulkRevokeResponseResponse';
+export * from './bulkRevokeStatus';
 export * from './completeProviderSetupResponseResponse';
 export * from './createAccessRuleRequestBody';
 export * from './createAccessRuleTarget';
@@ -32,6 +41,8 @@ export * from './createAccessRuleTargetWith';
 export * from './createFavoriteRequestBody';
 export * from './createGroupRequestBody';
 export * from './createProviderSetupRequestBody';
+export * from './createRecurringRequestRequestBody';
+export * from './createRequestCommentBody';
 export * from './createRequestRequestBody';
 export * from './createRequestResponseResponse';
 export * from './createRequestWith';
@@ -39,24 +50,47 @@ export * from './createRequestWithSubRequest';
 export * from './createTargetGroupLinkBody';
 export * from './createTargetGroupRequestBody';
 export * from './createUserRequestBody';
+export * from './delegation';
 export * from './deploymentVersionResponseResponse';
 export * from './diagnostic';
+export * from './dryRunRequest';
+export * from './dryRunRequestResponseResponse';
+export * from './dryRunRequestWith';
 export * from './errorResponseResponse';
+export * from './extendRequestBody';
+export * from './extendRequestResponseResponse';
 export * from './favorite';
 export * from './favoriteDetail';
 export * from './grant';
+export * from './grantDriftKind';
+export * from './grantRetry';
+export * from './grantRetryAction';
+export * from './grantRetryStatus';
+export * from './grantRoute';
+export * from './grantState';
+export * from './grantStateResponseResponse';
+export * from './grantStateState';
 export * from './grantStatus';
 export * from './group';
 export * from './identityConfigurationResponseResponse';
+export * from './identitySyncChangeAction';
+export * from './identitySyncDryRunRequestBody';
+export * from './identitySyncDryRunResponseResponse';
+export * from './identitySyncGroupChange';
+export * from './identitySyncSource';
+export * from './identitySyncUserChange';
 export * from './idpStatus';
 export * from './keyValue';
 export * from './listAccessRuleApproversResponseResponse';
 export * from './listAccessRulesDetailResponseResponse';
 export * from './listAccessRulesResponseResponse';
 export * from './listFavoritesResponseResponse';
+export * from './listGrantRetriesResponseResponse';
 export * from './listGroupsResponseResponse';
 export * from './listHandlersResponseResponse';
 export * from './listProviderSetupsResponseResponse';
+export * from './listRecurringRequestsResponseResponse';
+export * from './listRequestCommentsResponseResponse';
 export * from './listRequestEventsResponseResponse';
 export * from './listRequestsResponseResponse';
 export * from './listTargetGroupResponseResponse';
@@ -78,24 +112,36 @@ export * from './providerSetupStepDetails';
 export * from './providerSetupStepOverview';
 export * from './providerSetupValidation';
 export * from './providerSetupValidationStatus';
+export * from './recurrence';
+export * from './recurringRequest';
+export * from './recurringRequestDetailResponseResponse';
+export * from './recurringRequestStatus';
 export * from './registerHandlerRequestBody';
 export * from './request';
 export * from './requestAccessRule';
 export * from './requestAccessRuleTarget';
 export * from './requestAccessRuleTargetArguments';
+export * from './requestApprovalStage';
 export * from './requestArgument';
 export * from './requestArgumentFormElement';
+export * from './requestComment';
 export * from './requestDetail';
 export * from './requestDetailArguments';
 export * from './requestEvent';
 export * from './requestEventFromGrantStatus';
 export * from './requestEventRecordedEvent';
 export * from './requestEventToGrantStatus';
+export * from './requestExtension';
 export * from './requestStatus';
 export * from './requestTiming';
 export * from './reviewDecision';
+export * from './reviewExtensionRequestBody';
 export * from './reviewRequestBody';
 export * from './reviewResponseResponse';
+export * from './routingStrategy';
+export * from './sCIMTokenResponseResponse';
+export * from './separationOfDuties';
+export * from './setDelegateRequestBody';
 export * from './tGHandler';
 export * from './targetArgument';
 export * from './targetArgumentGroup';
@@ -109,11 +155,13 @@ export * from './targetSchema';
 export * from './timeConstraints';
 export * from './user';
 export * from './userCancelRequest200';
+export * from './userListRequestEventsParams';
 export * from './userListRequestsParams';
 export * from './userListRequestsPastParams';
 export * from './userListRequestsStatus';
 export * from './userListRequestsUpcomingParams';
 export * from './userLookupAccessRuleParams';
 export * from './userLookupAccessRuleType';
+export * from './weekday';
 export * from './with';
 export * from './withOption';
\ No newline at end of file
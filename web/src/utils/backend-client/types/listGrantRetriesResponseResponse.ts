@@ -0,0 +1,12 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { GrantRetry } from './grantRetry';
+
+export type ListGrantRetriesResponseResponse = {
+  grantRetries: GrantRetry[];
+};
@@ -0,0 +1,22 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { Weekday } from './weekday';
+
+/**
+ * A weekly schedule for access.
+ */
+export interface Recurrence {
+  /** The days of the week which access starts on. */
+  weekdays: Weekday[];
+  /** The time of day that access starts, in 24 hour HH:MM format. */
+  startTime: string;
+  /** How long access lasts for each occurrence. */
+  durationSeconds: number;
+  /** The IANA time zone that the start time is in. Defaults to UTC. */
+  timezone?: string;
+}
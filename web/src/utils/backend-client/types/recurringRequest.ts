@@ -0,0 +1,27 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+import type { Recurrence } from './recurrence';
+import type { RecurringRequestStatus } from './recurringRequestStatus';
+
+/**
+ * A series of requests which are made on a weekly schedule.
+ */
+export interface RecurringRequest {
+  id: string;
+  requestor: string;
+  accessRuleId: string;
+  reason?: string;
+  recurrence: Recurrence;
+  status: RecurringRequestStatus;
+  /** The start time of the next occurrence, which hasn't been requested yet. */
+  nextOccurrence: string;
+  /** The last time an occurrence of the series was approved by a reviewer. */
+  approvedAt?: string;
+  createdAt: string;
+  updatedAt: string;
+}
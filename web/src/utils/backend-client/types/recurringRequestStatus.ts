@@ -0,0 +1,16 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+export type RecurringRequestStatus = typeof RecurringRequestStatus[keyof typeof RecurringRequestStatus];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const RecurringRequestStatus = {
+  ACTIVE: 'ACTIVE',
+  CANCELLED: 'CANCELLED',
+} as const;
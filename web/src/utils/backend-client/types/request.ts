@@ -25,4 +25,6 @@ export interface Request {
   updatedAt: string;
   grant?: Grant;
   approvalMethod?: ApprovalMethod;
+  /** The ID of the recurring request which created this request, if any. */
+  recurringRequestId?: string;
 }
@@ -0,0 +1,19 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * The approval progress of a stage for an Access Request.
+ */
+export interface RequestApprovalStage {
+  name: string;
+  /** The number of approvals required to complete the stage. */
+  quorum: number;
+  /** The user IDs of the reviewers who approved this stage. */
+  approvedBy: string[];
+  complete: boolean;
+}
@@ -0,0 +1,19 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * A comment in the discussion on an access request.
+ */
+export interface RequestComment {
+  id: string;
+  requestId: string;
+  /** The ID of the user who wrote the comment. */
+  authorId: string;
+  body: string;
+  createdAt: string;
+}
@@ -11,6 +11,9 @@ import type { AccessRule } from './accessRule';
 import type { Grant } from './grant';
 import type { ApprovalMethod } from './approvalMethod';
 import type { RequestDetailArguments } from './requestDetailArguments';
+import type { RequestApprovalStage } from './requestApprovalStage';
+import type { RequestExtension } from './requestExtension';
+import type { BreakGlassReview } from './breakGlassReview';
 
 /**
  * A request to access something made by an end user in Common Fate.
@@ -29,4 +32,8 @@ export interface RequestDetail {
   canReview: boolean;
   approvalMethod?: ApprovalMethod;
   arguments: RequestDetailArguments;
+  /** The approval progress for rules which require more than a single approval. */
+  approvalStages?: RequestApprovalStage[];
+  pendingExtension?: RequestExtension;
+  breakGlassReview?: BreakGlassReview;
 }
@@ -10,6 +10,9 @@ import type { RequestTiming } from './requestTiming';
 import type { RequestEventFromGrantStatus } from './requestEventFromGrantStatus';
 import type { RequestEventToGrantStatus } from './requestEventToGrantStatus';
 import type { RequestEventRecordedEvent } from './requestEventRecordedEvent';
+import type { RequestApprovalStage } from './requestApprovalStage';
+import type { RequestComment } from './requestComment';
+import type { GrantDriftKind } from './grantDriftKind';
 
 export interface RequestEvent {
   id: string;
@@ -29,4 +32,19 @@ export interface RequestEvent {
   grantFailureReason?: string;
   /** An event which was recorded relating to the grant. */
   recordedEvent?: RequestEventRecordedEvent;
+  /** The provider or handler which recorded the event, such as 'ecs-shell'. */
+  recordedEventSource?: string;
+  /** The session which the recorded event occurred in, if the source tracks sessions. */
+  sessionId?: string;
+  approvalStage?: RequestApprovalStage;
+  /** true if the request was approved using break-glass access. */
+  breakGlass?: boolean;
+  comment?: RequestComment;
+  /** If the event was caused by a review made by a delegate, the ID of the user who delegated their reviews. */
+  delegatedFrom?: string;
+  /** true if the reviewers of the pending request were sent a reminder. */
+  reminderSent?: boolean;
+  grantDrift?: GrantDriftKind;
+  /** If the grant was revoked as part of a bulk revocation, the ID of the bulk revocation. */
+  bulkRevocationId?: string;
 }
@@ -0,0 +1,17 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * An extension to an access request which is awaiting approval.
+ */
+export interface RequestExtension {
+  /** The number of seconds the grant will be extended by. */
+  durationSeconds: number;
+  reason?: string;
+  requestedAt: string;
+}
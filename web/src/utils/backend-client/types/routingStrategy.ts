@@ -0,0 +1,23 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * How requests are routed between the valid handlers linked to a target group.
+PRIORITY uses the highest priority route, falling back to lower priority routes if it fails.
+WEIGHTED spreads requests between routes in proportion to their weight, falling back to the other routes if the chosen route fails.
+Target groups without a routing strategy use PRIORITY.
+
+ */
+export type RoutingStrategy = typeof RoutingStrategy[keyof typeof RoutingStrategy];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const RoutingStrategy = {
+  PRIORITY: 'PRIORITY',
+  WEIGHTED: 'WEIGHTED',
+} as const;
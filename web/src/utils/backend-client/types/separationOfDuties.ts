@@ -0,0 +1,21 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * Separation-of-duties constraints which are checked when a request is approved.
+Requestors can never approve their own requests, regardless of these settings.
+
+ */
+export interface SeparationOfDuties {
+  /** Group IDs whose members cannot approve requests made by other members of the same group.
+If the requestor belongs to one of these groups, the request must be approved by a user outside of it.
+ */
+  exclusiveGroups?: string[];
+  /** If set, the maximum number of requests for this rule that a single reviewer may approve in a 24 hour period. */
+  maxApprovalsPerDay?: number;
+}
@@ -0,0 +1,17 @@
+/**
+ * Generated by orval v6.10.3 🍺
+ * Do not edit manually.
+ * Common Fate
+ * Common Fate API
+ * OpenAPI spec version: 1.0
+ */
+
+export type SetDelegateRequestBody = {
+  /** The ID of the user who will review requests on your behalf. */
+  delegateId: string;
+  /** When the delegation starts. Defaults to the current time. */
+  startsAt?: string;
+  /** When the delegation ends. */
+  endsAt: string;
+  reason?: string;
+};
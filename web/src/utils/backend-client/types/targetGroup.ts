@@ -7,12 +7,14 @@
  */
 import type { TargetSchema } from './targetSchema';
 import type { TargetGroupFrom } from './targetGroupFrom';
+import type { RoutingStrategy } from './routingStrategy';
 
 export interface TargetGroup {
   id: string;
   schema: TargetSchema;
   from: TargetGroupFrom;
   icon: string;
+  routingStrategy?: RoutingStrategy;
   createdAt?: string;
   updatedAt?: string;
 }
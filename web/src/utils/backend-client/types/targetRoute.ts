@@ -12,6 +12,8 @@ export interface TargetRoute {
   handlerId: string;
   kind: string;
   priority: number;
+  /** The relative share of requests sent to this route when the target group uses weighted routing. */
+  weight?: number;
   valid: boolean;
   diagnostics: Diagnostic[];
 }
@@ -49,6 +49,10 @@ export const GetIDPName = (idpType: string): string => {
       return "Google Workspace";
     case "one-login":
       return "One Login";
+    case "ldap":
+      return "LDAP";
+    case "scim-client":
+      return "SCIM";
     default:
       return idpType;
   }